@@ -3,14 +3,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
 	initializer "immortal-architecture-clean/backend/internal/driver/initializer/api"
 )
 
+// shutdownTimeout bounds how long in-flight requests may take to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	e, cfg, cleanup, err := initializer.BuildServer(ctx)
 	if err != nil {
 		log.Fatalf("failed to initialize server: %v", err)
@@ -18,8 +29,23 @@ func main() {
 	defer cleanup()
 
 	addr := ":" + strconv.Itoa(cfg.ServerPort)
-	log.Printf("starting HTTP server at %s\n", addr)
-	if err := e.Start(addr); err != nil {
-		log.Fatalf("server exited: %v", err)
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("starting HTTP server at %s\n", addr)
+		errCh <- e.Start(addr)
+	}()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("server exited: %v", err)
+		}
+	case <-ctx.Done():
+		log.Println("shutting down HTTP server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := e.Shutdown(shutdownCtx); err != nil {
+			log.Printf("graceful shutdown failed: %v", err)
+		}
 	}
 }
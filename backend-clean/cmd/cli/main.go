@@ -0,0 +1,46 @@
+// Package main runs administrative commands against the database.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	clicontroller "immortal-architecture-clean/backend/internal/adapter/cli/controller"
+	initializer "immortal-architecture-clean/backend/internal/driver/initializer/cli"
+)
+
+const usage = `usage:
+  cli templates export [-owner ID] [-viewer ID] [-format json|yaml] [-o FILE] [ID...]
+  cli templates import -owner ID [-on-conflict skip|rename|update] [FILE|-]
+`
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 || args[0] != "templates" {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	ctx := context.Background()
+	cmd, cleanup, err := initializer.BuildTemplateBundleCommand(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize: %v\n", err)
+		return 1
+	}
+	defer cleanup()
+
+	if err := cmd.Run(ctx, args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "templates: %v\n", err)
+		if errors.Is(err, clicontroller.ErrUsage) {
+			fmt.Fprint(os.Stderr, usage)
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
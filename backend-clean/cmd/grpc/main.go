@@ -5,12 +5,21 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	initializer "immortal-architecture-clean/backend/internal/driver/initializer/grpc"
 )
 
+// shutdownTimeout bounds how long in-flight calls may take to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	s, cfg, cleanup, err := initializer.BuildServer(ctx)
 	if err != nil {
 		log.Fatalf("failed to initialize gRPC server: %v", err)
@@ -24,8 +33,30 @@ func main() {
 
 	grpcPort := cfg.ServerPort + 1
 	addr := fmt.Sprintf(":%d", grpcPort)
-	log.Printf("starting gRPC server at %s\n", addr)
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("server exited: %v", err)
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("starting gRPC server at %s\n", addr)
+		errCh <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			log.Printf("server exited: %v", err)
+		}
+	case <-ctx.Done():
+		log.Println("shutting down gRPC server")
+		// Watch streams were ended by ctx; force the stop if other calls take too long.
+		stopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			log.Println("graceful shutdown timed out")
+			s.Stop()
+		}
 	}
 }
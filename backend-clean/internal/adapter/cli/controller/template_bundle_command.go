@@ -0,0 +1,150 @@
+// Package controller implements command line controllers.
+package controller
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	clipresenter "immortal-architecture-clean/backend/internal/adapter/cli/presenter"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ErrUsage is returned when a command is called with invalid arguments.
+var ErrUsage = errors.New("invalid usage")
+
+// ErrImportFailed is returned when some templates of an imported bundle were rejected.
+var ErrImportFailed = errors.New("some templates were not imported")
+
+// TemplateBundleCommand runs the "templates export" and "templates import" subcommands.
+type TemplateBundleCommand struct {
+	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort
+	outputFactory func() *clipresenter.TemplateBundlePresenter
+	repoFactory   func() port.TemplateRepository
+	txFactory     func() port.TxManager
+}
+
+// NewTemplateBundleCommand creates TemplateBundleCommand.
+func NewTemplateBundleCommand(
+	inputFactory func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort,
+	outputFactory func() *clipresenter.TemplateBundlePresenter,
+	repoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+) *TemplateBundleCommand {
+	return &TemplateBundleCommand{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+		txFactory:     txFactory,
+	}
+}
+
+// Run dispatches args to the export or import subcommand:
+//
+//	export [-owner ID] [-viewer ID] [-format json|yaml] [-o FILE] [ID...]
+//	import -owner ID [-on-conflict skip|rename|update] [FILE|-]
+//
+// Export writes to stdout unless -o is given; import reads stdin when FILE is "-" or missing.
+func (c *TemplateBundleCommand) Run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: expected export or import", ErrUsage)
+	}
+	switch args[0] {
+	case "export":
+		return c.export(ctx, args[1:], stdout, stderr)
+	case "import":
+		return c.importBundle(ctx, args[1:], stdin, stdout, stderr)
+	default:
+		return fmt.Errorf("%w: unknown subcommand %q", ErrUsage, args[0])
+	}
+}
+
+func (c *TemplateBundleCommand) export(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("templates export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ownerID := fs.String("owner", "", "export every template of this owner when no IDs are given")
+	viewerID := fs.String("viewer", "", "account the export is made for")
+	format := fs.String("format", string(template.BundleFormatJSON), "bundle format: json or yaml")
+	out := fs.String("o", "", "write the bundle to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", ErrUsage, err)
+	}
+	bundleFormat := template.BundleFormat(*format)
+	if err := bundleFormat.Validate(); err != nil {
+		return err
+	}
+
+	input, p := c.newIO()
+	err := input.Export(ctx, port.TemplateBundleExportInput{
+		IDs:      fs.Args(),
+		OwnerID:  *ownerID,
+		ViewerID: *viewerID,
+	})
+	if err != nil {
+		return err
+	}
+	doc, err := p.Document(bundleFormat)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = stdout.Write(doc)
+		return err
+	}
+	return os.WriteFile(*out, doc, 0o600)
+}
+
+func (c *TemplateBundleCommand) importBundle(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("templates import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ownerID := fs.String("owner", "", "account the templates are created for (required)")
+	onConflict := fs.String("on-conflict", string(template.ConflictSkip), "existing template names: skip, rename or update")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", ErrUsage, err)
+	}
+	if fs.NArg() > 1 {
+		return fmt.Errorf("%w: expected at most one file", ErrUsage)
+	}
+
+	src := stdin
+	if path := fs.Arg(0); path != "" && path != "-" {
+		f, err := os.Open(path) //nolint:gosec
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		src = f
+	}
+	content, err := io.ReadAll(io.LimitReader(src, template.MaxBundleSize+1))
+	if err != nil {
+		return err
+	}
+
+	input, p := c.newIO()
+	err = input.Import(ctx, port.TemplateBundleImportInput{
+		OwnerID:    *ownerID,
+		Content:    content,
+		OnConflict: template.ConflictMode(*onConflict),
+	})
+	if err != nil {
+		return err
+	}
+	failed, err := p.WriteSummary(stdout)
+	if err != nil {
+		return err
+	}
+	if failed {
+		return ErrImportFailed
+	}
+	return nil
+}
+
+func (c *TemplateBundleCommand) newIO() (port.TemplateBundleInputPort, *clipresenter.TemplateBundlePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), c.txFactory(), output)
+	return input, output
+}
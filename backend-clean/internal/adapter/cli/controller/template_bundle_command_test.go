@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	clipresenter "immortal-architecture-clean/backend/internal/adapter/cli/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type bundleInputStub struct {
+	err      error
+	output   port.TemplateBundleOutputPort
+	results  []template.BundleImportResult
+	exported port.TemplateBundleExportInput
+	imported port.TemplateBundleImportInput
+}
+
+func (s *bundleInputStub) Export(ctx context.Context, input port.TemplateBundleExportInput) error {
+	s.exported = input
+	if s.err != nil {
+		return s.err
+	}
+	return s.output.PresentTemplateBundle(ctx, template.NewBundle([]template.Template{{Name: "Daily", Fields: []template.Field{{Label: "Body", Order: 1}}}}))
+}
+
+func (s *bundleInputStub) Import(ctx context.Context, input port.TemplateBundleImportInput) error {
+	s.imported = input
+	if s.err != nil {
+		return s.err
+	}
+	return s.output.PresentTemplateBundleImport(ctx, s.results)
+}
+
+func newTemplateBundleTestCommand(input *bundleInputStub) *TemplateBundleCommand {
+	return NewTemplateBundleCommand(
+		func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort {
+			input.output = output
+			return input
+		},
+		clipresenter.NewTemplateBundlePresenter,
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestTemplateBundleCommand_Export(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		inErr      error
+		wantPrefix string
+		wantError  error
+	}{
+		{name: "[Success] yaml to stdout", args: []string{"export", "-format", "yaml", "tpl-1"}, wantPrefix: "version: 1\ntemplates:\n  - name: Daily\n"},
+		{name: "[Success] json by default", args: []string{"export", "-owner", "owner-1"}, wantPrefix: "{\n  \"version\": 1"},
+		{name: "[Fail] unknown format", args: []string{"export", "-format", "xml"}, wantError: domainerr.ErrInvalidBundleFormat},
+		{name: "[Fail] unknown flag", args: []string{"export", "-zip"}, wantError: ErrUsage},
+		{name: "[Fail] unknown subcommand", args: []string{"delete"}, wantError: ErrUsage},
+		{name: "[Fail] use case error", args: []string{"export", "tpl-1"}, inErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &bundleInputStub{err: tt.inErr}
+			cmd := newTemplateBundleTestCommand(input)
+			var stdout, stderr bytes.Buffer
+
+			err := cmd.Run(context.Background(), tt.args, strings.NewReader(""), &stdout, &stderr)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasPrefix(stdout.String(), tt.wantPrefix) {
+				t.Fatalf("stdout = %q", stdout.String())
+			}
+		})
+	}
+}
+
+func TestTemplateBundleCommand_Import(t *testing.T) {
+	tests := []struct {
+		name       string
+		results    []template.BundleImportResult
+		wantOutput string
+		wantError  error
+	}{
+		{
+			name:       "[Success] import from stdin",
+			results:    []template.BundleImportResult{{Name: "Daily (2)", TemplateID: "tpl-2", Action: template.BundleActionRenamed}},
+			wantOutput: "renamed\tDaily (2)\ttpl-2\ncreated 0, renamed 1, updated 0, skipped 0, failed 0\n",
+		},
+		{
+			name:       "[Fail] rejected template",
+			results:    []template.BundleImportResult{{Name: "Broken", Err: domainerr.ErrFieldRequired}},
+			wantOutput: "failed\tBroken\t" + domainerr.ErrFieldRequired.Error() + "\ncreated 0, renamed 0, updated 0, skipped 0, failed 1\n",
+			wantError:  ErrImportFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &bundleInputStub{results: tt.results}
+			cmd := newTemplateBundleTestCommand(input)
+			var stdout, stderr bytes.Buffer
+
+			err := cmd.Run(context.Background(), []string{"import", "-owner", "owner-1", "-on-conflict", "rename", "-"}, strings.NewReader("version: 1\n"), &stdout, &stderr)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if input.imported.OwnerID != "owner-1" || input.imported.OnConflict != template.ConflictRename || string(input.imported.Content) != "version: 1\n" {
+				t.Fatalf("unexpected input: %+v", input.imported)
+			}
+			if stdout.String() != tt.wantOutput {
+				t.Fatalf("stdout = %q, want %q", stdout.String(), tt.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package presenter implements command line output ports.
+package presenter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateBundlePresenter implements port.TemplateBundleOutputPort for the command line.
+type TemplateBundlePresenter struct {
+	bundle  template.Bundle
+	results []template.BundleImportResult
+}
+
+var _ port.TemplateBundleOutputPort = (*TemplateBundlePresenter)(nil)
+
+// NewTemplateBundlePresenter creates a new command line template bundle presenter.
+func NewTemplateBundlePresenter() *TemplateBundlePresenter {
+	return &TemplateBundlePresenter{}
+}
+
+// PresentTemplateBundle stores the bundle to render.
+func (p *TemplateBundlePresenter) PresentTemplateBundle(_ context.Context, bundle template.Bundle) error {
+	p.bundle = bundle
+	return nil
+}
+
+// PresentTemplateBundleImport stores the per-template import results.
+func (p *TemplateBundlePresenter) PresentTemplateBundleImport(_ context.Context, results []template.BundleImportResult) error {
+	p.results = results
+	return nil
+}
+
+// Document returns the bundle rendered in the given format.
+func (p *TemplateBundlePresenter) Document(format template.BundleFormat) ([]byte, error) {
+	return template.EncodeBundle(p.bundle, format)
+}
+
+// WriteSummary writes one line per imported template followed by the counts per action.
+// It reports whether any template failed.
+func (p *TemplateBundlePresenter) WriteSummary(w io.Writer) (bool, error) {
+	counts := make(map[template.BundleAction]int, 4)
+	failed := 0
+	for _, r := range p.results {
+		if r.Err != nil {
+			failed++
+			if _, err := fmt.Fprintf(w, "failed\t%s\t%v\n", r.Name, r.Err); err != nil {
+				return false, err
+			}
+			continue
+		}
+		counts[r.Action]++
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", r.Action, r.Name, r.TemplateID); err != nil {
+			return false, err
+		}
+	}
+	_, err := fmt.Fprintf(w, "created %d, renamed %d, updated %d, skipped %d, failed %d\n",
+		counts[template.BundleActionCreated], counts[template.BundleActionRenamed],
+		counts[template.BundleActionUpdated], counts[template.BundleActionSkipped], failed)
+	return failed > 0, err
+}
@@ -0,0 +1,107 @@
+// Package blob implements the BlobStore port.
+package blob
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// LocalStore stores blobs as files under a root directory.
+type LocalStore struct {
+	root string
+}
+
+var _ port.BlobStore = (*LocalStore)(nil)
+
+// NewLocalStore creates LocalStore, creating the root directory if needed.
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, err
+	}
+	return &LocalStore{root: root}, nil
+}
+
+// Put writes content to a new randomly named file.
+func (s *LocalStore) Put(_ context.Context, content io.Reader) (string, int64, error) {
+	key, err := newKey()
+	if err != nil {
+		return "", 0, err
+	}
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", 0, err
+	}
+	// write to a temp file first so readers never see partial content
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".tmp-*")
+	if err != nil {
+		return "", 0, err
+	}
+	size, err := io.Copy(tmp, content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", 0, err
+	}
+	return key, size, nil
+}
+
+// Open opens the blob for reading.
+func (s *LocalStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	if !validKey(key) {
+		return nil, domainerr.ErrNotFound
+	}
+	f, err := os.Open(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, domainerr.ErrNotFound
+	}
+	return f, err
+}
+
+// Delete removes the blob. Missing blobs are ignored.
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	if !validKey(key) {
+		return nil
+	}
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path shards files by the first two key characters to keep directories small.
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.root, key[:2], key)
+}
+
+const keyBytes = 16
+
+func newKey() (string, error) {
+	b := make([]byte, keyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// validKey rejects anything that is not a generated key, e.g. path traversal attempts.
+func validKey(key string) bool {
+	if len(key) != keyBytes*2 {
+		return false
+	}
+	_, err := hex.DecodeString(key)
+	return err == nil
+}
@@ -0,0 +1,69 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestLocalStore_PutOpenDelete(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	key, size, err := store.Put(ctx, strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if size != 5 || !validKey(key) {
+		t.Fatalf("unexpected key/size: %q %d", key, size)
+	}
+
+	rc, err := store.Open(ctx, key)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	body, _ := io.ReadAll(rc)
+	_ = rc.Close()
+	if string(body) != "hello" {
+		t.Fatalf("body = %q", body)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Open(ctx, key); !errors.Is(err, domainerr.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("delete missing blob should be ignored: %v", err)
+	}
+}
+
+func TestLocalStore_RejectsInvalidKey(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{name: "[Fail] empty key", key: ""},
+		{name: "[Fail] path traversal", key: "../../../../etc/passwd"},
+		{name: "[Fail] non hex key", key: strings.Repeat("z", 32)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := store.Open(context.Background(), tt.key); !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("expected ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Package collabhub implements the CollabHub port in memory.
+package collabhub
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MemoryHub keeps the sessions of this process, one room per note with participants.
+type MemoryHub struct {
+	mu         sync.Mutex
+	now        func() time.Time
+	bufferSize int
+	seq        uint64
+	rooms      map[string]*room
+	closed     bool
+}
+
+type room struct {
+	state *collab.Room
+	subs  map[string]chan collab.Event
+}
+
+var _ port.CollabHub = (*MemoryHub)(nil)
+
+// NewMemoryHub creates MemoryHub buffering up to bufferSize events per connection before dropping it.
+func NewMemoryHub(bufferSize int) *MemoryHub {
+	return &MemoryHub{now: time.Now, bufferSize: bufferSize, rooms: map[string]*room{}}
+}
+
+// Join adds a connection to the note's room and tells the others.
+func (h *MemoryHub) Join(noteID, accountID string) port.CollabMembership {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.seq++
+	connID := "conn-" + strconv.FormatUint(h.seq, 10)
+	ch := make(chan collab.Event, h.bufferSize)
+	if h.closed {
+		close(ch)
+		return port.CollabMembership{ConnID: connID, Events: ch}
+	}
+	r, ok := h.rooms[noteID]
+	if !ok {
+		r = &room{state: collab.NewRoom(), subs: map[string]chan collab.Event{}}
+		h.rooms[noteID] = r
+	}
+	r.state.Join(collab.Participant{ConnID: connID, AccountID: accountID, JoinedAt: h.now()})
+	r.subs[connID] = ch
+	participants := r.state.Participants()
+	h.broadcast(noteID, r, collab.Event{Type: collab.EventPresence, NoteID: noteID, ConnID: connID, AccountID: accountID, Participants: participants}, connID)
+	return port.CollabMembership{ConnID: connID, Participants: participants, Locks: r.state.Locks(), Events: ch}
+}
+
+// Leave removes a connection, releasing its locks.
+func (h *MemoryHub) Leave(noteID, connID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if r, ok := h.rooms[noteID]; ok {
+		h.leave(noteID, r, connID)
+	}
+}
+
+// Lock locks a section for the connection, or renews the lock it holds.
+func (h *MemoryHub) Lock(noteID, sectionID, connID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r, ok := h.rooms[noteID]
+	if !ok {
+		return domainerr.ErrUnauthorized
+	}
+	before := r.state.Participants()
+	l, err := r.state.Acquire(sectionID, connID, h.now())
+	if err != nil {
+		return err
+	}
+	h.broadcast(noteID, r, lockEvent(collab.EventLocked, noteID, l), "")
+	h.presenceIfChanged(noteID, r, before)
+	return nil
+}
+
+// Unlock releases a section the connection holds.
+func (h *MemoryHub) Unlock(noteID, sectionID, connID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r, ok := h.rooms[noteID]
+	if !ok {
+		return domainerr.ErrSectionNotLocked
+	}
+	before := r.state.Participants()
+	l, err := r.state.Release(sectionID, connID, h.now())
+	if err != nil {
+		return err
+	}
+	h.broadcast(noteID, r, lockEvent(collab.EventUnlocked, noteID, l), "")
+	h.presenceIfChanged(noteID, r, before)
+	return nil
+}
+
+// Change renews the connection's lock on a section and sends the content to the other participants.
+func (h *MemoryHub) Change(noteID, sectionID, connID, content string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r, ok := h.rooms[noteID]
+	if !ok || !r.state.Holds(sectionID, connID, h.now()) {
+		return domainerr.ErrSectionNotLocked
+	}
+	l, err := r.state.Acquire(sectionID, connID, h.now())
+	if err != nil {
+		return err
+	}
+	h.broadcast(noteID, r, collab.Event{
+		Type:      collab.EventChanged,
+		NoteID:    noteID,
+		ConnID:    connID,
+		AccountID: l.AccountID,
+		SectionID: sectionID,
+		Content:   content,
+	}, connID)
+	return nil
+}
+
+// Holds reports whether the connection holds the section's lock.
+func (h *MemoryHub) Holds(noteID, sectionID, connID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r, ok := h.rooms[noteID]
+	return ok && r.state.Holds(sectionID, connID, h.now())
+}
+
+// Expire releases the note's locks whose time ran out.
+func (h *MemoryHub) Expire(noteID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r, ok := h.rooms[noteID]
+	if !ok {
+		return
+	}
+	before := r.state.Participants()
+	for _, l := range r.state.Expire(h.now()) {
+		h.broadcast(noteID, r, collab.Event{Type: collab.EventUnlocked, NoteID: noteID, SectionID: l.SectionID}, "")
+	}
+	h.presenceIfChanged(noteID, r, before)
+}
+
+// Broadcast sends an event to every participant of the note.
+func (h *MemoryHub) Broadcast(noteID string, e collab.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if r, ok := h.rooms[noteID]; ok {
+		h.broadcast(noteID, r, e, "")
+	}
+}
+
+// Close ends every connection and refuses new ones.
+func (h *MemoryHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for noteID, r := range h.rooms {
+		for _, ch := range r.subs {
+			close(ch)
+		}
+		delete(h.rooms, noteID)
+	}
+}
+
+// broadcast sends e to the room except one connection. A connection whose buffer is full
+// leaves the room, which releases its locks like a dropped connection.
+func (h *MemoryHub) broadcast(noteID string, r *room, e collab.Event, exceptConnID string) {
+	var dropped []string
+	for connID, ch := range r.subs {
+		if connID == exceptConnID {
+			continue
+		}
+		select {
+		case ch <- e:
+		default:
+			dropped = append(dropped, connID)
+		}
+	}
+	for _, connID := range dropped {
+		h.leave(noteID, r, connID)
+	}
+}
+
+func (h *MemoryHub) leave(noteID string, r *room, connID string) {
+	ch, ok := r.subs[connID]
+	if !ok {
+		return
+	}
+	delete(r.subs, connID)
+	close(ch)
+	accountID := ""
+	for _, p := range r.state.Participants() {
+		if p.ConnID == connID {
+			accountID = p.AccountID
+		}
+	}
+	for _, l := range r.state.Leave(connID) {
+		h.broadcast(noteID, r, lockEvent(collab.EventUnlocked, noteID, l), "")
+	}
+	if r.state.Empty() {
+		delete(h.rooms, noteID)
+		return
+	}
+	h.broadcast(noteID, r, collab.Event{Type: collab.EventPresence, NoteID: noteID, ConnID: connID, AccountID: accountID, Participants: r.state.Participants()}, "")
+}
+
+// presenceIfChanged broadcasts presence when lock changes turned someone from viewing to editing or back.
+func (h *MemoryHub) presenceIfChanged(noteID string, r *room, before []collab.Participant) {
+	after := r.state.Participants()
+	if slices.Equal(before, after) {
+		return
+	}
+	h.broadcast(noteID, r, collab.Event{Type: collab.EventPresence, NoteID: noteID, Participants: after}, "")
+}
+
+func lockEvent(t collab.EventType, noteID string, l collab.Lock) collab.Event {
+	return collab.Event{
+		Type:      t,
+		NoteID:    noteID,
+		ConnID:    l.ConnID,
+		AccountID: l.AccountID,
+		SectionID: l.SectionID,
+		ExpiresAt: l.ExpiresAt,
+	}
+}
@@ -0,0 +1,175 @@
+package collabhub
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestHub(buffer int) (*MemoryHub, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)}
+	h := NewMemoryHub(buffer)
+	h.now = clock.now
+	return h, clock
+}
+
+func drain(ch <-chan collab.Event) []collab.Event {
+	var out []collab.Event
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return out
+			}
+			out = append(out, e)
+		default:
+			return out
+		}
+	}
+}
+
+func types(events []collab.Event) []collab.EventType {
+	out := make([]collab.EventType, 0, len(events))
+	for _, e := range events {
+		out = append(out, e.Type)
+	}
+	return out
+}
+
+func TestMemoryHub_JoinBroadcastsPresence(t *testing.T) {
+	h, _ := newTestHub(16)
+	alice := h.Join("note-1", "alice")
+	bob := h.Join("note-1", "bob")
+	if len(bob.Participants) != 2 || bob.Participants[0].AccountID != "alice" {
+		t.Fatalf("unexpected participants: %+v", bob.Participants)
+	}
+	got := drain(alice.Events)
+	if len(got) != 1 || got[0].Type != collab.EventPresence || got[0].AccountID != "bob" {
+		t.Fatalf("unexpected events: %+v", got)
+	}
+	if len(drain(bob.Events)) != 0 {
+		t.Fatalf("joiner should not receive its own presence")
+	}
+	other := h.Join("note-2", "carol")
+	if len(other.Participants) != 1 {
+		t.Fatalf("rooms leaked across notes: %+v", other.Participants)
+	}
+}
+
+func TestMemoryHub_LockAndChange(t *testing.T) {
+	h, _ := newTestHub(16)
+	alice := h.Join("note-1", "alice")
+	bob := h.Join("note-1", "bob")
+	drain(alice.Events)
+
+	if err := h.Lock("note-1", "s1", alice.ConnID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := h.Lock("note-1", "s1", bob.ConnID); !errors.Is(err, domainerr.ErrSectionLocked) {
+		t.Fatalf("want ErrSectionLocked, got %v", err)
+	}
+	if err := h.Change("note-1", "s1", bob.ConnID, "x"); !errors.Is(err, domainerr.ErrSectionNotLocked) {
+		t.Fatalf("want ErrSectionNotLocked, got %v", err)
+	}
+	if err := h.Change("note-1", "s1", alice.ConnID, "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []collab.EventType{collab.EventLocked, collab.EventPresence, collab.EventChanged}
+	if got := types(drain(bob.Events)); !slices.Equal(got, want) {
+		t.Fatalf("bob: want %v, got %v", want, got)
+	}
+	// the editor does not get its own change back
+	want = []collab.EventType{collab.EventLocked, collab.EventPresence}
+	if got := types(drain(alice.Events)); !slices.Equal(got, want) {
+		t.Fatalf("alice: want %v, got %v", want, got)
+	}
+}
+
+func TestMemoryHub_LeaveReleasesLocks(t *testing.T) {
+	h, _ := newTestHub(16)
+	alice := h.Join("note-1", "alice")
+	bob := h.Join("note-1", "bob")
+	_ = h.Lock("note-1", "s1", alice.ConnID)
+	drain(bob.Events)
+
+	h.Leave("note-1", alice.ConnID)
+	for range alice.Events {
+	}
+	got := drain(bob.Events)
+	if want := []collab.EventType{collab.EventUnlocked, collab.EventPresence}; !slices.Equal(types(got), want) {
+		t.Fatalf("want %v, got %v", want, types(got))
+	}
+	if len(got[1].Participants) != 1 || got[1].AccountID != "alice" {
+		t.Fatalf("unexpected presence: %+v", got[1])
+	}
+	if err := h.Lock("note-1", "s1", bob.ConnID); err != nil {
+		t.Fatalf("released section should be free: %v", err)
+	}
+
+	h.Leave("note-1", bob.ConnID)
+	if len(h.rooms) != 0 {
+		t.Fatalf("empty room was kept")
+	}
+}
+
+func TestMemoryHub_Expire(t *testing.T) {
+	h, clock := newTestHub(16)
+	alice := h.Join("note-1", "alice")
+	_ = h.Lock("note-1", "s1", alice.ConnID)
+	drain(alice.Events)
+
+	clock.t = clock.t.Add(collab.LockTTL - time.Second)
+	h.Expire("note-1")
+	if len(drain(alice.Events)) != 0 {
+		t.Fatalf("lock expired early")
+	}
+	clock.t = clock.t.Add(time.Second)
+	h.Expire("note-1")
+	got := drain(alice.Events)
+	if want := []collab.EventType{collab.EventUnlocked, collab.EventPresence}; !slices.Equal(types(got), want) {
+		t.Fatalf("want %v, got %v", want, types(got))
+	}
+	if got[0].SectionID != "s1" || got[0].ConnID != "" {
+		t.Fatalf("unexpected unlock: %+v", got[0])
+	}
+}
+
+func TestMemoryHub_SlowConnectionIsDropped(t *testing.T) {
+	h, _ := newTestHub(1)
+	slow := h.Join("note-1", "alice")
+	_ = h.Lock("note-1", "s1", slow.ConnID)
+	h.Join("note-1", "bob")
+
+	// the buffer of one held the lock event, so presence overflowed it
+	var n int
+	for range slow.Events {
+		n++
+	}
+	if n != 1 {
+		t.Fatalf("want 1 buffered event, got %d", n)
+	}
+	if h.Holds("note-1", "s1", slow.ConnID) {
+		t.Fatalf("dropped connection kept its lock")
+	}
+}
+
+func TestMemoryHub_Close(t *testing.T) {
+	h, _ := newTestHub(4)
+	m := h.Join("note-1", "alice")
+	h.Close()
+	for range m.Events {
+	}
+	late := h.Join("note-1", "bob")
+	if _, ok := <-late.Events; ok {
+		t.Fatalf("join after close should get a closed channel")
+	}
+}
@@ -47,7 +47,7 @@ func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input accoun
 	if err != nil {
 		return nil, err
 	}
-	return toDomainAccount(row)
+	return withFollowCounts(ctx, q, row)
 }
 
 // GetByID fetches account by ID.
@@ -59,9 +59,12 @@ func (r *AccountRepository) GetByID(ctx context.Context, id string) (*account.Ac
 	}
 	row, err := q.GetAccountByID(ctx, uuid)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
 		return nil, err
 	}
-	return toDomainAccount(row)
+	return withFollowCounts(ctx, q, row)
 }
 
 // GetByEmail fetches account by email.
@@ -74,7 +77,22 @@ func (r *AccountRepository) GetByEmail(ctx context.Context, email string) (*acco
 		}
 		return nil, err
 	}
-	return toDomainAccount(row)
+	return withFollowCounts(ctx, q, row)
+}
+
+// withFollowCounts maps an account row and adds how many accounts follow it and it follows.
+func withFollowCounts(ctx context.Context, q *generated.Queries, row *generated.Account) (*account.Account, error) {
+	a, err := toDomainAccount(row)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := q.CountFollows(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	a.FollowerCount = int(counts.FollowerCount)
+	a.FollowingCount = int(counts.FollowingCount)
+	return a, nil
 }
 
 func toDomainAccount(a *generated.Account) (*account.Account, error) {
@@ -6,11 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
 
 func TestToDomainAccount(t *testing.T) {
@@ -154,17 +156,21 @@ func TestAccountRepository_GetByID(t *testing.T) {
 		{name: "[Success] GetByID returns domain", id: row.ID.String(), row: row},
 		{name: "[Fail] GetByID invalid uuid", id: "not-uuid", row: row, wantErr: true},
 		{name: "[Fail] GetByID query error", id: row.ID.String(), rowErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] GetByID not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewAccountDBTX(tt.row, tt.rowErr)
+			mock := mockdb.NewAccountDBTX(tt.row, tt.rowErr).WithFollowCounts(3, 1)
 			repo := &AccountRepository{queries: generated.New(mock)}
 			acc, err := repo.GetByID(context.Background(), tt.id)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
 				}
+				if errors.Is(tt.rowErr, pgx.ErrNoRows) && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
 				return
 			}
 			if err != nil {
@@ -173,6 +179,9 @@ func TestAccountRepository_GetByID(t *testing.T) {
 			if acc.Email != account.Email(row.Email) {
 				t.Fatalf("email = %s, want %s", acc.Email, row.Email)
 			}
+			if acc.FollowerCount != 3 || acc.FollowingCount != 1 {
+				t.Fatalf("counts = %d/%d, want 3/1", acc.FollowerCount, acc.FollowingCount)
+			}
 		})
 	}
 }
@@ -0,0 +1,133 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AttachmentRepository implements attachment metadata persistence.
+type AttachmentRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.AttachmentRepository = (*AttachmentRepository)(nil)
+
+// NewAttachmentRepository creates AttachmentRepository.
+func NewAttachmentRepository(pool *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Create inserts attachment metadata.
+func (r *AttachmentRepository) Create(ctx context.Context, a attachment.Attachment) (*attachment.Attachment, error) {
+	noteID, err := toUUID(a.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	var sectionID pgtype.UUID
+	if a.SectionID != nil {
+		if sectionID, err = toUUID(*a.SectionID); err != nil {
+			return nil, err
+		}
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateAttachment(ctx, &generated.CreateAttachmentParams{
+		NoteID:      noteID,
+		SectionID:   sectionID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		StorageKey:  a.StorageKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAttachment(row), nil
+}
+
+// Get returns attachment metadata by ID.
+func (r *AttachmentRepository) Get(ctx context.Context, id string) (*attachment.Attachment, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetAttachmentByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toAttachment(row), nil
+}
+
+// ListByNote returns attachments of a note in upload order.
+func (r *AttachmentRepository) ListByNote(ctx context.Context, noteID string) ([]attachment.Attachment, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListAttachmentsByNote(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]attachment.Attachment, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toAttachment(row))
+	}
+	return result, nil
+}
+
+// Delete deletes attachment metadata.
+func (r *AttachmentRepository) Delete(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteAttachment(ctx, pgID)
+}
+
+// UpdateSection links an attachment to another section of its note.
+func (r *AttachmentRepository) UpdateSection(ctx context.Context, id, sectionID string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	secID, err := toUUID(sectionID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).UpdateAttachmentSection(ctx, &generated.UpdateAttachmentSectionParams{
+		ID:        pgID,
+		SectionID: secID,
+	})
+}
+
+func toAttachment(row *generated.Attachment) *attachment.Attachment {
+	var sectionID *string
+	if row.SectionID.Valid {
+		s := uuidToString(row.SectionID)
+		sectionID = &s
+	}
+	return &attachment.Attachment{
+		ID:          uuidToString(row.ID),
+		NoteID:      uuidToString(row.NoteID),
+		SectionID:   sectionID,
+		FileName:    row.FileName,
+		ContentType: row.ContentType,
+		Size:        row.Size,
+		StorageKey:  row.StorageKey,
+		CreatedAt:   timestamptzToTime(row.CreatedAt),
+	}
+}
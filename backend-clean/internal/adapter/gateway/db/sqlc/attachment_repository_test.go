@@ -0,0 +1,185 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestAttachmentRepository_Create(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.Attachment{
+		ID:          pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		NoteID:      pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		SectionID:   pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		FileName:    "a.png",
+		ContentType: "image/png",
+		Size:        3,
+		StorageKey:  "key-1",
+		CreatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	sectionID := row.SectionID.String()
+	badSection := "bad-uuid"
+	tests := []struct {
+		name    string
+		in      attachment.Attachment
+		rowErr  error
+		wantErr bool
+	}{
+		{name: "[Success] create attachment", in: attachment.Attachment{NoteID: row.NoteID.String(), SectionID: &sectionID, FileName: "a.png", ContentType: "image/png", Size: 3, StorageKey: "key-1"}},
+		{name: "[Fail] invalid note uuid", in: attachment.Attachment{NoteID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] invalid section uuid", in: attachment.Attachment{NoteID: row.NoteID.String(), SectionID: &badSection}, wantErr: true},
+		{name: "[Fail] query error", in: attachment.Attachment{NoteID: row.NoteID.String()}, rowErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AttachmentRepository{queries: generated.New(mockdb.NewAttachmentDBTX(row, tt.rowErr, nil))}
+			got, err := repo.Create(context.Background(), tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.StorageKey != "key-1" || got.SectionID == nil || *got.SectionID != sectionID || !got.CreatedAt.Equal(now) {
+				t.Fatalf("unexpected attachment: %+v", got)
+			}
+		})
+	}
+}
+
+func TestAttachmentRepository_Get(t *testing.T) {
+	row := &generated.Attachment{
+		ID:     pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		NoteID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+	}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get attachment", id: row.ID.String()},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AttachmentRepository{queries: generated.New(mockdb.NewAttachmentDBTX(row, tt.rowErr, nil))}
+			got, err := repo.Get(context.Background(), tt.id)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.SectionID != nil {
+				t.Fatalf("expected nil section, got %v", *got.SectionID)
+			}
+		})
+	}
+}
+
+func TestAttachmentRepository_ListByNote(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	list := []*generated.Attachment{
+		{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, NoteID: noteID, StorageKey: "key-1"},
+		{ID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, NoteID: noteID, StorageKey: "key-2"},
+	}
+	tests := []struct {
+		name      string
+		noteID    string
+		queryErr  error
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "[Success] list attachments", noteID: noteID.String(), wantCount: 2},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", noteID: noteID.String(), queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AttachmentRepository{queries: generated.New(mockdb.NewAttachmentDBTX(nil, nil, nil).WithList(list, tt.queryErr))}
+			got, err := repo.ListByNote(context.Background(), tt.noteID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("len = %d, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestAttachmentRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] delete attachment", id: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
+		{name: "[Fail] exec error", id: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String(), execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AttachmentRepository{queries: generated.New(mockdb.NewAttachmentDBTX(nil, nil, tt.execErr))}
+			err := repo.Delete(context.Background(), tt.id)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentRepository_UpdateSection(t *testing.T) {
+	valid := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	tests := []struct {
+		name      string
+		id        string
+		sectionID string
+		execErr   error
+		wantErr   bool
+	}{
+		{name: "[Success] update section", id: valid, sectionID: valid},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", sectionID: valid, wantErr: true},
+		{name: "[Fail] invalid section uuid", id: valid, sectionID: "bad-uuid", wantErr: true},
+		{name: "[Fail] exec error", id: valid, sectionID: valid, execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AttachmentRepository{queries: generated.New(mockdb.NewAttachmentDBTX(nil, nil, tt.execErr))}
+			err := repo.UpdateSection(context.Background(), tt.id, tt.sectionID)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
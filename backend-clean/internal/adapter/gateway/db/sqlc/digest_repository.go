@@ -0,0 +1,155 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DigestRepository implements digest settings persistence.
+type DigestRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.DigestRepository = (*DigestRepository)(nil)
+
+// NewDigestRepository creates DigestRepository.
+func NewDigestRepository(pool *pgxpool.Pool) *DigestRepository {
+	return &DigestRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// GetSettings returns the account's settings, or the default frequency if it never saved any.
+func (r *DigestRepository) GetSettings(ctx context.Context, accountID string) (*digest.Settings, error) {
+	pgID, err := toUUID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetDigestSettings(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &digest.Settings{AccountID: accountID, Frequency: digest.DefaultFrequency}, nil
+		}
+		return nil, err
+	}
+	return toDigestSettings(row), nil
+}
+
+// SaveFrequency changes how often the account receives its digest.
+func (r *DigestRepository) SaveFrequency(ctx context.Context, accountID string, f digest.Frequency) (*digest.Settings, error) {
+	pgID, err := toUUID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpsertDigestFrequency(ctx, &generated.UpsertDigestFrequencyParams{
+		AccountID: pgID,
+		Frequency: string(f),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDigestSettings(row), nil
+}
+
+// ListDue returns settings of active accounts whose digest is due at now.
+func (r *DigestRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]digest.Settings, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListDueDigests(ctx, &generated.ListDueDigestsParams{
+		DefaultFrequency: string(digest.DefaultFrequency),
+		DailyBefore:      pgtype.Timestamptz{Time: now.Add(-digest.FrequencyDaily.Period()), Valid: true},
+		WeeklyBefore:     pgtype.Timestamptz{Time: now.Add(-digest.FrequencyWeekly.Period()), Valid: true},
+		PageLimit:        int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	settings := make([]digest.Settings, 0, len(rows))
+	for _, row := range rows {
+		settings = append(settings, digest.Settings{
+			AccountID:  uuidToString(row.AccountID),
+			Frequency:  digest.Frequency(row.Frequency),
+			LastSentAt: timestamptzToTimePtr(row.LastSentAt),
+		})
+	}
+	return settings, nil
+}
+
+// Claim records the digest as sent unless another worker already moved last_sent_at.
+func (r *DigestRepository) Claim(ctx context.Context, s digest.Settings, at time.Time) (bool, error) {
+	pgID, err := toUUID(s.AccountID)
+	if err != nil {
+		return false, err
+	}
+	n, err := queriesForContext(ctx, r.queries).ClaimDigest(ctx, &generated.ClaimDigestParams{
+		AccountID:      pgID,
+		Frequency:      string(s.Frequency),
+		SentAt:         pgtype.Timestamptz{Time: at, Valid: true},
+		PreviousSentAt: pgNullableTime(s.LastSentAt),
+	})
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// Release puts last_sent_at back if it is still the claimed time.
+func (r *DigestRepository) Release(ctx context.Context, s digest.Settings, at time.Time) error {
+	pgID, err := toUUID(s.AccountID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).ReleaseDigest(ctx, &generated.ReleaseDigestParams{
+		AccountID:      pgID,
+		SentAt:         pgtype.Timestamptz{Time: at, Valid: true},
+		PreviousSentAt: pgNullableTime(s.LastSentAt),
+	})
+}
+
+// ListStaleDrafts returns the owner's drafts last updated before the given time, without sections.
+func (r *DigestRepository) ListStaleDrafts(ctx context.Context, ownerID string, before time.Time, limit int) ([]note.Note, error) {
+	pgID, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListStaleDrafts(ctx, &generated.ListStaleDraftsParams{
+		OwnerID:       pgID,
+		UpdatedBefore: pgtype.Timestamptz{Time: before, Valid: true},
+		PageLimit:     int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	drafts := make([]note.Note, 0, len(rows))
+	for _, row := range rows {
+		drafts = append(drafts, note.Note{
+			ID:         uuidToString(row.ID),
+			Title:      row.Title,
+			TemplateID: uuidToString(row.TemplateID),
+			OwnerID:    uuidToString(row.OwnerID),
+			Status:     note.NoteStatus(row.Status),
+			CreatedAt:  timestamptzToTime(row.CreatedAt),
+			UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		})
+	}
+	return drafts, nil
+}
+
+func toDigestSettings(row *generated.DigestSetting) *digest.Settings {
+	return &digest.Settings{
+		AccountID:  uuidToString(row.AccountID),
+		Frequency:  digest.Frequency(row.Frequency),
+		LastSentAt: timestamptzToTimePtr(row.LastSentAt),
+		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+	}
+}
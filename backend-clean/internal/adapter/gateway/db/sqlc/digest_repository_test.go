@@ -0,0 +1,170 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestDigestRepository_GetSettings(t *testing.T) {
+	accountUUID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	accountID := accountUUID.String()
+	sentAt := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	saved := &generated.DigestSetting{
+		AccountID:  accountUUID,
+		Frequency:  string(digest.FrequencyDaily),
+		LastSentAt: pgtype.Timestamptz{Time: sentAt, Valid: true},
+		UpdatedAt:  pgtype.Timestamptz{Time: sentAt, Valid: true},
+	}
+	tests := []struct {
+		name          string
+		accountID     string
+		row           *generated.DigestSetting
+		rowErr        error
+		wantFrequency digest.Frequency
+		wantSent      bool
+		wantErr       bool
+	}{
+		{name: "[Success] saved settings", accountID: accountID, row: saved, wantFrequency: digest.FrequencyDaily, wantSent: true},
+		{name: "[Success] never saved gets default", accountID: accountID, rowErr: pgx.ErrNoRows, wantFrequency: digest.DefaultFrequency},
+		{name: "[Fail] invalid id", accountID: "bad", wantErr: true},
+		{name: "[Fail] db error", accountID: accountID, rowErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DigestRepository{queries: generated.New(mockdb.NewDigestDBTX(tt.row, tt.rowErr, nil))}
+			got, err := repo.GetSettings(context.Background(), tt.accountID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.AccountID != accountID || got.Frequency != tt.wantFrequency || (got.LastSentAt != nil) != tt.wantSent {
+				t.Fatalf("unexpected settings: %+v", got)
+			}
+		})
+	}
+}
+
+func TestDigestRepository_ListDue(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	due := []*generated.ListDueDigestsRow{
+		{AccountID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Frequency: "weekly"},
+		{AccountID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Frequency: "daily", LastSentAt: pgtype.Timestamptz{Time: now.Add(-25 * time.Hour), Valid: true}},
+	}
+	mock := mockdb.NewDigestDBTX(nil, nil, nil).WithDue(due, nil)
+	repo := &DigestRepository{queries: generated.New(mock)}
+
+	got, err := repo.ListDue(context.Background(), now, 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0].LastSentAt != nil || got[1].Frequency != digest.FrequencyDaily || got[1].LastSentAt == nil {
+		t.Fatalf("unexpected settings: %+v", got)
+	}
+	args := mock.QueryArgs[0]
+	if args[0] != string(digest.DefaultFrequency) {
+		t.Fatalf("default frequency = %v", args[0])
+	}
+	if daily := args[1].(pgtype.Timestamptz); !daily.Time.Equal(now.Add(-24 * time.Hour)) {
+		t.Fatalf("daily cutoff = %v", daily.Time)
+	}
+
+	failing := &DigestRepository{queries: generated.New(mockdb.NewDigestDBTX(nil, nil, nil).WithDue(nil, errors.New("db error")))}
+	if _, err := failing.ListDue(context.Background(), now, 20); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestDigestRepository_Claim(t *testing.T) {
+	accountID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	at := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		settings digest.Settings
+		affected int64
+		execErr  error
+		want     bool
+		wantErr  bool
+	}{
+		{name: "[Success] claimed", settings: digest.Settings{AccountID: accountID, Frequency: digest.FrequencyWeekly}, affected: 1, want: true},
+		{name: "[Success] claimed by another worker", settings: digest.Settings{AccountID: accountID, Frequency: digest.FrequencyWeekly}},
+		{name: "[Fail] invalid id", settings: digest.Settings{AccountID: "bad"}, wantErr: true},
+		{name: "[Fail] exec error", settings: digest.Settings{AccountID: accountID}, execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewDigestDBTX(nil, nil, tt.execErr).WithAffected(tt.affected)
+			repo := &DigestRepository{queries: generated.New(mock)}
+			got, err := repo.Claim(context.Background(), tt.settings, at)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Fatalf("claimed = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr {
+				return
+			}
+			if prev := mock.ExecArgs[0][3].(pgtype.Timestamptz); prev.Valid {
+				t.Fatalf("previous sent at should be NULL for a digest never sent: %+v", prev)
+			}
+		})
+	}
+}
+
+func TestDigestRepository_Release(t *testing.T) {
+	accountID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	prev := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	mock := mockdb.NewDigestDBTX(nil, nil, nil)
+	repo := &DigestRepository{queries: generated.New(mock)}
+
+	if err := repo.Release(context.Background(), digest.Settings{AccountID: accountID, LastSentAt: &prev}, prev.Add(7*24*time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restored := mock.ExecArgs[0][0].(pgtype.Timestamptz); !restored.Valid || !restored.Time.Equal(prev) {
+		t.Fatalf("unexpected restored time: %+v", restored)
+	}
+}
+
+func TestDigestRepository_ListStaleDrafts(t *testing.T) {
+	owner := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	updated := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+	drafts := []*generated.ListStaleDraftsRow{{
+		ID:         pgtype.UUID{Bytes: [16]byte{5}, Valid: true},
+		Title:      "Half-written ADR",
+		TemplateID: pgtype.UUID{Bytes: [16]byte{6}, Valid: true},
+		OwnerID:    owner,
+		Status:     string(note.StatusDraft),
+		CreatedAt:  pgtype.Timestamptz{Time: updated, Valid: true},
+		UpdatedAt:  pgtype.Timestamptz{Time: updated, Valid: true},
+	}}
+	repo := &DigestRepository{queries: generated.New(mockdb.NewDigestDBTX(nil, nil, nil).WithDrafts(drafts))}
+
+	got, err := repo.ListStaleDrafts(context.Background(), owner.String(), updated.Add(time.Hour), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Title != "Half-written ADR" || got[0].Status != note.StatusDraft || got[0].OwnerID != owner.String() || !got[0].UpdatedAt.Equal(updated) {
+		t.Fatalf("unexpected drafts: %+v", got)
+	}
+
+	if _, err := repo.ListStaleDrafts(context.Background(), "bad", updated, 10); err == nil {
+		t.Fatal("expected error for invalid owner")
+	}
+}
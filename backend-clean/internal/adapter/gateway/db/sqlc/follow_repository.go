@@ -0,0 +1,132 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// FollowRepository implements follow and feed item persistence.
+type FollowRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.FollowRepository = (*FollowRepository)(nil)
+
+// NewFollowRepository creates FollowRepository.
+func NewFollowRepository(pool *pgxpool.Pool) *FollowRepository {
+	return &FollowRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Follow records that the follower follows the followee.
+func (r *FollowRepository) Follow(ctx context.Context, followerID, followeeID string) error {
+	follower, followee, err := toFollowIDs(followerID, followeeID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).CreateFollow(ctx, &generated.CreateFollowParams{FollowerID: follower, FolloweeID: followee})
+}
+
+// Unfollow removes the follow.
+func (r *FollowRepository) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	follower, followee, err := toFollowIDs(followerID, followeeID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteFollow(ctx, &generated.DeleteFollowParams{FollowerID: follower, FolloweeID: followee})
+}
+
+// RecordFeedItem stores the item as the latest activity on its subject.
+func (r *FollowRepository) RecordFeedItem(ctx context.Context, item feed.Item) error {
+	subjectID, err := toUUID(item.SubjectID)
+	if err != nil {
+		return err
+	}
+	authorID, err := toUUID(item.AuthorID)
+	if err != nil {
+		return err
+	}
+	var templateID pgtype.UUID
+	if item.TemplateID != "" {
+		if templateID, err = toUUID(item.TemplateID); err != nil {
+			return err
+		}
+	}
+	return queriesForContext(ctx, r.queries).UpsertFeedItem(ctx, &generated.UpsertFeedItemParams{
+		SubjectID:  subjectID,
+		Kind:       string(item.Kind),
+		AuthorID:   authorID,
+		Title:      item.Title,
+		TemplateID: templateID,
+		OccurredAt: pgtype.Timestamptz{Time: item.OccurredAt, Valid: true},
+	})
+}
+
+// RemoveFeedItem removes the subject from the feed.
+func (r *FollowRepository) RemoveFeedItem(ctx context.Context, subjectID string) error {
+	id, err := toUUID(subjectID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteFeedItem(ctx, id)
+}
+
+// ListFeed returns items of the accounts the follower follows, newest first.
+func (r *FollowRepository) ListFeed(ctx context.Context, followerID string, after *feed.Cursor, limit int) ([]feed.Item, error) {
+	follower, err := toUUID(followerID)
+	if err != nil {
+		return nil, err
+	}
+	params := &generated.ListFeedParams{FollowerID: follower, PageLimit: int32(limit)} //nolint:gosec
+	if after != nil {
+		cursorID, err := toUUID(after.SubjectID)
+		if err != nil {
+			return nil, err
+		}
+		params.HasCursor = true
+		params.CursorAt = pgtype.Timestamptz{Time: after.OccurredAt, Valid: true}
+		params.CursorID = cursorID
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListFeed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]feed.Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, feed.Item{
+			SubjectID:  uuidToString(row.SubjectID),
+			Kind:       feed.Kind(row.Kind),
+			AuthorID:   uuidToString(row.AuthorID),
+			Title:      row.Title,
+			TemplateID: uuidToString(row.TemplateID),
+			OccurredAt: timestamptzToTime(row.OccurredAt),
+			Author: feed.Author{
+				FirstName: row.AuthorFirstName,
+				LastName:  row.AuthorLastName,
+				Thumbnail: nullableTextToString(row.AuthorThumbnail),
+			},
+		})
+	}
+	return items, nil
+}
+
+func toFollowIDs(followerID, followeeID string) (pgtype.UUID, pgtype.UUID, error) {
+	follower, err := toUUID(followerID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, err
+	}
+	followee, err := toUUID(followeeID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, err
+	}
+	return follower, followee, nil
+}
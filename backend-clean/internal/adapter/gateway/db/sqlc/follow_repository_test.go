@@ -0,0 +1,135 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+)
+
+func TestFollowRepository_Follow(t *testing.T) {
+	follower := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	followee := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	tests := []struct {
+		name      string
+		followee  string
+		execErr   error
+		wantExecs int
+		wantErr   bool
+	}{
+		{name: "[Success] follow", followee: followee, wantExecs: 1},
+		{name: "[Fail] invalid followee", followee: "bad", wantErr: true},
+		{name: "[Fail] exec error", followee: followee, execErr: errors.New("db error"), wantExecs: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewFollowDBTX(nil, tt.execErr, nil)
+			repo := &FollowRepository{queries: generated.New(mock)}
+			err := repo.Follow(context.Background(), follower, tt.followee)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(mock.ExecArgs) != tt.wantExecs {
+				t.Fatalf("execs = %d, want %d", len(mock.ExecArgs), tt.wantExecs)
+			}
+		})
+	}
+}
+
+func TestFollowRepository_RecordFeedItem(t *testing.T) {
+	valid := feed.Item{
+		SubjectID:  pgtype.UUID{Bytes: [16]byte{3}, Valid: true}.String(),
+		Kind:       feed.KindTemplateCreated,
+		AuthorID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String(),
+		Title:      "ADR template",
+		OccurredAt: time.Now(),
+	}
+	tests := []struct {
+		name         string
+		edit         func(i *feed.Item)
+		wantTemplate bool
+		wantErr      bool
+	}{
+		{name: "[Success] template without template id", edit: func(*feed.Item) {}},
+		{name: "[Success] note with template id", edit: func(i *feed.Item) {
+			i.Kind = feed.KindNotePublished
+			i.TemplateID = pgtype.UUID{Bytes: [16]byte{4}, Valid: true}.String()
+		}, wantTemplate: true},
+		{name: "[Fail] invalid author", edit: func(i *feed.Item) { i.AuthorID = "" }, wantErr: true},
+		{name: "[Fail] invalid template id", edit: func(i *feed.Item) { i.TemplateID = "bad" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := valid
+			tt.edit(&item)
+			mock := mockdb.NewFollowDBTX(nil, nil, nil)
+			repo := &FollowRepository{queries: generated.New(mock)}
+			err := repo.RecordFeedItem(context.Background(), item)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			templateID, ok := mock.ExecArgs[0][4].(pgtype.UUID)
+			if !ok || templateID.Valid != tt.wantTemplate {
+				t.Fatalf("unexpected template id: %v", mock.ExecArgs[0][4])
+			}
+		})
+	}
+}
+
+func TestFollowRepository_ListFeed(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	follower := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	rows := []*generated.ListFeedRow{{
+		SubjectID:       pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Kind:            string(feed.KindNotePublished),
+		AuthorID:        pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Title:           "ADR",
+		TemplateID:      pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		OccurredAt:      pgtype.Timestamptz{Time: now, Valid: true},
+		AuthorFirstName: "Taro",
+		AuthorThumbnail: pgtype.Text{String: "thumb", Valid: true},
+	}}
+	tests := []struct {
+		name       string
+		after      *feed.Cursor
+		queryErr   error
+		wantCursor bool
+		wantErr    bool
+	}{
+		{name: "[Success] first page", wantCursor: false},
+		{name: "[Success] page after cursor", after: &feed.Cursor{OccurredAt: now, SubjectID: rows[0].SubjectID.String()}, wantCursor: true},
+		{name: "[Fail] invalid cursor subject", after: &feed.Cursor{OccurredAt: now, SubjectID: "bad"}, wantErr: true},
+		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewFollowDBTX(rows, nil, tt.queryErr)
+			repo := &FollowRepository{queries: generated.New(mock)}
+			got, err := repo.ListFeed(context.Background(), follower, tt.after, 21)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 1 || got[0].Kind != feed.KindNotePublished || got[0].Author.FirstName != "Taro" || got[0].Author.Thumbnail != "thumb" || got[0].TemplateID == "" {
+				t.Fatalf("unexpected items: %+v", got)
+			}
+			if args := mock.QueryArgs[0]; args[1] != tt.wantCursor || args[4] != int32(21) {
+				t.Fatalf("unexpected args: %v", args)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: attachments.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAttachment = `-- name: CreateAttachment :one
+INSERT INTO attachments (note_id, section_id, file_name, content_type, size, storage_key)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, note_id, section_id, file_name, content_type, size, storage_key, created_at
+`
+
+type CreateAttachmentParams struct {
+	NoteID      pgtype.UUID `db:"note_id" json:"note_id"`
+	SectionID   pgtype.UUID `db:"section_id" json:"section_id"`
+	FileName    string      `db:"file_name" json:"file_name"`
+	ContentType string      `db:"content_type" json:"content_type"`
+	Size        int64       `db:"size" json:"size"`
+	StorageKey  string      `db:"storage_key" json:"storage_key"`
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg *CreateAttachmentParams) (*Attachment, error) {
+	row := q.db.QueryRow(ctx, createAttachment,
+		arg.NoteID,
+		arg.SectionID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+	)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SectionID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const deleteAttachment = `-- name: DeleteAttachment :exec
+DELETE FROM attachments
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAttachment(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAttachment, id)
+	return err
+}
+
+const getAttachmentByID = `-- name: GetAttachmentByID :one
+SELECT id, note_id, section_id, file_name, content_type, size, storage_key, created_at
+FROM attachments
+WHERE id = $1
+`
+
+func (q *Queries) GetAttachmentByID(ctx context.Context, id pgtype.UUID) (*Attachment, error) {
+	row := q.db.QueryRow(ctx, getAttachmentByID, id)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SectionID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listAttachmentsByNote = `-- name: ListAttachmentsByNote :many
+SELECT id, note_id, section_id, file_name, content_type, size, storage_key, created_at
+FROM attachments
+WHERE note_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListAttachmentsByNote(ctx context.Context, noteID pgtype.UUID) ([]*Attachment, error) {
+	rows, err := q.db.Query(ctx, listAttachmentsByNote, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.SectionID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAttachmentSection = `-- name: UpdateAttachmentSection :exec
+UPDATE attachments
+SET section_id = $2
+WHERE id = $1
+`
+
+type UpdateAttachmentSectionParams struct {
+	ID        pgtype.UUID `db:"id" json:"id"`
+	SectionID pgtype.UUID `db:"section_id" json:"section_id"`
+}
+
+func (q *Queries) UpdateAttachmentSection(ctx context.Context, arg *UpdateAttachmentSectionParams) error {
+	_, err := q.db.Exec(ctx, updateAttachmentSection, arg.ID, arg.SectionID)
+	return err
+}
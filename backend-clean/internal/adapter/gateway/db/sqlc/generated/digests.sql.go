@@ -0,0 +1,217 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: digests.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDigest = `-- name: ClaimDigest :execrows
+INSERT INTO digest_settings (account_id, frequency, last_sent_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (account_id) DO UPDATE
+SET last_sent_at = EXCLUDED.last_sent_at
+WHERE digest_settings.last_sent_at IS NOT DISTINCT FROM $4::timestamptz
+`
+
+type ClaimDigestParams struct {
+	AccountID      pgtype.UUID        `db:"account_id" json:"account_id"`
+	Frequency      string             `db:"frequency" json:"frequency"`
+	SentAt         pgtype.Timestamptz `db:"sent_at" json:"sent_at"`
+	PreviousSentAt pgtype.Timestamptz `db:"previous_sent_at" json:"previous_sent_at"`
+}
+
+// Moves last_sent_at forward only if no other worker did since the due list was read.
+func (q *Queries) ClaimDigest(ctx context.Context, arg *ClaimDigestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimDigest,
+		arg.AccountID,
+		arg.Frequency,
+		arg.SentAt,
+		arg.PreviousSentAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getDigestSettings = `-- name: GetDigestSettings :one
+SELECT account_id, frequency, last_sent_at, updated_at
+FROM digest_settings
+WHERE account_id = $1
+`
+
+func (q *Queries) GetDigestSettings(ctx context.Context, accountID pgtype.UUID) (*DigestSetting, error) {
+	row := q.db.QueryRow(ctx, getDigestSettings, accountID)
+	var i DigestSetting
+	err := row.Scan(
+		&i.AccountID,
+		&i.Frequency,
+		&i.LastSentAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const listDueDigests = `-- name: ListDueDigests :many
+SELECT
+    a.id AS account_id,
+    COALESCE(d.frequency, $1::text)::text AS frequency,
+    d.last_sent_at
+FROM accounts a
+LEFT JOIN digest_settings d ON d.account_id = a.id
+WHERE a.is_active
+  AND COALESCE(d.frequency, $1::text) <> 'off'
+  AND (
+    d.last_sent_at IS NULL
+    OR (COALESCE(d.frequency, $1::text) = 'daily' AND d.last_sent_at <= $2::timestamptz)
+    OR (COALESCE(d.frequency, $1::text) = 'weekly' AND d.last_sent_at <= $3::timestamptz)
+  )
+ORDER BY d.last_sent_at NULLS FIRST, a.id
+LIMIT $4
+`
+
+type ListDueDigestsParams struct {
+	DefaultFrequency string             `db:"default_frequency" json:"default_frequency"`
+	DailyBefore      pgtype.Timestamptz `db:"daily_before" json:"daily_before"`
+	WeeklyBefore     pgtype.Timestamptz `db:"weekly_before" json:"weekly_before"`
+	PageLimit        int32              `db:"page_limit" json:"page_limit"`
+}
+
+type ListDueDigestsRow struct {
+	AccountID  pgtype.UUID        `db:"account_id" json:"account_id"`
+	Frequency  string             `db:"frequency" json:"frequency"`
+	LastSentAt pgtype.Timestamptz `db:"last_sent_at" json:"last_sent_at"`
+}
+
+// Active accounts whose digest period has passed, those never sent one first.
+// Accounts without settings use the default frequency.
+func (q *Queries) ListDueDigests(ctx context.Context, arg *ListDueDigestsParams) ([]*ListDueDigestsRow, error) {
+	rows, err := q.db.Query(ctx, listDueDigests,
+		arg.DefaultFrequency,
+		arg.DailyBefore,
+		arg.WeeklyBefore,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListDueDigestsRow
+	for rows.Next() {
+		var i ListDueDigestsRow
+		if err := rows.Scan(&i.AccountID, &i.Frequency, &i.LastSentAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleDrafts = `-- name: ListStaleDrafts :many
+SELECT id, title, template_id, owner_id, status, created_at, updated_at
+FROM notes
+WHERE owner_id = $1
+  AND status = 'Draft'
+  AND updated_at <= $2::timestamptz
+ORDER BY updated_at, id
+LIMIT $3
+`
+
+type ListStaleDraftsParams struct {
+	OwnerID       pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	UpdatedBefore pgtype.Timestamptz `db:"updated_before" json:"updated_before"`
+	PageLimit     int32              `db:"page_limit" json:"page_limit"`
+}
+
+type ListStaleDraftsRow struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	Title      string             `db:"title" json:"title"`
+	TemplateID pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID    pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status     string             `db:"status" json:"status"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) ListStaleDrafts(ctx context.Context, arg *ListStaleDraftsParams) ([]*ListStaleDraftsRow, error) {
+	rows, err := q.db.Query(ctx, listStaleDrafts, arg.OwnerID, arg.UpdatedBefore, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStaleDraftsRow
+	for rows.Next() {
+		var i ListStaleDraftsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OwnerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseDigest = `-- name: ReleaseDigest :exec
+UPDATE digest_settings
+SET last_sent_at = $1::timestamptz
+WHERE account_id = $2
+  AND last_sent_at = $3::timestamptz
+`
+
+type ReleaseDigestParams struct {
+	PreviousSentAt pgtype.Timestamptz `db:"previous_sent_at" json:"previous_sent_at"`
+	AccountID      pgtype.UUID        `db:"account_id" json:"account_id"`
+	SentAt         pgtype.Timestamptz `db:"sent_at" json:"sent_at"`
+}
+
+// Gives a claimed digest back when it could not be sent.
+func (q *Queries) ReleaseDigest(ctx context.Context, arg *ReleaseDigestParams) error {
+	_, err := q.db.Exec(ctx, releaseDigest, arg.PreviousSentAt, arg.AccountID, arg.SentAt)
+	return err
+}
+
+const upsertDigestFrequency = `-- name: UpsertDigestFrequency :one
+INSERT INTO digest_settings (account_id, frequency)
+VALUES ($1, $2)
+ON CONFLICT (account_id) DO UPDATE
+SET
+    frequency = EXCLUDED.frequency,
+    updated_at = NOW()
+RETURNING account_id, frequency, last_sent_at, updated_at
+`
+
+type UpsertDigestFrequencyParams struct {
+	AccountID pgtype.UUID `db:"account_id" json:"account_id"`
+	Frequency string      `db:"frequency" json:"frequency"`
+}
+
+func (q *Queries) UpsertDigestFrequency(ctx context.Context, arg *UpsertDigestFrequencyParams) (*DigestSetting, error) {
+	row := q.db.QueryRow(ctx, upsertDigestFrequency, arg.AccountID, arg.Frequency)
+	var i DigestSetting
+	err := row.Scan(
+		&i.AccountID,
+		&i.Frequency,
+		&i.LastSentAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,185 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: follows.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countFollows = `-- name: CountFollows :one
+SELECT
+    (SELECT COUNT(*) FROM follows WHERE followee_id = $1::uuid)::bigint AS follower_count,
+    (SELECT COUNT(*) FROM follows WHERE follower_id = $1::uuid)::bigint AS following_count
+`
+
+type CountFollowsRow struct {
+	FollowerCount  int64 `db:"follower_count" json:"follower_count"`
+	FollowingCount int64 `db:"following_count" json:"following_count"`
+}
+
+func (q *Queries) CountFollows(ctx context.Context, accountID pgtype.UUID) (*CountFollowsRow, error) {
+	row := q.db.QueryRow(ctx, countFollows, accountID)
+	var i CountFollowsRow
+	err := row.Scan(&i.FollowerCount, &i.FollowingCount)
+	return &i, err
+}
+
+const createFollow = `-- name: CreateFollow :exec
+INSERT INTO follows (follower_id, followee_id)
+VALUES ($1, $2)
+ON CONFLICT (follower_id, followee_id) DO NOTHING
+`
+
+type CreateFollowParams struct {
+	FollowerID pgtype.UUID `db:"follower_id" json:"follower_id"`
+	FolloweeID pgtype.UUID `db:"followee_id" json:"followee_id"`
+}
+
+func (q *Queries) CreateFollow(ctx context.Context, arg *CreateFollowParams) error {
+	_, err := q.db.Exec(ctx, createFollow, arg.FollowerID, arg.FolloweeID)
+	return err
+}
+
+const deleteFeedItem = `-- name: DeleteFeedItem :exec
+DELETE FROM feed_items
+WHERE subject_id = $1
+`
+
+func (q *Queries) DeleteFeedItem(ctx context.Context, subjectID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteFeedItem, subjectID)
+	return err
+}
+
+const deleteFollow = `-- name: DeleteFollow :exec
+DELETE FROM follows
+WHERE follower_id = $1
+  AND followee_id = $2
+`
+
+type DeleteFollowParams struct {
+	FollowerID pgtype.UUID `db:"follower_id" json:"follower_id"`
+	FolloweeID pgtype.UUID `db:"followee_id" json:"followee_id"`
+}
+
+func (q *Queries) DeleteFollow(ctx context.Context, arg *DeleteFollowParams) error {
+	_, err := q.db.Exec(ctx, deleteFollow, arg.FollowerID, arg.FolloweeID)
+	return err
+}
+
+const listFeed = `-- name: ListFeed :many
+SELECT
+    fi.subject_id,
+    fi.kind,
+    fi.author_id,
+    fi.title,
+    fi.template_id,
+    fi.occurred_at,
+    a.first_name AS author_first_name,
+    a.last_name AS author_last_name,
+    a.thumbnail AS author_thumbnail
+FROM feed_items fi
+JOIN follows f ON f.followee_id = fi.author_id
+JOIN accounts a ON a.id = fi.author_id
+WHERE f.follower_id = $1
+  AND (
+    NOT $2::boolean
+    OR (fi.occurred_at, fi.subject_id) < ($3::timestamptz, $4::uuid)
+  )
+ORDER BY fi.occurred_at DESC, fi.subject_id DESC
+LIMIT $5
+`
+
+type ListFeedParams struct {
+	FollowerID pgtype.UUID        `db:"follower_id" json:"follower_id"`
+	HasCursor  bool               `db:"has_cursor" json:"has_cursor"`
+	CursorAt   pgtype.Timestamptz `db:"cursor_at" json:"cursor_at"`
+	CursorID   pgtype.UUID        `db:"cursor_id" json:"cursor_id"`
+	PageLimit  int32              `db:"page_limit" json:"page_limit"`
+}
+
+type ListFeedRow struct {
+	SubjectID       pgtype.UUID        `db:"subject_id" json:"subject_id"`
+	Kind            string             `db:"kind" json:"kind"`
+	AuthorID        pgtype.UUID        `db:"author_id" json:"author_id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OccurredAt      pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+	AuthorFirstName string             `db:"author_first_name" json:"author_first_name"`
+	AuthorLastName  string             `db:"author_last_name" json:"author_last_name"`
+	AuthorThumbnail pgtype.Text        `db:"author_thumbnail" json:"author_thumbnail"`
+}
+
+func (q *Queries) ListFeed(ctx context.Context, arg *ListFeedParams) ([]*ListFeedRow, error) {
+	rows, err := q.db.Query(ctx, listFeed,
+		arg.FollowerID,
+		arg.HasCursor,
+		arg.CursorAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListFeedRow
+	for rows.Next() {
+		var i ListFeedRow
+		if err := rows.Scan(
+			&i.SubjectID,
+			&i.Kind,
+			&i.AuthorID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OccurredAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.AuthorThumbnail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFeedItem = `-- name: UpsertFeedItem :exec
+INSERT INTO feed_items (subject_id, kind, author_id, title, template_id, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (subject_id) DO UPDATE SET
+    kind = EXCLUDED.kind,
+    author_id = EXCLUDED.author_id,
+    title = EXCLUDED.title,
+    template_id = EXCLUDED.template_id,
+    occurred_at = EXCLUDED.occurred_at
+WHERE feed_items.occurred_at <= EXCLUDED.occurred_at
+`
+
+type UpsertFeedItemParams struct {
+	SubjectID  pgtype.UUID        `db:"subject_id" json:"subject_id"`
+	Kind       string             `db:"kind" json:"kind"`
+	AuthorID   pgtype.UUID        `db:"author_id" json:"author_id"`
+	Title      string             `db:"title" json:"title"`
+	TemplateID pgtype.UUID        `db:"template_id" json:"template_id"`
+	OccurredAt pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+}
+
+// Events are delivered at least once and may arrive out of order, so an older activity never replaces a newer one.
+func (q *Queries) UpsertFeedItem(ctx context.Context, arg *UpsertFeedItemParams) error {
+	_, err := q.db.Exec(ctx, upsertFeedItem,
+		arg.SubjectID,
+		arg.Kind,
+		arg.AuthorID,
+		arg.Title,
+		arg.TemplateID,
+		arg.OccurredAt,
+	)
+	return err
+}
@@ -22,24 +22,108 @@ type Account struct {
 	UpdatedAt         pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
-type Field struct {
-	ID         pgtype.UUID `db:"id" json:"id"`
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
+type Attachment struct {
+	ID          pgtype.UUID        `db:"id" json:"id"`
+	NoteID      pgtype.UUID        `db:"note_id" json:"note_id"`
+	SectionID   pgtype.UUID        `db:"section_id" json:"section_id"`
+	FileName    string             `db:"file_name" json:"file_name"`
+	ContentType string             `db:"content_type" json:"content_type"`
+	Size        int64              `db:"size" json:"size"`
+	StorageKey  string             `db:"storage_key" json:"storage_key"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
 }
 
-type Note struct {
-	ID         pgtype.UUID        `db:"id" json:"id"`
+type DigestSetting struct {
+	AccountID  pgtype.UUID        `db:"account_id" json:"account_id"`
+	Frequency  string             `db:"frequency" json:"frequency"`
+	LastSentAt pgtype.Timestamptz `db:"last_sent_at" json:"last_sent_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type FeedItem struct {
+	SubjectID  pgtype.UUID        `db:"subject_id" json:"subject_id"`
+	Kind       string             `db:"kind" json:"kind"`
+	AuthorID   pgtype.UUID        `db:"author_id" json:"author_id"`
 	Title      string             `db:"title" json:"title"`
 	TemplateID pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID    pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status     string             `db:"status" json:"status"`
+	OccurredAt pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+}
+
+type Field struct {
+	ID          pgtype.UUID `db:"id" json:"id"`
+	TemplateID  pgtype.UUID `db:"template_id" json:"template_id"`
+	Label       string      `db:"label" json:"label"`
+	Order       int32       `db:"order" json:"order"`
+	IsRequired  bool        `db:"is_required" json:"is_required"`
+	Type        string      `db:"type" json:"type"`
+	Options     []byte      `db:"options" json:"options"`
+	MinLength   pgtype.Int4 `db:"min_length" json:"min_length"`
+	MaxLength   pgtype.Int4 `db:"max_length" json:"max_length"`
+	Pattern     string      `db:"pattern" json:"pattern"`
+	Placeholder string      `db:"placeholder" json:"placeholder"`
+	HelpText    string      `db:"help_text" json:"help_text"`
+	Version     int32       `db:"version" json:"version"`
+}
+
+type Follow struct {
+	FollowerID pgtype.UUID        `db:"follower_id" json:"follower_id"`
+	FolloweeID pgtype.UUID        `db:"followee_id" json:"followee_id"`
 	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type Note struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+}
+
+type NoteRevision struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	NoteID          pgtype.UUID        `db:"note_id" json:"note_id"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	Title           string             `db:"title" json:"title"`
+	Sections        []byte             `db:"sections" json:"sections"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type Notification struct {
+	ID           pgtype.UUID        `db:"id" json:"id"`
+	RecipientID  pgtype.UUID        `db:"recipient_id" json:"recipient_id"`
+	Kind         string             `db:"kind" json:"kind"`
+	ActorID      pgtype.UUID        `db:"actor_id" json:"actor_id"`
+	SubjectID    pgtype.UUID        `db:"subject_id" json:"subject_id"`
+	SubjectTitle string             `db:"subject_title" json:"subject_title"`
+	EventID      pgtype.UUID        `db:"event_id" json:"event_id"`
+	ReadAt       pgtype.Timestamptz `db:"read_at" json:"read_at"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type NotificationPreference struct {
+	AccountID  pgtype.UUID        `db:"account_id" json:"account_id"`
+	MutedKinds []string           `db:"muted_kinds" json:"muted_kinds"`
 	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type Outbox struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	Name          string             `db:"name" json:"name"`
+	AggregateID   pgtype.UUID        `db:"aggregate_id" json:"aggregate_id"`
+	ActorID       pgtype.UUID        `db:"actor_id" json:"actor_id"`
+	Data          []byte             `db:"data" json:"data"`
+	OccurredAt    pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+	Attempts      int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastError     pgtype.Text        `db:"last_error" json:"last_error"`
+	DeliveredAt   pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+	DeadAt        pgtype.Timestamptz `db:"dead_at" json:"dead_at"`
+}
+
 type Section struct {
 	ID      pgtype.UUID `db:"id" json:"id"`
 	NoteID  pgtype.UUID `db:"note_id" json:"note_id"`
@@ -48,8 +132,41 @@ type Section struct {
 }
 
 type Template struct {
+	ID           pgtype.UUID        `db:"id" json:"id"`
+	Name         string             `db:"name" json:"name"`
+	OwnerID      pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version      int32              `db:"version" json:"version"`
+	ForkedFromID pgtype.UUID        `db:"forked_from_id" json:"forked_from_id"`
+	Visibility   string             `db:"visibility" json:"visibility"`
+	DeprecatedAt pgtype.Timestamptz `db:"deprecated_at" json:"deprecated_at"`
+	SuccessorID  pgtype.UUID        `db:"successor_id" json:"successor_id"`
+}
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	SubscriptionID pgtype.UUID        `db:"subscription_id" json:"subscription_id"`
+	EventID        pgtype.UUID        `db:"event_id" json:"event_id"`
+	EventName      string             `db:"event_name" json:"event_name"`
+	Payload        []byte             `db:"payload" json:"payload"`
+	Status         string             `db:"status" json:"status"`
+	Attempts       int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	ResponseStatus pgtype.Int4        `db:"response_status" json:"response_status"`
+	LatencyMs      pgtype.Int4        `db:"latency_ms" json:"latency_ms"`
+	LastError      pgtype.Text        `db:"last_error" json:"last_error"`
+	RedeliveryOf   pgtype.UUID        `db:"redelivery_of" json:"redelivery_of"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	CompletedAt    pgtype.Timestamptz `db:"completed_at" json:"completed_at"`
+}
+
+type WebhookSubscription struct {
 	ID        pgtype.UUID        `db:"id" json:"id"`
-	Name      string             `db:"name" json:"name"`
 	OwnerID   pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Url       string             `db:"url" json:"url"`
+	Secret    string             `db:"secret" json:"secret"`
+	Events    []string           `db:"events" json:"events"`
+	Active    bool               `db:"active" json:"active"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
@@ -12,22 +12,24 @@ import (
 )
 
 const createNote = `-- name: CreateNote :one
-INSERT INTO notes (title, template_id, owner_id, status)
-VALUES ($1, $2, $3, $4)
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+INSERT INTO notes (title, template_id, template_version, owner_id, status)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, template_version
 `
 
 type CreateNoteParams struct {
-	Title      string      `db:"title" json:"title"`
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	OwnerID    pgtype.UUID `db:"owner_id" json:"owner_id"`
-	Status     string      `db:"status" json:"status"`
+	Title           string      `db:"title" json:"title"`
+	TemplateID      pgtype.UUID `db:"template_id" json:"template_id"`
+	TemplateVersion int32       `db:"template_version" json:"template_version"`
+	OwnerID         pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Status          string      `db:"status" json:"status"`
 }
 
 func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note, error) {
 	row := q.db.QueryRow(ctx, createNote,
 		arg.Title,
 		arg.TemplateID,
+		arg.TemplateVersion,
 		arg.OwnerID,
 		arg.Status,
 	)
@@ -40,6 +42,42 @@ func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TemplateVersion,
+	)
+	return &i, err
+}
+
+const createNoteRevision = `-- name: CreateNoteRevision :one
+INSERT INTO note_revisions (note_id, template_id, template_version, title, sections)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, note_id, template_id, template_version, title, sections, created_at
+`
+
+type CreateNoteRevisionParams struct {
+	NoteID          pgtype.UUID `db:"note_id" json:"note_id"`
+	TemplateID      pgtype.UUID `db:"template_id" json:"template_id"`
+	TemplateVersion int32       `db:"template_version" json:"template_version"`
+	Title           string      `db:"title" json:"title"`
+	Sections        []byte      `db:"sections" json:"sections"`
+}
+
+func (q *Queries) CreateNoteRevision(ctx context.Context, arg *CreateNoteRevisionParams) (*NoteRevision, error) {
+	row := q.db.QueryRow(ctx, createNoteRevision,
+		arg.NoteID,
+		arg.TemplateID,
+		arg.TemplateVersion,
+		arg.Title,
+		arg.Sections,
+	)
+	var i NoteRevision
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.TemplateID,
+		&i.TemplateVersion,
+		&i.Title,
+		&i.Sections,
+		&i.CreatedAt,
 	)
 	return &i, err
 }
@@ -90,7 +128,7 @@ func (q *Queries) DeleteSectionsByNote(ctx context.Context, noteID pgtype.UUID)
 
 const getNoteByID = `-- name: GetNoteByID :one
 SELECT
-    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at,
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.template_version,
     t.name AS template_name,
     a.first_name,
     a.last_name,
@@ -102,17 +140,18 @@ WHERE n.id = $1
 `
 
 type GetNoteByIDRow struct {
-	ID             pgtype.UUID        `db:"id" json:"id"`
-	Title          string             `db:"title" json:"title"`
-	TemplateID     pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status         string             `db:"status" json:"status"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	TemplateName   string             `db:"template_name" json:"template_name"`
-	FirstName      string             `db:"first_name" json:"first_name"`
-	LastName       string             `db:"last_name" json:"last_name"`
-	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
 }
 
 func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByIDRow, error) {
@@ -126,6 +165,7 @@ func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByID
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TemplateVersion,
 		&i.TemplateName,
 		&i.FirstName,
 		&i.LastName,
@@ -136,7 +176,7 @@ func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByID
 
 const listNotes = `-- name: ListNotes :many
 SELECT
-    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at,
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.template_version,
     t.name AS template_name,
     a.first_name,
     a.last_name,
@@ -159,17 +199,18 @@ type ListNotesParams struct {
 }
 
 type ListNotesRow struct {
-	ID             pgtype.UUID        `db:"id" json:"id"`
-	Title          string             `db:"title" json:"title"`
-	TemplateID     pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status         string             `db:"status" json:"status"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	TemplateName   string             `db:"template_name" json:"template_name"`
-	FirstName      string             `db:"first_name" json:"first_name"`
-	LastName       string             `db:"last_name" json:"last_name"`
-	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
 }
 
 func (q *Queries) ListNotes(ctx context.Context, arg *ListNotesParams) ([]*ListNotesRow, error) {
@@ -194,6 +235,7 @@ func (q *Queries) ListNotes(ctx context.Context, arg *ListNotesParams) ([]*ListN
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TemplateVersion,
 			&i.TemplateName,
 			&i.FirstName,
 			&i.LastName,
@@ -214,7 +256,9 @@ SELECT
     s.id, s.note_id, s.field_id, s.content,
     f.label,
     f."order",
-    f.is_required
+    f.is_required,
+    f.type AS field_type,
+    f.options AS field_options
 FROM sections s
 JOIN fields f ON f.id = s.field_id
 WHERE s.note_id = $1
@@ -222,13 +266,15 @@ ORDER BY f."order" ASC
 `
 
 type ListSectionsByNoteRow struct {
-	ID         pgtype.UUID `db:"id" json:"id"`
-	NoteID     pgtype.UUID `db:"note_id" json:"note_id"`
-	FieldID    pgtype.UUID `db:"field_id" json:"field_id"`
-	Content    string      `db:"content" json:"content"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
+	ID           pgtype.UUID `db:"id" json:"id"`
+	NoteID       pgtype.UUID `db:"note_id" json:"note_id"`
+	FieldID      pgtype.UUID `db:"field_id" json:"field_id"`
+	Content      string      `db:"content" json:"content"`
+	Label        string      `db:"label" json:"label"`
+	Order        int32       `db:"order" json:"order"`
+	IsRequired   bool        `db:"is_required" json:"is_required"`
+	FieldType    string      `db:"field_type" json:"field_type"`
+	FieldOptions []byte      `db:"field_options" json:"field_options"`
 }
 
 func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([]*ListSectionsByNoteRow, error) {
@@ -248,6 +294,8 @@ func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([
 			&i.Label,
 			&i.Order,
 			&i.IsRequired,
+			&i.FieldType,
+			&i.FieldOptions,
 		); err != nil {
 			return nil, err
 		}
@@ -265,7 +313,7 @@ SET
     title = $2,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, template_version
 `
 
 type UpdateNoteParams struct {
@@ -284,6 +332,37 @@ func (q *Queries) UpdateNote(ctx context.Context, arg *UpdateNoteParams) (*Note,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TemplateVersion,
+	)
+	return &i, err
+}
+
+const updateNoteOwner = `-- name: UpdateNoteOwner :one
+UPDATE notes
+SET
+    owner_id = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, template_version
+`
+
+type UpdateNoteOwnerParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	OwnerID pgtype.UUID `db:"owner_id" json:"owner_id"`
+}
+
+func (q *Queries) UpdateNoteOwner(ctx context.Context, arg *UpdateNoteOwnerParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, updateNoteOwner, arg.ID, arg.OwnerID)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TemplateVersion,
 	)
 	return &i, err
 }
@@ -294,7 +373,7 @@ SET
     status = $2,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, template_version
 `
 
 type UpdateNoteStatusParams struct {
@@ -313,6 +392,69 @@ func (q *Queries) UpdateNoteStatus(ctx context.Context, arg *UpdateNoteStatusPar
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TemplateVersion,
+	)
+	return &i, err
+}
+
+const updateNoteTemplate = `-- name: UpdateNoteTemplate :one
+UPDATE notes
+SET
+    template_id = $2,
+    template_version = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, template_version
+`
+
+type UpdateNoteTemplateParams struct {
+	ID              pgtype.UUID `db:"id" json:"id"`
+	TemplateID      pgtype.UUID `db:"template_id" json:"template_id"`
+	TemplateVersion int32       `db:"template_version" json:"template_version"`
+}
+
+func (q *Queries) UpdateNoteTemplate(ctx context.Context, arg *UpdateNoteTemplateParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, updateNoteTemplate, arg.ID, arg.TemplateID, arg.TemplateVersion)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TemplateVersion,
+	)
+	return &i, err
+}
+
+const updateNoteTemplateVersion = `-- name: UpdateNoteTemplateVersion :one
+UPDATE notes
+SET
+    template_version = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, template_version
+`
+
+type UpdateNoteTemplateVersionParams struct {
+	ID              pgtype.UUID `db:"id" json:"id"`
+	TemplateVersion int32       `db:"template_version" json:"template_version"`
+}
+
+func (q *Queries) UpdateNoteTemplateVersion(ctx context.Context, arg *UpdateNoteTemplateVersionParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, updateNoteTemplateVersion, arg.ID, arg.TemplateVersion)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TemplateVersion,
 	)
 	return &i, err
 }
@@ -0,0 +1,196 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notifications.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE recipient_id = $1
+  AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, recipientID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadNotifications, recipientID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createNotification = `-- name: CreateNotification :exec
+INSERT INTO notifications (recipient_id, kind, actor_id, subject_id, subject_title, event_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (recipient_id, event_id, kind) DO NOTHING
+`
+
+type CreateNotificationParams struct {
+	RecipientID  pgtype.UUID `db:"recipient_id" json:"recipient_id"`
+	Kind         string      `db:"kind" json:"kind"`
+	ActorID      pgtype.UUID `db:"actor_id" json:"actor_id"`
+	SubjectID    pgtype.UUID `db:"subject_id" json:"subject_id"`
+	SubjectTitle string      `db:"subject_title" json:"subject_title"`
+	EventID      pgtype.UUID `db:"event_id" json:"event_id"`
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg *CreateNotificationParams) error {
+	_, err := q.db.Exec(ctx, createNotification,
+		arg.RecipientID,
+		arg.Kind,
+		arg.ActorID,
+		arg.SubjectID,
+		arg.SubjectTitle,
+		arg.EventID,
+	)
+	return err
+}
+
+const getNotificationByID = `-- name: GetNotificationByID :one
+SELECT id, recipient_id, kind, actor_id, subject_id, subject_title, event_id, read_at, created_at
+FROM notifications
+WHERE id = $1
+`
+
+func (q *Queries) GetNotificationByID(ctx context.Context, id pgtype.UUID) (*Notification, error) {
+	row := q.db.QueryRow(ctx, getNotificationByID, id)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.RecipientID,
+		&i.Kind,
+		&i.ActorID,
+		&i.SubjectID,
+		&i.SubjectTitle,
+		&i.EventID,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getNotificationPreferences = `-- name: GetNotificationPreferences :one
+SELECT account_id, muted_kinds, updated_at
+FROM notification_preferences
+WHERE account_id = $1
+`
+
+func (q *Queries) GetNotificationPreferences(ctx context.Context, accountID pgtype.UUID) (*NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreferences, accountID)
+	var i NotificationPreference
+	err := row.Scan(&i.AccountID, &i.MutedKinds, &i.UpdatedAt)
+	return &i, err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, recipient_id, kind, actor_id, subject_id, subject_title, event_id, read_at, created_at
+FROM notifications
+WHERE recipient_id = $1
+  AND (NOT $3::boolean OR read_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type ListNotificationsParams struct {
+	RecipientID pgtype.UUID `db:"recipient_id" json:"recipient_id"`
+	Limit       int32       `db:"limit" json:"limit"`
+	UnreadOnly  bool        `db:"unread_only" json:"unread_only"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg *ListNotificationsParams) ([]*Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications, arg.RecipientID, arg.Limit, arg.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipientID,
+			&i.Kind,
+			&i.ActorID,
+			&i.SubjectID,
+			&i.SubjectTitle,
+			&i.EventID,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = NOW()
+WHERE recipient_id = $1
+  AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, recipientID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, markAllNotificationsRead, recipientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1
+RETURNING id, recipient_id, kind, actor_id, subject_id, subject_title, event_id, read_at, created_at
+`
+
+// A notification that was already read keeps its first read_at.
+func (q *Queries) MarkNotificationRead(ctx context.Context, id pgtype.UUID) (*Notification, error) {
+	row := q.db.QueryRow(ctx, markNotificationRead, id)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.RecipientID,
+		&i.Kind,
+		&i.ActorID,
+		&i.SubjectID,
+		&i.SubjectTitle,
+		&i.EventID,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const upsertNotificationPreferences = `-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (account_id, muted_kinds)
+VALUES ($1, $2)
+ON CONFLICT (account_id) DO UPDATE
+SET
+    muted_kinds = EXCLUDED.muted_kinds,
+    updated_at = NOW()
+RETURNING account_id, muted_kinds, updated_at
+`
+
+type UpsertNotificationPreferencesParams struct {
+	AccountID  pgtype.UUID `db:"account_id" json:"account_id"`
+	MutedKinds []string    `db:"muted_kinds" json:"muted_kinds"`
+}
+
+func (q *Queries) UpsertNotificationPreferences(ctx context.Context, arg *UpsertNotificationPreferencesParams) (*NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationPreferences, arg.AccountID, arg.MutedKinds)
+	var i NotificationPreference
+	err := row.Scan(&i.AccountID, &i.MutedKinds, &i.UpdatedAt)
+	return &i, err
+}
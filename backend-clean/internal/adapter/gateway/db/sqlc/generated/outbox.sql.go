@@ -0,0 +1,175 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const appendOutboxEvent = `-- name: AppendOutboxEvent :exec
+INSERT INTO outbox (name, aggregate_id, actor_id, data)
+VALUES ($1, $2, $3, $4)
+`
+
+type AppendOutboxEventParams struct {
+	Name        string      `db:"name" json:"name"`
+	AggregateID pgtype.UUID `db:"aggregate_id" json:"aggregate_id"`
+	ActorID     pgtype.UUID `db:"actor_id" json:"actor_id"`
+	Data        []byte      `db:"data" json:"data"`
+}
+
+func (q *Queries) AppendOutboxEvent(ctx context.Context, arg *AppendOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, appendOutboxEvent,
+		arg.Name,
+		arg.AggregateID,
+		arg.ActorID,
+		arg.Data,
+	)
+	return err
+}
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => $1::float8)
+WHERE id IN (
+    SELECT o.id
+    FROM outbox o
+    WHERE o.delivered_at IS NULL
+      AND o.dead_at IS NULL
+      AND o.next_attempt_at <= NOW()
+    ORDER BY o.occurred_at ASC
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, name, aggregate_id, actor_id, data, occurred_at, attempts, next_attempt_at, last_error, delivered_at, dead_at
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseSeconds float64 `db:"lease_seconds" json:"lease_seconds"`
+	BatchSize    int32   `db:"batch_size" json:"batch_size"`
+}
+
+// Claimed events count an attempt and are hidden for the lease, so a crashed dispatcher
+// only delays their delivery.
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg *ClaimOutboxEventsParams) ([]*Outbox, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Outbox
+	for rows.Next() {
+		var i Outbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.AggregateID,
+			&i.ActorID,
+			&i.Data,
+			&i.OccurredAt,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.DeadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOutboxEvent = `-- name: GetOutboxEvent :one
+SELECT id, name, aggregate_id, actor_id, data, occurred_at, attempts, next_attempt_at, last_error, delivered_at, dead_at FROM outbox
+WHERE id = $1
+`
+
+func (q *Queries) GetOutboxEvent(ctx context.Context, id pgtype.UUID) (*Outbox, error) {
+	row := q.db.QueryRow(ctx, getOutboxEvent, id)
+	var i Outbox
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.AggregateID,
+		&i.ActorID,
+		&i.Data,
+		&i.OccurredAt,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.DeadAt,
+	)
+	return &i, err
+}
+
+const markOutboxEventDead = `-- name: MarkOutboxEventDead :exec
+UPDATE outbox
+SET
+    dead_at = NOW(),
+    last_error = $2
+WHERE id = $1
+`
+
+type MarkOutboxEventDeadParams struct {
+	ID        pgtype.UUID `db:"id" json:"id"`
+	LastError pgtype.Text `db:"last_error" json:"last_error"`
+}
+
+func (q *Queries) MarkOutboxEventDead(ctx context.Context, arg *MarkOutboxEventDeadParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventDead, arg.ID, arg.LastError)
+	return err
+}
+
+const markOutboxEventDelivered = `-- name: MarkOutboxEventDelivered :exec
+UPDATE outbox
+SET
+    delivered_at = NOW(),
+    last_error = NULL
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxEventDelivered(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markOutboxEventDelivered, id)
+	return err
+}
+
+const notifyOutboxEvent = `-- name: NotifyOutboxEvent :exec
+SELECT pg_notify('outbox_events', $1::text)
+`
+
+// Only the ID is sent to stay well within the notification payload limit; listeners load the event.
+func (q *Queries) NotifyOutboxEvent(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, notifyOutboxEvent, id)
+	return err
+}
+
+const rescheduleOutboxEvent = `-- name: RescheduleOutboxEvent :exec
+UPDATE outbox
+SET
+    next_attempt_at = NOW() + make_interval(secs => $1::float8),
+    last_error = $2
+WHERE id = $3
+`
+
+type RescheduleOutboxEventParams struct {
+	DelaySeconds float64     `db:"delay_seconds" json:"delay_seconds"`
+	LastError    pgtype.Text `db:"last_error" json:"last_error"`
+	ID           pgtype.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) RescheduleOutboxEvent(ctx context.Context, arg *RescheduleOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, rescheduleOutboxEvent, arg.DelaySeconds, arg.LastError, arg.ID)
+	return err
+}
@@ -24,25 +24,59 @@ func (q *Queries) CheckTemplateInUse(ctx context.Context, templateID pgtype.UUID
 	return is_used, err
 }
 
+const checkTemplateUsedByOthers = `-- name: CheckTemplateUsedByOthers :one
+SELECT EXISTS (
+    SELECT 1 FROM notes WHERE template_id = $1 AND owner_id <> $2
+) AS is_used
+`
+
+type CheckTemplateUsedByOthersParams struct {
+	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
+	OwnerID    pgtype.UUID `db:"owner_id" json:"owner_id"`
+}
+
+func (q *Queries) CheckTemplateUsedByOthers(ctx context.Context, arg *CheckTemplateUsedByOthersParams) (bool, error) {
+	row := q.db.QueryRow(ctx, checkTemplateUsedByOthers, arg.TemplateID, arg.OwnerID)
+	var is_used bool
+	err := row.Scan(&is_used)
+	return is_used, err
+}
+
 const createField = `-- name: CreateField :one
-INSERT INTO fields (template_id, label, "order", is_required)
-VALUES ($1, $2, $3, $4)
-RETURNING id, template_id, label, "order", is_required
+INSERT INTO fields (template_id, version, label, "order", is_required, type, options, min_length, max_length, pattern, placeholder, help_text)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, template_id, label, "order", is_required, type, options, min_length, max_length, pattern, placeholder, help_text, version
 `
 
 type CreateFieldParams struct {
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
+	TemplateID  pgtype.UUID `db:"template_id" json:"template_id"`
+	Version     int32       `db:"version" json:"version"`
+	Label       string      `db:"label" json:"label"`
+	Order       int32       `db:"order" json:"order"`
+	IsRequired  bool        `db:"is_required" json:"is_required"`
+	Type        string      `db:"type" json:"type"`
+	Options     []byte      `db:"options" json:"options"`
+	MinLength   pgtype.Int4 `db:"min_length" json:"min_length"`
+	MaxLength   pgtype.Int4 `db:"max_length" json:"max_length"`
+	Pattern     string      `db:"pattern" json:"pattern"`
+	Placeholder string      `db:"placeholder" json:"placeholder"`
+	HelpText    string      `db:"help_text" json:"help_text"`
 }
 
 func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Field, error) {
 	row := q.db.QueryRow(ctx, createField,
 		arg.TemplateID,
+		arg.Version,
 		arg.Label,
 		arg.Order,
 		arg.IsRequired,
+		arg.Type,
+		arg.Options,
+		arg.MinLength,
+		arg.MaxLength,
+		arg.Pattern,
+		arg.Placeholder,
+		arg.HelpText,
 	)
 	var i Field
 	err := row.Scan(
@@ -51,77 +85,125 @@ func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Fie
 		&i.Label,
 		&i.Order,
 		&i.IsRequired,
+		&i.Type,
+		&i.Options,
+		&i.MinLength,
+		&i.MaxLength,
+		&i.Pattern,
+		&i.Placeholder,
+		&i.HelpText,
+		&i.Version,
 	)
 	return &i, err
 }
 
 const createTemplate = `-- name: CreateTemplate :one
-INSERT INTO templates (name, owner_id)
-VALUES ($1, $2)
-RETURNING id, name, owner_id, updated_at
+INSERT INTO templates (name, owner_id, forked_from_id, visibility)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, owner_id, updated_at, version, forked_from_id, visibility, deprecated_at, successor_id
 `
 
 type CreateTemplateParams struct {
-	Name    string      `db:"name" json:"name"`
-	OwnerID pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Name         string      `db:"name" json:"name"`
+	OwnerID      pgtype.UUID `db:"owner_id" json:"owner_id"`
+	ForkedFromID pgtype.UUID `db:"forked_from_id" json:"forked_from_id"`
+	Visibility   string      `db:"visibility" json:"visibility"`
 }
 
 func (q *Queries) CreateTemplate(ctx context.Context, arg *CreateTemplateParams) (*Template, error) {
-	row := q.db.QueryRow(ctx, createTemplate, arg.Name, arg.OwnerID)
+	row := q.db.QueryRow(ctx, createTemplate,
+		arg.Name,
+		arg.OwnerID,
+		arg.ForkedFromID,
+		arg.Visibility,
+	)
 	var i Template
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ForkedFromID,
+		&i.Visibility,
+		&i.DeprecatedAt,
+		&i.SuccessorID,
 	)
 	return &i, err
 }
 
-const deleteField = `-- name: DeleteField :exec
-DELETE FROM fields
+const deleteTemplate = `-- name: DeleteTemplate :exec
+DELETE FROM templates
 WHERE id = $1
 `
 
-func (q *Queries) DeleteField(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteField, id)
+func (q *Queries) DeleteTemplate(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTemplate, id)
 	return err
 }
 
-const deleteFieldsByTemplate = `-- name: DeleteFieldsByTemplate :exec
-DELETE FROM fields
-WHERE template_id = $1
+const deprecateTemplate = `-- name: DeprecateTemplate :one
+UPDATE templates
+SET
+    deprecated_at = COALESCE(deprecated_at, NOW()),
+    successor_id = $2
+WHERE id = $1
+RETURNING id, name, owner_id, updated_at, version, forked_from_id, visibility, deprecated_at, successor_id
 `
 
-func (q *Queries) DeleteFieldsByTemplate(ctx context.Context, templateID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteFieldsByTemplate, templateID)
-	return err
+type DeprecateTemplateParams struct {
+	ID          pgtype.UUID `db:"id" json:"id"`
+	SuccessorID pgtype.UUID `db:"successor_id" json:"successor_id"`
 }
 
-const deleteTemplate = `-- name: DeleteTemplate :exec
-DELETE FROM templates
-WHERE id = $1
-`
-
-func (q *Queries) DeleteTemplate(ctx context.Context, id pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteTemplate, id)
-	return err
+func (q *Queries) DeprecateTemplate(ctx context.Context, arg *DeprecateTemplateParams) (*Template, error) {
+	row := q.db.QueryRow(ctx, deprecateTemplate, arg.ID, arg.SuccessorID)
+	var i Template
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ForkedFromID,
+		&i.Visibility,
+		&i.DeprecatedAt,
+		&i.SuccessorID,
+	)
+	return &i, err
 }
 
 const getTemplateByID = `-- name: GetTemplateByID :one
 SELECT
-    t.id, t.name, t.owner_id, t.updated_at,
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.forked_from_id, t.visibility, t.deprecated_at, t.successor_id,
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
-    EXISTS (
-        SELECT 1
-        FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+    COALESCE(u.note_count, 0)::bigint AS note_count,
+    COALESCE(u.published_count, 0)::bigint AS published_count,
+    COALESCE(u.author_count, 0)::bigint AS author_count,
+    u.last_used_at::timestamptz AS last_used_at,
+    COALESCE(fk.fork_count, 0)::bigint AS fork_count
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
+LEFT JOIN (
+    SELECT
+        template_id,
+        COUNT(*) AS note_count,
+        COUNT(*) FILTER (WHERE status = 'Publish') AS published_count,
+        COUNT(DISTINCT owner_id) AS author_count,
+        MAX(created_at) AS last_used_at
+    FROM notes
+    GROUP BY template_id
+) u ON u.template_id = t.id
+LEFT JOIN (
+    -- only public forks are counted so the count does not reveal private or unlisted ones
+    SELECT forked_from_id, COUNT(*) AS fork_count
+    FROM templates
+    WHERE forked_from_id IS NOT NULL
+      AND visibility = 'public'
+    GROUP BY forked_from_id
+) fk ON fk.forked_from_id = t.id
 WHERE t.id = $1
 `
 
@@ -130,10 +212,19 @@ type GetTemplateByIDRow struct {
 	Name           string             `db:"name" json:"name"`
 	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
 	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	ForkedFromID   pgtype.UUID        `db:"forked_from_id" json:"forked_from_id"`
+	Visibility     string             `db:"visibility" json:"visibility"`
+	DeprecatedAt   pgtype.Timestamptz `db:"deprecated_at" json:"deprecated_at"`
+	SuccessorID    pgtype.UUID        `db:"successor_id" json:"successor_id"`
 	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
 	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
 	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
-	IsUsed         bool               `db:"is_used" json:"is_used"`
+	NoteCount      int64              `db:"note_count" json:"note_count"`
+	PublishedCount int64              `db:"published_count" json:"published_count"`
+	AuthorCount    int64              `db:"author_count" json:"author_count"`
+	LastUsedAt     pgtype.Timestamptz `db:"last_used_at" json:"last_used_at"`
+	ForkCount      int64              `db:"fork_count" json:"fork_count"`
 }
 
 func (q *Queries) GetTemplateByID(ctx context.Context, id pgtype.UUID) (*GetTemplateByIDRow, error) {
@@ -144,23 +235,38 @@ func (q *Queries) GetTemplateByID(ctx context.Context, id pgtype.UUID) (*GetTemp
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ForkedFromID,
+		&i.Visibility,
+		&i.DeprecatedAt,
+		&i.SuccessorID,
 		&i.OwnerFirstName,
 		&i.OwnerLastName,
 		&i.OwnerThumbnail,
-		&i.IsUsed,
+		&i.NoteCount,
+		&i.PublishedCount,
+		&i.AuthorCount,
+		&i.LastUsedAt,
+		&i.ForkCount,
 	)
 	return &i, err
 }
 
 const listFieldsByTemplate = `-- name: ListFieldsByTemplate :many
-SELECT id, template_id, label, "order", is_required
+SELECT id, template_id, label, "order", is_required, type, options, min_length, max_length, pattern, placeholder, help_text, version
 FROM fields
 WHERE template_id = $1
+  AND version = $2
 ORDER BY "order" ASC
 `
 
-func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UUID) ([]*Field, error) {
-	rows, err := q.db.Query(ctx, listFieldsByTemplate, templateID)
+type ListFieldsByTemplateParams struct {
+	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
+	Version    int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) ListFieldsByTemplate(ctx context.Context, arg *ListFieldsByTemplateParams) ([]*Field, error) {
+	rows, err := q.db.Query(ctx, listFieldsByTemplate, arg.TemplateID, arg.Version)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +280,14 @@ func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UU
 			&i.Label,
 			&i.Order,
 			&i.IsRequired,
+			&i.Type,
+			&i.Options,
+			&i.MinLength,
+			&i.MaxLength,
+			&i.Pattern,
+			&i.Placeholder,
+			&i.HelpText,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -187,26 +301,64 @@ func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UU
 
 const listTemplates = `-- name: ListTemplates :many
 SELECT
-    t.id, t.name, t.owner_id, t.updated_at,
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.forked_from_id, t.visibility, t.deprecated_at, t.successor_id,
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
-    EXISTS (
-        SELECT 1
-        FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+    COALESCE(u.note_count, 0)::bigint AS note_count,
+    COALESCE(u.published_count, 0)::bigint AS published_count,
+    COALESCE(u.author_count, 0)::bigint AS author_count,
+    u.last_used_at::timestamptz AS last_used_at,
+    COALESCE(fk.fork_count, 0)::bigint AS fork_count
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
+LEFT JOIN (
+    SELECT
+        template_id,
+        COUNT(*) AS note_count,
+        COUNT(*) FILTER (WHERE status = 'Publish') AS published_count,
+        COUNT(DISTINCT owner_id) AS author_count,
+        MAX(created_at) AS last_used_at
+    FROM notes
+    GROUP BY template_id
+) u ON u.template_id = t.id
+LEFT JOIN (
+    -- only public forks are counted so the count does not reveal private or unlisted ones
+    SELECT forked_from_id, COUNT(*) AS fork_count
+    FROM templates
+    WHERE forked_from_id IS NOT NULL
+      AND visibility = 'public'
+    GROUP BY forked_from_id
+) fk ON fk.forked_from_id = t.id
 WHERE ($1::uuid IS NULL OR t.owner_id = $1)
-  AND ($2::text IS NULL OR t.name ILIKE '%' || $2 || '%')
+  AND (
+    $2::text IS NULL
+    OR t.name ILIKE '%' || $2 || '%'
+    OR EXISTS (
+        SELECT 1
+        FROM fields f
+        WHERE f.template_id = t.id
+          AND f.version = t.version
+          AND f.label ILIKE '%' || $2 || '%'
+    )
+  )
+  AND (
+    NULLIF($3::text, '') IS NULL
+    OR EXISTS (
+        SELECT 1
+        FROM fields f
+        WHERE f.template_id = t.id
+          AND f.version = t.version
+          AND f.label = $3
+    )
+  )
 ORDER BY t.updated_at DESC
 `
 
 type ListTemplatesParams struct {
 	Column1 pgtype.UUID `db:"column_1" json:"column_1"`
 	Column2 string      `db:"column_2" json:"column_2"`
+	Column3 string      `db:"column_3" json:"column_3"`
 }
 
 type ListTemplatesRow struct {
@@ -214,14 +366,23 @@ type ListTemplatesRow struct {
 	Name           string             `db:"name" json:"name"`
 	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
 	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	ForkedFromID   pgtype.UUID        `db:"forked_from_id" json:"forked_from_id"`
+	Visibility     string             `db:"visibility" json:"visibility"`
+	DeprecatedAt   pgtype.Timestamptz `db:"deprecated_at" json:"deprecated_at"`
+	SuccessorID    pgtype.UUID        `db:"successor_id" json:"successor_id"`
 	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
 	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
 	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
-	IsUsed         bool               `db:"is_used" json:"is_used"`
+	NoteCount      int64              `db:"note_count" json:"note_count"`
+	PublishedCount int64              `db:"published_count" json:"published_count"`
+	AuthorCount    int64              `db:"author_count" json:"author_count"`
+	LastUsedAt     pgtype.Timestamptz `db:"last_used_at" json:"last_used_at"`
+	ForkCount      int64              `db:"fork_count" json:"fork_count"`
 }
 
 func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) ([]*ListTemplatesRow, error) {
-	rows, err := q.db.Query(ctx, listTemplates, arg.Column1, arg.Column2)
+	rows, err := q.db.Query(ctx, listTemplates, arg.Column1, arg.Column2, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -234,10 +395,19 @@ func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) (
 			&i.Name,
 			&i.OwnerID,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.ForkedFromID,
+			&i.Visibility,
+			&i.DeprecatedAt,
+			&i.SuccessorID,
 			&i.OwnerFirstName,
 			&i.OwnerLastName,
 			&i.OwnerThumbnail,
-			&i.IsUsed,
+			&i.NoteCount,
+			&i.PublishedCount,
+			&i.AuthorCount,
+			&i.LastUsedAt,
+			&i.ForkCount,
 		); err != nil {
 			return nil, err
 		}
@@ -249,37 +419,28 @@ func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) (
 	return items, nil
 }
 
-const updateField = `-- name: UpdateField :one
-UPDATE fields
+const undeprecateTemplate = `-- name: UndeprecateTemplate :one
+UPDATE templates
 SET
-    label = $2,
-    "order" = $3,
-    is_required = $4
+    deprecated_at = NULL,
+    successor_id = NULL
 WHERE id = $1
-RETURNING id, template_id, label, "order", is_required
+RETURNING id, name, owner_id, updated_at, version, forked_from_id, visibility, deprecated_at, successor_id
 `
 
-type UpdateFieldParams struct {
-	ID         pgtype.UUID `db:"id" json:"id"`
-	Label      string      `db:"label" json:"label"`
-	Order      int32       `db:"order" json:"order"`
-	IsRequired bool        `db:"is_required" json:"is_required"`
-}
-
-func (q *Queries) UpdateField(ctx context.Context, arg *UpdateFieldParams) (*Field, error) {
-	row := q.db.QueryRow(ctx, updateField,
-		arg.ID,
-		arg.Label,
-		arg.Order,
-		arg.IsRequired,
-	)
-	var i Field
+func (q *Queries) UndeprecateTemplate(ctx context.Context, id pgtype.UUID) (*Template, error) {
+	row := q.db.QueryRow(ctx, undeprecateTemplate, id)
+	var i Template
 	err := row.Scan(
 		&i.ID,
-		&i.TemplateID,
-		&i.Label,
-		&i.Order,
-		&i.IsRequired,
+		&i.Name,
+		&i.OwnerID,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ForkedFromID,
+		&i.Visibility,
+		&i.DeprecatedAt,
+		&i.SuccessorID,
 	)
 	return &i, err
 }
@@ -288,24 +449,32 @@ const updateTemplate = `-- name: UpdateTemplate :one
 UPDATE templates
 SET
     name = $2,
+    visibility = $3,
+    version = version + 1,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, owner_id, updated_at
+RETURNING id, name, owner_id, updated_at, version, forked_from_id, visibility, deprecated_at, successor_id
 `
 
 type UpdateTemplateParams struct {
-	ID   pgtype.UUID `db:"id" json:"id"`
-	Name string      `db:"name" json:"name"`
+	ID         pgtype.UUID `db:"id" json:"id"`
+	Name       string      `db:"name" json:"name"`
+	Visibility string      `db:"visibility" json:"visibility"`
 }
 
 func (q *Queries) UpdateTemplate(ctx context.Context, arg *UpdateTemplateParams) (*Template, error) {
-	row := q.db.QueryRow(ctx, updateTemplate, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateTemplate, arg.ID, arg.Name, arg.Visibility)
 	var i Template
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ForkedFromID,
+		&i.Visibility,
+		&i.DeprecatedAt,
+		&i.SuccessorID,
 	)
 	return &i, err
 }
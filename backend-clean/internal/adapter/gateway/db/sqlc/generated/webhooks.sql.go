@@ -0,0 +1,391 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhooks.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => $1::float8)
+WHERE id IN (
+    SELECT d.id
+    FROM webhook_deliveries d
+    WHERE d.status = 'pending'
+      AND d.next_attempt_at <= NOW()
+    ORDER BY d.created_at ASC
+    LIMIT $2::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, subscription_id, event_id, event_name, payload, status, attempts, next_attempt_at, response_status, latency_ms, last_error, redelivery_of, created_at, completed_at
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	LeaseSeconds float64 `db:"lease_seconds" json:"lease_seconds"`
+	BatchSize    int32   `db:"batch_size" json:"batch_size"`
+}
+
+// Claimed deliveries count an attempt and are hidden for the lease, so a crashed worker
+// only delays them.
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg *ClaimWebhookDeliveriesParams) ([]*WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, claimWebhookDeliveries, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventName,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ResponseStatus,
+			&i.LatencyMs,
+			&i.LastError,
+			&i.RedeliveryOf,
+			&i.CreatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhookRedelivery = `-- name: CreateWebhookRedelivery :one
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_name, payload, redelivery_of)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, subscription_id, event_id, event_name, payload, status, attempts, next_attempt_at, response_status, latency_ms, last_error, redelivery_of, created_at, completed_at
+`
+
+type CreateWebhookRedeliveryParams struct {
+	SubscriptionID pgtype.UUID `db:"subscription_id" json:"subscription_id"`
+	EventID        pgtype.UUID `db:"event_id" json:"event_id"`
+	EventName      string      `db:"event_name" json:"event_name"`
+	Payload        []byte      `db:"payload" json:"payload"`
+	RedeliveryOf   pgtype.UUID `db:"redelivery_of" json:"redelivery_of"`
+}
+
+func (q *Queries) CreateWebhookRedelivery(ctx context.Context, arg *CreateWebhookRedeliveryParams) (*WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookRedelivery,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventName,
+		arg.Payload,
+		arg.RedeliveryOf,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventName,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.ResponseStatus,
+		&i.LatencyMs,
+		&i.LastError,
+		&i.RedeliveryOf,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return &i, err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (owner_id, url, secret, events, active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, owner_id, url, secret, events, active, created_at, updated_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	OwnerID pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Url     string      `db:"url" json:"url"`
+	Secret  string      `db:"secret" json:"secret"`
+	Events  []string    `db:"events" json:"events"`
+	Active  bool        `db:"active" json:"active"`
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg *CreateWebhookSubscriptionParams) (*WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.OwnerID,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.Active,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteWebhookSubscription, id)
+	return err
+}
+
+const enqueueWebhookDelivery = `-- name: EnqueueWebhookDelivery :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_name, payload)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (subscription_id, event_id) WHERE redelivery_of IS NULL DO NOTHING
+`
+
+type EnqueueWebhookDeliveryParams struct {
+	SubscriptionID pgtype.UUID `db:"subscription_id" json:"subscription_id"`
+	EventID        pgtype.UUID `db:"event_id" json:"event_id"`
+	EventName      string      `db:"event_name" json:"event_name"`
+	Payload        []byte      `db:"payload" json:"payload"`
+}
+
+func (q *Queries) EnqueueWebhookDelivery(ctx context.Context, arg *EnqueueWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, enqueueWebhookDelivery,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventName,
+		arg.Payload,
+	)
+	return err
+}
+
+const getWebhookDeliveryByID = `-- name: GetWebhookDeliveryByID :one
+SELECT id, subscription_id, event_id, event_name, payload, status, attempts, next_attempt_at, response_status, latency_ms, last_error, redelivery_of, created_at, completed_at
+FROM webhook_deliveries
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookDeliveryByID(ctx context.Context, id pgtype.UUID) (*WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, getWebhookDeliveryByID, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventName,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.ResponseStatus,
+		&i.LatencyMs,
+		&i.LastError,
+		&i.RedeliveryOf,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return &i, err
+}
+
+const getWebhookSubscriptionByID = `-- name: GetWebhookSubscriptionByID :one
+SELECT id, owner_id, url, secret, events, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookSubscriptionByID(ctx context.Context, id pgtype.UUID) (*WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscriptionByID, id)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_name, payload, status, attempts, next_attempt_at, response_status, latency_ms, last_error, redelivery_of, created_at, completed_at
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID pgtype.UUID `db:"subscription_id" json:"subscription_id"`
+	Limit          int32       `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg *ListWebhookDeliveriesParams) ([]*WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries, arg.SubscriptionID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventName,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ResponseStatus,
+			&i.LatencyMs,
+			&i.LastError,
+			&i.RedeliveryOf,
+			&i.CreatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptionsByOwner = `-- name: ListWebhookSubscriptionsByOwner :many
+SELECT id, owner_id, url, secret, events, active, created_at, updated_at
+FROM webhook_subscriptions
+WHERE owner_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListWebhookSubscriptionsByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptionsByOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordWebhookAttempt = `-- name: RecordWebhookAttempt :exec
+UPDATE webhook_deliveries
+SET
+    status = $1,
+    response_status = $2,
+    latency_ms = $3,
+    last_error = $4,
+    next_attempt_at = NOW() + make_interval(secs => $5::float8),
+    completed_at = CASE WHEN $1 = 'pending' THEN NULL ELSE NOW() END
+WHERE id = $6
+`
+
+type RecordWebhookAttemptParams struct {
+	Status         string      `db:"status" json:"status"`
+	ResponseStatus pgtype.Int4 `db:"response_status" json:"response_status"`
+	LatencyMs      pgtype.Int4 `db:"latency_ms" json:"latency_ms"`
+	LastError      pgtype.Text `db:"last_error" json:"last_error"`
+	RetrySeconds   float64     `db:"retry_seconds" json:"retry_seconds"`
+	ID             pgtype.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) RecordWebhookAttempt(ctx context.Context, arg *RecordWebhookAttemptParams) error {
+	_, err := q.db.Exec(ctx, recordWebhookAttempt,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.LatencyMs,
+		arg.LastError,
+		arg.RetrySeconds,
+		arg.ID,
+	)
+	return err
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET
+    url = $2,
+    secret = $3,
+    events = $4,
+    active = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, url, secret, events, active, created_at, updated_at
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	ID     pgtype.UUID `db:"id" json:"id"`
+	Url    string      `db:"url" json:"url"`
+	Secret string      `db:"secret" json:"secret"`
+	Events []string    `db:"events" json:"events"`
+	Active bool        `db:"active" json:"active"`
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg *UpdateWebhookSubscriptionParams) (*WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, updateWebhookSubscription,
+		arg.ID,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.Active,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
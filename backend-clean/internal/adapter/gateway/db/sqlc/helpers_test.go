@@ -20,4 +20,11 @@ func TestPgNullableHelpers(t *testing.T) {
 	if v := pgNullableTime(&now); !v.Valid || !v.Time.Equal(now) {
 		t.Fatalf("unexpected time: %+v", v)
 	}
+	n := 5
+	if v := int4ToIntPtr(pgNullableInt4(nil)); v != nil {
+		t.Fatalf("expected nil int for invalid int4")
+	}
+	if v := int4ToIntPtr(pgNullableInt4(&n)); v == nil || *v != n {
+		t.Fatalf("unexpected int: %v", v)
+	}
 }
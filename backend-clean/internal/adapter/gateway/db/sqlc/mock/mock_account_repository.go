@@ -14,8 +14,10 @@ import (
 
 // AccountDBTX is a lightweight mock for sqlc.DBTX to test account repository.
 type AccountDBTX struct {
-	row *generated.Account
-	err error
+	row       *generated.Account
+	err       error
+	followers int64
+	following int64
 }
 
 // NewAccountDBTX creates a mock DBTX that always returns the given row/err.
@@ -23,6 +25,13 @@ func NewAccountDBTX(row *generated.Account, err error) *AccountDBTX {
 	return &AccountDBTX{row: row, err: err}
 }
 
+// WithFollowCounts configures the follower and following counts of the account.
+func (m *AccountDBTX) WithFollowCounts(followers, following int64) *AccountDBTX {
+	m.followers = followers
+	m.following = following
+	return m
+}
+
 // Exec implements sqlc.DBTX interface.
 func (m *AccountDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
 	return pgconn.CommandTag{}, nil
@@ -35,19 +44,26 @@ func (m *AccountDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.
 
 // QueryRow implements sqlc.DBTX interface.
 func (m *AccountDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
-	return &accountRow{row: m.row, err: m.err}
+	return &accountRow{row: m.row, err: m.err, followers: m.followers, following: m.following}
 }
 
-// accountRow mocks pgx.Row for account queries.
+// accountRow mocks pgx.Row for account queries; follow counts scan 2 columns.
 type accountRow struct {
-	row *generated.Account
-	err error
+	row       *generated.Account
+	err       error
+	followers int64
+	following int64
 }
 
 func (m *accountRow) Scan(dest ...interface{}) error {
 	if m.err != nil {
 		return m.err
 	}
+	if len(dest) == 2 {
+		setInt64(dest[0], m.followers)
+		setInt64(dest[1], m.following)
+		return nil
+	}
 	if len(dest) != 11 {
 		return errors.New("unexpected scan args")
 	}
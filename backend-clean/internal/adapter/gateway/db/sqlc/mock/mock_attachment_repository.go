@@ -0,0 +1,108 @@
+// Package mock provides test mocks for sqlc repositories.
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// AttachmentDBTX is a lightweight mock for sqlc.DBTX used in attachment repository tests.
+type AttachmentDBTX struct {
+	row      *generated.Attachment
+	list     []*generated.Attachment
+	rowErr   error
+	execErr  error
+	queryErr error
+}
+
+// NewAttachmentDBTX creates a mock DBTX that always returns the given row/err.
+func NewAttachmentDBTX(row *generated.Attachment, rowErr, execErr error) *AttachmentDBTX {
+	return &AttachmentDBTX{row: row, rowErr: rowErr, execErr: execErr}
+}
+
+// WithList configures rows returned by ListAttachmentsByNote.
+func (m *AttachmentDBTX) WithList(list []*generated.Attachment, queryErr error) *AttachmentDBTX {
+	m.list = list
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *AttachmentDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *AttachmentDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &attachmentRows{items: m.list}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *AttachmentDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &attachmentRow{row: m.row, err: m.rowErr}
+}
+
+type attachmentRow struct {
+	row *generated.Attachment
+	err error
+}
+
+func (m *attachmentRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	return scanAttachment(m.row, dest)
+}
+
+type attachmentRows struct {
+	items []*generated.Attachment
+	idx   int
+	err   error
+}
+
+func (r *attachmentRows) Close()                                       {}
+func (r *attachmentRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *attachmentRows) Err() error                                   { return r.err }
+func (r *attachmentRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *attachmentRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *attachmentRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *attachmentRows) RawValues() [][]byte                          { return nil }
+func (r *attachmentRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanAttachment(r.items[r.idx-1], dest)
+}
+func (r *attachmentRows) Conn() *pgx.Conn { return nil }
+
+func scanAttachment(row *generated.Attachment, dest []interface{}) error {
+	if len(dest) != 8 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.NoteID)
+	setUUID(dest[2], row.SectionID)
+	setString(dest[3], row.FileName)
+	setString(dest[4], row.ContentType)
+	setInt64(dest[5], row.Size)
+	setString(dest[6], row.StorageKey)
+	setTimestamptz(dest[7], row.CreatedAt)
+	return nil
+}
+
+func setInt64(ptr interface{}, v int64) {
+	if dest, ok := ptr.(*int64); ok {
+		*dest = v
+	}
+}
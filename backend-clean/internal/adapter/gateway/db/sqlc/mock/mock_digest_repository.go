@@ -0,0 +1,134 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// DigestDBTX is a lightweight mock for sqlc.DBTX used in digest repository tests.
+// Rows are told apart by their column count: due digests scan 3 columns, settings 4, drafts 7.
+type DigestDBTX struct {
+	settings *generated.DigestSetting
+	due      []*generated.ListDueDigestsRow
+	drafts   []*generated.ListStaleDraftsRow
+	affected int64
+	rowErr   error
+	execErr  error
+	queryErr error
+	// ExecArgs records the arguments of every Exec call.
+	ExecArgs [][]interface{}
+	// QueryArgs records the arguments of every Query call.
+	QueryArgs [][]interface{}
+}
+
+// NewDigestDBTX creates a mock DBTX returning the given settings from single-row queries.
+func NewDigestDBTX(settings *generated.DigestSetting, rowErr, execErr error) *DigestDBTX {
+	return &DigestDBTX{settings: settings, rowErr: rowErr, execErr: execErr}
+}
+
+// WithDue configures rows returned by ListDueDigests.
+func (m *DigestDBTX) WithDue(due []*generated.ListDueDigestsRow, queryErr error) *DigestDBTX {
+	m.due = due
+	m.queryErr = queryErr
+	return m
+}
+
+// WithDrafts configures rows returned by ListStaleDrafts.
+func (m *DigestDBTX) WithDrafts(drafts []*generated.ListStaleDraftsRow) *DigestDBTX {
+	m.drafts = drafts
+	return m
+}
+
+// WithAffected configures the rows affected by Exec.
+func (m *DigestDBTX) WithAffected(n int64) *DigestDBTX {
+	m.affected = n
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *DigestDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.ExecArgs = append(m.ExecArgs, args)
+	return pgconn.NewCommandTag("INSERT 0 " + strconv.FormatInt(m.affected, 10)), m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *DigestDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+	m.QueryArgs = append(m.QueryArgs, args)
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &digestRows{due: m.due, drafts: m.drafts}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *DigestDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &digestRow{m: m}
+}
+
+type digestRow struct {
+	m *DigestDBTX
+}
+
+func (r *digestRow) Scan(dest ...interface{}) error {
+	if r.m.rowErr != nil {
+		return r.m.rowErr
+	}
+	if len(dest) != 4 {
+		return errors.New("unexpected scan args")
+	}
+	if r.m.settings == nil {
+		return errors.New("settings are nil")
+	}
+	setUUID(dest[0], r.m.settings.AccountID)
+	setString(dest[1], r.m.settings.Frequency)
+	setTimestamptz(dest[2], r.m.settings.LastSentAt)
+	setTimestamptz(dest[3], r.m.settings.UpdatedAt)
+	return nil
+}
+
+// digestRows serves due digests or stale drafts, whichever the scan asks for.
+type digestRows struct {
+	due    []*generated.ListDueDigestsRow
+	drafts []*generated.ListStaleDraftsRow
+	idx    int
+}
+
+func (r *digestRows) Close() {}
+func (r *digestRows) Next() bool {
+	r.idx++
+	return r.idx <= max(len(r.due), len(r.drafts))
+}
+func (r *digestRows) Err() error                                   { return nil }
+func (r *digestRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *digestRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *digestRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *digestRows) RawValues() [][]byte                          { return nil }
+func (r *digestRows) Scan(dest ...interface{}) error {
+	switch {
+	case len(dest) == 3 && r.idx >= 1 && r.idx <= len(r.due):
+		row := r.due[r.idx-1]
+		setUUID(dest[0], row.AccountID)
+		setString(dest[1], row.Frequency)
+		setTimestamptz(dest[2], row.LastSentAt)
+		return nil
+	case len(dest) == 7 && r.idx >= 1 && r.idx <= len(r.drafts):
+		row := r.drafts[r.idx-1]
+		setUUID(dest[0], row.ID)
+		setString(dest[1], row.Title)
+		setUUID(dest[2], row.TemplateID)
+		setUUID(dest[3], row.OwnerID)
+		setString(dest[4], row.Status)
+		setTimestamptz(dest[5], row.CreatedAt)
+		setTimestamptz(dest[6], row.UpdatedAt)
+		return nil
+	default:
+		return errors.New("scan called out of range")
+	}
+}
+func (r *digestRows) Conn() *pgx.Conn { return nil }
@@ -0,0 +1,80 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// FollowDBTX is a lightweight mock for sqlc.DBTX used in follow repository tests.
+type FollowDBTX struct {
+	feed     []*generated.ListFeedRow
+	execErr  error
+	queryErr error
+	// ExecArgs records the arguments of every Exec call.
+	ExecArgs [][]interface{}
+	// QueryArgs records the arguments of every Query call.
+	QueryArgs [][]interface{}
+}
+
+// NewFollowDBTX creates a mock DBTX returning the given feed rows.
+func NewFollowDBTX(feed []*generated.ListFeedRow, execErr, queryErr error) *FollowDBTX {
+	return &FollowDBTX{feed: feed, execErr: execErr, queryErr: queryErr}
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *FollowDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.ExecArgs = append(m.ExecArgs, args)
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *FollowDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+	m.QueryArgs = append(m.QueryArgs, args)
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &feedRows{list: m.feed}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *FollowDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return nil
+}
+
+type feedRows struct {
+	list []*generated.ListFeedRow
+	idx  int
+}
+
+func (r *feedRows) Close()                                       {}
+func (r *feedRows) Next() bool                                   { r.idx++; return r.idx <= len(r.list) }
+func (r *feedRows) Err() error                                   { return nil }
+func (r *feedRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *feedRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *feedRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *feedRows) RawValues() [][]byte                          { return nil }
+func (r *feedRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.list) {
+		return errors.New("scan called out of range")
+	}
+	if len(dest) != 9 {
+		return errors.New("unexpected scan args")
+	}
+	row := r.list[r.idx-1]
+	setUUID(dest[0], row.SubjectID)
+	setString(dest[1], row.Kind)
+	setUUID(dest[2], row.AuthorID)
+	setString(dest[3], row.Title)
+	setUUID(dest[4], row.TemplateID)
+	setTimestamptz(dest[5], row.OccurredAt)
+	setString(dest[6], row.AuthorFirstName)
+	setString(dest[7], row.AuthorLastName)
+	setText(dest[8], row.AuthorThumbnail)
+	return nil
+}
+func (r *feedRows) Conn() *pgx.Conn { return nil }
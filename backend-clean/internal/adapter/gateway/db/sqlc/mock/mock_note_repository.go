@@ -16,6 +16,7 @@ type NoteDBTX struct {
 	row        *generated.Note
 	getRow     *generated.GetNoteByIDRow
 	sectionRow *generated.Section
+	revRow     *generated.NoteRevision
 	rowErr     error
 	execErr    error
 	queryErr   error
@@ -48,6 +49,12 @@ func (m *NoteDBTX) WithSectionRow(row *generated.Section) *NoteDBTX {
 	return m
 }
 
+// WithRevisionRow sets a NoteRevision row for CreateNoteRevision scans.
+func (m *NoteDBTX) WithRevisionRow(row *generated.NoteRevision) *NoteDBTX {
+	m.revRow = row
+	return m
+}
+
 // Exec implements sqlc.DBTX interface.
 func (m *NoteDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
 	return pgconn.CommandTag{}, m.execErr
@@ -67,13 +74,14 @@ func (m *NoteDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.
 
 // QueryRow implements sqlc.DBTX interface.
 func (m *NoteDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
-	return &noteRow{row: m.row, getRow: m.getRow, secRow: m.sectionRow, err: m.rowErr}
+	return &noteRow{row: m.row, getRow: m.getRow, secRow: m.sectionRow, revRow: m.revRow, err: m.rowErr}
 }
 
 type noteRow struct {
 	row    *generated.Note
 	getRow *generated.GetNoteByIDRow
 	secRow *generated.Section
+	revRow *generated.NoteRevision
 	err    error
 }
 
@@ -82,7 +90,7 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		return m.err
 	}
 	switch len(dest) {
-	case 11:
+	case 12:
 		if m.getRow == nil {
 			return errors.New("getRow is nil")
 		}
@@ -93,12 +101,13 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		setString(dest[4], m.getRow.Status)
 		setTimestamptz(dest[5], m.getRow.CreatedAt)
 		setTimestamptz(dest[6], m.getRow.UpdatedAt)
-		setString(dest[7], m.getRow.TemplateName)
-		setString(dest[8], m.getRow.FirstName)
-		setString(dest[9], m.getRow.LastName)
-		setText(dest[10], m.getRow.OwnerThumbnail)
+		setInt32(dest[7], m.getRow.TemplateVersion)
+		setString(dest[8], m.getRow.TemplateName)
+		setString(dest[9], m.getRow.FirstName)
+		setString(dest[10], m.getRow.LastName)
+		setText(dest[11], m.getRow.OwnerThumbnail)
 		return nil
-	case 7:
+	case 8:
 		if m.row == nil {
 			return errors.New("row is nil")
 		}
@@ -109,6 +118,19 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		setString(dest[4], m.row.Status)
 		setTimestamptz(dest[5], m.row.CreatedAt)
 		setTimestamptz(dest[6], m.row.UpdatedAt)
+		setInt32(dest[7], m.row.TemplateVersion)
+		return nil
+	case 7:
+		if m.revRow == nil {
+			return errors.New("revisionRow is nil")
+		}
+		setUUID(dest[0], m.revRow.ID)
+		setUUID(dest[1], m.revRow.NoteID)
+		setUUID(dest[2], m.revRow.TemplateID)
+		setInt32(dest[3], m.revRow.TemplateVersion)
+		setString(dest[4], m.revRow.Title)
+		setBytes(dest[5], m.revRow.Sections)
+		setTimestamptz(dest[6], m.revRow.CreatedAt)
 		return nil
 	case 4:
 		if m.secRow == nil {
@@ -147,7 +169,7 @@ func (r *noteRows) Scan(dest ...interface{}) error {
 		return errors.New("scan called out of range")
 	}
 	item := r.items[r.idx-1]
-	if len(dest) != 11 {
+	if len(dest) != 12 {
 		return errors.New("unexpected scan args")
 	}
 	setUUID(dest[0], item.ID)
@@ -157,10 +179,11 @@ func (r *noteRows) Scan(dest ...interface{}) error {
 	setString(dest[4], item.Status)
 	setTimestamptz(dest[5], item.CreatedAt)
 	setTimestamptz(dest[6], item.UpdatedAt)
-	setString(dest[7], item.TemplateName)
-	setString(dest[8], item.FirstName)
-	setString(dest[9], item.LastName)
-	setText(dest[10], item.OwnerThumbnail)
+	setInt32(dest[7], item.TemplateVersion)
+	setString(dest[8], item.TemplateName)
+	setString(dest[9], item.FirstName)
+	setString(dest[10], item.LastName)
+	setText(dest[11], item.OwnerThumbnail)
 	return nil
 }
 func (r *noteRows) Conn() *pgx.Conn { return nil }
@@ -183,7 +206,7 @@ func (r *sectionRows) Scan(dest ...interface{}) error {
 		return errors.New("scan called out of range")
 	}
 	item := r.items[r.idx-1]
-	if len(dest) != 7 {
+	if len(dest) != 9 {
 		return errors.New("unexpected scan args")
 	}
 	setUUID(dest[0], item.ID)
@@ -193,10 +216,18 @@ func (r *sectionRows) Scan(dest ...interface{}) error {
 	setString(dest[4], "")      // label
 	setInt32(dest[5], int32(0)) // order
 	setBool(dest[6], false)     // is_required
+	setString(dest[7], "text")  // field_type
+	setBytes(dest[8], nil)      // field_options
 	return nil
 }
 func (r *sectionRows) Conn() *pgx.Conn { return nil }
 
+func setBytes(ptr interface{}, v []byte) {
+	if dest, ok := ptr.(*[]byte); ok {
+		*dest = v
+	}
+}
+
 func setInt32(ptr interface{}, v int32) {
 	if dest, ok := ptr.(*int32); ok {
 		*dest = v
@@ -0,0 +1,137 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// NotificationDBTX is a lightweight mock for sqlc.DBTX used in notification repository tests.
+// Rows are told apart by their column count: counts scan 1 column, preferences 3, notifications 9.
+type NotificationDBTX struct {
+	notification  *generated.Notification
+	notifications []*generated.Notification
+	prefs         *generated.NotificationPreference
+	count         int64
+	rowErr        error
+	execErr       error
+	queryErr      error
+	// ExecArgs records the arguments of every Exec call.
+	ExecArgs [][]interface{}
+	// QueryArgs records the arguments of every Query call.
+	QueryArgs [][]interface{}
+}
+
+// NewNotificationDBTX creates a mock DBTX returning the given notification from single-row queries.
+func NewNotificationDBTX(n *generated.Notification, rowErr, execErr error) *NotificationDBTX {
+	return &NotificationDBTX{notification: n, rowErr: rowErr, execErr: execErr}
+}
+
+// WithNotifications configures rows returned by ListNotifications.
+func (m *NotificationDBTX) WithNotifications(list []*generated.Notification, queryErr error) *NotificationDBTX {
+	m.notifications = list
+	m.queryErr = queryErr
+	return m
+}
+
+// WithPreferences configures the row returned by preference queries.
+func (m *NotificationDBTX) WithPreferences(p *generated.NotificationPreference) *NotificationDBTX {
+	m.prefs = p
+	return m
+}
+
+// WithCount configures the unread count and the rows affected by Exec.
+func (m *NotificationDBTX) WithCount(count int64) *NotificationDBTX {
+	m.count = count
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *NotificationDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.ExecArgs = append(m.ExecArgs, args)
+	return pgconn.NewCommandTag("UPDATE " + strconv.FormatInt(m.count, 10)), m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *NotificationDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+	m.QueryArgs = append(m.QueryArgs, args)
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &notificationRows{list: m.notifications}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *NotificationDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &notificationRow{m: m}
+}
+
+type notificationRow struct {
+	m *NotificationDBTX
+}
+
+func (r *notificationRow) Scan(dest ...interface{}) error {
+	if r.m.rowErr != nil {
+		return r.m.rowErr
+	}
+	switch len(dest) {
+	case 1:
+		setInt64(dest[0], r.m.count)
+		return nil
+	case 3:
+		if r.m.prefs == nil {
+			return errors.New("preferences are nil")
+		}
+		setUUID(dest[0], r.m.prefs.AccountID)
+		if ptr, ok := dest[1].(*[]string); ok {
+			*ptr = r.m.prefs.MutedKinds
+		}
+		setTimestamptz(dest[2], r.m.prefs.UpdatedAt)
+		return nil
+	case 9:
+		if r.m.notification == nil {
+			return errors.New("notification is nil")
+		}
+		return scanNotification(r.m.notification, dest)
+	default:
+		return errors.New("unexpected scan args")
+	}
+}
+
+type notificationRows struct {
+	list []*generated.Notification
+	idx  int
+}
+
+func (r *notificationRows) Close()                                       {}
+func (r *notificationRows) Next() bool                                   { r.idx++; return r.idx <= len(r.list) }
+func (r *notificationRows) Err() error                                   { return nil }
+func (r *notificationRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *notificationRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *notificationRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *notificationRows) RawValues() [][]byte                          { return nil }
+func (r *notificationRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.list) {
+		return errors.New("scan called out of range")
+	}
+	return scanNotification(r.list[r.idx-1], dest)
+}
+func (r *notificationRows) Conn() *pgx.Conn { return nil }
+
+func scanNotification(row *generated.Notification, dest []interface{}) error {
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.RecipientID)
+	setString(dest[2], row.Kind)
+	setUUID(dest[3], row.ActorID)
+	setUUID(dest[4], row.SubjectID)
+	setString(dest[5], row.SubjectTitle)
+	setUUID(dest[6], row.EventID)
+	setTimestamptz(dest[7], row.ReadAt)
+	setTimestamptz(dest[8], row.CreatedAt)
+	return nil
+}
@@ -0,0 +1,102 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// OutboxDBTX is a lightweight mock for sqlc.DBTX used in outbox repository tests.
+type OutboxDBTX struct {
+	list     []*generated.Outbox
+	execErr  error
+	queryErr error
+	// ExecArgs records the arguments of every Exec call.
+	ExecArgs [][]interface{}
+}
+
+// NewOutboxDBTX creates a mock DBTX returning list from queries and the given errors.
+func NewOutboxDBTX(list []*generated.Outbox, queryErr, execErr error) *OutboxDBTX {
+	return &OutboxDBTX{list: list, queryErr: queryErr, execErr: execErr}
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *OutboxDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.ExecArgs = append(m.ExecArgs, args)
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *OutboxDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &outboxRows{items: m.list}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+// It returns the first list item, pgx.ErrNoRows when the list is empty, or the query error.
+func (m *OutboxDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	switch {
+	case m.queryErr != nil:
+		return &outboxRow{err: m.queryErr}
+	case len(m.list) == 0:
+		return &outboxRow{err: pgx.ErrNoRows}
+	}
+	return &outboxRow{item: m.list[0]}
+}
+
+type outboxRow struct {
+	item *generated.Outbox
+	err  error
+}
+
+func (r *outboxRow) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return scanOutbox(r.item, dest)
+}
+
+type outboxRows struct {
+	items []*generated.Outbox
+	idx   int
+	err   error
+}
+
+func (r *outboxRows) Close()                                       {}
+func (r *outboxRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *outboxRows) Err() error                                   { return r.err }
+func (r *outboxRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *outboxRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *outboxRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *outboxRows) RawValues() [][]byte                          { return nil }
+func (r *outboxRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanOutbox(r.items[r.idx-1], dest)
+}
+func (r *outboxRows) Conn() *pgx.Conn { return nil }
+
+func scanOutbox(item *generated.Outbox, dest []interface{}) error {
+	if len(dest) != 11 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], item.ID)
+	setString(dest[1], item.Name)
+	setUUID(dest[2], item.AggregateID)
+	setUUID(dest[3], item.ActorID)
+	setBytes(dest[4], item.Data)
+	setTimestamptz(dest[5], item.OccurredAt)
+	setInt32(dest[6], item.Attempts)
+	setTimestamptz(dest[7], item.NextAttemptAt)
+	setText(dest[8], item.LastError)
+	setTimestamptz(dest[9], item.DeliveredAt)
+	setTimestamptz(dest[10], item.DeadAt)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 )
@@ -16,6 +17,7 @@ type TemplateDBTX struct {
 	templateRow *generated.Template
 	detailRow   *generated.GetTemplateByIDRow
 	FieldRow    *generated.Field
+	Used        bool
 	rowErr      error
 	execErr     error
 	QueryErr    error
@@ -46,13 +48,14 @@ func (m *TemplateDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx
 
 // QueryRow implements sqlc.DBTX interface.
 func (m *TemplateDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
-	return &templateRow{templateRow: m.templateRow, detailRow: m.detailRow, fieldRow: m.FieldRow, err: m.rowErr}
+	return &templateRow{templateRow: m.templateRow, detailRow: m.detailRow, fieldRow: m.FieldRow, used: m.Used, err: m.rowErr}
 }
 
 type templateRow struct {
 	templateRow *generated.Template
 	detailRow   *generated.GetTemplateByIDRow
 	fieldRow    *generated.Field
+	used        bool
 	err         error
 }
 
@@ -61,7 +64,7 @@ func (m *templateRow) Scan(dest ...interface{}) error {
 		return m.err
 	}
 	switch len(dest) {
-	case 5: // Field
+	case 13: // Field
 		if m.fieldRow == nil {
 			return errors.New("fieldRow is nil")
 		}
@@ -70,20 +73,44 @@ func (m *templateRow) Scan(dest ...interface{}) error {
 		setString(dest[2], m.fieldRow.Label)
 		setInt32Field(dest[3], m.fieldRow.Order)
 		setBool(dest[4], m.fieldRow.IsRequired)
-	case 4: // Template
+		setString(dest[5], m.fieldRow.Type)
+		setBytes(dest[6], m.fieldRow.Options)
+		setInt4(dest[7], m.fieldRow.MinLength)
+		setInt4(dest[8], m.fieldRow.MaxLength)
+		setString(dest[9], m.fieldRow.Pattern)
+		setString(dest[10], m.fieldRow.Placeholder)
+		setString(dest[11], m.fieldRow.HelpText)
+		setInt32Field(dest[12], m.fieldRow.Version)
+	case 1: // EXISTS checks
+		setBool(dest[0], m.used)
+	case 9: // Template
 		setUUID(dest[0], m.templateRow.ID)
 		setString(dest[1], m.templateRow.Name)
 		setUUID(dest[2], m.templateRow.OwnerID)
 		setTimestamptz(dest[3], m.templateRow.UpdatedAt)
-	case 8: // GetTemplateByIDRow
+		setInt32Field(dest[4], m.templateRow.Version)
+		setUUID(dest[5], m.templateRow.ForkedFromID)
+		setString(dest[6], m.templateRow.Visibility)
+		setTimestamptz(dest[7], m.templateRow.DeprecatedAt)
+		setUUID(dest[8], m.templateRow.SuccessorID)
+	case 17: // GetTemplateByIDRow
 		setUUID(dest[0], m.detailRow.ID)
 		setString(dest[1], m.detailRow.Name)
 		setUUID(dest[2], m.detailRow.OwnerID)
 		setTimestamptz(dest[3], m.detailRow.UpdatedAt)
-		setString(dest[4], m.detailRow.OwnerFirstName)
-		setString(dest[5], m.detailRow.OwnerLastName)
-		setText(dest[6], m.detailRow.OwnerThumbnail)
-		setBool(dest[7], m.detailRow.IsUsed)
+		setInt32Field(dest[4], m.detailRow.Version)
+		setUUID(dest[5], m.detailRow.ForkedFromID)
+		setString(dest[6], m.detailRow.Visibility)
+		setTimestamptz(dest[7], m.detailRow.DeprecatedAt)
+		setUUID(dest[8], m.detailRow.SuccessorID)
+		setString(dest[9], m.detailRow.OwnerFirstName)
+		setString(dest[10], m.detailRow.OwnerLastName)
+		setText(dest[11], m.detailRow.OwnerThumbnail)
+		setInt64(dest[12], m.detailRow.NoteCount)
+		setInt64(dest[13], m.detailRow.PublishedCount)
+		setInt64(dest[14], m.detailRow.AuthorCount)
+		setTimestamptz(dest[15], m.detailRow.LastUsedAt)
+		setInt64(dest[16], m.detailRow.ForkCount)
 	default:
 		return errors.New("unexpected scan args")
 	}
@@ -112,3 +139,9 @@ func setInt32Field(ptr interface{}, v int32) {
 		*dest = v
 	}
 }
+
+func setInt4(ptr interface{}, v pgtype.Int4) {
+	if dest, ok := ptr.(*pgtype.Int4); ok {
+		*dest = v
+	}
+}
@@ -0,0 +1,155 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// WebhookDBTX is a lightweight mock for sqlc.DBTX used in webhook repository tests.
+// Rows are told apart by their column count: subscriptions scan 8 columns, deliveries 14.
+type WebhookDBTX struct {
+	sub        *generated.WebhookSubscription
+	subs       []*generated.WebhookSubscription
+	delivery   *generated.WebhookDelivery
+	deliveries []*generated.WebhookDelivery
+	rowErr     error
+	execErr    error
+	queryErr   error
+	// ExecArgs records the arguments of every Exec call.
+	ExecArgs [][]interface{}
+}
+
+// NewWebhookDBTX creates a mock DBTX returning the given subscription/delivery from single-row queries.
+func NewWebhookDBTX(sub *generated.WebhookSubscription, delivery *generated.WebhookDelivery, rowErr, execErr error) *WebhookDBTX {
+	return &WebhookDBTX{sub: sub, delivery: delivery, rowErr: rowErr, execErr: execErr}
+}
+
+// WithSubscriptions configures rows returned by ListWebhookSubscriptionsByOwner.
+func (m *WebhookDBTX) WithSubscriptions(list []*generated.WebhookSubscription, queryErr error) *WebhookDBTX {
+	m.subs = list
+	m.queryErr = queryErr
+	return m
+}
+
+// WithDeliveries configures rows returned by ListWebhookDeliveries and ClaimWebhookDeliveries.
+func (m *WebhookDBTX) WithDeliveries(list []*generated.WebhookDelivery, queryErr error) *WebhookDBTX {
+	m.deliveries = list
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *WebhookDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.ExecArgs = append(m.ExecArgs, args)
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *WebhookDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &webhookRows{subs: m.subs, deliveries: m.deliveries}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *WebhookDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &webhookRow{sub: m.sub, delivery: m.delivery, err: m.rowErr}
+}
+
+type webhookRow struct {
+	sub      *generated.WebhookSubscription
+	delivery *generated.WebhookDelivery
+	err      error
+}
+
+func (m *webhookRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	switch len(dest) {
+	case 8:
+		if m.sub == nil {
+			return errors.New("subscription is nil")
+		}
+		return scanWebhookSubscription(m.sub, dest)
+	case 14:
+		if m.delivery == nil {
+			return errors.New("delivery is nil")
+		}
+		return scanWebhookDelivery(m.delivery, dest)
+	default:
+		return errors.New("unexpected scan args")
+	}
+}
+
+type webhookRows struct {
+	subs       []*generated.WebhookSubscription
+	deliveries []*generated.WebhookDelivery
+	idx        int
+}
+
+func (r *webhookRows) Close()                                       {}
+func (r *webhookRows) Next() bool                                   { r.idx++; return r.idx <= len(r.subs)+len(r.deliveries) }
+func (r *webhookRows) Err() error                                   { return nil }
+func (r *webhookRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *webhookRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *webhookRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *webhookRows) RawValues() [][]byte                          { return nil }
+func (r *webhookRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 {
+		return errors.New("scan called out of range")
+	}
+	switch len(dest) {
+	case 8:
+		if r.idx > len(r.subs) {
+			return errors.New("scan called out of range")
+		}
+		return scanWebhookSubscription(r.subs[r.idx-1], dest)
+	case 14:
+		if r.idx > len(r.deliveries) {
+			return errors.New("scan called out of range")
+		}
+		return scanWebhookDelivery(r.deliveries[r.idx-1], dest)
+	default:
+		return errors.New("unexpected scan args")
+	}
+}
+func (r *webhookRows) Conn() *pgx.Conn { return nil }
+
+func scanWebhookSubscription(row *generated.WebhookSubscription, dest []interface{}) error {
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.OwnerID)
+	setString(dest[2], row.Url)
+	setString(dest[3], row.Secret)
+	if ptr, ok := dest[4].(*[]string); ok {
+		*ptr = row.Events
+	}
+	setBool(dest[5], row.Active)
+	setTimestamptz(dest[6], row.CreatedAt)
+	setTimestamptz(dest[7], row.UpdatedAt)
+	return nil
+}
+
+func scanWebhookDelivery(row *generated.WebhookDelivery, dest []interface{}) error {
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.SubscriptionID)
+	setUUID(dest[2], row.EventID)
+	setString(dest[3], row.EventName)
+	setBytes(dest[4], row.Payload)
+	setString(dest[5], row.Status)
+	setInt32(dest[6], row.Attempts)
+	setTimestamptz(dest[7], row.NextAttemptAt)
+	setInt4(dest[8], row.ResponseStatus)
+	setInt4(dest[9], row.LatencyMs)
+	setText(dest[10], row.LastError)
+	setUUID(dest[11], row.RedeliveryOf)
+	setTimestamptz(dest[12], row.CreatedAt)
+	setTimestamptz(dest[13], row.CompletedAt)
+	return nil
+}
@@ -3,6 +3,7 @@ package sqlc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/jackc/pgx/v5"
@@ -12,6 +13,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -69,13 +71,14 @@ func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note
 		}
 		result = append(result, note.WithMeta{
 			Note: note.Note{
-				ID:         uuidToString(row.ID),
-				Title:      row.Title,
-				TemplateID: uuidToString(row.TemplateID),
-				OwnerID:    uuidToString(row.OwnerID),
-				Status:     note.NoteStatus(row.Status),
-				CreatedAt:  timestamptzToTime(row.CreatedAt),
-				UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+				ID:              uuidToString(row.ID),
+				Title:           row.Title,
+				TemplateID:      uuidToString(row.TemplateID),
+				TemplateVersion: int(row.TemplateVersion),
+				OwnerID:         uuidToString(row.OwnerID),
+				Status:          note.NoteStatus(row.Status),
+				CreatedAt:       timestamptzToTime(row.CreatedAt),
+				UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 			},
 			TemplateName:   row.TemplateName,
 			OwnerFirstName: row.FirstName,
@@ -91,7 +94,8 @@ func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note
 func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, error) {
 	pgID, err := toUUID(id)
 	if err != nil {
-		return nil, err
+		// a malformed ID cannot match any note
+		return nil, domainerr.ErrNotFound
 	}
 	row, err := queriesForContext(ctx, r.queries).GetNoteByID(ctx, pgID)
 	if err != nil {
@@ -111,13 +115,14 @@ func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, er
 	}
 	return &note.WithMeta{
 		Note: note.Note{
-			ID:         uuidToString(row.ID),
-			Title:      row.Title,
-			TemplateID: uuidToString(row.TemplateID),
-			OwnerID:    uuidToString(row.OwnerID),
-			Status:     note.NoteStatus(row.Status),
-			CreatedAt:  timestamptzToTime(row.CreatedAt),
-			UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+			ID:              uuidToString(row.ID),
+			Title:           row.Title,
+			TemplateID:      uuidToString(row.TemplateID),
+			TemplateVersion: int(row.TemplateVersion),
+			OwnerID:         uuidToString(row.OwnerID),
+			Status:          note.NoteStatus(row.Status),
+			CreatedAt:       timestamptzToTime(row.CreatedAt),
+			UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 		},
 		TemplateName:   row.TemplateName,
 		OwnerFirstName: row.FirstName,
@@ -138,22 +143,24 @@ func (r *NoteRepository) Create(ctx context.Context, n note.Note) (*note.Note, e
 		return nil, err
 	}
 	row, err := queriesForContext(ctx, r.queries).CreateNote(ctx, &generated.CreateNoteParams{
-		Title:      n.Title,
-		TemplateID: templateID,
-		OwnerID:    ownerID,
-		Status:     string(n.Status),
+		Title:           n.Title,
+		TemplateID:      templateID,
+		TemplateVersion: int32(n.TemplateVersion), //nolint:gosec
+		OwnerID:         ownerID,
+		Status:          string(n.Status),
 	})
 	if err != nil {
 		return nil, err
 	}
 	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		OwnerID:         uuidToString(row.OwnerID),
+		Status:          note.NoteStatus(row.Status),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
@@ -174,13 +181,14 @@ func (r *NoteRepository) Update(ctx context.Context, n note.Note) (*note.Note, e
 		return nil, err
 	}
 	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		OwnerID:         uuidToString(row.OwnerID),
+		Status:          note.NoteStatus(row.Status),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
@@ -201,13 +209,14 @@ func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status not
 		return nil, err
 	}
 	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		OwnerID:         uuidToString(row.OwnerID),
+		Status:          note.NoteStatus(row.Status),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
@@ -220,6 +229,99 @@ func (r *NoteRepository) Delete(ctx context.Context, id string) error {
 	return queriesForContext(ctx, r.queries).DeleteNote(ctx, pgID)
 }
 
+// UpdateOwner transfers a note to another account.
+func (r *NoteRepository) UpdateOwner(ctx context.Context, id, ownerID string) (*note.Note, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	pgOwnerID, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateNoteOwner(ctx, &generated.UpdateNoteOwnerParams{
+		ID:      pgID,
+		OwnerID: pgOwnerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return &note.Note{
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		OwnerID:         uuidToString(row.OwnerID),
+		Status:          note.NoteStatus(row.Status),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+	}, nil
+}
+
+// UpdateTemplateVersion pins a note to another version of its template.
+func (r *NoteRepository) UpdateTemplateVersion(ctx context.Context, id string, version int) (*note.Note, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateNoteTemplateVersion(ctx, &generated.UpdateNoteTemplateVersionParams{
+		ID:              pgID,
+		TemplateVersion: int32(version), //nolint:gosec
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return &note.Note{
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		OwnerID:         uuidToString(row.OwnerID),
+		Status:          note.NoteStatus(row.Status),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+	}, nil
+}
+
+// UpdateTemplate moves a note to a version of another template.
+func (r *NoteRepository) UpdateTemplate(ctx context.Context, id, templateID string, version int) (*note.Note, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	pgTemplateID, err := toUUID(templateID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateNoteTemplate(ctx, &generated.UpdateNoteTemplateParams{
+		ID:              pgID,
+		TemplateID:      pgTemplateID,
+		TemplateVersion: int32(version), //nolint:gosec
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return &note.Note{
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		OwnerID:         uuidToString(row.OwnerID),
+		Status:          note.NoteStatus(row.Status),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+	}, nil
+}
+
 // ReplaceSections replaces note sections.
 func (r *NoteRepository) ReplaceSections(ctx context.Context, noteID string, sections []note.Section) error {
 	nID, err := toUUID(noteID)
@@ -257,6 +359,54 @@ func (r *NoteRepository) ReplaceSections(ctx context.Context, noteID string, sec
 	return nil
 }
 
+// DeleteSections deletes every section of a note.
+func (r *NoteRepository) DeleteSections(ctx context.Context, noteID string) error {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteSectionsByNote(ctx, pgID)
+}
+
+// CreateRevision stores a snapshot of a note's layout.
+func (r *NoteRepository) CreateRevision(ctx context.Context, rev note.Revision) (*note.Revision, error) {
+	noteID, err := toUUID(rev.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	templateID, err := toUUID(rev.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := encodeRevisionSections(rev.Sections)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateNoteRevision(ctx, &generated.CreateNoteRevisionParams{
+		NoteID:          noteID,
+		TemplateID:      templateID,
+		TemplateVersion: int32(rev.TemplateVersion), //nolint:gosec
+		Title:           rev.Title,
+		Sections:        sections,
+	})
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := decodeRevisionSections(row.Sections)
+	if err != nil {
+		return nil, err
+	}
+	return &note.Revision{
+		ID:              uuidToString(row.ID),
+		NoteID:          uuidToString(row.NoteID),
+		TemplateID:      uuidToString(row.TemplateID),
+		TemplateVersion: int(row.TemplateVersion),
+		Title:           row.Title,
+		Sections:        decoded,
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+	}, nil
+}
+
 func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) ([]note.SectionWithField, error) {
 	rows, err := queriesForContext(ctx, r.queries).ListSectionsByNote(ctx, noteID)
 	if err != nil {
@@ -264,6 +414,10 @@ func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) (
 	}
 	sections := make([]note.SectionWithField, 0, len(rows))
 	for _, row := range rows {
+		options, err := decodeFieldOptions(row.FieldOptions)
+		if err != nil {
+			return nil, err
+		}
 		sections = append(sections, note.SectionWithField{
 			Section: note.Section{
 				ID:      uuidToString(row.ID),
@@ -274,7 +428,37 @@ func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) (
 			FieldLabel: row.Label,
 			FieldOrder: int(row.Order),
 			IsRequired: row.IsRequired,
+			FieldType:  template.FieldType(row.FieldType),
+			Options:    options,
 		})
 	}
 	return sections, nil
 }
+
+// revisionSectionRecord is the JSONB representation of note.RevisionSection.
+type revisionSectionRecord struct {
+	FieldID string `json:"fieldId"`
+	Label   string `json:"label"`
+	Order   int    `json:"order"`
+	Content string `json:"content"`
+}
+
+func encodeRevisionSections(sections []note.RevisionSection) ([]byte, error) {
+	records := make([]revisionSectionRecord, 0, len(sections))
+	for _, s := range sections {
+		records = append(records, revisionSectionRecord(s))
+	}
+	return json.Marshal(records)
+}
+
+func decodeRevisionSections(raw []byte) ([]note.RevisionSection, error) {
+	var records []revisionSectionRecord
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return nil, err
+	}
+	sections := make([]note.RevisionSection, 0, len(records))
+	for _, rec := range records {
+		sections = append(sections, note.RevisionSection(rec))
+	}
+	return sections, nil
+}
@@ -60,6 +60,53 @@ func TestNoteRepository_UpdateStatus(t *testing.T) {
 	}
 }
 
+func TestNoteRepository_UpdateOwner(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.Note{
+		ID:         pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:      "t",
+		TemplateID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		OwnerID:    pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		Status:     string(note.StatusDraft),
+		CreatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	tests := []struct {
+		name    string
+		id      string
+		ownerID string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] update owner", id: row.ID.String(), ownerID: row.OwnerID.String()},
+		{name: "[Fail] invalid owner uuid", id: row.ID.String(), ownerID: "bad-uuid", wantErr: errors.New("invalid")},
+		{name: "[Fail] not found", id: row.ID.String(), ownerID: row.OwnerID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(row, tt.rowErr, nil)
+			repo := &NoteRepository{queries: generated.New(mock)}
+			got, err := repo.UpdateOwner(context.Background(), tt.id, tt.ownerID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.OwnerID != tt.ownerID {
+					t.Fatalf("owner = %s, want %s", got.OwnerID, tt.ownerID)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
+
 func TestNoteRepository_Delete(t *testing.T) {
 	baseID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
 	tests := []struct {
@@ -391,3 +438,101 @@ func TestNoteRepository_ReplaceSections(t *testing.T) {
 		})
 	}
 }
+
+func TestNoteRepository_UpdateTemplate(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.Note{
+		ID:              pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:           "t",
+		TemplateID:      pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		TemplateVersion: 2,
+		OwnerID:         pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Status:          string(note.StatusDraft),
+		CreatedAt:       pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:       pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	tests := []struct {
+		name       string
+		id         string
+		templateID string
+		rowErr     error
+		wantErr    bool
+	}{
+		{name: "[Success] move to template", id: row.ID.String(), templateID: row.TemplateID.String()},
+		{name: "[Fail] invalid note uuid", id: "bad-uuid", templateID: row.TemplateID.String(), wantErr: true},
+		{name: "[Fail] invalid template uuid", id: row.ID.String(), templateID: "bad-uuid", wantErr: true},
+		{name: "[Fail] not found", id: row.ID.String(), templateID: row.TemplateID.String(), rowErr: pgx.ErrNoRows, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &NoteRepository{queries: generated.New(mockdb.NewNoteDBTX(row, tt.rowErr, nil))}
+			got, err := repo.UpdateTemplate(context.Background(), tt.id, tt.templateID, 2)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if tt.rowErr != nil && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.TemplateID != tt.templateID || got.TemplateVersion != 2 {
+				t.Fatalf("unexpected note: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteRepository_CreateRevision(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	noteID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	templateID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	revRow := &generated.NoteRevision{
+		ID:              pgtype.UUID{Bytes: [16]byte{5}, Valid: true},
+		NoteID:          noteID,
+		TemplateID:      templateID,
+		TemplateVersion: 3,
+		Title:           "t",
+		Sections:        []byte(`[{"fieldId":"f1","label":"Context","order":1,"content":"old"}]`),
+		CreatedAt:       pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	valid := note.Revision{NoteID: noteID.String(), TemplateID: templateID.String(), TemplateVersion: 3, Title: "t"}
+	tests := []struct {
+		name    string
+		rev     note.Revision
+		rowErr  error
+		wantErr bool
+	}{
+		{name: "[Success] create revision", rev: valid},
+		{name: "[Fail] invalid note uuid", rev: note.Revision{NoteID: "bad-uuid", TemplateID: templateID.String()}, wantErr: true},
+		{name: "[Fail] invalid template uuid", rev: note.Revision{NoteID: noteID.String(), TemplateID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] insert error", rev: valid, rowErr: errors.New("insert err"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(nil, tt.rowErr, nil).WithRevisionRow(revRow)
+			repo := &NoteRepository{queries: generated.New(mock)}
+			got, err := repo.CreateRevision(context.Background(), tt.rev)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.NoteID != noteID.String() || got.TemplateVersion != 3 || got.CreatedAt.IsZero() {
+				t.Fatalf("unexpected revision: %+v", got)
+			}
+			if len(got.Sections) != 1 || got.Sections[0].Label != "Context" || got.Sections[0].Content != "old" {
+				t.Fatalf("sections not decoded: %+v", got.Sections)
+			}
+		})
+	}
+}
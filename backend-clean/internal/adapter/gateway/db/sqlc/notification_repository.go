@@ -0,0 +1,199 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotificationRepository implements notification and preference persistence.
+type NotificationRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.NotificationRepository = (*NotificationRepository)(nil)
+
+// NewNotificationRepository creates NotificationRepository.
+func NewNotificationRepository(pool *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Create stores a notification unless the recipient already has one for the event and kind.
+func (r *NotificationRepository) Create(ctx context.Context, n notification.Notification) error {
+	recipientID, err := toUUID(n.RecipientID)
+	if err != nil {
+		return err
+	}
+	actorID, err := toUUID(n.ActorID)
+	if err != nil {
+		return err
+	}
+	subjectID, err := toUUID(n.SubjectID)
+	if err != nil {
+		return err
+	}
+	eventID, err := toUUID(n.EventID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).CreateNotification(ctx, &generated.CreateNotificationParams{
+		RecipientID:  recipientID,
+		Kind:         string(n.Kind),
+		ActorID:      actorID,
+		SubjectID:    subjectID,
+		SubjectTitle: n.SubjectTitle,
+		EventID:      eventID,
+	})
+}
+
+// List returns the latest notifications of a recipient, newest first.
+func (r *NotificationRepository) List(ctx context.Context, recipientID string, unreadOnly bool, limit int) ([]notification.Notification, error) {
+	pgID, err := toUUID(recipientID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNotifications(ctx, &generated.ListNotificationsParams{
+		RecipientID: pgID,
+		Limit:       int32(limit), //nolint:gosec
+		UnreadOnly:  unreadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]notification.Notification, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toNotification(row))
+	}
+	return result, nil
+}
+
+// CountUnread counts the unread notifications of a recipient.
+func (r *NotificationRepository) CountUnread(ctx context.Context, recipientID string) (int, error) {
+	pgID, err := toUUID(recipientID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := queriesForContext(ctx, r.queries).CountUnreadNotifications(ctx, pgID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// Get returns a notification by ID.
+func (r *NotificationRepository) Get(ctx context.Context, id string) (*notification.Notification, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetNotificationByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toNotification(row), nil
+}
+
+// MarkRead marks a notification read, keeping the first read time of one that was already read.
+func (r *NotificationRepository) MarkRead(ctx context.Context, id string) (*notification.Notification, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).MarkNotificationRead(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toNotification(row), nil
+}
+
+// MarkAllRead marks every unread notification of a recipient read.
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, recipientID string) (int, error) {
+	pgID, err := toUUID(recipientID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := queriesForContext(ctx, r.queries).MarkAllNotificationsRead(ctx, pgID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// GetPreferences returns the preferences of an account, muting nothing when none were saved.
+func (r *NotificationRepository) GetPreferences(ctx context.Context, accountID string) (*notification.Preferences, error) {
+	pgID, err := toUUID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetNotificationPreferences(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &notification.Preferences{AccountID: accountID, MutedKinds: []notification.Kind{}}, nil
+		}
+		return nil, err
+	}
+	return toPreferences(row), nil
+}
+
+// SavePreferences replaces the preferences of an account.
+func (r *NotificationRepository) SavePreferences(ctx context.Context, p notification.Preferences) (*notification.Preferences, error) {
+	pgID, err := toUUID(p.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	muted := make([]string, 0, len(p.MutedKinds))
+	for _, k := range p.MutedKinds {
+		muted = append(muted, string(k))
+	}
+	row, err := queriesForContext(ctx, r.queries).UpsertNotificationPreferences(ctx, &generated.UpsertNotificationPreferencesParams{
+		AccountID:  pgID,
+		MutedKinds: muted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPreferences(row), nil
+}
+
+func toNotification(row *generated.Notification) *notification.Notification {
+	return &notification.Notification{
+		ID:           uuidToString(row.ID),
+		RecipientID:  uuidToString(row.RecipientID),
+		Kind:         notification.Kind(row.Kind),
+		ActorID:      uuidToString(row.ActorID),
+		SubjectID:    uuidToString(row.SubjectID),
+		SubjectTitle: row.SubjectTitle,
+		EventID:      uuidToString(row.EventID),
+		ReadAt:       timestamptzToTimePtr(row.ReadAt),
+		CreatedAt:    timestamptzToTime(row.CreatedAt),
+	}
+}
+
+func toPreferences(row *generated.NotificationPreference) *notification.Preferences {
+	muted := make([]notification.Kind, 0, len(row.MutedKinds))
+	for _, k := range row.MutedKinds {
+		muted = append(muted, notification.Kind(k))
+	}
+	return &notification.Preferences{
+		AccountID:  uuidToString(row.AccountID),
+		MutedKinds: muted,
+		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,184 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+)
+
+func notificationRow(read bool) *generated.Notification {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &generated.Notification{
+		ID:           pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		RecipientID:  pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Kind:         string(notification.KindTemplateNotePublished),
+		ActorID:      pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		SubjectID:    pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		SubjectTitle: "ADR",
+		EventID:      pgtype.UUID{Bytes: [16]byte{5}, Valid: true},
+		ReadAt:       pgtype.Timestamptz{Time: now, Valid: read},
+		CreatedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+	}
+}
+
+func TestNotificationRepository_Create(t *testing.T) {
+	row := notificationRow(false)
+	valid := notification.Notification{
+		RecipientID:  row.RecipientID.String(),
+		Kind:         notification.KindNoteTransferred,
+		ActorID:      row.ActorID.String(),
+		SubjectID:    row.SubjectID.String(),
+		SubjectTitle: "ADR",
+		EventID:      row.EventID.String(),
+	}
+	tests := []struct {
+		name      string
+		edit      func(n *notification.Notification)
+		execErr   error
+		wantExecs int
+		wantErr   bool
+	}{
+		{name: "[Success] create notification", edit: func(*notification.Notification) {}, wantExecs: 1},
+		{name: "[Fail] invalid recipient", edit: func(n *notification.Notification) { n.RecipientID = "bad" }, wantErr: true},
+		{name: "[Fail] invalid event", edit: func(n *notification.Notification) { n.EventID = "" }, wantErr: true},
+		{name: "[Fail] exec error", edit: func(*notification.Notification) {}, execErr: errors.New("db error"), wantExecs: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := valid
+			tt.edit(&n)
+			mock := mockdb.NewNotificationDBTX(nil, nil, tt.execErr)
+			repo := &NotificationRepository{queries: generated.New(mock)}
+			err := repo.Create(context.Background(), n)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(mock.ExecArgs) != tt.wantExecs {
+				t.Fatalf("execs = %d, want %d", len(mock.ExecArgs), tt.wantExecs)
+			}
+			if tt.wantExecs == 1 && mock.ExecArgs[0][1] != string(notification.KindNoteTransferred) {
+				t.Fatalf("unexpected args: %v", mock.ExecArgs[0])
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_List(t *testing.T) {
+	unread, read := notificationRow(false), notificationRow(true)
+	tests := []struct {
+		name      string
+		recipient string
+		list      []*generated.Notification
+		queryErr  error
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "[Success] list notifications", recipient: unread.RecipientID.String(), list: []*generated.Notification{unread, read}, wantCount: 2},
+		{name: "[Fail] invalid recipient", recipient: "bad", wantErr: true},
+		{name: "[Fail] query error", recipient: unread.RecipientID.String(), queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNotificationDBTX(nil, nil, nil).WithNotifications(tt.list, tt.queryErr)
+			repo := &NotificationRepository{queries: generated.New(mock)}
+			got, err := repo.List(context.Background(), tt.recipient, true, 50)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("count = %d, want %d", len(got), tt.wantCount)
+			}
+			if tt.wantCount > 0 {
+				if got[0].ReadAt != nil || got[1].ReadAt == nil || got[0].Kind != notification.KindTemplateNotePublished {
+					t.Fatalf("unexpected notifications: %+v", got)
+				}
+				if args := mock.QueryArgs[0]; args[1] != int32(50) || args[2] != true {
+					t.Fatalf("unexpected args: %v", args)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_MarkRead(t *testing.T) {
+	row := notificationRow(true)
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] mark read", id: row.ID.String()},
+		{name: "[Fail] invalid id", id: "bad", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &NotificationRepository{queries: generated.New(mockdb.NewNotificationDBTX(row, tt.rowErr, nil))}
+			got, err := repo.MarkRead(context.Background(), tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && got.ReadAt == nil {
+				t.Fatalf("expected read notification: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNotificationRepository_Counts(t *testing.T) {
+	recipient := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	repo := &NotificationRepository{queries: generated.New(mockdb.NewNotificationDBTX(nil, nil, nil).WithCount(3))}
+	unread, err := repo.CountUnread(context.Background(), recipient)
+	if err != nil || unread != 3 {
+		t.Fatalf("CountUnread = %d, %v", unread, err)
+	}
+	marked, err := repo.MarkAllRead(context.Background(), recipient)
+	if err != nil || marked != 3 {
+		t.Fatalf("MarkAllRead = %d, %v", marked, err)
+	}
+}
+
+func TestNotificationRepository_GetPreferences(t *testing.T) {
+	accountID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	saved := &generated.NotificationPreference{AccountID: accountID, MutedKinds: []string{string(notification.KindTemplateDeprecated)}}
+	tests := []struct {
+		name      string
+		rowErr    error
+		wantMuted int
+		wantErr   bool
+	}{
+		{name: "[Success] saved preferences", wantMuted: 1},
+		{name: "[Success] defaults when never saved", rowErr: pgx.ErrNoRows, wantMuted: 0},
+		{name: "[Fail] query error", rowErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNotificationDBTX(nil, tt.rowErr, nil).WithPreferences(saved)
+			repo := &NotificationRepository{queries: generated.New(mock)}
+			got, err := repo.GetPreferences(context.Background(), accountID.String())
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.AccountID != accountID.String() || len(got.MutedKinds) != tt.wantMuted {
+				t.Fatalf("unexpected preferences: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// outboxChannel is notified with the event ID by OutboxNotifier.
+const outboxChannel = "outbox_events"
+
+// OutboxNotifier announces dispatched events to the OutboxListeners of every process, so they see
+// the same events, in the same order, as the dispatcher's own handlers.
+type OutboxNotifier struct {
+	queries *generated.Queries
+}
+
+var _ port.EventHandler = (*OutboxNotifier)(nil)
+
+// NewOutboxNotifier creates OutboxNotifier.
+func NewOutboxNotifier(pool *pgxpool.Pool) *OutboxNotifier {
+	return &OutboxNotifier{queries: generated.New(pool)}
+}
+
+// Handle notifies the event ID. An event retried by the dispatcher is notified again; buses
+// ignore events they have already seen.
+func (n *OutboxNotifier) Handle(ctx context.Context, e event.Event) error {
+	return n.queries.NotifyOutboxEvent(ctx, e.ID)
+}
+
+// OutboxListener follows the events OutboxNotifier announces through LISTEN/NOTIFY. They arrive
+// as the dispatcher delivers them, so only once their transaction is visible.
+type OutboxListener struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+	names   []event.Name
+}
+
+var _ port.EventListener = (*OutboxListener)(nil)
+
+// NewOutboxListener creates OutboxListener. Only the named events are passed on; all are when names is empty.
+func NewOutboxListener(pool *pgxpool.Pool, names ...event.Name) *OutboxListener {
+	return &OutboxListener{
+		pool:    pool,
+		queries: generated.New(pool),
+		names:   names,
+	}
+}
+
+// Listen holds a dedicated connection until ctx is canceled or the connection fails.
+// Notifications sent while no connection listens are lost, so callers treat every return as a gap.
+func (l *OutboxListener) Listen(ctx context.Context, onListening func(), handler port.EventHandler) error {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// A listening connection must not go back to the pool.
+	conn := pooled.Hijack()
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+outboxChannel); err != nil {
+		return err
+	}
+	onListening()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		e, ok, err := l.load(ctx, n.Payload)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if err := handler.Handle(ctx, e); err != nil {
+			return err
+		}
+	}
+}
+
+// load reads a notified event; ok is false when it is gone or not one of the listened names.
+func (l *OutboxListener) load(ctx context.Context, id string) (event.Event, bool, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return event.Event{}, false, err
+	}
+	row, err := l.queries.GetOutboxEvent(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return event.Event{}, false, nil
+		}
+		return event.Event{}, false, err
+	}
+	if len(l.names) > 0 && !slices.Contains(l.names, event.Name(row.Name)) {
+		return event.Event{}, false, nil
+	}
+	e, err := toOutboxEvent(row)
+	if err != nil {
+		return event.Event{}, false, err
+	}
+	return e, true, nil
+}
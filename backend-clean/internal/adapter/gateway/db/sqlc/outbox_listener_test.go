@@ -0,0 +1,81 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestOutboxListener_Load(t *testing.T) {
+	id := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+	row := &generated.Outbox{
+		ID:          id,
+		Name:        string(event.NoteUpdated),
+		AggregateID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Data:        []byte(`{"title":"t"}`),
+	}
+	tests := []struct {
+		name     string
+		id       string
+		list     []*generated.Outbox
+		queryErr error
+		names    []event.Name
+		wantOK   bool
+		wantErr  bool
+	}{
+		{name: "[Success] load listened event", id: id.String(), list: []*generated.Outbox{row}, names: event.NoteEvents, wantOK: true},
+		{name: "[Success] no name filter passes everything", id: id.String(), list: []*generated.Outbox{row}, wantOK: true},
+		{name: "[Success] skip other events", id: id.String(), list: []*generated.Outbox{row}, names: []event.Name{event.TemplateChanged}},
+		{name: "[Success] skip missing event", id: id.String()},
+		{name: "[Fail] invalid uuid", id: "bad", wantErr: true},
+		{name: "[Fail] query error", id: id.String(), queryErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] broken data", id: id.String(), list: []*generated.Outbox{{ID: id, Name: row.Name, Data: []byte(`{`)}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &OutboxListener{queries: generated.New(mockdb.NewOutboxDBTX(tt.list, tt.queryErr, nil)), names: tt.names}
+			e, ok, err := l.load(context.Background(), tt.id)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (e.ID != id.String() || e.Data["title"] != "t") {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+		})
+	}
+}
+
+func TestOutboxNotifier_Handle(t *testing.T) {
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] notify event id"},
+		{name: "[Fail] exec error", execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewOutboxDBTX(nil, nil, tt.execErr)
+			n := &OutboxNotifier{queries: generated.New(mock)}
+			err := n.Handle(context.Background(), event.Event{ID: "ev-1", Name: event.NoteUpdated})
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(mock.ExecArgs) != 1 || mock.ExecArgs[0][0] != "ev-1" {
+				t.Fatalf("unexpected args: %v", mock.ExecArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// OutboxRepository implements the domain event outbox.
+type OutboxRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.OutboxRepository = (*OutboxRepository)(nil)
+
+// NewOutboxRepository creates OutboxRepository.
+func NewOutboxRepository(pool *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Append stores an event in the transaction carried by ctx.
+func (r *OutboxRepository) Append(ctx context.Context, e event.Event) error {
+	aggregateID, err := toUUID(e.AggregateID)
+	if err != nil {
+		return err
+	}
+	actorID, err := toUUID(e.ActorID)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).AppendOutboxEvent(ctx, &generated.AppendOutboxEventParams{
+		Name:        string(e.Name),
+		AggregateID: aggregateID,
+		ActorID:     actorID,
+		Data:        data,
+	})
+}
+
+// Claim takes up to limit due events and hides them from other dispatchers for the lease.
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]event.Delivery, error) {
+	rows, err := queriesForContext(ctx, r.queries).ClaimOutboxEvents(ctx, &generated.ClaimOutboxEventsParams{
+		LeaseSeconds: lease.Seconds(),
+		BatchSize:    int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]event.Delivery, 0, len(rows))
+	for _, row := range rows {
+		e, err := toOutboxEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, event.Delivery{
+			Event:     e,
+			Attempts:  int(row.Attempts),
+			LastError: nullableTextToString(row.LastError),
+		})
+	}
+	return deliveries, nil
+}
+
+// MarkDelivered records that every handler accepted the event.
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).MarkOutboxEventDelivered(ctx, pgID)
+}
+
+// Reschedule makes a failed event due again after delay.
+func (r *OutboxRepository) Reschedule(ctx context.Context, id string, delay time.Duration, lastErr string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).RescheduleOutboxEvent(ctx, &generated.RescheduleOutboxEventParams{
+		ID:           pgID,
+		DelaySeconds: delay.Seconds(),
+		LastError:    pgtype.Text{String: lastErr, Valid: true},
+	})
+}
+
+// MarkDead moves an event to the dead letters; it is kept but no longer delivered.
+func (r *OutboxRepository) MarkDead(ctx context.Context, id, lastErr string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).MarkOutboxEventDead(ctx, &generated.MarkOutboxEventDeadParams{
+		ID:        pgID,
+		LastError: pgtype.Text{String: lastErr, Valid: true},
+	})
+}
+
+func toOutboxEvent(row *generated.Outbox) (event.Event, error) {
+	var data map[string]string
+	if len(row.Data) > 0 {
+		if err := json.Unmarshal(row.Data, &data); err != nil {
+			return event.Event{}, err
+		}
+	}
+	return event.Event{
+		ID:          uuidToString(row.ID),
+		Name:        event.Name(row.Name),
+		AggregateID: uuidToString(row.AggregateID),
+		ActorID:     uuidToString(row.ActorID),
+		Data:        data,
+		OccurredAt:  timestamptzToTime(row.OccurredAt),
+	}, nil
+}
@@ -0,0 +1,132 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestOutboxRepository_Append(t *testing.T) {
+	aggregateID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	actorID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	valid := event.Event{Name: event.NoteCreated, AggregateID: aggregateID, ActorID: actorID, Data: map[string]string{"title": "t"}}
+	tests := []struct {
+		name      string
+		event     event.Event
+		execErr   error
+		wantExecs int
+		wantErr   bool
+	}{
+		{name: "[Success] append event", event: valid, wantExecs: 1},
+		{name: "[Fail] invalid aggregate uuid", event: event.Event{Name: event.NoteCreated, AggregateID: "bad", ActorID: actorID}, wantErr: true},
+		{name: "[Fail] invalid actor uuid", event: event.Event{Name: event.NoteCreated, AggregateID: aggregateID, ActorID: "bad"}, wantErr: true},
+		{name: "[Fail] exec error", event: valid, execErr: errors.New("db error"), wantExecs: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewOutboxDBTX(nil, nil, tt.execErr)
+			repo := &OutboxRepository{queries: generated.New(mock)}
+			err := repo.Append(context.Background(), tt.event)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(mock.ExecArgs) != tt.wantExecs {
+				t.Fatalf("execs = %d, want %d", len(mock.ExecArgs), tt.wantExecs)
+			}
+			if tt.wantExecs > 0 && !tt.wantErr {
+				if name, data := mock.ExecArgs[0][0], mock.ExecArgs[0][3]; name != "note.created" || string(data.([]byte)) != `{"title":"t"}` {
+					t.Fatalf("unexpected args: %v", mock.ExecArgs[0])
+				}
+			}
+		})
+	}
+}
+
+func TestOutboxRepository_Claim(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.Outbox{
+		ID:          pgtype.UUID{Bytes: [16]byte{9}, Valid: true},
+		Name:        string(event.NotePublished),
+		AggregateID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		ActorID:     pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Data:        []byte(`{"title":"t"}`),
+		OccurredAt:  pgtype.Timestamptz{Time: now, Valid: true},
+		Attempts:    2,
+		LastError:   pgtype.Text{String: "handler down", Valid: true},
+	}
+	tests := []struct {
+		name      string
+		list      []*generated.Outbox
+		queryErr  error
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "[Success] claim events", list: []*generated.Outbox{row}, wantCount: 1},
+		{name: "[Success] nothing due", wantCount: 0},
+		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] broken data", list: []*generated.Outbox{{ID: row.ID, Data: []byte(`{`)}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &OutboxRepository{queries: generated.New(mockdb.NewOutboxDBTX(tt.list, tt.queryErr, nil))}
+			got, err := repo.Claim(context.Background(), 10, time.Minute)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("len = %d, want %d", len(got), tt.wantCount)
+			}
+			if tt.wantCount == 1 {
+				d := got[0]
+				if d.Event.ID != row.ID.String() || d.Event.Name != event.NotePublished || d.Event.Data["title"] != "t" || d.Attempts != 2 || d.LastError != "handler down" {
+					t.Fatalf("unexpected delivery: %+v", d)
+				}
+			}
+		})
+	}
+}
+
+func TestOutboxRepository_Mark(t *testing.T) {
+	id := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}.String()
+	tests := []struct {
+		name    string
+		id      string
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] update event", id: id},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
+		{name: "[Fail] exec error", id: id, execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &OutboxRepository{queries: generated.New(mockdb.NewOutboxDBTX(nil, nil, tt.execErr))}
+			ctx := context.Background()
+			for op, err := range map[string]error{
+				"delivered":  repo.MarkDelivered(ctx, tt.id),
+				"reschedule": repo.Reschedule(ctx, tt.id, time.Second, "boom"),
+				"dead":       repo.MarkDead(ctx, tt.id, "boom"),
+			} {
+				if tt.wantErr != (err != nil) {
+					t.Fatalf("%s: wantErr %v, got %v", op, tt.wantErr, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+-- name: CreateAttachment :one
+INSERT INTO attachments (note_id, section_id, file_name, content_type, size, storage_key)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetAttachmentByID :one
+SELECT *
+FROM attachments
+WHERE id = $1;
+
+-- name: ListAttachmentsByNote :many
+SELECT *
+FROM attachments
+WHERE note_id = $1
+ORDER BY created_at ASC;
+
+-- name: DeleteAttachment :exec
+DELETE FROM attachments
+WHERE id = $1;
+
+-- name: UpdateAttachmentSection :exec
+UPDATE attachments
+SET section_id = $2
+WHERE id = $1;
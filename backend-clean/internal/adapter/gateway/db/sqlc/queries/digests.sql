@@ -0,0 +1,56 @@
+-- name: GetDigestSettings :one
+SELECT *
+FROM digest_settings
+WHERE account_id = $1;
+
+-- name: UpsertDigestFrequency :one
+INSERT INTO digest_settings (account_id, frequency)
+VALUES ($1, $2)
+ON CONFLICT (account_id) DO UPDATE
+SET
+    frequency = EXCLUDED.frequency,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListDueDigests :many
+-- Active accounts whose digest period has passed, those never sent one first.
+-- Accounts without settings use the default frequency.
+SELECT
+    a.id AS account_id,
+    COALESCE(d.frequency, sqlc.arg(default_frequency)::text)::text AS frequency,
+    d.last_sent_at
+FROM accounts a
+LEFT JOIN digest_settings d ON d.account_id = a.id
+WHERE a.is_active
+  AND COALESCE(d.frequency, sqlc.arg(default_frequency)::text) <> 'off'
+  AND (
+    d.last_sent_at IS NULL
+    OR (COALESCE(d.frequency, sqlc.arg(default_frequency)::text) = 'daily' AND d.last_sent_at <= sqlc.arg(daily_before)::timestamptz)
+    OR (COALESCE(d.frequency, sqlc.arg(default_frequency)::text) = 'weekly' AND d.last_sent_at <= sqlc.arg(weekly_before)::timestamptz)
+  )
+ORDER BY d.last_sent_at NULLS FIRST, a.id
+LIMIT sqlc.arg(page_limit);
+
+-- name: ClaimDigest :execrows
+-- Moves last_sent_at forward only if no other worker did since the due list was read.
+INSERT INTO digest_settings (account_id, frequency, last_sent_at)
+VALUES (sqlc.arg(account_id), sqlc.arg(frequency), sqlc.arg(sent_at))
+ON CONFLICT (account_id) DO UPDATE
+SET last_sent_at = EXCLUDED.last_sent_at
+WHERE digest_settings.last_sent_at IS NOT DISTINCT FROM sqlc.narg(previous_sent_at)::timestamptz;
+
+-- name: ReleaseDigest :exec
+-- Gives a claimed digest back when it could not be sent.
+UPDATE digest_settings
+SET last_sent_at = sqlc.narg(previous_sent_at)::timestamptz
+WHERE account_id = sqlc.arg(account_id)
+  AND last_sent_at = sqlc.arg(sent_at)::timestamptz;
+
+-- name: ListStaleDrafts :many
+SELECT id, title, template_id, owner_id, status, created_at, updated_at
+FROM notes
+WHERE owner_id = $1
+  AND status = 'Draft'
+  AND updated_at <= sqlc.arg(updated_before)::timestamptz
+ORDER BY updated_at, id
+LIMIT sqlc.arg(page_limit);
@@ -0,0 +1,52 @@
+-- name: CreateFollow :exec
+INSERT INTO follows (follower_id, followee_id)
+VALUES ($1, $2)
+ON CONFLICT (follower_id, followee_id) DO NOTHING;
+
+-- name: DeleteFollow :exec
+DELETE FROM follows
+WHERE follower_id = $1
+  AND followee_id = $2;
+
+-- name: CountFollows :one
+SELECT
+    (SELECT COUNT(*) FROM follows WHERE followee_id = sqlc.arg(account_id)::uuid)::bigint AS follower_count,
+    (SELECT COUNT(*) FROM follows WHERE follower_id = sqlc.arg(account_id)::uuid)::bigint AS following_count;
+
+-- name: UpsertFeedItem :exec
+-- Events are delivered at least once and may arrive out of order, so an older activity never replaces a newer one.
+INSERT INTO feed_items (subject_id, kind, author_id, title, template_id, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (subject_id) DO UPDATE SET
+    kind = EXCLUDED.kind,
+    author_id = EXCLUDED.author_id,
+    title = EXCLUDED.title,
+    template_id = EXCLUDED.template_id,
+    occurred_at = EXCLUDED.occurred_at
+WHERE feed_items.occurred_at <= EXCLUDED.occurred_at;
+
+-- name: DeleteFeedItem :exec
+DELETE FROM feed_items
+WHERE subject_id = $1;
+
+-- name: ListFeed :many
+SELECT
+    fi.subject_id,
+    fi.kind,
+    fi.author_id,
+    fi.title,
+    fi.template_id,
+    fi.occurred_at,
+    a.first_name AS author_first_name,
+    a.last_name AS author_last_name,
+    a.thumbnail AS author_thumbnail
+FROM feed_items fi
+JOIN follows f ON f.followee_id = fi.author_id
+JOIN accounts a ON a.id = fi.author_id
+WHERE f.follower_id = sqlc.arg(follower_id)
+  AND (
+    NOT sqlc.arg(has_cursor)::boolean
+    OR (fi.occurred_at, fi.subject_id) < (sqlc.arg(cursor_at)::timestamptz, sqlc.arg(cursor_id)::uuid)
+  )
+ORDER BY fi.occurred_at DESC, fi.subject_id DESC
+LIMIT sqlc.arg(page_limit);
@@ -27,8 +27,8 @@ JOIN accounts a ON a.id = n.owner_id
 WHERE n.id = $1;
 
 -- name: CreateNote :one
-INSERT INTO notes (title, template_id, owner_id, status)
-VALUES ($1, $2, $3, $4)
+INSERT INTO notes (title, template_id, template_version, owner_id, status)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: UpdateNote :one
@@ -51,12 +51,39 @@ SET
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateNoteOwner :one
+UPDATE notes
+SET
+    owner_id = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateNoteTemplateVersion :one
+UPDATE notes
+SET
+    template_version = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateNoteTemplate :one
+UPDATE notes
+SET
+    template_id = $2,
+    template_version = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: ListSectionsByNote :many
 SELECT
     s.*,
     f.label,
     f."order",
-    f.is_required
+    f.is_required,
+    f.type AS field_type,
+    f.options AS field_options
 FROM sections s
 JOIN fields f ON f.id = s.field_id
 WHERE s.note_id = $1
@@ -76,3 +103,8 @@ RETURNING *;
 -- name: DeleteSectionsByNote :exec
 DELETE FROM sections
 WHERE note_id = $1;
+
+-- name: CreateNoteRevision :one
+INSERT INTO note_revisions (note_id, template_id, template_version, title, sections)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
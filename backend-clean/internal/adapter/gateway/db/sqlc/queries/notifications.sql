@@ -0,0 +1,50 @@
+-- name: CreateNotification :exec
+INSERT INTO notifications (recipient_id, kind, actor_id, subject_id, subject_title, event_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (recipient_id, event_id, kind) DO NOTHING;
+
+-- name: ListNotifications :many
+SELECT *
+FROM notifications
+WHERE recipient_id = $1
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE recipient_id = $1
+  AND read_at IS NULL;
+
+-- name: GetNotificationByID :one
+SELECT *
+FROM notifications
+WHERE id = $1;
+
+-- name: MarkNotificationRead :one
+-- A notification that was already read keeps its first read_at.
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1
+RETURNING *;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = NOW()
+WHERE recipient_id = $1
+  AND read_at IS NULL;
+
+-- name: GetNotificationPreferences :one
+SELECT *
+FROM notification_preferences
+WHERE account_id = $1;
+
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (account_id, muted_kinds)
+VALUES ($1, $2)
+ON CONFLICT (account_id) DO UPDATE
+SET
+    muted_kinds = EXCLUDED.muted_kinds,
+    updated_at = NOW()
+RETURNING *;
@@ -0,0 +1,51 @@
+-- name: AppendOutboxEvent :exec
+INSERT INTO outbox (name, aggregate_id, actor_id, data)
+VALUES ($1, $2, $3, $4);
+
+-- name: ClaimOutboxEvents :many
+-- Claimed events count an attempt and are hidden for the lease, so a crashed dispatcher
+-- only delays their delivery.
+UPDATE outbox
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::float8)
+WHERE id IN (
+    SELECT o.id
+    FROM outbox o
+    WHERE o.delivered_at IS NULL
+      AND o.dead_at IS NULL
+      AND o.next_attempt_at <= NOW()
+    ORDER BY o.occurred_at ASC
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxEventDelivered :exec
+UPDATE outbox
+SET
+    delivered_at = NOW(),
+    last_error = NULL
+WHERE id = $1;
+
+-- name: RescheduleOutboxEvent :exec
+UPDATE outbox
+SET
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg(delay_seconds)::float8),
+    last_error = sqlc.arg(last_error)
+WHERE id = sqlc.arg(id);
+
+-- name: MarkOutboxEventDead :exec
+UPDATE outbox
+SET
+    dead_at = NOW(),
+    last_error = $2
+WHERE id = $1;
+
+-- name: GetOutboxEvent :one
+SELECT * FROM outbox
+WHERE id = $1;
+
+-- name: NotifyOutboxEvent :exec
+-- Only the ID is sent to stay well within the notification payload limit; listeners load the event.
+SELECT pg_notify('outbox_events', sqlc.arg(id)::text);
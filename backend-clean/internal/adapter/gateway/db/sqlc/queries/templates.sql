@@ -4,16 +4,53 @@ SELECT
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
-    EXISTS (
-        SELECT 1
-        FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+    COALESCE(u.note_count, 0)::bigint AS note_count,
+    COALESCE(u.published_count, 0)::bigint AS published_count,
+    COALESCE(u.author_count, 0)::bigint AS author_count,
+    u.last_used_at::timestamptz AS last_used_at,
+    COALESCE(fk.fork_count, 0)::bigint AS fork_count
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
+LEFT JOIN (
+    SELECT
+        template_id,
+        COUNT(*) AS note_count,
+        COUNT(*) FILTER (WHERE status = 'Publish') AS published_count,
+        COUNT(DISTINCT owner_id) AS author_count,
+        MAX(created_at) AS last_used_at
+    FROM notes
+    GROUP BY template_id
+) u ON u.template_id = t.id
+LEFT JOIN (
+    -- only public forks are counted so the count does not reveal private or unlisted ones
+    SELECT forked_from_id, COUNT(*) AS fork_count
+    FROM templates
+    WHERE forked_from_id IS NOT NULL
+      AND visibility = 'public'
+    GROUP BY forked_from_id
+) fk ON fk.forked_from_id = t.id
 WHERE ($1::uuid IS NULL OR t.owner_id = $1)
-  AND ($2::text IS NULL OR t.name ILIKE '%' || $2 || '%')
+  AND (
+    $2::text IS NULL
+    OR t.name ILIKE '%' || $2 || '%'
+    OR EXISTS (
+        SELECT 1
+        FROM fields f
+        WHERE f.template_id = t.id
+          AND f.version = t.version
+          AND f.label ILIKE '%' || $2 || '%'
+    )
+  )
+  AND (
+    NULLIF($3::text, '') IS NULL
+    OR EXISTS (
+        SELECT 1
+        FROM fields f
+        WHERE f.template_id = t.id
+          AND f.version = t.version
+          AND f.label = $3
+    )
+  )
 ORDER BY t.updated_at DESC;
 
 -- name: GetTemplateByID :one
@@ -22,29 +59,64 @@ SELECT
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
-    EXISTS (
-        SELECT 1
-        FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+    COALESCE(u.note_count, 0)::bigint AS note_count,
+    COALESCE(u.published_count, 0)::bigint AS published_count,
+    COALESCE(u.author_count, 0)::bigint AS author_count,
+    u.last_used_at::timestamptz AS last_used_at,
+    COALESCE(fk.fork_count, 0)::bigint AS fork_count
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
+LEFT JOIN (
+    SELECT
+        template_id,
+        COUNT(*) AS note_count,
+        COUNT(*) FILTER (WHERE status = 'Publish') AS published_count,
+        COUNT(DISTINCT owner_id) AS author_count,
+        MAX(created_at) AS last_used_at
+    FROM notes
+    GROUP BY template_id
+) u ON u.template_id = t.id
+LEFT JOIN (
+    -- only public forks are counted so the count does not reveal private or unlisted ones
+    SELECT forked_from_id, COUNT(*) AS fork_count
+    FROM templates
+    WHERE forked_from_id IS NOT NULL
+      AND visibility = 'public'
+    GROUP BY forked_from_id
+) fk ON fk.forked_from_id = t.id
 WHERE t.id = $1;
 
 -- name: CreateTemplate :one
-INSERT INTO templates (name, owner_id)
-VALUES ($1, $2)
+INSERT INTO templates (name, owner_id, forked_from_id, visibility)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: UpdateTemplate :one
 UPDATE templates
 SET
     name = $2,
+    visibility = $3,
+    version = version + 1,
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
+-- name: DeprecateTemplate :one
+UPDATE templates
+SET
+    deprecated_at = COALESCE(deprecated_at, NOW()),
+    successor_id = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UndeprecateTemplate :one
+UPDATE templates
+SET
+    deprecated_at = NULL,
+    successor_id = NULL
+WHERE id = $1
+RETURNING *;
+
 -- name: DeleteTemplate :exec
 DELETE FROM templates
 WHERE id = $1;
@@ -54,30 +126,19 @@ SELECT EXISTS (
     SELECT 1 FROM notes WHERE template_id = $1
 ) AS is_used;
 
+-- name: CheckTemplateUsedByOthers :one
+SELECT EXISTS (
+    SELECT 1 FROM notes WHERE template_id = $1 AND owner_id <> $2
+) AS is_used;
+
 -- name: ListFieldsByTemplate :many
 SELECT *
 FROM fields
 WHERE template_id = $1
+  AND version = $2
 ORDER BY "order" ASC;
 
 -- name: CreateField :one
-INSERT INTO fields (template_id, label, "order", is_required)
-VALUES ($1, $2, $3, $4)
+INSERT INTO fields (template_id, version, label, "order", is_required, type, options, min_length, max_length, pattern, placeholder, help_text)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
-
--- name: UpdateField :one
-UPDATE fields
-SET
-    label = $2,
-    "order" = $3,
-    is_required = $4
-WHERE id = $1
-RETURNING *;
-
--- name: DeleteFieldsByTemplate :exec
-DELETE FROM fields
-WHERE template_id = $1;
-
--- name: DeleteField :exec
-DELETE FROM fields
-WHERE id = $1;
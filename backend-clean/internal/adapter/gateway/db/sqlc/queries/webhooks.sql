@@ -0,0 +1,81 @@
+-- name: ListWebhookSubscriptionsByOwner :many
+SELECT *
+FROM webhook_subscriptions
+WHERE owner_id = $1
+ORDER BY created_at ASC;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT *
+FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (owner_id, url, secret, events, active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET
+    url = $2,
+    secret = $3,
+    events = $4,
+    active = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: EnqueueWebhookDelivery :exec
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_name, payload)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (subscription_id, event_id) WHERE redelivery_of IS NULL DO NOTHING;
+
+-- name: CreateWebhookRedelivery :one
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_name, payload, redelivery_of)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT *
+FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: ClaimWebhookDeliveries :many
+-- Claimed deliveries count an attempt and are hidden for the lease, so a crashed worker
+-- only delays them.
+UPDATE webhook_deliveries
+SET
+    attempts = attempts + 1,
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::float8)
+WHERE id IN (
+    SELECT d.id
+    FROM webhook_deliveries d
+    WHERE d.status = 'pending'
+      AND d.next_attempt_at <= NOW()
+    ORDER BY d.created_at ASC
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordWebhookAttempt :exec
+UPDATE webhook_deliveries
+SET
+    status = sqlc.arg(status),
+    response_status = sqlc.narg(response_status),
+    latency_ms = sqlc.arg(latency_ms),
+    last_error = sqlc.narg(last_error),
+    next_attempt_at = NOW() + make_interval(secs => sqlc.arg(retry_seconds)::float8),
+    completed_at = CASE WHEN sqlc.arg(status) = 'pending' THEN NULL ELSE NOW() END
+WHERE id = sqlc.arg(id);
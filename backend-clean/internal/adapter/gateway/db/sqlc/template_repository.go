@@ -3,6 +3,7 @@ package sqlc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/jackc/pgx/v5"
@@ -29,6 +30,29 @@ func toTemplateOwner(ownerID pgtype.UUID, first, last string, thumb pgtype.Text)
 	}
 }
 
+func toTemplateUsage(notes, published, authors int64, lastUsedAt pgtype.Timestamptz) template.Usage {
+	return template.Usage{
+		NoteCount:      int(notes),
+		PublishedCount: int(published),
+		AuthorCount:    int(authors),
+		LastUsedAt:     timestamptzToTimePtr(lastUsedAt),
+	}
+}
+
+func toDomainTemplate(row *generated.Template) *template.Template {
+	return &template.Template{
+		ID:           uuidToString(row.ID),
+		Name:         row.Name,
+		OwnerID:      uuidToString(row.OwnerID),
+		Version:      int(row.Version),
+		ForkedFromID: uuidToString(row.ForkedFromID),
+		Visibility:   template.Visibility(row.Visibility),
+		DeprecatedAt: timestamptzToTimePtr(row.DeprecatedAt),
+		SuccessorID:  uuidToString(row.SuccessorID),
+		UpdatedAt:    timestamptzToTime(row.UpdatedAt),
+	}
+}
+
 // TemplateRepository implements template persistence.
 type TemplateRepository struct {
 	pool    *pgxpool.Pool
@@ -56,6 +80,9 @@ func (r *TemplateRepository) List(ctx context.Context, filters template.Filters)
 	if filters.Query != nil && *filters.Query != "" {
 		params.Column2 = *filters.Query
 	}
+	if filters.FieldLabel != nil {
+		params.Column3 = *filters.FieldLabel
+	}
 
 	rows, err := queriesForContext(ctx, r.queries).ListTemplates(ctx, params)
 	if err != nil {
@@ -64,28 +91,48 @@ func (r *TemplateRepository) List(ctx context.Context, filters template.Filters)
 
 	result := make([]template.WithUsage, 0, len(rows))
 	for _, row := range rows {
-		fields, err := r.listFields(ctx, row.ID)
+		fields, err := r.listFields(ctx, row.ID, row.Version)
 		if err != nil {
 			return nil, err
 		}
 		owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
 		result = append(result, template.WithUsage{
 			Template: template.Template{
-				ID:        uuidToString(row.ID),
-				Name:      row.Name,
-				OwnerID:   uuidToString(row.OwnerID),
-				UpdatedAt: timestamptzToTime(row.UpdatedAt),
-				Fields:    fields,
+				ID:           uuidToString(row.ID),
+				Name:         row.Name,
+				OwnerID:      uuidToString(row.OwnerID),
+				Version:      int(row.Version),
+				ForkedFromID: uuidToString(row.ForkedFromID),
+				Visibility:   template.Visibility(row.Visibility),
+				DeprecatedAt: timestamptzToTimePtr(row.DeprecatedAt),
+				SuccessorID:  uuidToString(row.SuccessorID),
+				UpdatedAt:    timestamptzToTime(row.UpdatedAt),
+				Fields:       fields,
 			},
-			IsUsed: row.IsUsed,
-			Owner:  owner,
+			IsUsed:    row.NoteCount > 0,
+			Usage:     toTemplateUsage(row.NoteCount, row.PublishedCount, row.AuthorCount, row.LastUsedAt),
+			Owner:     owner,
+			ForkCount: int(row.ForkCount),
 		})
 	}
 	return result, nil
 }
 
-// Get returns a template with usage and fields.
+// Get returns a template with usage and the fields of its current version.
 func (r *TemplateRepository) Get(ctx context.Context, id string) (*template.WithUsage, error) {
+	return r.getVersion(ctx, id, 0)
+}
+
+// GetVersion returns a template with usage and the fields of the given version.
+func (r *TemplateRepository) GetVersion(ctx context.Context, id string, version int) (*template.WithUsage, error) {
+	if version < 1 {
+		return nil, domainerr.ErrNotFound
+	}
+	return r.getVersion(ctx, id, version)
+}
+
+// getVersion loads a template with the fields of version, or of the current version when it is 0.
+func (r *TemplateRepository) getVersion(ctx context.Context, id string, version int) (*template.WithUsage, error) {
 	pgID, err := toUUID(id)
 	if err != nil {
 		return nil, err
@@ -97,54 +144,75 @@ func (r *TemplateRepository) Get(ctx context.Context, id string) (*template.With
 		}
 		return nil, err
 	}
-	fields, err := r.listFields(ctx, row.ID)
+	if version == 0 {
+		version = int(row.Version)
+	}
+	if version > int(row.Version) {
+		return nil, domainerr.ErrNotFound
+	}
+	fields, err := r.listFields(ctx, row.ID, int32(version)) //nolint:gosec
 	if err != nil {
 		return nil, err
 	}
 	owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
 	return &template.WithUsage{
 		Template: template.Template{
-			ID:        uuidToString(row.ID),
-			Name:      row.Name,
-			OwnerID:   uuidToString(row.OwnerID),
-			UpdatedAt: timestamptzToTime(row.UpdatedAt),
-			Fields:    fields,
+			ID:           uuidToString(row.ID),
+			Name:         row.Name,
+			OwnerID:      uuidToString(row.OwnerID),
+			Version:      version,
+			ForkedFromID: uuidToString(row.ForkedFromID),
+			Visibility:   template.Visibility(row.Visibility),
+			DeprecatedAt: timestamptzToTimePtr(row.DeprecatedAt),
+			SuccessorID:  uuidToString(row.SuccessorID),
+			UpdatedAt:    timestamptzToTime(row.UpdatedAt),
+			Fields:       fields,
 		},
-		IsUsed: row.IsUsed,
-		Owner:  owner,
+		IsUsed:    row.NoteCount > 0,
+		Usage:     toTemplateUsage(row.NoteCount, row.PublishedCount, row.AuthorCount, row.LastUsedAt),
+		Owner:     owner,
+		ForkCount: int(row.ForkCount),
 	}, nil
 }
 
-// Create inserts a template.
+// Create inserts a template, recording its source when it is a fork.
 func (r *TemplateRepository) Create(ctx context.Context, tpl template.Template) (*template.Template, error) {
 	owner, err := toUUID(tpl.OwnerID)
 	if err != nil {
 		return nil, err
 	}
+	var forkedFrom pgtype.UUID
+	if tpl.ForkedFromID != "" {
+		if forkedFrom, err = toUUID(tpl.ForkedFromID); err != nil {
+			return nil, err
+		}
+	}
+	visibility := tpl.Visibility
+	if visibility == "" {
+		visibility = template.DefaultVisibility
+	}
 	row, err := queriesForContext(ctx, r.queries).CreateTemplate(ctx, &generated.CreateTemplateParams{
-		Name:    tpl.Name,
-		OwnerID: owner,
+		Name:         tpl.Name,
+		OwnerID:      owner,
+		ForkedFromID: forkedFrom,
+		Visibility:   string(visibility),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &template.Template{
-		ID:        uuidToString(row.ID),
-		Name:      row.Name,
-		OwnerID:   uuidToString(row.OwnerID),
-		UpdatedAt: timestamptzToTime(row.UpdatedAt),
-	}, nil
+	return toDomainTemplate(row), nil
 }
 
-// Update updates template name.
+// Update updates template name and visibility and moves the template to its next version.
 func (r *TemplateRepository) Update(ctx context.Context, tpl template.Template) (*template.Template, error) {
 	pgID, err := toUUID(tpl.ID)
 	if err != nil {
 		return nil, err
 	}
 	row, err := queriesForContext(ctx, r.queries).UpdateTemplate(ctx, &generated.UpdateTemplateParams{
-		ID:   pgID,
-		Name: tpl.Name,
+		ID:         pgID,
+		Name:       tpl.Name,
+		Visibility: string(tpl.Visibility),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -152,12 +220,49 @@ func (r *TemplateRepository) Update(ctx context.Context, tpl template.Template)
 		}
 		return nil, err
 	}
-	return &template.Template{
-		ID:        uuidToString(row.ID),
-		Name:      row.Name,
-		OwnerID:   uuidToString(row.OwnerID),
-		UpdatedAt: timestamptzToTime(row.UpdatedAt),
-	}, nil
+	return toDomainTemplate(row), nil
+}
+
+// Deprecate marks a template deprecated with an optional successor. A template that is already
+// deprecated keeps its original deprecation time and only gets the new successor.
+func (r *TemplateRepository) Deprecate(ctx context.Context, id, successorID string) (*template.Template, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	var successor pgtype.UUID
+	if successorID != "" {
+		if successor, err = toUUID(successorID); err != nil {
+			return nil, err
+		}
+	}
+	row, err := queriesForContext(ctx, r.queries).DeprecateTemplate(ctx, &generated.DeprecateTemplateParams{
+		ID:          pgID,
+		SuccessorID: successor,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainTemplate(row), nil
+}
+
+// Undeprecate clears the deprecation and successor of a template.
+func (r *TemplateRepository) Undeprecate(ctx context.Context, id string) (*template.Template, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UndeprecateTemplate(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainTemplate(row), nil
 }
 
 // Delete deletes a template.
@@ -169,46 +274,142 @@ func (r *TemplateRepository) Delete(ctx context.Context, id string) error {
 	return queriesForContext(ctx, r.queries).DeleteTemplate(ctx, pgID)
 }
 
-// ReplaceFields replaces template fields.
-func (r *TemplateRepository) ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error {
+// IsUsedByOthers reports whether notes of accounts other than ownerID use the template.
+func (r *TemplateRepository) IsUsedByOthers(ctx context.Context, id, ownerID string) (bool, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return false, err
+	}
+	owner, err := toUUID(ownerID)
+	if err != nil {
+		return false, err
+	}
+	return queriesForContext(ctx, r.queries).CheckTemplateUsedByOthers(ctx, &generated.CheckTemplateUsedByOthersParams{
+		TemplateID: pgID,
+		OwnerID:    owner,
+	})
+}
+
+// CreateFields stores the field set of a template version and returns it with the new IDs.
+// Field rows are never changed afterwards, so notes keep reading the version they were written against.
+func (r *TemplateRepository) CreateFields(ctx context.Context, templateID string, version int, fields []template.Field) ([]template.Field, error) {
 	pgID, err := toUUID(templateID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	q := queriesForContext(ctx, r.queries)
-	if err := q.DeleteFieldsByTemplate(ctx, pgID); err != nil {
-		return err
-	}
+	created := make([]template.Field, 0, len(fields))
 	for idx, f := range fields {
-		order := f.Order
-		if order == 0 {
-			order = idx + 1
+		if f.Order == 0 {
+			f.Order = idx + 1
 		}
-		if _, err := q.CreateField(ctx, &generated.CreateFieldParams{
-			TemplateID: pgID,
-			Label:      f.Label,
-			Order:      int32(order), //nolint:gosec
-			IsRequired: f.IsRequired,
-		}); err != nil {
-			return err
+		params, err := toCreateFieldParams(pgID, version, f)
+		if err != nil {
+			return nil, err
+		}
+		row, err := q.CreateField(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		field, err := toDomainField(row)
+		if err != nil {
+			return nil, err
 		}
+		created = append(created, *field)
+	}
+	return created, nil
+}
+
+func toCreateFieldParams(templateID pgtype.UUID, version int, f template.Field) (*generated.CreateFieldParams, error) {
+	fieldType := f.Type
+	if fieldType == "" {
+		fieldType = template.FieldTypeText
+	}
+	options, err := encodeFieldOptions(f.Options)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return &generated.CreateFieldParams{
+		TemplateID:  templateID,
+		Version:     int32(version), //nolint:gosec
+		Label:       f.Label,
+		Order:       int32(f.Order), //nolint:gosec
+		IsRequired:  f.IsRequired,
+		Type:        string(fieldType),
+		Options:     options,
+		MinLength:   pgNullableInt4(f.MinLength),
+		MaxLength:   pgNullableInt4(f.MaxLength),
+		Pattern:     f.Pattern,
+		Placeholder: f.Placeholder,
+		HelpText:    f.HelpText,
+	}, nil
 }
 
-func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.UUID) ([]template.Field, error) {
-	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplate(ctx, templateID)
+func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.UUID, version int32) ([]template.Field, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplate(ctx, &generated.ListFieldsByTemplateParams{
+		TemplateID: templateID,
+		Version:    version,
+	})
 	if err != nil {
 		return nil, err
 	}
 	fields := make([]template.Field, 0, len(rows))
-	for _, f := range rows {
-		fields = append(fields, template.Field{
-			ID:         uuidToString(f.ID),
-			Label:      f.Label,
-			Order:      int(f.Order),
-			IsRequired: f.IsRequired,
-		})
+	for _, row := range rows {
+		f, err := toDomainField(row)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, *f)
 	}
 	return fields, nil
 }
+
+func toDomainField(f *generated.Field) (*template.Field, error) {
+	options, err := decodeFieldOptions(f.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &template.Field{
+		ID:          uuidToString(f.ID),
+		Label:       f.Label,
+		Order:       int(f.Order),
+		IsRequired:  f.IsRequired,
+		Type:        template.FieldType(f.Type),
+		Options:     options,
+		MinLength:   int4ToIntPtr(f.MinLength),
+		MaxLength:   int4ToIntPtr(f.MaxLength),
+		Pattern:     f.Pattern,
+		Placeholder: f.Placeholder,
+		HelpText:    f.HelpText,
+	}, nil
+}
+
+// fieldOptionsRecord is the JSONB representation of template.FieldOptions.
+type fieldOptionsRecord struct {
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Choices []string `json:"choices,omitempty"`
+}
+
+func encodeFieldOptions(opts template.FieldOptions) ([]byte, error) {
+	return json.Marshal(fieldOptionsRecord{
+		Min:     opts.Min,
+		Max:     opts.Max,
+		Choices: opts.Choices,
+	})
+}
+
+func decodeFieldOptions(raw []byte) (template.FieldOptions, error) {
+	if len(raw) == 0 {
+		return template.FieldOptions{}, nil
+	}
+	var rec fieldOptionsRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return template.FieldOptions{}, err
+	}
+	return template.FieldOptions{
+		Min:     rec.Min,
+		Max:     rec.Max,
+		Choices: rec.Choices,
+	}, nil
+}
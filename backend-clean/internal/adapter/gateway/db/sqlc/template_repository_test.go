@@ -32,6 +32,7 @@ func TestTemplateRepository_Create(t *testing.T) {
 	}{
 		{name: "[Success] create template", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String()}, row: row},
 		{name: "[Fail] invalid owner uuid", tpl: template.Template{Name: "tpl", OwnerID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] invalid fork source uuid", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String(), ForkedFromID: "bad-uuid"}, wantErr: true},
 		{name: "[Fail] query error", tpl: template.Template{Name: "tpl", OwnerID: row.OwnerID.String()}, rowErr: errors.New("db error"), wantErr: true},
 	}
 
@@ -66,7 +67,13 @@ func TestTemplateRepository_Get(t *testing.T) {
 		OwnerFirstName: "Taro",
 		OwnerLastName:  "Yamada",
 		OwnerThumbnail: pgtype.Text{String: "thumb", Valid: true},
-		IsUsed:         false,
+		NoteCount:      5,
+		PublishedCount: 3,
+		AuthorCount:    2,
+		LastUsedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+		ForkedFromID:   pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Visibility:     "unlisted",
+		ForkCount:      2,
 	}
 	tests := []struct {
 		name    string
@@ -92,6 +99,17 @@ func TestTemplateRepository_Get(t *testing.T) {
 				if got.Template.Name != tt.row.Name {
 					t.Fatalf("name = %s, want %s", got.Template.Name, tt.row.Name)
 				}
+				if got.Template.Visibility != template.VisibilityUnlisted {
+					t.Fatalf("visibility = %s", got.Template.Visibility)
+				}
+				if got.Template.ForkedFromID != tt.row.ForkedFromID.String() || got.ForkCount != 2 {
+					t.Fatalf("fork lineage = %s/%d", got.Template.ForkedFromID, got.ForkCount)
+				}
+				want := template.Usage{NoteCount: 5, PublishedCount: 3, AuthorCount: 2}
+				if !got.IsUsed || got.Usage.NoteCount != want.NoteCount || got.Usage.PublishedCount != want.PublishedCount ||
+					got.Usage.AuthorCount != want.AuthorCount || got.Usage.LastUsedAt == nil || !got.Usage.LastUsedAt.Equal(now) {
+					t.Fatalf("usage = %+v, used = %v", got.Usage, got.IsUsed)
+				}
 				return
 			}
 			if err == nil {
@@ -206,8 +224,114 @@ func TestTemplateRepository_List(t *testing.T) {
 	}
 }
 
-func TestTemplateRepository_ReplaceFields(t *testing.T) {
-	now := time.Now().UTC().Truncate(time.Second)
+func TestTemplateRepository_IsUsedByOthers(t *testing.T) {
+	tplID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	ownerID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	tests := []struct {
+		name    string
+		id      string
+		used    bool
+		rowErr  error
+		want    bool
+		wantErr bool
+	}{
+		{name: "[Success] used by others", id: tplID.String(), used: true, want: true},
+		{name: "[Success] not used by others", id: tplID.String()},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", id: tplID.String(), rowErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(nil, nil, tt.rowErr, nil)
+			mock.Used = tt.used
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			got, err := repo.IsUsedByOthers(context.Background(), tt.id, ownerID.String())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("used = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_Deprecate(t *testing.T) {
+	tplID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	successorID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	now := time.Now()
+	row := &generated.Template{
+		ID:           tplID,
+		Name:         "Old",
+		OwnerID:      pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+		Version:      1,
+		Visibility:   "public",
+		DeprecatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		SuccessorID:  successorID,
+	}
+	tests := []struct {
+		name        string
+		id          string
+		successorID string
+		rowErr      error
+		wantErr     bool
+	}{
+		{name: "[Success] with successor", id: tplID.String(), successorID: successorID.String()},
+		{name: "[Success] without successor", id: tplID.String()},
+		{name: "[Fail] invalid successor uuid", id: tplID.String(), successorID: "bad-uuid", wantErr: true},
+		{name: "[Fail] not found", id: tplID.String(), rowErr: pgx.ErrNoRows, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &TemplateRepository{queries: generated.New(mockdb.NewTemplateDBTX(row, nil, tt.rowErr, nil))}
+			got, err := repo.Deprecate(context.Background(), tt.id, tt.successorID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if tt.rowErr != nil && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.IsDeprecated() || got.SuccessorID != successorID.String() {
+				t.Fatalf("unexpected template: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_Undeprecate(t *testing.T) {
+	tplID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	row := &generated.Template{ID: tplID, Name: "Old", OwnerID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Version: 1, Visibility: "public"}
+	repo := &TemplateRepository{queries: generated.New(mockdb.NewTemplateDBTX(row, nil, nil, nil))}
+	got, err := repo.Undeprecate(context.Background(), tplID.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.IsDeprecated() || got.SuccessorID != "" {
+		t.Fatalf("unexpected template: %+v", got)
+	}
+
+	repo = &TemplateRepository{queries: generated.New(mockdb.NewTemplateDBTX(nil, nil, pgx.ErrNoRows, nil))}
+	if _, err := repo.Undeprecate(context.Background(), tplID.String()); !errors.Is(err, domainerr.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got %v", err)
+	}
+}
+
+func TestTemplateRepository_CreateFields(t *testing.T) {
 	tplID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
 	fieldRow := &generated.Field{
 		ID:         pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
@@ -215,35 +339,94 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 		Label:      "lbl",
 		Order:      1,
 		IsRequired: true,
+		Version:    2,
 	}
 	tests := []struct {
 		name    string
 		tplID   string
 		field   template.Field
 		rowErr  error
-		execErr error
 		wantErr bool
 	}{
-		{name: "[Success] replace fields", tplID: tplID.String(), field: template.Field{Label: "lbl", Order: 1, IsRequired: true}},
+		{name: "[Success] create fields", tplID: tplID.String(), field: template.Field{Label: "lbl", Order: 1, IsRequired: true}},
 		{name: "[Fail] invalid tpl uuid", tplID: "bad-uuid", field: template.Field{Label: "lbl"}, wantErr: true},
-		{name: "[Fail] delete error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, execErr: errors.New("del error"), wantErr: true},
 		{name: "[Fail] create error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, rowErr: errors.New("create error"), wantErr: true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewTemplateDBTX(nil, nil, tt.rowErr, tt.execErr)
+			mock := mockdb.NewTemplateDBTX(nil, nil, tt.rowErr, nil)
 			mock.FieldRow = fieldRow
 			repo := &TemplateRepository{queries: generated.New(mock)}
-			err := repo.ReplaceFields(context.Background(), tt.tplID, []template.Field{tt.field})
+			got, err := repo.CreateFields(context.Background(), tt.tplID, 2, []template.Field{tt.field})
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
 				}
-			} else if err != nil {
+				return
+			}
+			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			_ = now
+			if len(got) != 1 || got[0].ID != fieldRow.ID.String() {
+				t.Fatalf("unexpected fields: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_GetVersion(t *testing.T) {
+	detail := &generated.GetTemplateByIDRow{
+		ID:      pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Name:    "tpl",
+		OwnerID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Version: 3,
+	}
+	tests := []struct {
+		name    string
+		version int
+		wantErr error
+	}{
+		{name: "[Success] earlier version", version: 2},
+		{name: "[Success] current version", version: 3},
+		{name: "[Fail] version zero", version: 0, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] future version", version: 4, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(nil, detail, nil, nil)
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			got, err := repo.GetVersion(context.Background(), detail.ID.String(), tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.Template.Version != tt.version {
+				t.Fatalf("version = %d, want %d", got.Template.Version, tt.version)
+			}
 		})
 	}
 }
+
+func TestFieldOptionsCodec(t *testing.T) {
+	minV, maxV := 1.0, 5.0
+	opts := template.FieldOptions{Min: &minV, Max: &maxV, Choices: []string{"a", "b"}}
+	raw, err := encodeFieldOptions(opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := decodeFieldOptions(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *got.Min != minV || *got.Max != maxV || len(got.Choices) != 2 {
+		t.Fatalf("unexpected options: %+v", got)
+	}
+	empty, err := decodeFieldOptions(nil)
+	if err != nil || empty.Min != nil || empty.Choices != nil {
+		t.Fatalf("unexpected empty options: %+v, %v", empty, err)
+	}
+	if _, err := decodeFieldOptions([]byte("not-json")); err == nil {
+		t.Fatalf("expected error for invalid json")
+	}
+}
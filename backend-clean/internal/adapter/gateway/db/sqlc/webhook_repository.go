@@ -0,0 +1,281 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookRepository implements webhook subscription and delivery persistence.
+type WebhookRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.WebhookRepository = (*WebhookRepository)(nil)
+
+// NewWebhookRepository creates WebhookRepository.
+func NewWebhookRepository(pool *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// ListByOwner returns the subscriptions of an account in creation order.
+func (r *WebhookRepository) ListByOwner(ctx context.Context, ownerID string) ([]webhook.Subscription, error) {
+	pgID, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhookSubscriptionsByOwner(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]webhook.Subscription, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toSubscription(row))
+	}
+	return result, nil
+}
+
+// Get returns a subscription by ID.
+func (r *WebhookRepository) Get(ctx context.Context, id string) (*webhook.Subscription, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWebhookSubscriptionByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toSubscription(row), nil
+}
+
+// Create inserts a subscription.
+func (r *WebhookRepository) Create(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	ownerID, err := toUUID(sub.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateWebhookSubscription(ctx, &generated.CreateWebhookSubscriptionParams{
+		OwnerID: ownerID,
+		Url:     sub.URL,
+		Secret:  sub.Secret,
+		Events:  fromEventNames(sub.Events),
+		Active:  sub.Active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSubscription(row), nil
+}
+
+// Update replaces URL, secret, event filter and state of a subscription.
+func (r *WebhookRepository) Update(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	pgID, err := toUUID(sub.ID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateWebhookSubscription(ctx, &generated.UpdateWebhookSubscriptionParams{
+		ID:     pgID,
+		Url:    sub.URL,
+		Secret: sub.Secret,
+		Events: fromEventNames(sub.Events),
+		Active: sub.Active,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toSubscription(row), nil
+}
+
+// Delete deletes a subscription together with its deliveries.
+func (r *WebhookRepository) Delete(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteWebhookSubscription(ctx, pgID)
+}
+
+// Enqueue stores the first delivery of an event to a subscription unless it already exists.
+func (r *WebhookRepository) Enqueue(ctx context.Context, d webhook.Delivery) error {
+	subID, err := toUUID(d.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	eventID, err := toUUID(d.EventID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).EnqueueWebhookDelivery(ctx, &generated.EnqueueWebhookDeliveryParams{
+		SubscriptionID: subID,
+		EventID:        eventID,
+		EventName:      string(d.EventName),
+		Payload:        d.Payload,
+	})
+}
+
+// CreateDelivery stores a redelivery of an earlier delivery.
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+	subID, err := toUUID(d.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := toUUID(d.EventID)
+	if err != nil {
+		return nil, err
+	}
+	var redeliveryOf pgtype.UUID
+	if d.RedeliveryOf != "" {
+		if redeliveryOf, err = toUUID(d.RedeliveryOf); err != nil {
+			return nil, err
+		}
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateWebhookRedelivery(ctx, &generated.CreateWebhookRedeliveryParams{
+		SubscriptionID: subID,
+		EventID:        eventID,
+		EventName:      string(d.EventName),
+		Payload:        d.Payload,
+		RedeliveryOf:   redeliveryOf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDelivery(row), nil
+}
+
+// GetDelivery returns a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWebhookDeliveryByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDelivery(row), nil
+}
+
+// ListDeliveries returns the latest deliveries of a subscription, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhook.Delivery, error) {
+	pgID, err := toUUID(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhookDeliveries(ctx, &generated.ListWebhookDeliveriesParams{
+		SubscriptionID: pgID,
+		Limit:          int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveries(rows), nil
+}
+
+// ClaimDeliveries takes up to limit due pending deliveries and hides them from other workers for the lease.
+func (r *WebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	rows, err := queriesForContext(ctx, r.queries).ClaimWebhookDeliveries(ctx, &generated.ClaimWebhookDeliveriesParams{
+		LeaseSeconds: lease.Seconds(),
+		BatchSize:    int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveries(rows), nil
+}
+
+// RecordAttempt stores the outcome of an attempt; a pending delivery is due again after retryIn.
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, id string, attempt webhook.Attempt, retryIn time.Duration) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).RecordWebhookAttempt(ctx, &generated.RecordWebhookAttemptParams{
+		ID:             pgID,
+		Status:         string(attempt.Status),
+		ResponseStatus: pgtype.Int4{Int32: int32(attempt.ResponseStatus), Valid: attempt.ResponseStatus != 0}, //nolint:gosec
+		LatencyMs:      pgtype.Int4{Int32: int32(attempt.Latency.Milliseconds()), Valid: true},                //nolint:gosec
+		LastError:      pgtype.Text{String: attempt.Error, Valid: attempt.Error != ""},
+		RetrySeconds:   retryIn.Seconds(),
+	})
+}
+
+func toSubscription(row *generated.WebhookSubscription) *webhook.Subscription {
+	events := make([]event.Name, 0, len(row.Events))
+	for _, e := range row.Events {
+		events = append(events, event.Name(e))
+	}
+	return &webhook.Subscription{
+		ID:        uuidToString(row.ID),
+		OwnerID:   uuidToString(row.OwnerID),
+		URL:       row.Url,
+		Secret:    row.Secret,
+		Events:    events,
+		Active:    row.Active,
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+		UpdatedAt: timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func fromEventNames(names []event.Name) []string {
+	res := make([]string, 0, len(names))
+	for _, n := range names {
+		res = append(res, string(n))
+	}
+	return res
+}
+
+func toDeliveries(rows []*generated.WebhookDelivery) []webhook.Delivery {
+	result := make([]webhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *toDelivery(row))
+	}
+	return result
+}
+
+func toDelivery(row *generated.WebhookDelivery) *webhook.Delivery {
+	d := &webhook.Delivery{
+		ID:             uuidToString(row.ID),
+		SubscriptionID: uuidToString(row.SubscriptionID),
+		EventID:        uuidToString(row.EventID),
+		EventName:      event.Name(row.EventName),
+		Payload:        row.Payload,
+		Status:         webhook.DeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		LastError:      nullableTextToString(row.LastError),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+		CompletedAt:    timestamptzToTimePtr(row.CompletedAt),
+	}
+	if row.ResponseStatus.Valid {
+		d.ResponseStatus = int(row.ResponseStatus.Int32)
+	}
+	if row.LatencyMs.Valid {
+		d.Latency = time.Duration(row.LatencyMs.Int32) * time.Millisecond
+	}
+	if row.RedeliveryOf.Valid {
+		d.RedeliveryOf = uuidToString(row.RedeliveryOf)
+	}
+	return d
+}
@@ -0,0 +1,235 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+func webhookSubscriptionRow() *generated.WebhookSubscription {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &generated.WebhookSubscription{
+		ID:        pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		OwnerID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Url:       "https://example.com/hook",
+		Secret:    "0123456789abcdef",
+		Events:    []string{string(event.NoteCreated)},
+		Active:    true,
+		CreatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+	}
+}
+
+func webhookDeliveryRow() *generated.WebhookDelivery {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &generated.WebhookDelivery{
+		ID:             pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		SubscriptionID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		EventID:        pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		EventName:      string(event.NoteCreated),
+		Payload:        []byte(`{"event":"note.created"}`),
+		Status:         string(webhook.DeliveryFailed),
+		Attempts:       2,
+		ResponseStatus: pgtype.Int4{Int32: 500, Valid: true},
+		LatencyMs:      pgtype.Int4{Int32: 120, Valid: true},
+		LastError:      pgtype.Text{String: "unexpected response status 500", Valid: true},
+		CreatedAt:      pgtype.Timestamptz{Time: now, Valid: true},
+		CompletedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+	}
+}
+
+func TestWebhookRepository_Get(t *testing.T) {
+	row := webhookSubscriptionRow()
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get subscription", id: row.ID.String()},
+		{name: "[Fail] invalid id", id: "bad", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WebhookRepository{queries: generated.New(mockdb.NewWebhookDBTX(row, nil, tt.rowErr, nil))}
+			got, err := repo.Get(context.Background(), tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.URL != row.Url || !got.Active || len(got.Events) != 1 || got.Events[0] != event.NoteCreated {
+				t.Fatalf("unexpected subscription: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_ListByOwner(t *testing.T) {
+	row := webhookSubscriptionRow()
+	tests := []struct {
+		name      string
+		ownerID   string
+		list      []*generated.WebhookSubscription
+		queryErr  error
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "[Success] list subscriptions", ownerID: row.OwnerID.String(), list: []*generated.WebhookSubscription{row}, wantCount: 1},
+		{name: "[Fail] invalid owner", ownerID: "bad", wantErr: true},
+		{name: "[Fail] query error", ownerID: row.OwnerID.String(), queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewWebhookDBTX(nil, nil, nil, nil).WithSubscriptions(tt.list, tt.queryErr)
+			repo := &WebhookRepository{queries: generated.New(mock)}
+			got, err := repo.ListByOwner(context.Background(), tt.ownerID)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("count = %d, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_Enqueue(t *testing.T) {
+	valid := webhook.Delivery{
+		SubscriptionID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String(),
+		EventID:        pgtype.UUID{Bytes: [16]byte{4}, Valid: true}.String(),
+		EventName:      event.NoteCreated,
+		Payload:        []byte(`{}`),
+	}
+	tests := []struct {
+		name      string
+		delivery  webhook.Delivery
+		execErr   error
+		wantExecs int
+		wantErr   bool
+	}{
+		{name: "[Success] enqueue delivery", delivery: valid, wantExecs: 1},
+		{name: "[Fail] invalid subscription", delivery: webhook.Delivery{SubscriptionID: "bad", EventID: valid.EventID}, wantErr: true},
+		{name: "[Fail] exec error", delivery: valid, execErr: errors.New("db error"), wantExecs: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewWebhookDBTX(nil, nil, nil, tt.execErr)
+			repo := &WebhookRepository{queries: generated.New(mock)}
+			err := repo.Enqueue(context.Background(), tt.delivery)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(mock.ExecArgs) != tt.wantExecs {
+				t.Fatalf("execs = %d, want %d", len(mock.ExecArgs), tt.wantExecs)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_GetDelivery(t *testing.T) {
+	row := webhookDeliveryRow()
+	tests := []struct {
+		name    string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get delivery"},
+		{name: "[Fail] not found", rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WebhookRepository{queries: generated.New(mockdb.NewWebhookDBTX(nil, row, tt.rowErr, nil))}
+			got, err := repo.GetDelivery(context.Background(), row.ID.String())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Status != webhook.DeliveryFailed || got.ResponseStatus != 500 || got.Latency != 120*time.Millisecond || got.CompletedAt == nil || got.RedeliveryOf != "" {
+				t.Fatalf("unexpected delivery: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_ClaimDeliveries(t *testing.T) {
+	row := webhookDeliveryRow()
+	tests := []struct {
+		name      string
+		list      []*generated.WebhookDelivery
+		queryErr  error
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "[Success] claim deliveries", list: []*generated.WebhookDelivery{row}, wantCount: 1},
+		{name: "[Success] nothing due", wantCount: 0},
+		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewWebhookDBTX(nil, nil, nil, nil).WithDeliveries(tt.list, tt.queryErr)
+			repo := &WebhookRepository{queries: generated.New(mock)}
+			got, err := repo.ClaimDeliveries(context.Background(), 10, time.Minute)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("count = %d, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_RecordAttempt(t *testing.T) {
+	id := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}.String()
+	tests := []struct {
+		name       string
+		attempt    webhook.Attempt
+		wantStatus pgtype.Int4
+		wantError  pgtype.Text
+	}{
+		{
+			name:       "[Success] record response",
+			attempt:    webhook.Attempt{Status: webhook.DeliverySucceeded, ResponseStatus: 204, Latency: 15 * time.Millisecond},
+			wantStatus: pgtype.Int4{Int32: 204, Valid: true},
+		},
+		{
+			name:      "[Success] record transport error",
+			attempt:   webhook.Attempt{Status: webhook.DeliveryPending, Error: "connection refused"},
+			wantError: pgtype.Text{String: "connection refused", Valid: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewWebhookDBTX(nil, nil, nil, nil)
+			repo := &WebhookRepository{queries: generated.New(mock)}
+			if err := repo.RecordAttempt(context.Background(), id, tt.attempt, time.Minute); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			args := mock.ExecArgs[0]
+			if args[0] != string(tt.attempt.Status) || args[1] != tt.wantStatus || args[3] != tt.wantError || args[4] != 60.0 {
+				t.Fatalf("unexpected args: %v", args)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Package eventbus implements the EventBus port in memory.
+package eventbus
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MemoryBus fans events out to subscribers of this process. Envelope IDs carry a per-process
+// prefix so IDs issued before a restart are recognized as unknown instead of being misread.
+type MemoryBus struct {
+	mu          sync.Mutex
+	prefix      string
+	seq         uint64
+	history     []event.Envelope
+	historySize int
+	bufferSize  int
+	seen        map[string]bool
+	subs        map[chan event.Envelope]struct{}
+	lagged      map[chan event.Envelope]bool
+	closed      bool
+}
+
+var _ port.EventBus = (*MemoryBus)(nil)
+
+// NewMemoryBus creates MemoryBus keeping historySize events for resuming and buffering up to
+// bufferSize events per subscriber before dropping it.
+func NewMemoryBus(historySize, bufferSize int) *MemoryBus {
+	return &MemoryBus{
+		prefix:      newPrefix(),
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		seen:        map[string]bool{},
+		subs:        map[chan event.Envelope]struct{}{},
+		lagged:      map[chan event.Envelope]bool{},
+	}
+}
+
+// Publish appends an event to the history and sends it to every subscriber.
+// A subscriber whose buffer is full is dropped; it can resume from the history.
+func (b *MemoryBus) Publish(e event.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed || (e.ID != "" && b.seen[e.ID]) {
+		return
+	}
+	b.seq++
+	env := event.Envelope{ID: b.prefix + "-" + strconv.FormatUint(b.seq, 10), Event: e}
+	b.history = append(b.history, env)
+	if e.ID != "" {
+		b.seen[e.ID] = true
+	}
+	if len(b.history) > b.historySize {
+		delete(b.seen, b.history[0].Event.ID)
+		b.history = b.history[1:]
+	}
+	for ch := range b.subs {
+		select {
+		case ch <- env:
+		default:
+			delete(b.subs, ch)
+			b.lagged[ch] = true
+			close(ch)
+		}
+	}
+}
+
+// Subscribe returns the history after lastID and a channel of later events.
+func (b *MemoryBus) Subscribe(lastID string) port.EventSubscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch := make(chan event.Envelope, b.bufferSize)
+	sub := port.EventSubscription{
+		Resumed: true,
+		LastID:  b.prefix + "-" + strconv.FormatUint(b.seq, 10),
+		Events:  ch,
+		Lagged:  func() bool { return b.isLagged(ch) },
+		Cancel:  func() { b.cancel(ch) },
+	}
+	if lastID != "" {
+		sub.Replay, sub.Resumed = b.replay(lastID)
+	}
+	if b.closed {
+		close(ch)
+		return sub
+	}
+	b.subs[ch] = struct{}{}
+	return sub
+}
+
+// Close ends every subscription and ignores later events.
+func (b *MemoryBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for ch := range b.subs {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
+
+// Reset forgets the history and ends every subscription, so clients resuming with an earlier ID
+// are told they may have missed events. Use it when events may have been lost on the way to the bus.
+func (b *MemoryBus) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prefix = newPrefix()
+	b.history = nil
+	b.seen = map[string]bool{}
+	for ch := range b.subs {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
+
+func (b *MemoryBus) isLagged(ch chan event.Envelope) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lagged[ch]
+}
+
+func (b *MemoryBus) cancel(ch chan event.Envelope) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.lagged, ch)
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
+
+// replay returns the events after lastID, or false when events after it are no longer known.
+func (b *MemoryBus) replay(lastID string) ([]event.Envelope, bool) {
+	prefix, rawSeq, ok := strings.Cut(lastID, "-")
+	if !ok || prefix != b.prefix {
+		return nil, false
+	}
+	seq, err := strconv.ParseUint(rawSeq, 10, 64)
+	if err != nil || seq > b.seq {
+		return nil, false
+	}
+	// history holds the newest events, so its first entry has sequence b.seq-len+1
+	first := b.seq - uint64(len(b.history)) + 1
+	if seq+1 < first {
+		return nil, false
+	}
+	return append([]event.Envelope(nil), b.history[seq+1-first:]...), true
+}
+
+func newPrefix() string {
+	return strconv.FormatInt(time.Now().UnixNano(), 36)
+}
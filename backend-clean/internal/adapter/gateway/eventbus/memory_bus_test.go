@@ -0,0 +1,140 @@
+package eventbus
+
+import (
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func publishN(b *MemoryBus, n int) []string {
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b.Publish(event.Event{ID: string(rune('a' + i)), Name: event.NoteUpdated})
+		ids = append(ids, b.history[len(b.history)-1].ID)
+	}
+	return ids
+}
+
+func TestMemoryBus_Subscribe(t *testing.T) {
+	tests := []struct {
+		name        string
+		lastID      func(ids []string) string
+		wantReplay  int
+		wantResumed bool
+	}{
+		{name: "[Success] live only", lastID: func([]string) string { return "" }, wantReplay: 0, wantResumed: true},
+		{name: "[Success] resume from history", lastID: func(ids []string) string { return ids[3] }, wantReplay: 1, wantResumed: true},
+		{name: "[Success] resume at newest", lastID: func(ids []string) string { return ids[4] }, wantReplay: 0, wantResumed: true},
+		{name: "[Success] resume just before history", lastID: func(ids []string) string { return ids[1] }, wantReplay: 3, wantResumed: true},
+		{name: "[Fail] evicted from history", lastID: func(ids []string) string { return ids[0] }, wantResumed: false},
+		{name: "[Fail] id of another process", lastID: func([]string) string { return "zzz-1" }, wantResumed: false},
+		{name: "[Fail] malformed id", lastID: func([]string) string { return "garbage" }, wantResumed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewMemoryBus(3, 4)
+			ids := publishN(b, 5)
+			sub := b.Subscribe(tt.lastID(ids))
+			defer sub.Cancel()
+			if sub.Resumed != tt.wantResumed || len(sub.Replay) != tt.wantReplay {
+				t.Fatalf("resumed = %v, replay = %d", sub.Resumed, len(sub.Replay))
+			}
+			if tt.wantReplay > 0 && sub.Replay[len(sub.Replay)-1].ID != ids[4] {
+				t.Fatalf("unexpected replay: %+v", sub.Replay)
+			}
+		})
+	}
+}
+
+func TestMemoryBus_Publish(t *testing.T) {
+	b := NewMemoryBus(10, 1)
+	sub := b.Subscribe("")
+	slow := b.Subscribe("")
+
+	b.Publish(event.Event{ID: "ev-1", Name: event.NoteCreated})
+	b.Publish(event.Event{ID: "ev-1", Name: event.NoteCreated})
+	if got := <-sub.Events; got.Event.ID != "ev-1" {
+		t.Fatalf("unexpected event: %+v", got)
+	}
+	if len(b.history) != 1 {
+		t.Fatalf("duplicate was recorded: %d", len(b.history))
+	}
+
+	// slow never reads: its one-slot buffer is full, so the next event drops it
+	b.Publish(event.Event{ID: "ev-2", Name: event.NoteUpdated})
+	<-slow.Events
+	if _, ok := <-slow.Events; ok {
+		t.Fatal("slow subscriber should be dropped")
+	}
+	if !slow.Lagged() {
+		t.Fatal("dropped subscriber should be reported as lagged")
+	}
+	<-sub.Events
+
+	b.Close()
+	if _, ok := <-sub.Events; ok {
+		t.Fatal("close should end subscriptions")
+	}
+	if sub.Lagged() {
+		t.Fatal("closing the bus is not lagging")
+	}
+	sub.Cancel()
+	if late := b.Subscribe(""); func() bool { _, ok := <-late.Events; return ok }() {
+		t.Fatal("subscribing after close should end immediately")
+	}
+}
+
+func TestMemoryBus_LastID(t *testing.T) {
+	b := NewMemoryBus(10, 4)
+	empty := b.Subscribe("")
+	defer empty.Cancel()
+	publishN(b, 2)
+	sub := b.Subscribe("")
+	defer sub.Cancel()
+
+	for _, id := range []string{empty.LastID, sub.LastID} {
+		resumed := b.Subscribe(id)
+		resumed.Cancel()
+		if !resumed.Resumed {
+			t.Fatalf("LastID %q should be resumable", id)
+		}
+	}
+	if got := b.Subscribe(empty.LastID); len(got.Replay) != 2 {
+		t.Fatalf("expected both events after the first LastID, got %d", len(got.Replay))
+	}
+	if got := b.Subscribe(sub.LastID); len(got.Replay) != 0 {
+		t.Fatalf("expected nothing after the newest LastID, got %d", len(got.Replay))
+	}
+}
+
+func TestMemoryBus_Reset(t *testing.T) {
+	b := NewMemoryBus(10, 4)
+	ids := publishN(b, 2)
+	sub := b.Subscribe("")
+	before := sub.LastID
+
+	b.Reset()
+	if _, ok := <-sub.Events; ok {
+		t.Fatal("reset should end subscriptions")
+	}
+	if sub.Lagged() {
+		t.Fatal("reset is not lagging")
+	}
+	for _, id := range []string{ids[0], before} {
+		if got := b.Subscribe(id); got.Resumed {
+			t.Fatalf("ID %q from before the reset should be unknown", id)
+		}
+	}
+
+	b.Publish(event.Event{ID: "a", Name: event.NoteUpdated})
+	live := b.Subscribe("")
+	defer live.Cancel()
+	if len(b.history) != 1 {
+		t.Fatalf("event seen before the reset should be recorded again, history = %d", len(b.history))
+	}
+	b.Publish(event.Event{ID: "c", Name: event.NoteUpdated})
+	if got := <-live.Events; got.Event.ID != "c" {
+		t.Fatalf("unexpected event after reset: %+v", got)
+	}
+}
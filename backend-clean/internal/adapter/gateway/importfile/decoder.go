@@ -0,0 +1,97 @@
+// Package importfile implements the ImportDecoder port for Markdown files and zip archives.
+package importfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Decoder reads a single Markdown file or a zip archive of them.
+type Decoder struct{}
+
+var _ port.ImportDecoder = (*Decoder)(nil)
+
+// NewDecoder creates Decoder.
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+// Decode returns the Markdown documents of a single file or a zip archive.
+// Archives are read up to the import limits; non-Markdown entries are skipped.
+func (d *Decoder) Decode(fileName string, content []byte) ([]note.ImportFile, error) {
+	if len(content) > note.MaxImportUploadSize {
+		return nil, domainerr.ErrImportTooLarge
+	}
+	if !strings.EqualFold(path.Ext(fileName), ".zip") {
+		if !isMarkdownFile(fileName) {
+			return nil, domainerr.ErrImportNoDocuments
+		}
+		if len(content) > note.MaxImportFileSize {
+			return nil, domainerr.ErrImportTooLarge
+		}
+		if !utf8.Valid(content) {
+			return nil, domainerr.ErrImportInvalidArchive
+		}
+		return []note.ImportFile{{Name: path.Base(fileName), Content: string(content)}}, nil
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, domainerr.ErrImportInvalidArchive
+	}
+	var files []note.ImportFile
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() || !isMarkdownFile(zf.Name) || isHiddenPath(zf.Name) {
+			continue
+		}
+		if len(files) == note.MaxImportFiles {
+			return nil, domainerr.ErrImportTooLarge
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, domainerr.ErrImportInvalidArchive
+		}
+		// read one byte past the limit to detect oversized entries without trusting headers
+		data, err := io.ReadAll(io.LimitReader(rc, note.MaxImportFileSize+1))
+		_ = rc.Close()
+		if err != nil {
+			return nil, domainerr.ErrImportInvalidArchive
+		}
+		if len(data) > note.MaxImportFileSize {
+			return nil, domainerr.ErrImportTooLarge
+		}
+		if !utf8.Valid(data) {
+			return nil, domainerr.ErrImportInvalidArchive
+		}
+		files = append(files, note.ImportFile{Name: zf.Name, Content: string(data)})
+	}
+	if len(files) == 0 {
+		return nil, domainerr.ErrImportNoDocuments
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+func isMarkdownFile(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// isHiddenPath skips metadata such as __MACOSX/ and dotfiles added by archivers.
+func isHiddenPath(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") || strings.HasPrefix(part, "__") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package importfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func zipOf(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("zip create: %v", err)
+		}
+		_, _ = f.Write([]byte(content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecoder_Decode(t *testing.T) {
+	tests := []struct {
+		name      string
+		fileName  string
+		content   []byte
+		wantNames []string
+		wantError error
+	}{
+		{name: "[Success] single markdown file", fileName: "docs/adr.md", content: []byte("# A"), wantNames: []string{"adr.md"}},
+		{
+			name:     "[Success] zip skips other and hidden entries",
+			fileName: "adrs.ZIP",
+			content: zipOf(t, map[string]string{
+				"adrs/2.markdown":    "# B",
+				"adrs/1.md":          "# A",
+				"adrs/readme.txt":    "skip",
+				"__MACOSX/adrs/1.md": "skip",
+				"adrs/.draft.md":     "skip",
+			}),
+			wantNames: []string{"adrs/1.md", "adrs/2.markdown"},
+		},
+		{name: "[Fail] broken archive", fileName: "adrs.zip", content: []byte("not a zip"), wantError: domainerr.ErrImportInvalidArchive},
+		{name: "[Fail] archive without markdown", fileName: "adrs.zip", content: zipOf(t, map[string]string{"a.txt": "x"}), wantError: domainerr.ErrImportNoDocuments},
+		{name: "[Fail] oversized entry", fileName: "adrs.zip", content: zipOf(t, map[string]string{"a.md": strings.Repeat("x", 1<<20+1)}), wantError: domainerr.ErrImportTooLarge},
+		{name: "[Fail] not markdown", fileName: "adr.docx", content: []byte("x"), wantError: domainerr.ErrImportNoDocuments},
+		{name: "[Fail] not utf-8", fileName: "adr.md", content: []byte{0xff, 0xfe}, wantError: domainerr.ErrImportInvalidArchive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := NewDecoder().Decode(tt.fileName, tt.content)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if len(files) != len(tt.wantNames) {
+				t.Fatalf("files = %d, want %d", len(files), len(tt.wantNames))
+			}
+			for i, name := range tt.wantNames {
+				if files[i].Name != name {
+					t.Fatalf("file %d = %q, want %q", i, files[i].Name, name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var (
+	digestHTML = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/digest.html.tmpl"))
+	digestText = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/digest.txt.tmpl"))
+)
+
+// DigestRenderer renders digests from the HTML and text templates, linking to the web app.
+type DigestRenderer struct {
+	appBaseURL string
+	loc        *time.Location
+}
+
+var _ port.DigestRenderer = (*DigestRenderer)(nil)
+
+// NewDigestRenderer creates DigestRenderer. Links point under appBaseURL and dates are shown in loc.
+func NewDigestRenderer(appBaseURL string, loc *time.Location) *DigestRenderer {
+	return &DigestRenderer{appBaseURL: strings.TrimRight(appBaseURL, "/"), loc: loc}
+}
+
+// digestView is what the templates see.
+type digestView struct {
+	Name           string
+	Heading        string
+	Since          string
+	Notes          []linkView
+	Pending        []linkView
+	Drafts         []linkView
+	UnsubscribeURL string
+}
+
+type linkView struct {
+	Title string
+	// Detail is shown next to the title: the author, the notification kind or the last update.
+	Detail string
+	URL    string
+}
+
+// Render renders the digest into an email with one-click List-Unsubscribe headers (RFC 8058).
+// The same URL is linked in both bodies, where opening it shows a confirmation page instead.
+func (r *DigestRenderer) Render(d digest.Digest) (port.MailMessage, error) {
+	view := digestView{
+		Name:           displayName(d),
+		Heading:        heading(d.Frequency),
+		Since:          d.Since.In(r.loc).Format("2006/01/02"),
+		UnsubscribeURL: d.UnsubscribeURL,
+	}
+	for _, it := range d.Notes {
+		author := strings.TrimSpace(it.Author.LastName + " " + it.Author.FirstName)
+		if it.Kind == feed.KindNoteUpdated {
+			author += " が更新"
+		}
+		view.Notes = append(view.Notes, linkView{Title: it.Title, Detail: author, URL: r.appBaseURL + "/notes/" + it.SubjectID})
+	}
+	for _, n := range d.Pending {
+		view.Pending = append(view.Pending, linkView{Title: n.SubjectTitle, Detail: kindLabel(n.Kind)})
+	}
+	for _, n := range d.StaleDrafts {
+		view.Drafts = append(view.Drafts, linkView{
+			Title:  n.Title,
+			Detail: "最終更新 " + n.UpdatedAt.In(r.loc).Format("2006/01/02"),
+			URL:    r.appBaseURL + "/my-notes/" + n.ID,
+		})
+	}
+
+	var text, html bytes.Buffer
+	if err := digestText.Execute(&text, view); err != nil {
+		return port.MailMessage{}, err
+	}
+	if err := digestHTML.Execute(&html, view); err != nil {
+		return port.MailMessage{}, err
+	}
+	return port.MailMessage{
+		To:      d.Recipient.Email.String(),
+		Subject: fmt.Sprintf("Mini Notion %s（新着ノート %d 件）", view.Heading, len(d.Notes)),
+		Text:    text.String(),
+		HTML:    html.String(),
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + d.UnsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}, nil
+}
+
+func displayName(d digest.Digest) string {
+	if name := strings.TrimSpace(d.Recipient.LastName + " " + d.Recipient.FirstName); name != "" {
+		return name
+	}
+	return d.Recipient.Email.String()
+}
+
+func heading(f digest.Frequency) string {
+	if f == digest.FrequencyDaily {
+		return "デイリーダイジェスト"
+	}
+	return "ウィークリーダイジェスト"
+}
+
+func kindLabel(k notification.Kind) string {
+	switch k {
+	case notification.KindTemplateNotePublished:
+		return "あなたのテンプレートを使ったノートが公開されました"
+	case notification.KindNoteTransferred:
+		return "ノートがあなたに移譲されました"
+	case notification.KindTemplateDeprecated:
+		return "使用中のテンプレートが非推奨になりました"
+	default:
+		return string(k)
+	}
+}
@@ -0,0 +1,80 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+)
+
+func TestDigestRenderer_Render(t *testing.T) {
+	since := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	d := digest.Digest{
+		Recipient: account.Account{ID: "reader", Email: "reader@example.com", FirstName: "花子", LastName: "山田"},
+		Frequency: digest.FrequencyWeekly,
+		Since:     since,
+		Notes: []feed.Item{
+			{SubjectID: "note-1", Kind: feed.KindNotePublished, Title: "ADR <draft>", Author: feed.Author{FirstName: "太郎", LastName: "鈴木"}},
+		},
+		Pending:        []notification.Notification{{Kind: notification.KindNoteTransferred, SubjectTitle: "Runbook"}},
+		StaleDrafts:    []note.Note{{ID: "note-2", Title: "Half-written", UpdatedAt: since.Add(-30 * 24 * time.Hour)}},
+		UnsubscribeURL: "https://api.example.com/api/digests/unsubscribe?token=abc",
+	}
+	r := NewDigestRenderer("https://app.example.com/", time.UTC)
+
+	msg, err := r.Render(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.To != "reader@example.com" || msg.Subject != "Mini Notion ウィークリーダイジェスト（新着ノート 1 件）" {
+		t.Fatalf("unexpected envelope: %s %s", msg.To, msg.Subject)
+	}
+	if msg.Headers["List-Unsubscribe"] != "<"+d.UnsubscribeURL+">" || msg.Headers["List-Unsubscribe-Post"] != "List-Unsubscribe=One-Click" {
+		t.Fatalf("unexpected headers: %v", msg.Headers)
+	}
+	for _, want := range []string{
+		"山田 花子 さん",
+		"2026/10/12 以降",
+		"- ADR <draft>（鈴木 太郎）",
+		"https://app.example.com/notes/note-1",
+		"Runbook: ノートがあなたに移譲されました",
+		"Half-written（最終更新 2026/09/12）",
+		"https://app.example.com/my-notes/note-2",
+		"このメールの配信を停止する: " + d.UnsubscribeURL,
+	} {
+		if !strings.Contains(msg.Text, want) {
+			t.Errorf("text body missing %q:\n%s", want, msg.Text)
+		}
+	}
+	for _, want := range []string{
+		`<a href="https://app.example.com/notes/note-1">ADR &lt;draft&gt;</a>`,
+		`<a href="https://api.example.com/api/digests/unsubscribe?token=abc">`,
+	} {
+		if !strings.Contains(msg.HTML, want) {
+			t.Errorf("html body missing %q:\n%s", want, msg.HTML)
+		}
+	}
+}
+
+func TestDigestRenderer_RenderSkipsEmptySections(t *testing.T) {
+	d := digest.Digest{
+		Recipient:   account.Account{Email: "reader@example.com"},
+		Frequency:   digest.FrequencyDaily,
+		StaleDrafts: []note.Note{{ID: "note-2", Title: "Half-written"}},
+	}
+	msg, err := NewDigestRenderer("https://app.example.com", time.UTC).Render(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(msg.Text, "reader@example.com さん") || !strings.Contains(msg.Subject, "デイリーダイジェスト") {
+		t.Fatalf("unexpected greeting or subject: %s / %s", msg.Subject, msg.Text)
+	}
+	if strings.Contains(msg.Text, "新着ノート\n") || strings.Contains(msg.HTML, "未読の通知") {
+		t.Fatalf("empty sections should be left out:\n%s", msg.Text)
+	}
+}
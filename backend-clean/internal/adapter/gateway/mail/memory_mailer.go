@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"context"
+	"sync"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MemoryMailer keeps sent emails in memory instead of sending them. It stands in for SMTP in tests.
+type MemoryMailer struct {
+	mu   sync.Mutex
+	sent []port.MailMessage
+	// Err, when set, is returned by Send and the message is not kept.
+	Err error
+}
+
+var _ port.Mailer = (*MemoryMailer)(nil)
+
+// NewMemoryMailer creates MemoryMailer.
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+// Send records the message.
+func (m *MemoryMailer) Send(_ context.Context, msg port.MailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Err != nil {
+		return m.Err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first.
+func (m *MemoryMailer) Sent() []port.MailMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]port.MailMessage(nil), m.sent...)
+}
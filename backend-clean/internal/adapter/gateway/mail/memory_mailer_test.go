@@ -0,0 +1,23 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestMemoryMailer_Send(t *testing.T) {
+	m := NewMemoryMailer()
+	if err := m.Send(context.Background(), port.MailMessage{To: "a@example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m.Err = errors.New("smtp down")
+	if err := m.Send(context.Background(), port.MailMessage{To: "b@example.com"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if sent := m.Sent(); len(sent) != 1 || sent[0].To != "a@example.com" {
+		t.Fatalf("unexpected sent: %+v", sent)
+	}
+}
@@ -0,0 +1,169 @@
+// Package mail implements the Mailer port over SMTP, an in-memory Mailer for tests and the
+// templates digests are rendered with.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// SMTPMailer sends emails through an SMTP server, upgrading to TLS when the server offers STARTTLS.
+type SMTPMailer struct {
+	addr     string
+	host     string
+	from     *mail.Address
+	username string
+	password string
+	timeout  time.Duration
+}
+
+var _ port.Mailer = (*SMTPMailer)(nil)
+
+// NewSMTPMailer creates SMTPMailer sending from the given address through the server at addr
+// (host:port). It authenticates only when a username is given; a send gives up after timeout.
+func NewSMTPMailer(addr, username, password, from string, timeout time.Duration) (*SMTPMailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("smtp address: %w", err)
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("mail from: %w", err)
+	}
+	return &SMTPMailer{addr: addr, host: host, from: sender, username: username, password: password, timeout: timeout}, nil
+}
+
+// Send composes a multipart message with the text and HTML bodies and hands it to the server.
+func (m *SMTPMailer) Send(ctx context.Context, msg port.MailMessage) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("mail to: %w", err)
+	}
+	body, err := compose(m.from, to, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: m.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(m.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() { _ = c.Close() }()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if m.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(m.from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// errLineBreak rejects header values that would start a new header.
+var errLineBreak = errors.New("mail header contains a line break")
+
+// compose renders a multipart/alternative message: the text part first, so clients that can show
+// HTML pick the last part they understand.
+func compose(from, to *mail.Address, msg port.MailMessage, date time.Time) ([]byte, error) {
+	headers := map[string]string{
+		"From":         from.String(),
+		"To":           to.String(),
+		"Subject":      mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date":         date.Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+	}
+	for k, v := range msg.Headers {
+		headers[textproto.CanonicalMIMEHeaderKey(k)] = v
+	}
+
+	var parts bytes.Buffer
+	mw := multipart.NewWriter(&parts)
+	headers["Content-Type"] = `multipart/alternative; boundary="` + mw.Boundary() + `"`
+	for _, p := range []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", msg.Text},
+		{"text/html; charset=UTF-8", msg.HTML},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(p.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		if strings.ContainsAny(k+headers[k], "\r\n") {
+			return nil, fmt.Errorf("%w: %s", errLineBreak, k)
+		}
+		out.WriteString(k + ": " + headers[k] + "\r\n")
+	}
+	out.WriteString("\r\n")
+	out.Write(parts.Bytes())
+	return out.Bytes(), nil
+}
@@ -0,0 +1,181 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// smtpServer accepts one session and records the envelope and message it was given.
+type smtpServer struct {
+	addr     string
+	rejectTo bool
+	from     string
+	to       string
+	data     chan []byte
+}
+
+func newSMTPServer(t *testing.T, rejectTo bool) *smtpServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	s := &smtpServer{addr: ln.Addr().String(), rejectTo: rejectTo, data: make(chan []byte, 1)}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		s.serve(textproto.NewConn(conn))
+	}()
+	return s
+}
+
+func (s *smtpServer) serve(c *textproto.Conn) {
+	_ = c.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch {
+		case cmd == "EHLO" || cmd == "HELO":
+			_ = c.PrintfLine("250 localhost")
+		case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM:"):
+			s.from = line[len("MAIL FROM:"):]
+			_ = c.PrintfLine("250 OK")
+		case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:"):
+			if s.rejectTo {
+				_ = c.PrintfLine("550 no such user")
+				continue
+			}
+			s.to = line[len("RCPT TO:"):]
+			_ = c.PrintfLine("250 OK")
+		case cmd == "DATA":
+			_ = c.PrintfLine("354 go ahead")
+			body, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.data <- body
+			_ = c.PrintfLine("250 OK")
+		case cmd == "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			_ = c.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	msg := port.MailMessage{
+		To:      "reader@example.com",
+		Subject: "Mini Notion ウィークリーダイジェスト",
+		Text:    "新着ノート 1 件",
+		HTML:    "<p>新着ノート 1 件</p>",
+		Headers: map[string]string{"List-Unsubscribe": "<https://api.example.com/unsubscribe?token=abc>"},
+	}
+	tests := []struct {
+		name     string
+		to       string
+		rejectTo bool
+		wantErr  bool
+	}{
+		{name: "[Success] delivered", to: msg.To},
+		{name: "[Fail] recipient rejected", to: msg.To, rejectTo: true, wantErr: true},
+		{name: "[Fail] invalid recipient", to: "not an address", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newSMTPServer(t, tt.rejectTo)
+			mailer, err := NewSMTPMailer(srv.addr, "", "", "Mini Notion <noreply@example.com>", 5*time.Second)
+			if err != nil {
+				t.Fatalf("new mailer: %v", err)
+			}
+			m := msg
+			m.To = tt.to
+			err = mailer.Send(context.Background(), m)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if srv.from != "<noreply@example.com>" || srv.to != "<reader@example.com>" {
+				t.Fatalf("unexpected envelope: %s -> %s", srv.from, srv.to)
+			}
+			assertMessage(t, <-srv.data, msg)
+		})
+	}
+}
+
+func TestNewSMTPMailer(t *testing.T) {
+	if _, err := NewSMTPMailer("localhost", "", "", "noreply@example.com", time.Second); err == nil {
+		t.Fatal("expected error for address without port")
+	}
+	if _, err := NewSMTPMailer("localhost:25", "", "", "not an address", time.Second); err == nil {
+		t.Fatal("expected error for invalid sender")
+	}
+}
+
+func TestCompose_RejectsLineBreaks(t *testing.T) {
+	from := &mail.Address{Address: "noreply@example.com"}
+	to := &mail.Address{Address: "reader@example.com"}
+	msg := port.MailMessage{Subject: "hi", Headers: map[string]string{"List-Unsubscribe": "<x>\r\nBcc: victim@example.com"}}
+	if _, err := compose(from, to, msg, time.Now()); !errors.Is(err, errLineBreak) {
+		t.Fatalf("want %v, got %v", errLineBreak, err)
+	}
+}
+
+// assertMessage parses a raw message and checks its headers and both bodies.
+func assertMessage(t *testing.T, raw []byte, want port.MailMessage) {
+	t.Helper()
+	parsed, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != want.Subject {
+		t.Fatalf("subject = %q, %v", subject, err)
+	}
+	if got := parsed.Header.Get("List-Unsubscribe"); got != want.Headers["List-Unsubscribe"] {
+		t.Fatalf("List-Unsubscribe = %q", got)
+	}
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("content type = %q, %v", mediaType, err)
+	}
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	for _, wantPart := range []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", want.Text},
+		{"text/html; charset=UTF-8", want.HTML},
+	} {
+		part, err := mr.NextPart()
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+		body, _ := io.ReadAll(part)
+		if part.Header.Get("Content-Type") != wantPart.contentType || string(body) != wantPart.body {
+			t.Fatalf("unexpected part %q: %q", part.Header.Get("Content-Type"), body)
+		}
+	}
+}
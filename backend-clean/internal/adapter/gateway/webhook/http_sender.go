@@ -0,0 +1,90 @@
+// Package webhook implements the WebhookSender port over HTTP.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// maxResponseBody caps how much of a receiver's answer is read before the connection is reused.
+const maxResponseBody = 64 << 10
+
+// HTTPSender posts webhook payloads with net/http.
+type HTTPSender struct {
+	client *http.Client
+}
+
+var _ port.WebhookSender = (*HTTPSender)(nil)
+
+// NewHTTPSender creates HTTPSender whose requests give up after timeout. It only connects to
+// public addresses; allowed lists extra networks it may reach anyway, such as a local test receiver.
+func NewHTTPSender(timeout time.Duration, allowed ...netip.Prefix) *HTTPSender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Control sees the address after DNS resolution, so names that resolve or rebind to
+		// internal addresses are refused as well.
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkAddress(address, allowed)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the receiver and hide its address from Control.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &HTTPSender{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// A redirect is reported as the receiver's answer rather than followed to another host.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send posts body as JSON to url with the given headers and reports the status and latency.
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (webhook.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return webhook.Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return webhook.Response{Latency: time.Since(start)}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+	return webhook.Response{StatusCode: resp.StatusCode, Latency: time.Since(start)}, nil
+}
+
+func checkAddress(address string, allowed []netip.Prefix) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domainerr.ErrWebhookTargetNotAllowed, address)
+	}
+	addr := ap.Addr().Unmap()
+	for _, p := range allowed {
+		if p.Contains(addr) {
+			return nil
+		}
+	}
+	if !webhook.IsPublicAddr(addr) {
+		return fmt.Errorf("%w: %s", domainerr.ErrWebhookTargetNotAllowed, addr)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+var loopback = netip.MustParsePrefix("127.0.0.0/8")
+
+func TestHTTPSender_Send(t *testing.T) {
+	body := []byte(`{"event":"note.created"}`)
+	headers := map[string]string{webhook.SignatureHeader: "sha256=abc", webhook.EventHeader: "note.created"}
+	tests := []struct {
+		name       string
+		status     int
+		closed     bool
+		wantStatus int
+		wantErr    bool
+	}{
+		{name: "[Success] receiver accepts", status: http.StatusNoContent, wantStatus: http.StatusNoContent},
+		{name: "[Success] receiver rejects", status: http.StatusInternalServerError, wantStatus: http.StatusInternalServerError},
+		{name: "[Fail] receiver unreachable", closed: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" ||
+					r.Header.Get(webhook.SignatureHeader) != "sha256=abc" || r.Header.Get(webhook.EventHeader) != "note.created" ||
+					string(got) != string(body) {
+					t.Errorf("unexpected request: %s %v %s", r.Method, r.Header, got)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			if tt.closed {
+				srv.Close()
+			} else {
+				defer srv.Close()
+			}
+
+			res, err := NewHTTPSender(time.Second, loopback).Send(context.Background(), srv.URL, headers, body)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if res.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+			if res.Latency <= 0 {
+				t.Fatalf("latency not measured: %v", res.Latency)
+			}
+		})
+	}
+}
+
+func TestHTTPSender_SendRefusesInternalTargets(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	t.Run("[Fail] loopback without allow-list", func(t *testing.T) {
+		_, err := NewHTTPSender(time.Second).Send(context.Background(), srv.URL, nil, nil)
+		if !errors.Is(err, domainerr.ErrWebhookTargetNotAllowed) {
+			t.Fatalf("want ErrWebhookTargetNotAllowed, got %v", err)
+		}
+		if hits != 0 {
+			t.Fatalf("receiver was reached %d times", hits)
+		}
+	})
+	t.Run("[Fail] name resolving to loopback", func(t *testing.T) {
+		_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+		_, err := NewHTTPSender(time.Second).Send(context.Background(), "http://localhost:"+port, nil, nil)
+		if !errors.Is(err, domainerr.ErrWebhookTargetNotAllowed) {
+			t.Fatalf("want ErrWebhookTargetNotAllowed, got %v", err)
+		}
+	})
+	t.Run("[Success] redirect is reported, not followed", func(t *testing.T) {
+		res, err := NewHTTPSender(time.Second, loopback).Send(context.Background(), srv.URL+"/redirect", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.StatusCode != http.StatusFound {
+			t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusFound)
+		}
+	})
+}
@@ -3,15 +3,10 @@ package controller
 
 import (
 	"context"
-	"errors"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/accountpb"
 	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
 	"immortal-architecture-clean/backend/internal/domain/account"
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -86,20 +81,3 @@ func (s *AccountController) CreateOrGetAccount(ctx context.Context, req *account
 
 	return presenter.Response(), nil
 }
-
-// handleError converts domain errors to gRPC status codes.
-func handleError(err error) error {
-	if errors.Is(err, domainerr.ErrNotFound) {
-		return status.Error(codes.NotFound, err.Error())
-	}
-	if errors.Is(err, domainerr.ErrUnauthorized) {
-		return status.Error(codes.Unauthenticated, err.Error())
-	}
-	if errors.Is(err, account.ErrInvalidEmail) {
-		return status.Error(codes.InvalidArgument, err.Error())
-	}
-	if errors.Is(err, domainerr.ErrProviderRequired) {
-		return status.Error(codes.InvalidArgument, err.Error())
-	}
-	return status.Error(codes.Internal, "internal server error")
-}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// errorDomain is the ErrorInfo domain of the reasons below.
+const errorDomain = "mini-notion"
+
+// handleError converts domain errors to gRPC status codes. It maps every error the HTTP
+// helper maps; per-field validation errors and deprecated template successors are attached
+// as status details.
+func handleError(err error) error {
+	var verr *domainerr.ValidationError
+	var derr *domainerr.DeprecatedTemplateError
+	if _, ok := status.FromError(err); ok {
+		// Already a gRPC status, e.g. from sending on a stream whose client went away.
+		return err
+	}
+	switch {
+	case errors.As(err, &verr):
+		return withDetails(codes.InvalidArgument, err, toBadRequest(verr))
+	case errors.As(err, &derr):
+		info := &errdetails.ErrorInfo{Reason: "TEMPLATE_DEPRECATED", Domain: errorDomain}
+		if derr.SuccessorID != "" {
+			info.Metadata = map[string]string{"successorTemplateId": derr.SuccessorID}
+		}
+		return withDetails(codes.FailedPrecondition, err, info)
+	case errors.Is(err, domainerr.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domainerr.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, account.ErrInvalidEmail) || errors.Is(err, account.ErrInvalidName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrProviderRequired) || errors.Is(err, domainerr.ErrProviderAccountRequired) || errors.Is(err, domainerr.ErrOwnerRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrTitleRequired) || errors.Is(err, domainerr.ErrInvalidStatus) || errors.Is(err, domainerr.ErrSectionsMissing) || errors.Is(err, domainerr.ErrInvalidSectionContent):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrTemplateNameRequired) || errors.Is(err, domainerr.ErrTemplateOwnerRequired) || errors.Is(err, domainerr.ErrFieldRequired) || errors.Is(err, domainerr.ErrFieldOrderInvalid) || errors.Is(err, domainerr.ErrFieldLabelRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrInvalidTemplateField) || errors.Is(err, domainerr.ErrInvalidFieldType) || errors.Is(err, domainerr.ErrInvalidFieldOptions) || errors.Is(err, domainerr.ErrInvalidFieldConstraints) || errors.Is(err, domainerr.ErrUnknownField) || errors.Is(err, domainerr.ErrInvalidFieldMapping) || errors.Is(err, domainerr.ErrInvalidFieldRemovalPolicy):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrInvalidVisibility) || errors.Is(err, domainerr.ErrInvalidSuccessor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrAttachmentNameRequired) || errors.Is(err, domainerr.ErrAttachmentTooLarge) || errors.Is(err, domainerr.ErrUnsupportedContentType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrImportInvalidArchive) || errors.Is(err, domainerr.ErrImportTooLarge) || errors.Is(err, domainerr.ErrImportNoDocuments):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrCSVInvalid) || errors.Is(err, domainerr.ErrCSVHeaderInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrBundleInvalid) || errors.Is(err, domainerr.ErrBundleEmpty) || errors.Is(err, domainerr.ErrBundleTooLarge) || errors.Is(err, domainerr.ErrInvalidBundleFormat) || errors.Is(err, domainerr.ErrInvalidConflictMode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrBatchEmpty) || errors.Is(err, domainerr.ErrBatchTooLarge) || errors.Is(err, domainerr.ErrInvalidBatchMode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrInvalidWebhookURL) || errors.Is(err, domainerr.ErrWebhookTargetNotAllowed) || errors.Is(err, domainerr.ErrWebhookSecretTooShort) || errors.Is(err, domainerr.ErrInvalidWebhookEvent):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrInvalidNotificationKind) || errors.Is(err, domainerr.ErrInvalidDigestFrequency) || errors.Is(err, domainerr.ErrInvalidUnsubscribeToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domainerr.ErrCannotFollowSelf) || errors.Is(err, domainerr.ErrInvalidCursor) || errors.Is(err, domainerr.ErrInvalidPageSize):
+		return status.Error(codes.InvalidArgument, err.Error())
+	// These depend on the current state of the note or template rather than on the request.
+	case errors.Is(err, domainerr.ErrInvalidStatusChange) || errors.Is(err, domainerr.ErrTemplateInUse) || errors.Is(err, domainerr.ErrTemplateUsedByOthers):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domainerr.ErrNoteUpToDate) || errors.Is(err, domainerr.ErrNoteSameTemplate) || errors.Is(err, domainerr.ErrFieldHasContent):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domainerr.ErrConsumerTooSlow):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	// The client may retry at once; it resumes with a new snapshot since its token is no longer known.
+	case errors.Is(err, domainerr.ErrResyncRequired):
+		return withDetails(codes.Unavailable, err, &errdetails.ErrorInfo{Reason: "RESYNC_REQUIRED", Domain: errorDomain})
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// withDetails returns a status error carrying details; the bare status is returned if they cannot be attached.
+func withDetails(code codes.Code, err error, details ...protoadapt.MessageV1) error {
+	st := status.New(code, err.Error())
+	if detailed, derr := st.WithDetails(details...); derr == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
+
+func toBadRequest(verr *domainerr.ValidationError) *errdetails.BadRequest {
+	res := &errdetails.BadRequest{FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(verr.Fields))}
+	for _, f := range verr.Fields {
+		res.FieldViolations = append(res.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.FieldID,
+			Description: f.Error(),
+			Reason:      fieldErrorReason(f.Err),
+		})
+	}
+	return res
+}
+
+// fieldErrorReason uses the same codes as the field errors of the HTTP API.
+func fieldErrorReason(err error) string {
+	switch {
+	case errors.Is(err, domainerr.ErrRequiredFieldEmpty):
+		return "REQUIRED"
+	case errors.Is(err, domainerr.ErrContentTooShort):
+		return "TOO_SHORT"
+	case errors.Is(err, domainerr.ErrContentTooLong):
+		return "TOO_LONG"
+	case errors.Is(err, domainerr.ErrContentPatternMismatch):
+		return "PATTERN_MISMATCH"
+	default:
+		return "INVALID_CONTENT"
+	}
+}
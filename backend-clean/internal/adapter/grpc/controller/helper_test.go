@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestHandleError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{name: "[Success] not found", err: domainerr.ErrNotFound, wantCode: codes.NotFound},
+		{name: "[Success] wrapped not found", err: fmt.Errorf("get note: %w", domainerr.ErrNotFound), wantCode: codes.NotFound},
+		{name: "[Success] unauthorized", err: domainerr.ErrUnauthorized, wantCode: codes.Unauthenticated},
+		{name: "[Success] invalid email", err: account.ErrInvalidEmail, wantCode: codes.InvalidArgument},
+		{name: "[Success] owner required", err: domainerr.ErrOwnerRequired, wantCode: codes.InvalidArgument},
+		{name: "[Success] invalid status", err: domainerr.ErrInvalidStatus, wantCode: codes.InvalidArgument},
+		{name: "[Success] field required", err: domainerr.ErrFieldRequired, wantCode: codes.InvalidArgument},
+		{name: "[Success] invalid visibility", err: domainerr.ErrInvalidVisibility, wantCode: codes.InvalidArgument},
+		{
+			name:     "[Success] section validation",
+			err:      &domainerr.ValidationError{Fields: []domainerr.FieldError{{FieldID: "f1", Label: "Title", Err: domainerr.ErrRequiredFieldEmpty}}},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "[Success] template in use", err: domainerr.ErrTemplateInUse, wantCode: codes.FailedPrecondition},
+		{
+			name:     "[Success] deprecated template keeps successor in message",
+			err:      &domainerr.DeprecatedTemplateError{TemplateID: "tpl-1", SuccessorID: "tpl-2"},
+			wantCode: codes.FailedPrecondition,
+			wantMsg:  "template is deprecated; use template tpl-2 instead",
+		},
+		{name: "[Success] note already up to date", err: domainerr.ErrNoteUpToDate, wantCode: codes.FailedPrecondition},
+		{name: "[Success] batch too large", err: domainerr.ErrBatchTooLarge, wantCode: codes.InvalidArgument},
+		{name: "[Success] slow stream consumer", err: domainerr.ErrConsumerTooSlow, wantCode: codes.ResourceExhausted},
+		{name: "[Success] stream needs resync", err: domainerr.ErrResyncRequired, wantCode: codes.Unavailable},
+		{name: "[Success] status passes through", err: status.Error(codes.Unavailable, "transport is closing"), wantCode: codes.Unavailable, wantMsg: "transport is closing"},
+		{name: "[Fail] unknown error is hidden", err: errors.New("db down"), wantCode: codes.Internal, wantMsg: "internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(handleError(tt.err))
+			if !ok {
+				t.Fatalf("expected gRPC status error")
+			}
+			if st.Code() != tt.wantCode {
+				t.Fatalf("expected code %v, got %v", tt.wantCode, st.Code())
+			}
+			if tt.wantMsg != "" && st.Message() != tt.wantMsg {
+				t.Fatalf("expected message %q, got %q", tt.wantMsg, st.Message())
+			}
+		})
+	}
+}
+
+func TestHandleError_Details(t *testing.T) {
+	t.Run("[Success] field violations", func(t *testing.T) {
+		err := &domainerr.ValidationError{Fields: []domainerr.FieldError{
+			{FieldID: "f1", Label: "Title", Err: domainerr.ErrRequiredFieldEmpty},
+			{FieldID: "f2", Label: "Code", Err: domainerr.ErrContentPatternMismatch},
+		}}
+		st := status.Convert(handleError(err))
+		if len(st.Details()) != 1 {
+			t.Fatalf("expected one detail, got %v", st.Details())
+		}
+		br, ok := st.Details()[0].(*errdetails.BadRequest)
+		if !ok || len(br.GetFieldViolations()) != 2 {
+			t.Fatalf("expected bad request with two violations, got %v", st.Details()[0])
+		}
+		v := br.GetFieldViolations()[1]
+		if v.GetField() != "f2" || v.GetReason() != "PATTERN_MISMATCH" || v.GetDescription() != "Code: section content does not match pattern" {
+			t.Fatalf("unexpected violation: %v", v)
+		}
+	})
+	t.Run("[Success] deprecated template successor", func(t *testing.T) {
+		st := status.Convert(handleError(&domainerr.DeprecatedTemplateError{TemplateID: "tpl-1", SuccessorID: "tpl-2"}))
+		if len(st.Details()) != 1 {
+			t.Fatalf("expected one detail, got %v", st.Details())
+		}
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		if !ok || info.GetReason() != "TEMPLATE_DEPRECATED" || info.GetMetadata()["successorTemplateId"] != "tpl-2" {
+			t.Fatalf("unexpected detail: %v", st.Details()[0])
+		}
+	})
+	t.Run("[Success] resync reason", func(t *testing.T) {
+		st := status.Convert(handleError(domainerr.ErrResyncRequired))
+		if len(st.Details()) != 1 {
+			t.Fatalf("expected one detail, got %v", st.Details())
+		}
+		if info, ok := st.Details()[0].(*errdetails.ErrorInfo); !ok || info.GetReason() != "RESYNC_REQUIRED" {
+			t.Fatalf("unexpected detail: %v", st.Details()[0])
+		}
+	})
+}
+
+// TestHandleError_CoversHTTPMappings fails when the HTTP helper maps an error that gRPC
+// would report as Internal, so the two transports do not drift apart.
+func TestHandleError_CoversHTTPMappings(t *testing.T) {
+	errs := map[string]error{
+		"account.ErrInvalidEmail":                account.ErrInvalidEmail,
+		"account.ErrInvalidName":                 account.ErrInvalidName,
+		"domainerr.ErrNotFound":                  domainerr.ErrNotFound,
+		"domainerr.ErrUnauthorized":              domainerr.ErrUnauthorized,
+		"domainerr.ValidationError":              &domainerr.ValidationError{},
+		"domainerr.DeprecatedTemplateError":      &domainerr.DeprecatedTemplateError{},
+		"domainerr.ErrInvalidStatus":             domainerr.ErrInvalidStatus,
+		"domainerr.ErrInvalidStatusChange":       domainerr.ErrInvalidStatusChange,
+		"domainerr.ErrInvalidTemplateField":      domainerr.ErrInvalidTemplateField,
+		"domainerr.ErrInvalidFieldType":          domainerr.ErrInvalidFieldType,
+		"domainerr.ErrInvalidFieldOptions":       domainerr.ErrInvalidFieldOptions,
+		"domainerr.ErrInvalidFieldConstraints":   domainerr.ErrInvalidFieldConstraints,
+		"domainerr.ErrInvalidSectionContent":     domainerr.ErrInvalidSectionContent,
+		"domainerr.ErrAttachmentNameRequired":    domainerr.ErrAttachmentNameRequired,
+		"domainerr.ErrAttachmentTooLarge":        domainerr.ErrAttachmentTooLarge,
+		"domainerr.ErrUnsupportedContentType":    domainerr.ErrUnsupportedContentType,
+		"domainerr.ErrImportInvalidArchive":      domainerr.ErrImportInvalidArchive,
+		"domainerr.ErrImportTooLarge":            domainerr.ErrImportTooLarge,
+		"domainerr.ErrImportNoDocuments":         domainerr.ErrImportNoDocuments,
+		"domainerr.ErrUnknownField":              domainerr.ErrUnknownField,
+		"domainerr.ErrInvalidFieldMapping":       domainerr.ErrInvalidFieldMapping,
+		"domainerr.ErrInvalidFieldRemovalPolicy": domainerr.ErrInvalidFieldRemovalPolicy,
+		"domainerr.ErrFieldHasContent":           domainerr.ErrFieldHasContent,
+		"domainerr.ErrNoteUpToDate":              domainerr.ErrNoteUpToDate,
+		"domainerr.ErrNoteSameTemplate":          domainerr.ErrNoteSameTemplate,
+		"domainerr.ErrCSVInvalid":                domainerr.ErrCSVInvalid,
+		"domainerr.ErrCSVHeaderInvalid":          domainerr.ErrCSVHeaderInvalid,
+		"domainerr.ErrInvalidVisibility":         domainerr.ErrInvalidVisibility,
+		"domainerr.ErrTemplateUsedByOthers":      domainerr.ErrTemplateUsedByOthers,
+		"domainerr.ErrInvalidSuccessor":          domainerr.ErrInvalidSuccessor,
+		"domainerr.ErrBundleInvalid":             domainerr.ErrBundleInvalid,
+		"domainerr.ErrBundleEmpty":               domainerr.ErrBundleEmpty,
+		"domainerr.ErrBundleTooLarge":            domainerr.ErrBundleTooLarge,
+		"domainerr.ErrInvalidBundleFormat":       domainerr.ErrInvalidBundleFormat,
+		"domainerr.ErrInvalidConflictMode":       domainerr.ErrInvalidConflictMode,
+		"domainerr.ErrBatchEmpty":                domainerr.ErrBatchEmpty,
+		"domainerr.ErrBatchTooLarge":             domainerr.ErrBatchTooLarge,
+		"domainerr.ErrInvalidBatchMode":          domainerr.ErrInvalidBatchMode,
+		"domainerr.ErrInvalidWebhookURL":         domainerr.ErrInvalidWebhookURL,
+		"domainerr.ErrWebhookTargetNotAllowed":   domainerr.ErrWebhookTargetNotAllowed,
+		"domainerr.ErrWebhookSecretTooShort":     domainerr.ErrWebhookSecretTooShort,
+		"domainerr.ErrInvalidWebhookEvent":       domainerr.ErrInvalidWebhookEvent,
+		"domainerr.ErrInvalidNotificationKind":   domainerr.ErrInvalidNotificationKind,
+		"domainerr.ErrCannotFollowSelf":          domainerr.ErrCannotFollowSelf,
+		"domainerr.ErrInvalidCursor":             domainerr.ErrInvalidCursor,
+		"domainerr.ErrInvalidPageSize":           domainerr.ErrInvalidPageSize,
+		"domainerr.ErrInvalidDigestFrequency":    domainerr.ErrInvalidDigestFrequency,
+		"domainerr.ErrInvalidUnsubscribeToken":   domainerr.ErrInvalidUnsubscribeToken,
+	}
+
+	for _, name := range httpMappedErrors(t) {
+		t.Run(name, func(t *testing.T) {
+			err, ok := errs[name]
+			if !ok {
+				t.Fatalf("%s is mapped by the HTTP helper; add it here and to handleError", name)
+			}
+			if code := status.Code(handleError(err)); code == codes.Internal {
+				t.Fatalf("%s is reported as %v", name, code)
+			}
+		})
+	}
+}
+
+// httpMappedErrors lists the domain errors and error types the HTTP handleError switches on.
+func httpMappedErrors(t *testing.T) []string {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), "../../http/controller/helper.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parse HTTP helper: %v", err)
+	}
+	var names []string
+	seen := map[string]bool{}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "handleError" {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok || (pkg.Name != "domainerr" && pkg.Name != "account") {
+				return true
+			}
+			if name := pkg.Name + "." + sel.Sel.Name; !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+			return true
+		})
+	}
+	if len(names) == 0 {
+		t.Fatalf("no errors found in the HTTP helper")
+	}
+	return names
+}
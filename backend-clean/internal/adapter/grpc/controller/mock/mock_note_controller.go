@@ -0,0 +1,126 @@
+package mock
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteInputStub is a lightweight stub for note use case input.
+type NoteInputStub struct {
+	Err    error
+	Output port.NoteOutputPort
+	Notes  []note.WithMeta
+	// Filters records the last list filters.
+	Filters note.Filters
+	// Created records the last create input.
+	Created port.NoteCreateInput
+	// Updated records the last update input.
+	Updated port.NoteUpdateInput
+	// StatusChanged records the last status change input.
+	StatusChanged port.NoteStatusChangeInput
+}
+
+func (s *NoteInputStub) List(ctx context.Context, filters note.Filters) error {
+	s.Filters = filters
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteList(ctx, s.Notes)
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) ListByTemplate(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteList(ctx, s.Notes)
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Get(ctx context.Context, id string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: id}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Create(ctx context.Context, input port.NoteCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: "note-1", Title: input.Title, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Update(ctx context.Context, input port.NoteUpdateInput) error {
+	s.Updated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, Title: input.Title, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) ChangeStatus(ctx context.Context, input port.NoteStatusChangeInput) error {
+	s.StatusChanged = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID, Status: input.Status}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Delete(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Export(ctx context.Context, id, _ string) error {
+	return s.Get(ctx, id)
+}
+
+func (s *NoteInputStub) Upgrade(ctx context.Context, input port.NoteUpgradeInput) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Retemplate(ctx context.Context, input port.NoteRetemplateInput) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID, TemplateID: input.TemplateID}})
+	}
+	return s.Err
+}
+
+// NoteWatchInputStub is a lightweight stub for the note watch use case input.
+type NoteWatchInputStub struct {
+	Err    error
+	Output port.NoteWatchOutputPort
+	// Input records the last watch input.
+	Input port.NoteWatchInput
+}
+
+func (s *NoteWatchInputStub) Watch(ctx context.Context, input port.NoteWatchInput) error {
+	s.Input = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentSnapshotComplete(ctx, "token-1")
+	}
+	return s.Err
+}
+
+// WatchStreamStub is a server stream recording the messages sent on it.
+type WatchStreamStub struct {
+	grpc.ServerStream
+	Sent []*notepb.WatchNotesResponse
+}
+
+func (s *WatchStreamStub) Context() context.Context { return context.Background() }
+
+func (s *WatchStreamStub) Send(res *notepb.WatchNotesResponse) error {
+	s.Sent = append(s.Sent, res)
+	return nil
+}
@@ -0,0 +1,88 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateInputStub is a lightweight stub for template use case input.
+type TemplateInputStub struct {
+	Err    error
+	Output port.TemplateOutputPort
+	// Called records the name of the last called method.
+	Called string
+	// Version records the version passed to GetVersion.
+	Version int
+	// Created records the last create input.
+	Created port.TemplateCreateInput
+	// Updated records the last update input.
+	Updated port.TemplateUpdateInput
+	// Deprecated records the last deprecate input.
+	Deprecated port.TemplateDeprecateInput
+}
+
+func (s *TemplateInputStub) List(ctx context.Context, _ template.Filters) error {
+	s.Called = "List"
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplateList(ctx, []template.WithUsage{{Template: template.Template{ID: "tpl-1"}}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) Get(ctx context.Context, id, _ string) error {
+	s.Called = "Get"
+	return s.present(ctx, template.Template{ID: id})
+}
+
+func (s *TemplateInputStub) GetVersion(ctx context.Context, id string, version int, _ string) error {
+	s.Called = "GetVersion"
+	s.Version = version
+	return s.present(ctx, template.Template{ID: id, Version: version})
+}
+
+func (s *TemplateInputStub) Create(ctx context.Context, input port.TemplateCreateInput) error {
+	s.Called = "Create"
+	s.Created = input
+	return s.present(ctx, template.Template{ID: "tpl-1", Name: input.Name, OwnerID: input.OwnerID, Visibility: input.Visibility, Fields: input.Fields})
+}
+
+func (s *TemplateInputStub) Update(ctx context.Context, input port.TemplateUpdateInput) error {
+	s.Called = "Update"
+	s.Updated = input
+	return s.present(ctx, template.Template{ID: input.ID, Name: input.Name, OwnerID: input.OwnerID})
+}
+
+func (s *TemplateInputStub) Fork(ctx context.Context, input port.TemplateForkInput) error {
+	s.Called = "Fork"
+	return s.present(ctx, template.Template{ID: "tpl-2", Name: input.Name, OwnerID: input.OwnerID, ForkedFromID: input.ID})
+}
+
+func (s *TemplateInputStub) Deprecate(ctx context.Context, input port.TemplateDeprecateInput) error {
+	s.Called = "Deprecate"
+	s.Deprecated = input
+	now := time.Now()
+	return s.present(ctx, template.Template{ID: input.ID, OwnerID: input.OwnerID, DeprecatedAt: &now, SuccessorID: input.SuccessorID})
+}
+
+func (s *TemplateInputStub) Undeprecate(ctx context.Context, id, ownerID string) error {
+	s.Called = "Undeprecate"
+	return s.present(ctx, template.Template{ID: id, OwnerID: ownerID})
+}
+
+func (s *TemplateInputStub) Delete(ctx context.Context, _, _ string) error {
+	s.Called = "Delete"
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplateDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) present(ctx context.Context, tpl template.Template) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: tpl})
+	}
+	return s.Err
+}
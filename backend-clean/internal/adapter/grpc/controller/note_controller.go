@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+var noteStatuses = map[notepb.NoteStatus]note.NoteStatus{
+	notepb.NoteStatus_NOTE_STATUS_DRAFT:   note.StatusDraft,
+	notepb.NoteStatus_NOTE_STATUS_PUBLISH: note.StatusPublish,
+}
+
+// NoteController implements notepb.NoteServiceServer.
+type NoteController struct {
+	notepb.UnimplementedNoteServiceServer
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort
+	outputFactory   func() *grpcpresenter.NotePresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+	txFactory       func() port.TxManager
+	// Watching needs no transaction, so it has its own use case.
+	watchInputFactory  func(noteRepo port.NoteRepository, bus port.EventBus, output port.NoteWatchOutputPort) port.NoteWatchInputPort
+	watchOutputFactory func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteWatchPresenter
+	busFactory         func() port.EventBus
+}
+
+// NewNoteController creates a new gRPC note controller.
+func NewNoteController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort,
+	outputFactory func() *grpcpresenter.NotePresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+	watchInputFactory func(noteRepo port.NoteRepository, bus port.EventBus, output port.NoteWatchOutputPort) port.NoteWatchInputPort,
+	watchOutputFactory func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteWatchPresenter,
+	busFactory func() port.EventBus,
+) *NoteController {
+	return &NoteController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		noteRepoFactory:    noteRepoFactory,
+		tplRepoFactory:     tplRepoFactory,
+		txFactory:          txFactory,
+		watchInputFactory:  watchInputFactory,
+		watchOutputFactory: watchOutputFactory,
+		busFactory:         busFactory,
+	}
+}
+
+// ListNotes lists notes matching the filters.
+func (s *NoteController) ListNotes(ctx context.Context, req *notepb.ListNotesRequest) (*notepb.ListNotesResponse, error) {
+	var status *note.NoteStatus
+	if req.Status != nil {
+		st, ok := noteStatuses[req.GetStatus()]
+		if !ok {
+			return nil, handleError(domainerr.ErrInvalidStatus)
+		}
+		status = &st
+	}
+	filters := note.Filters{
+		Status:     status,
+		TemplateID: req.TemplateId,
+		OwnerID:    req.OwnerId,
+		Query:      req.Q,
+	}
+	input, presenter := s.newIO()
+	if err := input.List(ctx, filters); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Notes(), nil
+}
+
+// GetNote retrieves a note by ID.
+func (s *NoteController) GetNote(ctx context.Context, req *notepb.GetNoteRequest) (*notepb.NoteResponse, error) {
+	input, presenter := s.newIO()
+	if err := input.Get(ctx, req.GetNoteId()); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Note(), nil
+}
+
+// CreateNote creates a note.
+func (s *NoteController) CreateNote(ctx context.Context, req *notepb.CreateNoteRequest) (*notepb.NoteResponse, error) {
+	sections := make([]port.SectionInput, 0, len(req.GetSections()))
+	for _, sec := range req.GetSections() {
+		sections = append(sections, port.SectionInput{
+			FieldID: sec.GetFieldId(),
+			Content: sec.GetContent(),
+		})
+	}
+	input, presenter := s.newIO()
+	err := input.Create(ctx, port.NoteCreateInput{
+		Title:      req.GetTitle(),
+		TemplateID: req.GetTemplateId(),
+		OwnerID:    strings.TrimSpace(req.GetOwnerId()),
+		Sections:   sections,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Note(), nil
+}
+
+// UpdateNote updates a note.
+func (s *NoteController) UpdateNote(ctx context.Context, req *notepb.UpdateNoteRequest) (*notepb.NoteResponse, error) {
+	ownerID := strings.TrimSpace(req.GetOwnerId())
+	if ownerID == "" {
+		return nil, handleError(domainerr.ErrUnauthorized)
+	}
+	sections := make([]port.SectionUpdateInput, 0, len(req.GetSections()))
+	for _, sec := range req.GetSections() {
+		sections = append(sections, port.SectionUpdateInput{
+			SectionID: sec.GetSectionId(),
+			Content:   sec.GetContent(),
+		})
+	}
+	input, presenter := s.newIO()
+	err := input.Update(ctx, port.NoteUpdateInput{
+		ID:       req.GetNoteId(),
+		Title:    req.GetTitle(),
+		OwnerID:  ownerID,
+		Sections: sections,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Note(), nil
+}
+
+// DeleteNote deletes a note.
+func (s *NoteController) DeleteNote(ctx context.Context, req *notepb.DeleteNoteRequest) (*notepb.DeleteNoteResponse, error) {
+	ownerID := strings.TrimSpace(req.GetOwnerId())
+	if ownerID == "" {
+		return nil, handleError(domainerr.ErrUnauthorized)
+	}
+	input, presenter := s.newIO()
+	if err := input.Delete(ctx, req.GetNoteId(), ownerID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.DeleteResponse(), nil
+}
+
+// ChangeNoteStatus publishes or unpublishes a note.
+func (s *NoteController) ChangeNoteStatus(ctx context.Context, req *notepb.ChangeNoteStatusRequest) (*notepb.NoteResponse, error) {
+	ownerID := strings.TrimSpace(req.GetOwnerId())
+	if ownerID == "" {
+		return nil, handleError(domainerr.ErrOwnerRequired)
+	}
+	status, ok := noteStatuses[req.GetStatus()]
+	if !ok {
+		return nil, handleError(domainerr.ErrInvalidStatus)
+	}
+	input, presenter := s.newIO()
+	err := input.ChangeStatus(ctx, port.NoteStatusChangeInput{
+		ID:      req.GetNoteId(),
+		OwnerID: ownerID,
+		Status:  status,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Note(), nil
+}
+
+// WatchNotes streams matching notes and their changes until the client leaves or the server shuts down.
+func (s *NoteController) WatchNotes(req *notepb.WatchNotesRequest, stream notepb.NoteService_WatchNotesServer) error {
+	filter := event.WatchFilter{
+		TemplateID: req.GetTemplateId(),
+		OwnerID:    req.GetOwnerId(),
+	}
+	if req.Status != nil {
+		st, ok := noteStatuses[req.GetStatus()]
+		if !ok {
+			return handleError(domainerr.ErrInvalidStatus)
+		}
+		filter.Status = st
+	}
+	input := s.watchInputFactory(s.noteRepoFactory(), s.busFactory(), s.watchOutputFactory(stream))
+	if err := input.Watch(stream.Context(), port.NoteWatchInput{Filter: filter, ResumeToken: req.GetResumeToken()}); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (s *NoteController) newIO() (port.NoteInputPort, *grpcpresenter.NotePresenter) {
+	output := s.outputFactory()
+	input := s.inputFactory(s.noteRepoFactory(), s.tplRepoFactory(), s.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,285 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/grpc/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTestNoteController(input *ctrlmock.NoteInputStub) *NoteController {
+	return newTestNoteWatchController(input, &ctrlmock.NoteWatchInputStub{})
+}
+
+func newTestNoteWatchController(input *ctrlmock.NoteInputStub, watch *ctrlmock.NoteWatchInputStub) *NoteController {
+	return NewNoteController(
+		func(_ port.NoteRepository, _ port.TemplateRepository, _ port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+			input.Output = output
+			return input
+		},
+		grpcpresenter.NewNotePresenter,
+		func() port.NoteRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+		func(_ port.NoteRepository, _ port.EventBus, output port.NoteWatchOutputPort) port.NoteWatchInputPort {
+			watch.Output = output
+			return watch
+		},
+		grpcpresenter.NewNoteWatchPresenter,
+		func() port.EventBus { return nil },
+	)
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if want == codes.OK {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	if got := status.Code(err); got != want {
+		t.Fatalf("expected code %v, got %v (%v)", want, got, err)
+	}
+}
+
+func TestNoteController_ListNotes(t *testing.T) {
+	draft := notepb.NoteStatus_NOTE_STATUS_DRAFT
+	unspecified := notepb.NoteStatus_NOTE_STATUS_UNSPECIFIED
+	owner := "owner-1"
+	tests := []struct {
+		name       string
+		req        *notepb.ListNotesRequest
+		inErr      error
+		wantCode   codes.Code
+		wantStatus *note.NoteStatus
+		wantCount  int
+	}{
+		{
+			name:       "[Success] list with filters",
+			req:        &notepb.ListNotesRequest{Status: &draft, OwnerId: &owner},
+			wantStatus: func() *note.NoteStatus { s := note.StatusDraft; return &s }(),
+			wantCount:  2,
+		},
+		{
+			name:      "[Success] list without filters",
+			req:       &notepb.ListNotesRequest{},
+			wantCount: 2,
+		},
+		{
+			name:     "[Fail] unspecified status",
+			req:      &notepb.ListNotesRequest{Status: &unspecified},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] usecase error",
+			req:      &notepb.ListNotesRequest{},
+			inErr:    domainerr.ErrNotFound,
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr, Notes: []note.WithMeta{{Note: note.Note{ID: "n1"}}, {Note: note.Note{ID: "n2"}}}}
+			res, err := newTestNoteController(input).ListNotes(context.Background(), tt.req)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if len(res.GetNotes()) != tt.wantCount {
+				t.Fatalf("expected %d notes, got %d", tt.wantCount, len(res.GetNotes()))
+			}
+			if (tt.wantStatus == nil) != (input.Filters.Status == nil) || (tt.wantStatus != nil && *input.Filters.Status != *tt.wantStatus) {
+				t.Fatalf("unexpected status filter: %v", input.Filters.Status)
+			}
+			if tt.req.OwnerId != nil && input.Filters.OwnerID != tt.req.OwnerId {
+				t.Fatalf("owner filter was not passed through")
+			}
+		})
+	}
+}
+
+func TestNoteController_CreateAndUpdate(t *testing.T) {
+	t.Run("[Success] create note", func(t *testing.T) {
+		input := &ctrlmock.NoteInputStub{}
+		res, err := newTestNoteController(input).CreateNote(context.Background(), &notepb.CreateNoteRequest{
+			Title:      "Hello",
+			TemplateId: "tpl-1",
+			OwnerId:    " owner-1 ",
+			Sections:   []*notepb.SectionInput{{FieldId: "f1", Content: "c1"}},
+		})
+		assertCode(t, err, codes.OK)
+		if res.GetId() != "note-1" || input.Created.OwnerID != "owner-1" || input.Created.Sections[0].FieldID != "f1" {
+			t.Fatalf("unexpected create: %+v %+v", res, input.Created)
+		}
+	})
+	t.Run("[Fail] create with section error", func(t *testing.T) {
+		input := &ctrlmock.NoteInputStub{Err: domainerr.ErrSectionsMissing}
+		_, err := newTestNoteController(input).CreateNote(context.Background(), &notepb.CreateNoteRequest{Title: "Hello", OwnerId: "owner-1"})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("[Success] update note", func(t *testing.T) {
+		input := &ctrlmock.NoteInputStub{}
+		res, err := newTestNoteController(input).UpdateNote(context.Background(), &notepb.UpdateNoteRequest{
+			NoteId:   "note-1",
+			OwnerId:  "owner-1",
+			Title:    "Updated",
+			Sections: []*notepb.SectionUpdate{{SectionId: "s1", Content: "c2"}},
+		})
+		assertCode(t, err, codes.OK)
+		if res.GetTitle() != "Updated" || input.Updated.Sections[0].SectionID != "s1" {
+			t.Fatalf("unexpected update: %+v %+v", res, input.Updated)
+		}
+	})
+	t.Run("[Fail] update without owner", func(t *testing.T) {
+		input := &ctrlmock.NoteInputStub{}
+		_, err := newTestNoteController(input).UpdateNote(context.Background(), &notepb.UpdateNoteRequest{NoteId: "note-1", OwnerId: " "})
+		assertCode(t, err, codes.Unauthenticated)
+	})
+}
+
+func TestNoteController_ChangeNoteStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *notepb.ChangeNoteStatusRequest
+		inErr      error
+		wantCode   codes.Code
+		wantStatus notepb.NoteStatus
+	}{
+		{
+			name:       "[Success] publish",
+			req:        &notepb.ChangeNoteStatusRequest{NoteId: "note-1", OwnerId: "owner-1", Status: notepb.NoteStatus_NOTE_STATUS_PUBLISH},
+			wantStatus: notepb.NoteStatus_NOTE_STATUS_PUBLISH,
+		},
+		{
+			name:       "[Success] unpublish",
+			req:        &notepb.ChangeNoteStatusRequest{NoteId: "note-1", OwnerId: "owner-1", Status: notepb.NoteStatus_NOTE_STATUS_DRAFT},
+			wantStatus: notepb.NoteStatus_NOTE_STATUS_DRAFT,
+		},
+		{
+			name:     "[Fail] owner missing",
+			req:      &notepb.ChangeNoteStatusRequest{NoteId: "note-1", Status: notepb.NoteStatus_NOTE_STATUS_PUBLISH},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] unspecified status",
+			req:      &notepb.ChangeNoteStatusRequest{NoteId: "note-1", OwnerId: "owner-1"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] not owner",
+			req:      &notepb.ChangeNoteStatusRequest{NoteId: "note-1", OwnerId: "other", Status: notepb.NoteStatus_NOTE_STATUS_PUBLISH},
+			inErr:    domainerr.ErrUnauthorized,
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			res, err := newTestNoteController(input).ChangeNoteStatus(context.Background(), tt.req)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode == codes.OK && res.GetStatus() != tt.wantStatus {
+				t.Fatalf("expected status %v, got %v", tt.wantStatus, res.GetStatus())
+			}
+		})
+	}
+}
+
+func TestNoteController_GetAndDelete(t *testing.T) {
+	t.Run("[Success] get note", func(t *testing.T) {
+		res, err := newTestNoteController(&ctrlmock.NoteInputStub{}).GetNote(context.Background(), &notepb.GetNoteRequest{NoteId: "note-1"})
+		assertCode(t, err, codes.OK)
+		if res.GetId() != "note-1" {
+			t.Fatalf("unexpected note: %+v", res)
+		}
+	})
+	t.Run("[Fail] get missing note", func(t *testing.T) {
+		_, err := newTestNoteController(&ctrlmock.NoteInputStub{Err: domainerr.ErrNotFound}).GetNote(context.Background(), &notepb.GetNoteRequest{NoteId: "missing"})
+		assertCode(t, err, codes.NotFound)
+	})
+	t.Run("[Success] delete note", func(t *testing.T) {
+		res, err := newTestNoteController(&ctrlmock.NoteInputStub{}).DeleteNote(context.Background(), &notepb.DeleteNoteRequest{NoteId: "note-1", OwnerId: "owner-1"})
+		assertCode(t, err, codes.OK)
+		if !res.GetSuccess() {
+			t.Fatalf("expected success")
+		}
+	})
+	t.Run("[Fail] delete without owner", func(t *testing.T) {
+		_, err := newTestNoteController(&ctrlmock.NoteInputStub{}).DeleteNote(context.Background(), &notepb.DeleteNoteRequest{NoteId: "note-1"})
+		assertCode(t, err, codes.Unauthenticated)
+	})
+}
+
+func TestNoteController_WatchNotes(t *testing.T) {
+	publish := notepb.NoteStatus_NOTE_STATUS_PUBLISH
+	unspecified := notepb.NoteStatus_NOTE_STATUS_UNSPECIFIED
+	tpl := "tpl-1"
+	tests := []struct {
+		name       string
+		req        *notepb.WatchNotesRequest
+		inErr      error
+		wantCode   codes.Code
+		wantStatus note.NoteStatus
+		wantSent   int
+	}{
+		{
+			name:       "[Success] watch with filters",
+			req:        &notepb.WatchNotesRequest{TemplateId: &tpl, Status: &publish, ResumeToken: "token-0"},
+			wantStatus: note.StatusPublish,
+			wantSent:   1,
+		},
+		{
+			name:     "[Success] watch without filters",
+			req:      &notepb.WatchNotesRequest{},
+			wantSent: 1,
+		},
+		{
+			name:     "[Fail] unspecified status",
+			req:      &notepb.WatchNotesRequest{Status: &unspecified},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] consumer too slow",
+			req:      &notepb.WatchNotesRequest{},
+			inErr:    domainerr.ErrConsumerTooSlow,
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "[Fail] client went away",
+			req:      &notepb.WatchNotesRequest{},
+			inErr:    status.Error(codes.Canceled, "context canceled"),
+			wantCode: codes.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watch := &ctrlmock.NoteWatchInputStub{Err: tt.inErr}
+			stream := &ctrlmock.WatchStreamStub{}
+			err := newTestNoteWatchController(&ctrlmock.NoteInputStub{}, watch).WatchNotes(tt.req, stream)
+			assertCode(t, err, tt.wantCode)
+			if len(stream.Sent) != tt.wantSent {
+				t.Fatalf("sent %d messages, want %d", len(stream.Sent), tt.wantSent)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if f := watch.Input.Filter; f.Status != tt.wantStatus || f.TemplateID != tt.req.GetTemplateId() || watch.Input.ResumeToken != tt.req.GetResumeToken() {
+				t.Fatalf("unexpected input: %+v", watch.Input)
+			}
+			if stream.Sent[0].GetResumeToken() != "token-1" {
+				t.Fatalf("unexpected message: %v", stream.Sent[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+var visibilities = map[templatepb.TemplateVisibility]template.Visibility{
+	templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PRIVATE:  template.VisibilityPrivate,
+	templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_UNLISTED: template.VisibilityUnlisted,
+	templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PUBLIC:   template.VisibilityPublic,
+}
+
+var fieldTypes = map[templatepb.FieldType]template.FieldType{
+	templatepb.FieldType_FIELD_TYPE_TEXT:      template.FieldTypeText,
+	templatepb.FieldType_FIELD_TYPE_MARKDOWN:  template.FieldTypeMarkdown,
+	templatepb.FieldType_FIELD_TYPE_URL:       template.FieldTypeURL,
+	templatepb.FieldType_FIELD_TYPE_NUMBER:    template.FieldTypeNumber,
+	templatepb.FieldType_FIELD_TYPE_DATE:      template.FieldTypeDate,
+	templatepb.FieldType_FIELD_TYPE_SELECT:    template.FieldTypeSelect,
+	templatepb.FieldType_FIELD_TYPE_CHECKLIST: template.FieldTypeChecklist,
+}
+
+// TemplateController implements templatepb.TemplateServiceServer.
+type TemplateController struct {
+	templatepb.UnimplementedTemplateServiceServer
+	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort
+	outputFactory func() *grpcpresenter.TemplatePresenter
+	repoFactory   func() port.TemplateRepository
+	txFactory     func() port.TxManager
+}
+
+// NewTemplateController creates a new gRPC template controller.
+func NewTemplateController(
+	inputFactory func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort,
+	outputFactory func() *grpcpresenter.TemplatePresenter,
+	repoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+) *TemplateController {
+	return &TemplateController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+		txFactory:     txFactory,
+	}
+}
+
+// ListTemplates lists templates matching the filters.
+func (s *TemplateController) ListTemplates(ctx context.Context, req *templatepb.ListTemplatesRequest) (*templatepb.ListTemplatesResponse, error) {
+	filters := template.Filters{
+		Query:             req.Q,
+		OwnerID:           req.OwnerId,
+		FieldLabel:        req.FieldLabel,
+		ViewerID:          req.ViewerId,
+		IncludeDeprecated: req.GetIncludeDeprecated(),
+	}
+	input, presenter := s.newIO()
+	if err := input.List(ctx, filters); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Templates(), nil
+}
+
+// GetTemplate retrieves a template, or one of its past versions when a version is given.
+func (s *TemplateController) GetTemplate(ctx context.Context, req *templatepb.GetTemplateRequest) (*templatepb.TemplateResponse, error) {
+	input, presenter := s.newIO()
+	var err error
+	if req.Version != nil {
+		err = input.GetVersion(ctx, req.GetTemplateId(), int(req.GetVersion()), req.GetViewerId())
+	} else {
+		err = input.Get(ctx, req.GetTemplateId(), req.GetViewerId())
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Template(), nil
+}
+
+// CreateTemplate creates a template.
+func (s *TemplateController) CreateTemplate(ctx context.Context, req *templatepb.CreateTemplateRequest) (*templatepb.TemplateResponse, error) {
+	input, presenter := s.newIO()
+	err := input.Create(ctx, port.TemplateCreateInput{
+		Name:       req.GetName(),
+		OwnerID:    strings.TrimSpace(req.GetOwnerId()),
+		Visibility: visibilities[req.GetVisibility()],
+		Fields:     toFields(req.GetFields()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Template(), nil
+}
+
+// UpdateTemplate stores a new version of a template. An empty field list keeps the current fields.
+func (s *TemplateController) UpdateTemplate(ctx context.Context, req *templatepb.UpdateTemplateRequest) (*templatepb.TemplateResponse, error) {
+	ownerID := strings.TrimSpace(req.GetOwnerId())
+	if ownerID == "" {
+		return nil, handleError(domainerr.ErrUnauthorized)
+	}
+	var fields []template.Field
+	if len(req.GetFields()) > 0 {
+		fields = toFields(req.GetFields())
+	}
+	input, presenter := s.newIO()
+	err := input.Update(ctx, port.TemplateUpdateInput{
+		ID:         req.GetTemplateId(),
+		Name:       req.GetName(),
+		Visibility: visibilities[req.GetVisibility()],
+		Fields:     fields,
+		OwnerID:    ownerID,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Template(), nil
+}
+
+// DeleteTemplate deletes a template.
+func (s *TemplateController) DeleteTemplate(ctx context.Context, req *templatepb.DeleteTemplateRequest) (*templatepb.DeleteTemplateResponse, error) {
+	ownerID := strings.TrimSpace(req.GetOwnerId())
+	if ownerID == "" {
+		return nil, handleError(domainerr.ErrUnauthorized)
+	}
+	input, presenter := s.newIO()
+	if err := input.Delete(ctx, req.GetTemplateId(), ownerID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.DeleteResponse(), nil
+}
+
+// ChangeTemplateStatus deprecates a template or makes it usable again.
+func (s *TemplateController) ChangeTemplateStatus(ctx context.Context, req *templatepb.ChangeTemplateStatusRequest) (*templatepb.TemplateResponse, error) {
+	ownerID := strings.TrimSpace(req.GetOwnerId())
+	if ownerID == "" {
+		return nil, handleError(domainerr.ErrUnauthorized)
+	}
+	input, presenter := s.newIO()
+	var err error
+	switch req.GetStatus() {
+	case templatepb.TemplateStatus_TEMPLATE_STATUS_DEPRECATED:
+		err = input.Deprecate(ctx, port.TemplateDeprecateInput{
+			ID:          req.GetTemplateId(),
+			OwnerID:     ownerID,
+			SuccessorID: strings.TrimSpace(req.GetSuccessorTemplateId()),
+		})
+	case templatepb.TemplateStatus_TEMPLATE_STATUS_ACTIVE:
+		err = input.Undeprecate(ctx, req.GetTemplateId(), ownerID)
+	default:
+		err = domainerr.ErrInvalidStatus
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Template(), nil
+}
+
+func toFields(in []*templatepb.Field) []template.Field {
+	fields := make([]template.Field, 0, len(in))
+	for _, f := range in {
+		fields = append(fields, template.Field{
+			ID:          f.GetId(),
+			Label:       f.GetLabel(),
+			Order:       int(f.GetOrder()),
+			IsRequired:  f.GetIsRequired(),
+			Type:        fieldTypes[f.GetType()],
+			Options:     toFieldOptions(f.GetOptions()),
+			MinLength:   toIntPtr(f.MinLength),
+			MaxLength:   toIntPtr(f.MaxLength),
+			Pattern:     f.GetPattern(),
+			Placeholder: f.GetPlaceholder(),
+			HelpText:    f.GetHelpText(),
+		})
+	}
+	return fields
+}
+
+func toFieldOptions(o *templatepb.FieldOptions) template.FieldOptions {
+	if o == nil {
+		return template.FieldOptions{}
+	}
+	return template.FieldOptions{Min: o.Min, Max: o.Max, Choices: o.GetChoices()}
+}
+
+func toIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func (s *TemplateController) newIO() (port.TemplateInputPort, *grpcpresenter.TemplatePresenter) {
+	output := s.outputFactory()
+	input := s.inputFactory(s.repoFactory(), s.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,240 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/grpc/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTestTemplateController(input *ctrlmock.TemplateInputStub) *TemplateController {
+	return NewTemplateController(
+		func(_ port.TemplateRepository, _ port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+			input.Output = output
+			return input
+		},
+		grpcpresenter.NewTemplatePresenter,
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestTemplateController_GetTemplate(t *testing.T) {
+	version := int32(2)
+	tests := []struct {
+		name       string
+		req        *templatepb.GetTemplateRequest
+		inErr      error
+		wantCode   codes.Code
+		wantCalled string
+	}{
+		{name: "[Success] current version", req: &templatepb.GetTemplateRequest{TemplateId: "tpl-1"}, wantCalled: "Get"},
+		{name: "[Success] past version", req: &templatepb.GetTemplateRequest{TemplateId: "tpl-1", Version: &version}, wantCalled: "GetVersion"},
+		{name: "[Fail] not visible", req: &templatepb.GetTemplateRequest{TemplateId: "tpl-1"}, inErr: domainerr.ErrNotFound, wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			res, err := newTestTemplateController(input).GetTemplate(context.Background(), tt.req)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if input.Called != tt.wantCalled || res.GetId() != "tpl-1" || res.GetVersion() != tt.req.GetVersion() {
+				t.Fatalf("unexpected call %s: %+v", input.Called, res)
+			}
+		})
+	}
+}
+
+func TestTemplateController_CreateTemplate(t *testing.T) {
+	maxLen := int32(40)
+	tests := []struct {
+		name     string
+		req      *templatepb.CreateTemplateRequest
+		inErr    error
+		wantCode codes.Code
+		check    func(t *testing.T, in port.TemplateCreateInput, res *templatepb.TemplateResponse)
+	}{
+		{
+			name: "[Success] create with typed fields",
+			req: &templatepb.CreateTemplateRequest{
+				Name:       "Daily",
+				OwnerId:    "owner-1",
+				Visibility: templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PRIVATE,
+				Fields: []*templatepb.Field{
+					{Label: "Mood", Order: 1, Type: templatepb.FieldType_FIELD_TYPE_SELECT, Options: &templatepb.FieldOptions{Choices: []string{"good", "bad"}}},
+					{Label: "Memo", Order: 2, MaxLength: &maxLen},
+				},
+			},
+			check: func(t *testing.T, in port.TemplateCreateInput, res *templatepb.TemplateResponse) {
+				if in.Visibility != template.VisibilityPrivate || res.GetVisibility() != templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PRIVATE {
+					t.Fatalf("unexpected visibility: %v %v", in.Visibility, res.GetVisibility())
+				}
+				if in.Fields[0].Type != template.FieldTypeSelect || len(in.Fields[0].Options.Choices) != 2 {
+					t.Fatalf("unexpected select field: %+v", in.Fields[0])
+				}
+				if in.Fields[1].Type != "" || in.Fields[1].MaxLength == nil || *in.Fields[1].MaxLength != 40 || in.Fields[1].MinLength != nil {
+					t.Fatalf("unexpected text field: %+v", in.Fields[1])
+				}
+			},
+		},
+		{
+			name: "[Success] unspecified visibility uses the default",
+			req:  &templatepb.CreateTemplateRequest{Name: "Daily", OwnerId: "owner-1"},
+			check: func(t *testing.T, in port.TemplateCreateInput, _ *templatepb.TemplateResponse) {
+				if in.Visibility != "" {
+					t.Fatalf("expected empty visibility, got %q", in.Visibility)
+				}
+			},
+		},
+		{
+			name:     "[Fail] no fields",
+			req:      &templatepb.CreateTemplateRequest{Name: "Daily", OwnerId: "owner-1"},
+			inErr:    domainerr.ErrFieldRequired,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			res, err := newTestTemplateController(input).CreateTemplate(context.Background(), tt.req)
+			assertCode(t, err, tt.wantCode)
+			if tt.check != nil {
+				tt.check(t, input.Created, res)
+			}
+		})
+	}
+}
+
+func TestTemplateController_UpdateTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *templatepb.UpdateTemplateRequest
+		inErr      error
+		wantCode   codes.Code
+		wantFields bool
+	}{
+		{
+			name:       "[Success] replace fields",
+			req:        &templatepb.UpdateTemplateRequest{TemplateId: "tpl-1", OwnerId: "owner-1", Name: "Daily", Fields: []*templatepb.Field{{Id: "f1", Label: "Mood", Order: 1}}},
+			wantFields: true,
+		},
+		{
+			name: "[Success] empty field list keeps fields",
+			req:  &templatepb.UpdateTemplateRequest{TemplateId: "tpl-1", OwnerId: "owner-1", Name: "Renamed"},
+		},
+		{
+			name:     "[Fail] owner missing",
+			req:      &templatepb.UpdateTemplateRequest{TemplateId: "tpl-1", Name: "Daily"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "[Fail] private while used by others",
+			req:      &templatepb.UpdateTemplateRequest{TemplateId: "tpl-1", OwnerId: "owner-1", Visibility: templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PRIVATE},
+			inErr:    domainerr.ErrTemplateUsedByOthers,
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			_, err := newTestTemplateController(input).UpdateTemplate(context.Background(), tt.req)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if (input.Updated.Fields != nil) != tt.wantFields {
+				t.Fatalf("unexpected fields: %+v", input.Updated.Fields)
+			}
+		})
+	}
+}
+
+func TestTemplateController_ChangeTemplateStatus(t *testing.T) {
+	successor := "tpl-2"
+	tests := []struct {
+		name           string
+		req            *templatepb.ChangeTemplateStatusRequest
+		inErr          error
+		wantCode       codes.Code
+		wantCalled     string
+		wantDeprecated bool
+	}{
+		{
+			name:           "[Success] deprecate with successor",
+			req:            &templatepb.ChangeTemplateStatusRequest{TemplateId: "tpl-1", OwnerId: "owner-1", Status: templatepb.TemplateStatus_TEMPLATE_STATUS_DEPRECATED, SuccessorTemplateId: &successor},
+			wantCalled:     "Deprecate",
+			wantDeprecated: true,
+		},
+		{
+			name:       "[Success] make active again",
+			req:        &templatepb.ChangeTemplateStatusRequest{TemplateId: "tpl-1", OwnerId: "owner-1", Status: templatepb.TemplateStatus_TEMPLATE_STATUS_ACTIVE},
+			wantCalled: "Undeprecate",
+		},
+		{
+			name:     "[Fail] unspecified status",
+			req:      &templatepb.ChangeTemplateStatusRequest{TemplateId: "tpl-1", OwnerId: "owner-1"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] owner missing",
+			req:      &templatepb.ChangeTemplateStatusRequest{TemplateId: "tpl-1", Status: templatepb.TemplateStatus_TEMPLATE_STATUS_ACTIVE},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "[Fail] invalid successor",
+			req:      &templatepb.ChangeTemplateStatusRequest{TemplateId: "tpl-1", OwnerId: "owner-1", Status: templatepb.TemplateStatus_TEMPLATE_STATUS_DEPRECATED, SuccessorTemplateId: &successor},
+			inErr:    domainerr.ErrInvalidSuccessor,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			res, err := newTestTemplateController(input).ChangeTemplateStatus(context.Background(), tt.req)
+			assertCode(t, err, tt.wantCode)
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if input.Called != tt.wantCalled || res.GetDeprecated() != tt.wantDeprecated {
+				t.Fatalf("unexpected call %s: %+v", input.Called, res)
+			}
+			if tt.wantDeprecated && (input.Deprecated.SuccessorID != successor || res.GetSuccessorTemplateId() != successor) {
+				t.Fatalf("successor was not passed through: %+v", input.Deprecated)
+			}
+		})
+	}
+}
+
+func TestTemplateController_ListAndDelete(t *testing.T) {
+	t.Run("[Success] list templates", func(t *testing.T) {
+		res, err := newTestTemplateController(&ctrlmock.TemplateInputStub{}).ListTemplates(context.Background(), &templatepb.ListTemplatesRequest{IncludeDeprecated: true})
+		assertCode(t, err, codes.OK)
+		if len(res.GetTemplates()) != 1 {
+			t.Fatalf("unexpected list: %+v", res)
+		}
+	})
+	t.Run("[Success] delete template", func(t *testing.T) {
+		res, err := newTestTemplateController(&ctrlmock.TemplateInputStub{}).DeleteTemplate(context.Background(), &templatepb.DeleteTemplateRequest{TemplateId: "tpl-1", OwnerId: "owner-1"})
+		assertCode(t, err, codes.OK)
+		if !res.GetSuccess() {
+			t.Fatalf("expected success")
+		}
+	})
+	t.Run("[Fail] delete template in use", func(t *testing.T) {
+		_, err := newTestTemplateController(&ctrlmock.TemplateInputStub{Err: domainerr.ErrTemplateInUse}).DeleteTemplate(context.Background(), &templatepb.DeleteTemplateRequest{TemplateId: "tpl-1", OwnerId: "owner-1"})
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+}
@@ -0,0 +1,1426 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: proto/note.proto
+
+package notepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	templatepb "immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NoteStatus int32
+
+const (
+	NoteStatus_NOTE_STATUS_UNSPECIFIED NoteStatus = 0
+	NoteStatus_NOTE_STATUS_DRAFT       NoteStatus = 1
+	NoteStatus_NOTE_STATUS_PUBLISH     NoteStatus = 2
+)
+
+// Enum value maps for NoteStatus.
+var (
+	NoteStatus_name = map[int32]string{
+		0: "NOTE_STATUS_UNSPECIFIED",
+		1: "NOTE_STATUS_DRAFT",
+		2: "NOTE_STATUS_PUBLISH",
+	}
+	NoteStatus_value = map[string]int32{
+		"NOTE_STATUS_UNSPECIFIED": 0,
+		"NOTE_STATUS_DRAFT":       1,
+		"NOTE_STATUS_PUBLISH":     2,
+	}
+)
+
+func (x NoteStatus) Enum() *NoteStatus {
+	p := new(NoteStatus)
+	*p = x
+	return p
+}
+
+func (x NoteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_note_proto_enumTypes[0].Descriptor()
+}
+
+func (NoteStatus) Type() protoreflect.EnumType {
+	return &file_proto_note_proto_enumTypes[0]
+}
+
+func (x NoteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoteStatus.Descriptor instead.
+func (NoteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{0}
+}
+
+type NoteChangeKind int32
+
+const (
+	NoteChangeKind_NOTE_CHANGE_KIND_UNSPECIFIED NoteChangeKind = 0
+	NoteChangeKind_NOTE_CHANGE_KIND_CREATED     NoteChangeKind = 1
+	NoteChangeKind_NOTE_CHANGE_KIND_UPDATED     NoteChangeKind = 2
+	NoteChangeKind_NOTE_CHANGE_KIND_PUBLISHED   NoteChangeKind = 3
+	NoteChangeKind_NOTE_CHANGE_KIND_UNPUBLISHED NoteChangeKind = 4
+	NoteChangeKind_NOTE_CHANGE_KIND_DELETED     NoteChangeKind = 5
+	// the note was transferred or retemplated out of the watched owner or template
+	NoteChangeKind_NOTE_CHANGE_KIND_REMOVED NoteChangeKind = 6
+)
+
+// Enum value maps for NoteChangeKind.
+var (
+	NoteChangeKind_name = map[int32]string{
+		0: "NOTE_CHANGE_KIND_UNSPECIFIED",
+		1: "NOTE_CHANGE_KIND_CREATED",
+		2: "NOTE_CHANGE_KIND_UPDATED",
+		3: "NOTE_CHANGE_KIND_PUBLISHED",
+		4: "NOTE_CHANGE_KIND_UNPUBLISHED",
+		5: "NOTE_CHANGE_KIND_DELETED",
+		6: "NOTE_CHANGE_KIND_REMOVED",
+	}
+	NoteChangeKind_value = map[string]int32{
+		"NOTE_CHANGE_KIND_UNSPECIFIED": 0,
+		"NOTE_CHANGE_KIND_CREATED":     1,
+		"NOTE_CHANGE_KIND_UPDATED":     2,
+		"NOTE_CHANGE_KIND_PUBLISHED":   3,
+		"NOTE_CHANGE_KIND_UNPUBLISHED": 4,
+		"NOTE_CHANGE_KIND_DELETED":     5,
+		"NOTE_CHANGE_KIND_REMOVED":     6,
+	}
+)
+
+func (x NoteChangeKind) Enum() *NoteChangeKind {
+	p := new(NoteChangeKind)
+	*p = x
+	return p
+}
+
+func (x NoteChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoteChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_note_proto_enumTypes[1].Descriptor()
+}
+
+func (NoteChangeKind) Type() protoreflect.EnumType {
+	return &file_proto_note_proto_enumTypes[1]
+}
+
+func (x NoteChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoteChangeKind.Descriptor instead.
+func (NoteChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{1}
+}
+
+type ListNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *NoteStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=note.v1.NoteStatus,oneof" json:"status,omitempty"`
+	TemplateId    *string                `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	OwnerId       *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	Q             *string                `protobuf:"bytes,4,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_proto_note_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListNotesRequest) GetStatus() NoteStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *ListNotesRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+type ListNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*NoteResponse        `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_proto_note_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotesResponse) GetNotes() []*NoteResponse {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type GetNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRequest) Reset() {
+	*x = GetNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRequest) ProtoMessage() {}
+
+func (x *GetNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Sections      []*SectionInput        `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetSections() []*SectionInput {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type SectionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionInput) Reset() {
+	*x = SectionInput{}
+	mi := &file_proto_note_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionInput) ProtoMessage() {}
+
+func (x *SectionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionInput.ProtoReflect.Descriptor instead.
+func (*SectionInput) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SectionInput) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *SectionInput) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Sections      []*SectionUpdate       `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetSections() []*SectionUpdate {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type SectionUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionUpdate) Reset() {
+	*x = SectionUpdate{}
+	mi := &file_proto_note_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionUpdate) ProtoMessage() {}
+
+func (x *SectionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionUpdate.ProtoReflect.Descriptor instead.
+func (*SectionUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SectionUpdate) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *SectionUpdate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type DeleteNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *DeleteNoteRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_proto_note_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ChangeNoteStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Status        NoteStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=note.v1.NoteStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeNoteStatusRequest) Reset() {
+	*x = ChangeNoteStatusRequest{}
+	mi := &file_proto_note_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeNoteStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeNoteStatusRequest) ProtoMessage() {}
+
+func (x *ChangeNoteStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeNoteStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeNoteStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeNoteStatusRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *ChangeNoteStatusRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ChangeNoteStatusRequest) GetStatus() NoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+type Section struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       string                   `protobuf:"bytes,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	FieldLabel    string                   `protobuf:"bytes,3,opt,name=field_label,json=fieldLabel,proto3" json:"field_label,omitempty"`
+	Content       string                   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	IsRequired    bool                     `protobuf:"varint,5,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	FieldType     templatepb.FieldType     `protobuf:"varint,6,opt,name=field_type,json=fieldType,proto3,enum=template.v1.FieldType" json:"field_type,omitempty"`
+	FieldOptions  *templatepb.FieldOptions `protobuf:"bytes,7,opt,name=field_options,json=fieldOptions,proto3" json:"field_options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_proto_note_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Section) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Section) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *Section) GetFieldLabel() string {
+	if x != nil {
+		return x.FieldLabel
+	}
+	return ""
+}
+
+func (x *Section) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Section) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *Section) GetFieldType() templatepb.FieldType {
+	if x != nil {
+		return x.FieldType
+	}
+	return templatepb.FieldType(0)
+}
+
+func (x *Section) GetFieldOptions() *templatepb.FieldOptions {
+	if x != nil {
+		return x.FieldOptions
+	}
+	return nil
+}
+
+type NoteResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	Id              string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TemplateId      string                     `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateName    string                     `protobuf:"bytes,4,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	TemplateVersion int32                      `protobuf:"varint,5,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	OwnerId         string                     `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Owner           *templatepb.AccountSummary `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status          NoteStatus                 `protobuf:"varint,8,opt,name=status,proto3,enum=note.v1.NoteStatus" json:"status,omitempty"`
+	Sections        []*Section                 `protobuf:"bytes,9,rep,name=sections,proto3" json:"sections,omitempty"`
+	CreatedAt       *timestamppb.Timestamp     `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp     `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NoteResponse) Reset() {
+	*x = NoteResponse{}
+	mi := &file_proto_note_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteResponse) ProtoMessage() {}
+
+func (x *NoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteResponse.ProtoReflect.Descriptor instead.
+func (*NoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NoteResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateVersion() int32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetOwner() *templatepb.AccountSummary {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetStatus() NoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *NoteResponse) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WatchNotesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId *string                `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	OwnerId    *string                `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	Status     *NoteStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=note.v1.NoteStatus,oneof" json:"status,omitempty"`
+	// resume_token continues after an earlier message; a snapshot is sent first when it is empty or expired
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotesRequest) Reset() {
+	*x = WatchNotesRequest{}
+	mi := &file_proto_note_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotesRequest) ProtoMessage() {}
+
+func (x *WatchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotesRequest.ProtoReflect.Descriptor instead.
+func (*WatchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchNotesRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *WatchNotesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *WatchNotesRequest) GetStatus() NoteStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *WatchNotesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type NoteChange struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Kind       NoteChangeKind         `protobuf:"varint,1,opt,name=kind,proto3,enum=note.v1.NoteChangeKind" json:"kind,omitempty"`
+	NoteId     string                 `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	ActorId    string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// note is the current state of the note; it is unset once the note is deleted or removed
+	Note          *NoteResponse `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteChange) Reset() {
+	*x = NoteChange{}
+	mi := &file_proto_note_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteChange) ProtoMessage() {}
+
+func (x *NoteChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteChange.ProtoReflect.Descriptor instead.
+func (*NoteChange) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NoteChange) GetKind() NoteChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NoteChangeKind_NOTE_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *NoteChange) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *NoteChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *NoteChange) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *NoteChange) GetNote() *NoteResponse {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type SnapshotComplete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotComplete) Reset() {
+	*x = SnapshotComplete{}
+	mi := &file_proto_note_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotComplete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotComplete) ProtoMessage() {}
+
+func (x *SnapshotComplete) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotComplete.ProtoReflect.Descriptor instead.
+func (*SnapshotComplete) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{14}
+}
+
+type WatchNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resume_token is set on snapshot_complete and change messages
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*WatchNotesResponse_SnapshotNote
+	//	*WatchNotesResponse_SnapshotComplete
+	//	*WatchNotesResponse_Change
+	Message       isWatchNotesResponse_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotesResponse) Reset() {
+	*x = WatchNotesResponse{}
+	mi := &file_proto_note_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotesResponse) ProtoMessage() {}
+
+func (x *WatchNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotesResponse.ProtoReflect.Descriptor instead.
+func (*WatchNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchNotesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchNotesResponse) GetMessage() isWatchNotesResponse_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *WatchNotesResponse) GetSnapshotNote() *NoteResponse {
+	if x != nil {
+		if x, ok := x.Message.(*WatchNotesResponse_SnapshotNote); ok {
+			return x.SnapshotNote
+		}
+	}
+	return nil
+}
+
+func (x *WatchNotesResponse) GetSnapshotComplete() *SnapshotComplete {
+	if x != nil {
+		if x, ok := x.Message.(*WatchNotesResponse_SnapshotComplete); ok {
+			return x.SnapshotComplete
+		}
+	}
+	return nil
+}
+
+func (x *WatchNotesResponse) GetChange() *NoteChange {
+	if x != nil {
+		if x, ok := x.Message.(*WatchNotesResponse_Change); ok {
+			return x.Change
+		}
+	}
+	return nil
+}
+
+type isWatchNotesResponse_Message interface {
+	isWatchNotesResponse_Message()
+}
+
+type WatchNotesResponse_SnapshotNote struct {
+	SnapshotNote *NoteResponse `protobuf:"bytes,2,opt,name=snapshot_note,json=snapshotNote,proto3,oneof"`
+}
+
+type WatchNotesResponse_SnapshotComplete struct {
+	SnapshotComplete *SnapshotComplete `protobuf:"bytes,3,opt,name=snapshot_complete,json=snapshotComplete,proto3,oneof"`
+}
+
+type WatchNotesResponse_Change struct {
+	Change *NoteChange `protobuf:"bytes,4,opt,name=change,proto3,oneof"`
+}
+
+func (*WatchNotesResponse_SnapshotNote) isWatchNotesResponse_Message() {}
+
+func (*WatchNotesResponse_SnapshotComplete) isWatchNotesResponse_Message() {}
+
+func (*WatchNotesResponse_Change) isWatchNotesResponse_Message() {}
+
+var File_proto_note_proto protoreflect.FileDescriptor
+
+const file_proto_note_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/note.proto\x12\anote.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14proto/template.proto\"\xcb\x01\n" +
+	"\x10ListNotesRequest\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.note.v1.NoteStatusH\x00R\x06status\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x02 \x01(\tH\x01R\n" +
+	"templateId\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x02R\aownerId\x88\x01\x01\x12\x11\n" +
+	"\x01q\x18\x04 \x01(\tH\x03R\x01q\x88\x01\x01B\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_template_idB\v\n" +
+	"\t_owner_idB\x04\n" +
+	"\x02_q\"@\n" +
+	"\x11ListNotesResponse\x12+\n" +
+	"\x05notes\x18\x01 \x03(\v2\x15.note.v1.NoteResponseR\x05notes\")\n" +
+	"\x0eGetNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\"\x98\x01\n" +
+	"\x11CreateNoteRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x121\n" +
+	"\bsections\x18\x04 \x03(\v2\x15.note.v1.SectionInputR\bsections\"C\n" +
+	"\fSectionInput\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\x91\x01\n" +
+	"\x11UpdateNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x122\n" +
+	"\bsections\x18\x04 \x03(\v2\x16.note.v1.SectionUpdateR\bsections\"H\n" +
+	"\rSectionUpdate\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"G\n" +
+	"\x11DeleteNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\".\n" +
+	"\x12DeleteNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"z\n" +
+	"\x17ChangeNoteStatusRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12+\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.note.v1.NoteStatusR\x06status\"\x87\x02\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\tR\afieldId\x12\x1f\n" +
+	"\vfield_label\x18\x03 \x01(\tR\n" +
+	"fieldLabel\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1f\n" +
+	"\vis_required\x18\x05 \x01(\bR\n" +
+	"isRequired\x125\n" +
+	"\n" +
+	"field_type\x18\x06 \x01(\x0e2\x16.template.v1.FieldTypeR\tfieldType\x12>\n" +
+	"\rfield_options\x18\a \x01(\v2\x19.template.v1.FieldOptionsR\ffieldOptions\"\xc4\x03\n" +
+	"\fNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x12#\n" +
+	"\rtemplate_name\x18\x04 \x01(\tR\ftemplateName\x12)\n" +
+	"\x10template_version\x18\x05 \x01(\x05R\x0ftemplateVersion\x12\x19\n" +
+	"\bowner_id\x18\x06 \x01(\tR\aownerId\x121\n" +
+	"\x05owner\x18\a \x01(\v2\x1b.template.v1.AccountSummaryR\x05owner\x12+\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.note.v1.NoteStatusR\x06status\x12,\n" +
+	"\bsections\x18\t \x03(\v2\x10.note.v1.SectionR\bsections\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd6\x01\n" +
+	"\x11WatchNotesRequest\x12$\n" +
+	"\vtemplate_id\x18\x01 \x01(\tH\x00R\n" +
+	"templateId\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x02 \x01(\tH\x01R\aownerId\x88\x01\x01\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.note.v1.NoteStatusH\x02R\x06status\x88\x01\x01\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeTokenB\x0e\n" +
+	"\f_template_idB\v\n" +
+	"\t_owner_idB\t\n" +
+	"\a_status\"\xd5\x01\n" +
+	"\n" +
+	"NoteChange\x12+\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x17.note.v1.NoteChangeKindR\x04kind\x12\x17\n" +
+	"\anote_id\x18\x02 \x01(\tR\x06noteId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12)\n" +
+	"\x04note\x18\x05 \x01(\v2\x15.note.v1.NoteResponseR\x04note\"\x12\n" +
+	"\x10SnapshotComplete\"\xf9\x01\n" +
+	"\x12WatchNotesResponse\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12<\n" +
+	"\rsnapshot_note\x18\x02 \x01(\v2\x15.note.v1.NoteResponseH\x00R\fsnapshotNote\x12H\n" +
+	"\x11snapshot_complete\x18\x03 \x01(\v2\x19.note.v1.SnapshotCompleteH\x00R\x10snapshotComplete\x12-\n" +
+	"\x06change\x18\x04 \x01(\v2\x13.note.v1.NoteChangeH\x00R\x06changeB\t\n" +
+	"\amessage*Y\n" +
+	"\n" +
+	"NoteStatus\x12\x1b\n" +
+	"\x17NOTE_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11NOTE_STATUS_DRAFT\x10\x01\x12\x17\n" +
+	"\x13NOTE_STATUS_PUBLISH\x10\x02*\xec\x01\n" +
+	"\x0eNoteChangeKind\x12 \n" +
+	"\x1cNOTE_CHANGE_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18NOTE_CHANGE_KIND_CREATED\x10\x01\x12\x1c\n" +
+	"\x18NOTE_CHANGE_KIND_UPDATED\x10\x02\x12\x1e\n" +
+	"\x1aNOTE_CHANGE_KIND_PUBLISHED\x10\x03\x12 \n" +
+	"\x1cNOTE_CHANGE_KIND_UNPUBLISHED\x10\x04\x12\x1c\n" +
+	"\x18NOTE_CHANGE_KIND_DELETED\x10\x05\x12\x1c\n" +
+	"\x18NOTE_CHANGE_KIND_REMOVED\x10\x062\xeb\x03\n" +
+	"\vNoteService\x12B\n" +
+	"\tListNotes\x12\x19.note.v1.ListNotesRequest\x1a\x1a.note.v1.ListNotesResponse\x129\n" +
+	"\aGetNote\x12\x17.note.v1.GetNoteRequest\x1a\x15.note.v1.NoteResponse\x12?\n" +
+	"\n" +
+	"CreateNote\x12\x1a.note.v1.CreateNoteRequest\x1a\x15.note.v1.NoteResponse\x12?\n" +
+	"\n" +
+	"UpdateNote\x12\x1a.note.v1.UpdateNoteRequest\x1a\x15.note.v1.NoteResponse\x12E\n" +
+	"\n" +
+	"DeleteNote\x12\x1a.note.v1.DeleteNoteRequest\x1a\x1b.note.v1.DeleteNoteResponse\x12K\n" +
+	"\x10ChangeNoteStatus\x12 .note.v1.ChangeNoteStatusRequest\x1a\x15.note.v1.NoteResponse\x12G\n" +
+	"\n" +
+	"WatchNotes\x12\x1a.note.v1.WatchNotesRequest\x1a\x1b.note.v1.WatchNotesResponse0\x01BLZJimmortal-architecture-clean/backend/internal/adapter/grpc/generated/notepbb\x06proto3"
+
+var (
+	file_proto_note_proto_rawDescOnce sync.Once
+	file_proto_note_proto_rawDescData []byte
+)
+
+func file_proto_note_proto_rawDescGZIP() []byte {
+	file_proto_note_proto_rawDescOnce.Do(func() {
+		file_proto_note_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_note_proto_rawDesc), len(file_proto_note_proto_rawDesc)))
+	})
+	return file_proto_note_proto_rawDescData
+}
+
+var file_proto_note_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_note_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_note_proto_goTypes = []any{
+	(NoteStatus)(0),                   // 0: note.v1.NoteStatus
+	(NoteChangeKind)(0),               // 1: note.v1.NoteChangeKind
+	(*ListNotesRequest)(nil),          // 2: note.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),         // 3: note.v1.ListNotesResponse
+	(*GetNoteRequest)(nil),            // 4: note.v1.GetNoteRequest
+	(*CreateNoteRequest)(nil),         // 5: note.v1.CreateNoteRequest
+	(*SectionInput)(nil),              // 6: note.v1.SectionInput
+	(*UpdateNoteRequest)(nil),         // 7: note.v1.UpdateNoteRequest
+	(*SectionUpdate)(nil),             // 8: note.v1.SectionUpdate
+	(*DeleteNoteRequest)(nil),         // 9: note.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),        // 10: note.v1.DeleteNoteResponse
+	(*ChangeNoteStatusRequest)(nil),   // 11: note.v1.ChangeNoteStatusRequest
+	(*Section)(nil),                   // 12: note.v1.Section
+	(*NoteResponse)(nil),              // 13: note.v1.NoteResponse
+	(*WatchNotesRequest)(nil),         // 14: note.v1.WatchNotesRequest
+	(*NoteChange)(nil),                // 15: note.v1.NoteChange
+	(*SnapshotComplete)(nil),          // 16: note.v1.SnapshotComplete
+	(*WatchNotesResponse)(nil),        // 17: note.v1.WatchNotesResponse
+	(templatepb.FieldType)(0),         // 18: template.v1.FieldType
+	(*templatepb.FieldOptions)(nil),   // 19: template.v1.FieldOptions
+	(*templatepb.AccountSummary)(nil), // 20: template.v1.AccountSummary
+	(*timestamppb.Timestamp)(nil),     // 21: google.protobuf.Timestamp
+}
+var file_proto_note_proto_depIdxs = []int32{
+	0,  // 0: note.v1.ListNotesRequest.status:type_name -> note.v1.NoteStatus
+	13, // 1: note.v1.ListNotesResponse.notes:type_name -> note.v1.NoteResponse
+	6,  // 2: note.v1.CreateNoteRequest.sections:type_name -> note.v1.SectionInput
+	8,  // 3: note.v1.UpdateNoteRequest.sections:type_name -> note.v1.SectionUpdate
+	0,  // 4: note.v1.ChangeNoteStatusRequest.status:type_name -> note.v1.NoteStatus
+	18, // 5: note.v1.Section.field_type:type_name -> template.v1.FieldType
+	19, // 6: note.v1.Section.field_options:type_name -> template.v1.FieldOptions
+	20, // 7: note.v1.NoteResponse.owner:type_name -> template.v1.AccountSummary
+	0,  // 8: note.v1.NoteResponse.status:type_name -> note.v1.NoteStatus
+	12, // 9: note.v1.NoteResponse.sections:type_name -> note.v1.Section
+	21, // 10: note.v1.NoteResponse.created_at:type_name -> google.protobuf.Timestamp
+	21, // 11: note.v1.NoteResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: note.v1.WatchNotesRequest.status:type_name -> note.v1.NoteStatus
+	1,  // 13: note.v1.NoteChange.kind:type_name -> note.v1.NoteChangeKind
+	21, // 14: note.v1.NoteChange.occurred_at:type_name -> google.protobuf.Timestamp
+	13, // 15: note.v1.NoteChange.note:type_name -> note.v1.NoteResponse
+	13, // 16: note.v1.WatchNotesResponse.snapshot_note:type_name -> note.v1.NoteResponse
+	16, // 17: note.v1.WatchNotesResponse.snapshot_complete:type_name -> note.v1.SnapshotComplete
+	15, // 18: note.v1.WatchNotesResponse.change:type_name -> note.v1.NoteChange
+	2,  // 19: note.v1.NoteService.ListNotes:input_type -> note.v1.ListNotesRequest
+	4,  // 20: note.v1.NoteService.GetNote:input_type -> note.v1.GetNoteRequest
+	5,  // 21: note.v1.NoteService.CreateNote:input_type -> note.v1.CreateNoteRequest
+	7,  // 22: note.v1.NoteService.UpdateNote:input_type -> note.v1.UpdateNoteRequest
+	9,  // 23: note.v1.NoteService.DeleteNote:input_type -> note.v1.DeleteNoteRequest
+	11, // 24: note.v1.NoteService.ChangeNoteStatus:input_type -> note.v1.ChangeNoteStatusRequest
+	14, // 25: note.v1.NoteService.WatchNotes:input_type -> note.v1.WatchNotesRequest
+	3,  // 26: note.v1.NoteService.ListNotes:output_type -> note.v1.ListNotesResponse
+	13, // 27: note.v1.NoteService.GetNote:output_type -> note.v1.NoteResponse
+	13, // 28: note.v1.NoteService.CreateNote:output_type -> note.v1.NoteResponse
+	13, // 29: note.v1.NoteService.UpdateNote:output_type -> note.v1.NoteResponse
+	10, // 30: note.v1.NoteService.DeleteNote:output_type -> note.v1.DeleteNoteResponse
+	13, // 31: note.v1.NoteService.ChangeNoteStatus:output_type -> note.v1.NoteResponse
+	17, // 32: note.v1.NoteService.WatchNotes:output_type -> note.v1.WatchNotesResponse
+	26, // [26:33] is the sub-list for method output_type
+	19, // [19:26] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_proto_note_proto_init() }
+func file_proto_note_proto_init() {
+	if File_proto_note_proto != nil {
+		return
+	}
+	file_proto_note_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[12].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[15].OneofWrappers = []any{
+		(*WatchNotesResponse_SnapshotNote)(nil),
+		(*WatchNotesResponse_SnapshotComplete)(nil),
+		(*WatchNotesResponse_Change)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_note_proto_rawDesc), len(file_proto_note_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_note_proto_goTypes,
+		DependencyIndexes: file_proto_note_proto_depIdxs,
+		EnumInfos:         file_proto_note_proto_enumTypes,
+		MessageInfos:      file_proto_note_proto_msgTypes,
+	}.Build()
+	File_proto_note_proto = out.File
+	file_proto_note_proto_goTypes = nil
+	file_proto_note_proto_depIdxs = nil
+}
@@ -0,0 +1,1650 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: proto/template.proto
+
+package templatepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TemplateVisibility int32
+
+const (
+	TemplateVisibility_TEMPLATE_VISIBILITY_UNSPECIFIED TemplateVisibility = 0
+	TemplateVisibility_TEMPLATE_VISIBILITY_PRIVATE     TemplateVisibility = 1
+	TemplateVisibility_TEMPLATE_VISIBILITY_UNLISTED    TemplateVisibility = 2
+	TemplateVisibility_TEMPLATE_VISIBILITY_PUBLIC      TemplateVisibility = 3
+)
+
+// Enum value maps for TemplateVisibility.
+var (
+	TemplateVisibility_name = map[int32]string{
+		0: "TEMPLATE_VISIBILITY_UNSPECIFIED",
+		1: "TEMPLATE_VISIBILITY_PRIVATE",
+		2: "TEMPLATE_VISIBILITY_UNLISTED",
+		3: "TEMPLATE_VISIBILITY_PUBLIC",
+	}
+	TemplateVisibility_value = map[string]int32{
+		"TEMPLATE_VISIBILITY_UNSPECIFIED": 0,
+		"TEMPLATE_VISIBILITY_PRIVATE":     1,
+		"TEMPLATE_VISIBILITY_UNLISTED":    2,
+		"TEMPLATE_VISIBILITY_PUBLIC":      3,
+	}
+)
+
+func (x TemplateVisibility) Enum() *TemplateVisibility {
+	p := new(TemplateVisibility)
+	*p = x
+	return p
+}
+
+func (x TemplateVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_template_proto_enumTypes[0].Descriptor()
+}
+
+func (TemplateVisibility) Type() protoreflect.EnumType {
+	return &file_proto_template_proto_enumTypes[0]
+}
+
+func (x TemplateVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateVisibility.Descriptor instead.
+func (TemplateVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{0}
+}
+
+type TemplateStatus int32
+
+const (
+	TemplateStatus_TEMPLATE_STATUS_UNSPECIFIED TemplateStatus = 0
+	TemplateStatus_TEMPLATE_STATUS_ACTIVE      TemplateStatus = 1
+	TemplateStatus_TEMPLATE_STATUS_DEPRECATED  TemplateStatus = 2
+)
+
+// Enum value maps for TemplateStatus.
+var (
+	TemplateStatus_name = map[int32]string{
+		0: "TEMPLATE_STATUS_UNSPECIFIED",
+		1: "TEMPLATE_STATUS_ACTIVE",
+		2: "TEMPLATE_STATUS_DEPRECATED",
+	}
+	TemplateStatus_value = map[string]int32{
+		"TEMPLATE_STATUS_UNSPECIFIED": 0,
+		"TEMPLATE_STATUS_ACTIVE":      1,
+		"TEMPLATE_STATUS_DEPRECATED":  2,
+	}
+)
+
+func (x TemplateStatus) Enum() *TemplateStatus {
+	p := new(TemplateStatus)
+	*p = x
+	return p
+}
+
+func (x TemplateStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_template_proto_enumTypes[1].Descriptor()
+}
+
+func (TemplateStatus) Type() protoreflect.EnumType {
+	return &file_proto_template_proto_enumTypes[1]
+}
+
+func (x TemplateStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateStatus.Descriptor instead.
+func (TemplateStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{1}
+}
+
+type FieldType int32
+
+const (
+	FieldType_FIELD_TYPE_UNSPECIFIED FieldType = 0
+	FieldType_FIELD_TYPE_TEXT        FieldType = 1
+	FieldType_FIELD_TYPE_MARKDOWN    FieldType = 2
+	FieldType_FIELD_TYPE_URL         FieldType = 3
+	FieldType_FIELD_TYPE_NUMBER      FieldType = 4
+	FieldType_FIELD_TYPE_DATE        FieldType = 5
+	FieldType_FIELD_TYPE_SELECT      FieldType = 6
+	FieldType_FIELD_TYPE_CHECKLIST   FieldType = 7
+)
+
+// Enum value maps for FieldType.
+var (
+	FieldType_name = map[int32]string{
+		0: "FIELD_TYPE_UNSPECIFIED",
+		1: "FIELD_TYPE_TEXT",
+		2: "FIELD_TYPE_MARKDOWN",
+		3: "FIELD_TYPE_URL",
+		4: "FIELD_TYPE_NUMBER",
+		5: "FIELD_TYPE_DATE",
+		6: "FIELD_TYPE_SELECT",
+		7: "FIELD_TYPE_CHECKLIST",
+	}
+	FieldType_value = map[string]int32{
+		"FIELD_TYPE_UNSPECIFIED": 0,
+		"FIELD_TYPE_TEXT":        1,
+		"FIELD_TYPE_MARKDOWN":    2,
+		"FIELD_TYPE_URL":         3,
+		"FIELD_TYPE_NUMBER":      4,
+		"FIELD_TYPE_DATE":        5,
+		"FIELD_TYPE_SELECT":      6,
+		"FIELD_TYPE_CHECKLIST":   7,
+	}
+)
+
+func (x FieldType) Enum() *FieldType {
+	p := new(FieldType)
+	*p = x
+	return p
+}
+
+func (x FieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_template_proto_enumTypes[2].Descriptor()
+}
+
+func (FieldType) Type() protoreflect.EnumType {
+	return &file_proto_template_proto_enumTypes[2]
+}
+
+func (x FieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldType.Descriptor instead.
+func (FieldType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{2}
+}
+
+type FieldChangeKind int32
+
+const (
+	FieldChangeKind_FIELD_CHANGE_KIND_UNSPECIFIED FieldChangeKind = 0
+	FieldChangeKind_FIELD_CHANGE_KIND_ADDED       FieldChangeKind = 1
+	FieldChangeKind_FIELD_CHANGE_KIND_REMOVED     FieldChangeKind = 2
+	FieldChangeKind_FIELD_CHANGE_KIND_RENAMED     FieldChangeKind = 3
+	FieldChangeKind_FIELD_CHANGE_KIND_REORDERED   FieldChangeKind = 4
+	FieldChangeKind_FIELD_CHANGE_KIND_MODIFIED    FieldChangeKind = 5
+)
+
+// Enum value maps for FieldChangeKind.
+var (
+	FieldChangeKind_name = map[int32]string{
+		0: "FIELD_CHANGE_KIND_UNSPECIFIED",
+		1: "FIELD_CHANGE_KIND_ADDED",
+		2: "FIELD_CHANGE_KIND_REMOVED",
+		3: "FIELD_CHANGE_KIND_RENAMED",
+		4: "FIELD_CHANGE_KIND_REORDERED",
+		5: "FIELD_CHANGE_KIND_MODIFIED",
+	}
+	FieldChangeKind_value = map[string]int32{
+		"FIELD_CHANGE_KIND_UNSPECIFIED": 0,
+		"FIELD_CHANGE_KIND_ADDED":       1,
+		"FIELD_CHANGE_KIND_REMOVED":     2,
+		"FIELD_CHANGE_KIND_RENAMED":     3,
+		"FIELD_CHANGE_KIND_REORDERED":   4,
+		"FIELD_CHANGE_KIND_MODIFIED":    5,
+	}
+)
+
+func (x FieldChangeKind) Enum() *FieldChangeKind {
+	p := new(FieldChangeKind)
+	*p = x
+	return p
+}
+
+func (x FieldChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_template_proto_enumTypes[3].Descriptor()
+}
+
+func (FieldChangeKind) Type() protoreflect.EnumType {
+	return &file_proto_template_proto_enumTypes[3]
+}
+
+func (x FieldChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldChangeKind.Descriptor instead.
+func (FieldChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{3}
+}
+
+type ListTemplatesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Q                 *string                `protobuf:"bytes,1,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	OwnerId           *string                `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	FieldLabel        *string                `protobuf:"bytes,3,opt,name=field_label,json=fieldLabel,proto3,oneof" json:"field_label,omitempty"`
+	ViewerId          *string                `protobuf:"bytes,4,opt,name=viewer_id,json=viewerId,proto3,oneof" json:"viewer_id,omitempty"`
+	IncludeDeprecated bool                   `protobuf:"varint,5,opt,name=include_deprecated,json=includeDeprecated,proto3" json:"include_deprecated,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_proto_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListTemplatesRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetFieldLabel() string {
+	if x != nil && x.FieldLabel != nil {
+		return *x.FieldLabel
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetViewerId() string {
+	if x != nil && x.ViewerId != nil {
+		return *x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetIncludeDeprecated() bool {
+	if x != nil {
+		return x.IncludeDeprecated
+	}
+	return false
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TemplateResponse    `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_proto_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*TemplateResponse {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// version selects a past version; the current version is returned when omitted
+	Version       *int32  `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	ViewerId      *string `protobuf:"bytes,3,opt,name=viewer_id,json=viewerId,proto3,oneof" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_proto_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *GetTemplateRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *GetTemplateRequest) GetViewerId() string {
+	if x != nil && x.ViewerId != nil {
+		return *x.ViewerId
+	}
+	return ""
+}
+
+type CreateTemplateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// visibility defaults to public when unspecified
+	Visibility    TemplateVisibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=template.v1.TemplateVisibility" json:"visibility,omitempty"`
+	Fields        []*Field           `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_proto_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetVisibility() TemplateVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TemplateVisibility_TEMPLATE_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateTemplateRequest) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	OwnerId    string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// visibility keeps the current visibility when unspecified
+	Visibility TemplateVisibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=template.v1.TemplateVisibility" json:"visibility,omitempty"`
+	// fields keeps the current fields when empty; fields without an id are added
+	Fields        []*Field `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_proto_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetVisibility() TemplateVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TemplateVisibility_TEMPLATE_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdateTemplateRequest) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_proto_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *DeleteTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_proto_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ChangeTemplateStatusRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	OwnerId    string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Status     TemplateStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=template.v1.TemplateStatus" json:"status,omitempty"`
+	// successor_template_id names the replacement when deprecating
+	SuccessorTemplateId *string `protobuf:"bytes,4,opt,name=successor_template_id,json=successorTemplateId,proto3,oneof" json:"successor_template_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChangeTemplateStatusRequest) Reset() {
+	*x = ChangeTemplateStatusRequest{}
+	mi := &file_proto_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTemplateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTemplateStatusRequest) ProtoMessage() {}
+
+func (x *ChangeTemplateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTemplateStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTemplateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangeTemplateStatusRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ChangeTemplateStatusRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ChangeTemplateStatusRequest) GetStatus() TemplateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TemplateStatus_TEMPLATE_STATUS_UNSPECIFIED
+}
+
+func (x *ChangeTemplateStatusRequest) GetSuccessorTemplateId() string {
+	if x != nil && x.SuccessorTemplateId != nil {
+		return *x.SuccessorTemplateId
+	}
+	return ""
+}
+
+type AccountSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Thumbnail     *string                `protobuf:"bytes,4,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountSummary) Reset() {
+	*x = AccountSummary{}
+	mi := &file_proto_template_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSummary) ProtoMessage() {}
+
+func (x *AccountSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSummary.ProtoReflect.Descriptor instead.
+func (*AccountSummary) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AccountSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountSummary) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AccountSummary) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *AccountSummary) GetThumbnail() string {
+	if x != nil && x.Thumbnail != nil {
+		return *x.Thumbnail
+	}
+	return ""
+}
+
+type FieldOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Choices       []string               `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldOptions) Reset() {
+	*x = FieldOptions{}
+	mi := &file_proto_template_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOptions) ProtoMessage() {}
+
+func (x *FieldOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
+func (*FieldOptions) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FieldOptions) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *FieldOptions) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *FieldOptions) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+type Field struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	IsRequired    bool                   `protobuf:"varint,4,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	Type          FieldType              `protobuf:"varint,5,opt,name=type,proto3,enum=template.v1.FieldType" json:"type,omitempty"`
+	Options       *FieldOptions          `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	MinLength     *int32                 `protobuf:"varint,7,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
+	MaxLength     *int32                 `protobuf:"varint,8,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
+	Pattern       *string                `protobuf:"bytes,9,opt,name=pattern,proto3,oneof" json:"pattern,omitempty"`
+	Placeholder   *string                `protobuf:"bytes,10,opt,name=placeholder,proto3,oneof" json:"placeholder,omitempty"`
+	HelpText      *string                `protobuf:"bytes,11,opt,name=help_text,json=helpText,proto3,oneof" json:"help_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	mi := &file_proto_template_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Field) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Field) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Field) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Field) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *Field) GetType() FieldType {
+	if x != nil {
+		return x.Type
+	}
+	return FieldType_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *Field) GetOptions() *FieldOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Field) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *Field) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *Field) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *Field) GetPlaceholder() string {
+	if x != nil && x.Placeholder != nil {
+		return *x.Placeholder
+	}
+	return ""
+}
+
+func (x *Field) GetHelpText() string {
+	if x != nil && x.HelpText != nil {
+		return *x.HelpText
+	}
+	return ""
+}
+
+type TemplateUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NoteCount      int32                  `protobuf:"varint,1,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	PublishedCount int32                  `protobuf:"varint,2,opt,name=published_count,json=publishedCount,proto3" json:"published_count,omitempty"`
+	AuthorCount    int32                  `protobuf:"varint,3,opt,name=author_count,json=authorCount,proto3" json:"author_count,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TemplateUsage) Reset() {
+	*x = TemplateUsage{}
+	mi := &file_proto_template_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateUsage) ProtoMessage() {}
+
+func (x *TemplateUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateUsage.ProtoReflect.Descriptor instead.
+func (*TemplateUsage) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TemplateUsage) GetNoteCount() int32 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+func (x *TemplateUsage) GetPublishedCount() int32 {
+	if x != nil {
+		return x.PublishedCount
+	}
+	return 0
+}
+
+func (x *TemplateUsage) GetAuthorCount() int32 {
+	if x != nil {
+		return x.AuthorCount
+	}
+	return 0
+}
+
+func (x *TemplateUsage) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type FieldChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FieldId         *string                `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3,oneof" json:"field_id,omitempty"`
+	PreviousFieldId *string                `protobuf:"bytes,2,opt,name=previous_field_id,json=previousFieldId,proto3,oneof" json:"previous_field_id,omitempty"`
+	Label           string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	PreviousLabel   *string                `protobuf:"bytes,4,opt,name=previous_label,json=previousLabel,proto3,oneof" json:"previous_label,omitempty"`
+	Kinds           []FieldChangeKind      `protobuf:"varint,5,rep,packed,name=kinds,proto3,enum=template.v1.FieldChangeKind" json:"kinds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_template_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldChange) GetFieldId() string {
+	if x != nil && x.FieldId != nil {
+		return *x.FieldId
+	}
+	return ""
+}
+
+func (x *FieldChange) GetPreviousFieldId() string {
+	if x != nil && x.PreviousFieldId != nil {
+		return *x.PreviousFieldId
+	}
+	return ""
+}
+
+func (x *FieldChange) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FieldChange) GetPreviousLabel() string {
+	if x != nil && x.PreviousLabel != nil {
+		return *x.PreviousLabel
+	}
+	return ""
+}
+
+func (x *FieldChange) GetKinds() []FieldChangeKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type TemplateChangeReport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PreviousVersion int32                  `protobuf:"varint,1,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	Version         int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Fields          []*FieldChange         `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TemplateChangeReport) Reset() {
+	*x = TemplateChangeReport{}
+	mi := &file_proto_template_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateChangeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateChangeReport) ProtoMessage() {}
+
+func (x *TemplateChangeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateChangeReport.ProtoReflect.Descriptor instead.
+func (*TemplateChangeReport) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TemplateChangeReport) GetPreviousVersion() int32 {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return 0
+}
+
+func (x *TemplateChangeReport) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateChangeReport) GetFields() []*FieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type TemplateResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId      string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Owner        *AccountSummary        `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Visibility   TemplateVisibility     `protobuf:"varint,5,opt,name=visibility,proto3,enum=template.v1.TemplateVisibility" json:"visibility,omitempty"`
+	Version      int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Fields       []*Field               `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	IsUsed       bool                   `protobuf:"varint,8,opt,name=is_used,json=isUsed,proto3" json:"is_used,omitempty"`
+	Usage        *TemplateUsage         `protobuf:"bytes,9,opt,name=usage,proto3" json:"usage,omitempty"`
+	ForkedFromId *string                `protobuf:"bytes,10,opt,name=forked_from_id,json=forkedFromId,proto3,oneof" json:"forked_from_id,omitempty"`
+	// fork_count only counts public forks
+	ForkCount           int32                  `protobuf:"varint,11,opt,name=fork_count,json=forkCount,proto3" json:"fork_count,omitempty"`
+	Deprecated          bool                   `protobuf:"varint,12,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	DeprecatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deprecated_at,json=deprecatedAt,proto3" json:"deprecated_at,omitempty"`
+	SuccessorTemplateId *string                `protobuf:"bytes,14,opt,name=successor_template_id,json=successorTemplateId,proto3,oneof" json:"successor_template_id,omitempty"`
+	MatchedFieldIds     []string               `protobuf:"bytes,15,rep,name=matched_field_ids,json=matchedFieldIds,proto3" json:"matched_field_ids,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// changes is only set in the response to UpdateTemplate
+	Changes       *TemplateChangeReport `protobuf:"bytes,17,opt,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateResponse) Reset() {
+	*x = TemplateResponse{}
+	mi := &file_proto_template_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateResponse) ProtoMessage() {}
+
+func (x *TemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_template_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateResponse.ProtoReflect.Descriptor instead.
+func (*TemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_template_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TemplateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetOwner() *AccountSummary {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetVisibility() TemplateVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return TemplateVisibility_TEMPLATE_VISIBILITY_UNSPECIFIED
+}
+
+func (x *TemplateResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateResponse) GetFields() []*Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetIsUsed() bool {
+	if x != nil {
+		return x.IsUsed
+	}
+	return false
+}
+
+func (x *TemplateResponse) GetUsage() *TemplateUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetForkedFromId() string {
+	if x != nil && x.ForkedFromId != nil {
+		return *x.ForkedFromId
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetForkCount() int32 {
+	if x != nil {
+		return x.ForkCount
+	}
+	return 0
+}
+
+func (x *TemplateResponse) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *TemplateResponse) GetDeprecatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeprecatedAt
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetSuccessorTemplateId() string {
+	if x != nil && x.SuccessorTemplateId != nil {
+		return *x.SuccessorTemplateId
+	}
+	return ""
+}
+
+func (x *TemplateResponse) GetMatchedFieldIds() []string {
+	if x != nil {
+		return x.MatchedFieldIds
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TemplateResponse) GetChanges() *TemplateChangeReport {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_proto_template_proto protoreflect.FileDescriptor
+
+const file_proto_template_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/template.proto\x12\vtemplate.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x01\n" +
+	"\x14ListTemplatesRequest\x12\x11\n" +
+	"\x01q\x18\x01 \x01(\tH\x00R\x01q\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x02 \x01(\tH\x01R\aownerId\x88\x01\x01\x12$\n" +
+	"\vfield_label\x18\x03 \x01(\tH\x02R\n" +
+	"fieldLabel\x88\x01\x01\x12 \n" +
+	"\tviewer_id\x18\x04 \x01(\tH\x03R\bviewerId\x88\x01\x01\x12-\n" +
+	"\x12include_deprecated\x18\x05 \x01(\bR\x11includeDeprecatedB\x04\n" +
+	"\x02_qB\v\n" +
+	"\t_owner_idB\x0e\n" +
+	"\f_field_labelB\f\n" +
+	"\n" +
+	"_viewer_id\"T\n" +
+	"\x15ListTemplatesResponse\x12;\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1d.template.v1.TemplateResponseR\ttemplates\"\x90\x01\n" +
+	"\x12GetTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\x05H\x00R\aversion\x88\x01\x01\x12 \n" +
+	"\tviewer_id\x18\x03 \x01(\tH\x01R\bviewerId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_viewer_id\"\xb3\x01\n" +
+	"\x15CreateTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12?\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x1f.template.v1.TemplateVisibilityR\n" +
+	"visibility\x12*\n" +
+	"\x06fields\x18\x04 \x03(\v2\x12.template.v1.FieldR\x06fields\"\xd4\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12?\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x1f.template.v1.TemplateVisibilityR\n" +
+	"visibility\x12*\n" +
+	"\x06fields\x18\x05 \x03(\v2\x12.template.v1.FieldR\x06fields\"S\n" +
+	"\x15DeleteTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"2\n" +
+	"\x16DeleteTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe1\x01\n" +
+	"\x1bChangeTemplateStatusRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.template.v1.TemplateStatusR\x06status\x127\n" +
+	"\x15successor_template_id\x18\x04 \x01(\tH\x00R\x13successorTemplateId\x88\x01\x01B\x18\n" +
+	"\x16_successor_template_id\"\x8d\x01\n" +
+	"\x0eAccountSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\tthumbnail\x18\x04 \x01(\tH\x00R\tthumbnail\x88\x01\x01B\f\n" +
+	"\n" +
+	"_thumbnail\"f\n" +
+	"\fFieldOptions\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x18\n" +
+	"\achoices\x18\x03 \x03(\tR\achoicesB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xbd\x03\n" +
+	"\x05Field\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\x05R\x05order\x12\x1f\n" +
+	"\vis_required\x18\x04 \x01(\bR\n" +
+	"isRequired\x12*\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x16.template.v1.FieldTypeR\x04type\x123\n" +
+	"\aoptions\x18\x06 \x01(\v2\x19.template.v1.FieldOptionsR\aoptions\x12\"\n" +
+	"\n" +
+	"min_length\x18\a \x01(\x05H\x00R\tminLength\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_length\x18\b \x01(\x05H\x01R\tmaxLength\x88\x01\x01\x12\x1d\n" +
+	"\apattern\x18\t \x01(\tH\x02R\apattern\x88\x01\x01\x12%\n" +
+	"\vplaceholder\x18\n" +
+	" \x01(\tH\x03R\vplaceholder\x88\x01\x01\x12 \n" +
+	"\thelp_text\x18\v \x01(\tH\x04R\bhelpText\x88\x01\x01B\r\n" +
+	"\v_min_lengthB\r\n" +
+	"\v_max_lengthB\n" +
+	"\n" +
+	"\b_patternB\x0e\n" +
+	"\f_placeholderB\f\n" +
+	"\n" +
+	"_help_text\"\xb8\x01\n" +
+	"\rTemplateUsage\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\x01 \x01(\x05R\tnoteCount\x12'\n" +
+	"\x0fpublished_count\x18\x02 \x01(\x05R\x0epublishedCount\x12!\n" +
+	"\fauthor_count\x18\x03 \x01(\x05R\vauthorCount\x12<\n" +
+	"\flast_used_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\x8a\x02\n" +
+	"\vFieldChange\x12\x1e\n" +
+	"\bfield_id\x18\x01 \x01(\tH\x00R\afieldId\x88\x01\x01\x12/\n" +
+	"\x11previous_field_id\x18\x02 \x01(\tH\x01R\x0fpreviousFieldId\x88\x01\x01\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12*\n" +
+	"\x0eprevious_label\x18\x04 \x01(\tH\x02R\rpreviousLabel\x88\x01\x01\x122\n" +
+	"\x05kinds\x18\x05 \x03(\x0e2\x1c.template.v1.FieldChangeKindR\x05kindsB\v\n" +
+	"\t_field_idB\x14\n" +
+	"\x12_previous_field_idB\x11\n" +
+	"\x0f_previous_label\"\x8d\x01\n" +
+	"\x14TemplateChangeReport\x12)\n" +
+	"\x10previous_version\x18\x01 \x01(\x05R\x0fpreviousVersion\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x120\n" +
+	"\x06fields\x18\x03 \x03(\v2\x18.template.v1.FieldChangeR\x06fields\"\x8b\x06\n" +
+	"\x10TemplateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x121\n" +
+	"\x05owner\x18\x04 \x01(\v2\x1b.template.v1.AccountSummaryR\x05owner\x12?\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x1f.template.v1.TemplateVisibilityR\n" +
+	"visibility\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\x12*\n" +
+	"\x06fields\x18\a \x03(\v2\x12.template.v1.FieldR\x06fields\x12\x17\n" +
+	"\ais_used\x18\b \x01(\bR\x06isUsed\x120\n" +
+	"\x05usage\x18\t \x01(\v2\x1a.template.v1.TemplateUsageR\x05usage\x12)\n" +
+	"\x0eforked_from_id\x18\n" +
+	" \x01(\tH\x00R\fforkedFromId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"fork_count\x18\v \x01(\x05R\tforkCount\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\f \x01(\bR\n" +
+	"deprecated\x12?\n" +
+	"\rdeprecated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\fdeprecatedAt\x127\n" +
+	"\x15successor_template_id\x18\x0e \x01(\tH\x01R\x13successorTemplateId\x88\x01\x01\x12*\n" +
+	"\x11matched_field_ids\x18\x0f \x03(\tR\x0fmatchedFieldIds\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\achanges\x18\x11 \x01(\v2!.template.v1.TemplateChangeReportR\achangesB\x11\n" +
+	"\x0f_forked_from_idB\x18\n" +
+	"\x16_successor_template_id*\x9c\x01\n" +
+	"\x12TemplateVisibility\x12#\n" +
+	"\x1fTEMPLATE_VISIBILITY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTEMPLATE_VISIBILITY_PRIVATE\x10\x01\x12 \n" +
+	"\x1cTEMPLATE_VISIBILITY_UNLISTED\x10\x02\x12\x1e\n" +
+	"\x1aTEMPLATE_VISIBILITY_PUBLIC\x10\x03*m\n" +
+	"\x0eTemplateStatus\x12\x1f\n" +
+	"\x1bTEMPLATE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TEMPLATE_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aTEMPLATE_STATUS_DEPRECATED\x10\x02*\xc6\x01\n" +
+	"\tFieldType\x12\x1a\n" +
+	"\x16FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fFIELD_TYPE_TEXT\x10\x01\x12\x17\n" +
+	"\x13FIELD_TYPE_MARKDOWN\x10\x02\x12\x12\n" +
+	"\x0eFIELD_TYPE_URL\x10\x03\x12\x15\n" +
+	"\x11FIELD_TYPE_NUMBER\x10\x04\x12\x13\n" +
+	"\x0fFIELD_TYPE_DATE\x10\x05\x12\x15\n" +
+	"\x11FIELD_TYPE_SELECT\x10\x06\x12\x18\n" +
+	"\x14FIELD_TYPE_CHECKLIST\x10\a*\xd0\x01\n" +
+	"\x0fFieldChangeKind\x12!\n" +
+	"\x1dFIELD_CHANGE_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FIELD_CHANGE_KIND_ADDED\x10\x01\x12\x1d\n" +
+	"\x19FIELD_CHANGE_KIND_REMOVED\x10\x02\x12\x1d\n" +
+	"\x19FIELD_CHANGE_KIND_RENAMED\x10\x03\x12\x1f\n" +
+	"\x1bFIELD_CHANGE_KIND_REORDERED\x10\x04\x12\x1e\n" +
+	"\x1aFIELD_CHANGE_KIND_MODIFIED\x10\x052\x9e\x04\n" +
+	"\x0fTemplateService\x12V\n" +
+	"\rListTemplates\x12!.template.v1.ListTemplatesRequest\x1a\".template.v1.ListTemplatesResponse\x12M\n" +
+	"\vGetTemplate\x12\x1f.template.v1.GetTemplateRequest\x1a\x1d.template.v1.TemplateResponse\x12S\n" +
+	"\x0eCreateTemplate\x12\".template.v1.CreateTemplateRequest\x1a\x1d.template.v1.TemplateResponse\x12S\n" +
+	"\x0eUpdateTemplate\x12\".template.v1.UpdateTemplateRequest\x1a\x1d.template.v1.TemplateResponse\x12Y\n" +
+	"\x0eDeleteTemplate\x12\".template.v1.DeleteTemplateRequest\x1a#.template.v1.DeleteTemplateResponse\x12_\n" +
+	"\x14ChangeTemplateStatus\x12(.template.v1.ChangeTemplateStatusRequest\x1a\x1d.template.v1.TemplateResponseBPZNimmortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepbb\x06proto3"
+
+var (
+	file_proto_template_proto_rawDescOnce sync.Once
+	file_proto_template_proto_rawDescData []byte
+)
+
+func file_proto_template_proto_rawDescGZIP() []byte {
+	file_proto_template_proto_rawDescOnce.Do(func() {
+		file_proto_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_template_proto_rawDesc), len(file_proto_template_proto_rawDesc)))
+	})
+	return file_proto_template_proto_rawDescData
+}
+
+var file_proto_template_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_template_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_template_proto_goTypes = []any{
+	(TemplateVisibility)(0),             // 0: template.v1.TemplateVisibility
+	(TemplateStatus)(0),                 // 1: template.v1.TemplateStatus
+	(FieldType)(0),                      // 2: template.v1.FieldType
+	(FieldChangeKind)(0),                // 3: template.v1.FieldChangeKind
+	(*ListTemplatesRequest)(nil),        // 4: template.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),       // 5: template.v1.ListTemplatesResponse
+	(*GetTemplateRequest)(nil),          // 6: template.v1.GetTemplateRequest
+	(*CreateTemplateRequest)(nil),       // 7: template.v1.CreateTemplateRequest
+	(*UpdateTemplateRequest)(nil),       // 8: template.v1.UpdateTemplateRequest
+	(*DeleteTemplateRequest)(nil),       // 9: template.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),      // 10: template.v1.DeleteTemplateResponse
+	(*ChangeTemplateStatusRequest)(nil), // 11: template.v1.ChangeTemplateStatusRequest
+	(*AccountSummary)(nil),              // 12: template.v1.AccountSummary
+	(*FieldOptions)(nil),                // 13: template.v1.FieldOptions
+	(*Field)(nil),                       // 14: template.v1.Field
+	(*TemplateUsage)(nil),               // 15: template.v1.TemplateUsage
+	(*FieldChange)(nil),                 // 16: template.v1.FieldChange
+	(*TemplateChangeReport)(nil),        // 17: template.v1.TemplateChangeReport
+	(*TemplateResponse)(nil),            // 18: template.v1.TemplateResponse
+	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
+}
+var file_proto_template_proto_depIdxs = []int32{
+	18, // 0: template.v1.ListTemplatesResponse.templates:type_name -> template.v1.TemplateResponse
+	0,  // 1: template.v1.CreateTemplateRequest.visibility:type_name -> template.v1.TemplateVisibility
+	14, // 2: template.v1.CreateTemplateRequest.fields:type_name -> template.v1.Field
+	0,  // 3: template.v1.UpdateTemplateRequest.visibility:type_name -> template.v1.TemplateVisibility
+	14, // 4: template.v1.UpdateTemplateRequest.fields:type_name -> template.v1.Field
+	1,  // 5: template.v1.ChangeTemplateStatusRequest.status:type_name -> template.v1.TemplateStatus
+	2,  // 6: template.v1.Field.type:type_name -> template.v1.FieldType
+	13, // 7: template.v1.Field.options:type_name -> template.v1.FieldOptions
+	19, // 8: template.v1.TemplateUsage.last_used_at:type_name -> google.protobuf.Timestamp
+	3,  // 9: template.v1.FieldChange.kinds:type_name -> template.v1.FieldChangeKind
+	16, // 10: template.v1.TemplateChangeReport.fields:type_name -> template.v1.FieldChange
+	12, // 11: template.v1.TemplateResponse.owner:type_name -> template.v1.AccountSummary
+	0,  // 12: template.v1.TemplateResponse.visibility:type_name -> template.v1.TemplateVisibility
+	14, // 13: template.v1.TemplateResponse.fields:type_name -> template.v1.Field
+	15, // 14: template.v1.TemplateResponse.usage:type_name -> template.v1.TemplateUsage
+	19, // 15: template.v1.TemplateResponse.deprecated_at:type_name -> google.protobuf.Timestamp
+	19, // 16: template.v1.TemplateResponse.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 17: template.v1.TemplateResponse.changes:type_name -> template.v1.TemplateChangeReport
+	4,  // 18: template.v1.TemplateService.ListTemplates:input_type -> template.v1.ListTemplatesRequest
+	6,  // 19: template.v1.TemplateService.GetTemplate:input_type -> template.v1.GetTemplateRequest
+	7,  // 20: template.v1.TemplateService.CreateTemplate:input_type -> template.v1.CreateTemplateRequest
+	8,  // 21: template.v1.TemplateService.UpdateTemplate:input_type -> template.v1.UpdateTemplateRequest
+	9,  // 22: template.v1.TemplateService.DeleteTemplate:input_type -> template.v1.DeleteTemplateRequest
+	11, // 23: template.v1.TemplateService.ChangeTemplateStatus:input_type -> template.v1.ChangeTemplateStatusRequest
+	5,  // 24: template.v1.TemplateService.ListTemplates:output_type -> template.v1.ListTemplatesResponse
+	18, // 25: template.v1.TemplateService.GetTemplate:output_type -> template.v1.TemplateResponse
+	18, // 26: template.v1.TemplateService.CreateTemplate:output_type -> template.v1.TemplateResponse
+	18, // 27: template.v1.TemplateService.UpdateTemplate:output_type -> template.v1.TemplateResponse
+	10, // 28: template.v1.TemplateService.DeleteTemplate:output_type -> template.v1.DeleteTemplateResponse
+	18, // 29: template.v1.TemplateService.ChangeTemplateStatus:output_type -> template.v1.TemplateResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_proto_template_proto_init() }
+func file_proto_template_proto_init() {
+	if File_proto_template_proto != nil {
+		return
+	}
+	file_proto_template_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[7].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[8].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[10].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[12].OneofWrappers = []any{}
+	file_proto_template_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_template_proto_rawDesc), len(file_proto_template_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_template_proto_goTypes,
+		DependencyIndexes: file_proto_template_proto_depIdxs,
+		EnumInfos:         file_proto_template_proto_enumTypes,
+		MessageInfos:      file_proto_template_proto_msgTypes,
+	}.Build()
+	File_proto_template_proto = out.File
+	file_proto_template_proto_goTypes = nil
+	file_proto_template_proto_depIdxs = nil
+}
@@ -0,0 +1,118 @@
+package presenter
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+var noteStatuses = map[note.NoteStatus]notepb.NoteStatus{
+	note.StatusDraft:   notepb.NoteStatus_NOTE_STATUS_DRAFT,
+	note.StatusPublish: notepb.NoteStatus_NOTE_STATUS_PUBLISH,
+}
+
+// NotePresenter implements port.NoteOutputPort for gRPC.
+type NotePresenter struct {
+	mu      sync.RWMutex
+	note    *notepb.NoteResponse
+	notes   []*notepb.NoteResponse
+	deleted bool
+}
+
+var _ port.NoteOutputPort = (*NotePresenter)(nil)
+
+// NewNotePresenter creates a new gRPC note presenter.
+func NewNotePresenter() *NotePresenter {
+	return &NotePresenter{}
+}
+
+// PresentNoteList converts domain notes to gRPC responses and stores them.
+func (p *NotePresenter) PresentNoteList(_ context.Context, notes []note.WithMeta) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]*notepb.NoteResponse, 0, len(notes))
+	for _, n := range notes {
+		res = append(res, toNoteResponse(n))
+	}
+	p.notes = res
+	return nil
+}
+
+// PresentNote converts a domain note to a gRPC response and stores it.
+func (p *NotePresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.note = toNoteResponse(*n)
+	return nil
+}
+
+// PresentNoteDeleted marks delete success.
+func (p *NotePresenter) PresentNoteDeleted(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.deleted = true
+	return nil
+}
+
+// Note returns the stored note response.
+func (p *NotePresenter) Note() *notepb.NoteResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.note
+}
+
+// Notes returns the stored note list response.
+func (p *NotePresenter) Notes() *notepb.ListNotesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &notepb.ListNotesResponse{Notes: p.notes}
+}
+
+// DeleteResponse returns the deletion response.
+func (p *NotePresenter) DeleteResponse() *notepb.DeleteNoteResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &notepb.DeleteNoteResponse{Success: p.deleted}
+}
+
+func toNoteResponse(n note.WithMeta) *notepb.NoteResponse {
+	sections := make([]*notepb.Section, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		sections = append(sections, &notepb.Section{
+			Id:           s.Section.ID,
+			FieldId:      s.Section.FieldID,
+			FieldLabel:   s.FieldLabel,
+			Content:      s.Section.Content,
+			IsRequired:   s.IsRequired,
+			FieldType:    fieldTypes[s.FieldType],
+			FieldOptions: toFieldOptions(s.Options),
+		})
+	}
+	return &notepb.NoteResponse{
+		Id:              n.Note.ID,
+		Title:           n.Note.Title,
+		TemplateId:      n.Note.TemplateID,
+		TemplateName:    n.TemplateName,
+		TemplateVersion: int32(n.Note.TemplateVersion), //nolint:gosec
+		OwnerId:         n.Note.OwnerID,
+		Owner: &templatepb.AccountSummary{
+			Id:        n.Note.OwnerID,
+			FirstName: n.OwnerFirstName,
+			LastName:  n.OwnerLastName,
+			Thumbnail: n.OwnerThumbnail,
+		},
+		Status:    noteStatuses[n.Note.Status],
+		Sections:  sections,
+		CreatedAt: timestamppb.New(n.Note.CreatedAt),
+		UpdatedAt: timestamppb.New(n.Note.UpdatedAt),
+	}
+}
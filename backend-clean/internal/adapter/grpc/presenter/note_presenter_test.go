@@ -0,0 +1,106 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestNotePresenter_TableDriven(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		action     string
+		single     *note.WithMeta
+		list       []note.WithMeta
+		wantID     string
+		wantStatus notepb.NoteStatus
+		wantType   templatepb.FieldType
+		wantCount  int
+	}{
+		{
+			name:   "[Success] single note",
+			action: "single",
+			single: &note.WithMeta{
+				Note: note.Note{
+					ID:              "note-1",
+					Title:           "Hello",
+					TemplateID:      "tpl-1",
+					TemplateVersion: 2,
+					OwnerID:         "owner-1",
+					Status:          note.StatusPublish,
+					CreatedAt:       now,
+					UpdatedAt:       now,
+				},
+				TemplateName:   "Tpl",
+				OwnerFirstName: "Taro",
+				OwnerLastName:  "Yamada",
+				Sections: []note.SectionWithField{
+					{
+						Section:    note.Section{ID: "sec1", FieldID: "f1", Content: "2026-10-19"},
+						FieldLabel: "Date",
+						IsRequired: true,
+						FieldType:  template.FieldTypeDate,
+					},
+				},
+			},
+			wantID:     "note-1",
+			wantStatus: notepb.NoteStatus_NOTE_STATUS_PUBLISH,
+			wantType:   templatepb.FieldType_FIELD_TYPE_DATE,
+		},
+		{
+			name:      "[Success] list",
+			action:    "list",
+			list:      []note.WithMeta{{Note: note.Note{ID: "n1"}}, {Note: note.Note{ID: "n2"}}},
+			wantCount: 2,
+		},
+		{
+			name:   "[Success] deleted",
+			action: "delete",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewNotePresenter()
+			switch tt.action {
+			case "single":
+				if err := p.PresentNote(context.Background(), tt.single); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got := p.Note()
+				if got.GetId() != tt.wantID || got.GetStatus() != tt.wantStatus {
+					t.Fatalf("unexpected note: %+v", got)
+				}
+				if got.GetOwner().GetFirstName() != "Taro" || got.GetTemplateVersion() != 2 || !got.GetCreatedAt().AsTime().Equal(now) {
+					t.Fatalf("unexpected note metadata: %+v", got)
+				}
+				if len(got.GetSections()) != 1 || got.GetSections()[0].GetFieldType() != tt.wantType || !got.GetSections()[0].GetIsRequired() {
+					t.Fatalf("unexpected sections: %+v", got.GetSections())
+				}
+			case "list":
+				if err := p.PresentNoteList(context.Background(), tt.list); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(p.Notes().GetNotes()) != tt.wantCount {
+					t.Fatalf("expected %d notes, got %d", tt.wantCount, len(p.Notes().GetNotes()))
+				}
+			case "delete":
+				if p.DeleteResponse().GetSuccess() {
+					t.Fatalf("expected no success before delete")
+				}
+				if err := p.PresentNoteDeleted(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !p.DeleteResponse().GetSuccess() {
+					t.Fatalf("expected success after delete")
+				}
+			}
+		})
+	}
+}
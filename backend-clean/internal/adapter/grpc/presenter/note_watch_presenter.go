@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+var noteChangeKinds = map[event.Name]notepb.NoteChangeKind{
+	event.NoteCreated:     notepb.NoteChangeKind_NOTE_CHANGE_KIND_CREATED,
+	event.NoteUpdated:     notepb.NoteChangeKind_NOTE_CHANGE_KIND_UPDATED,
+	event.NotePublished:   notepb.NoteChangeKind_NOTE_CHANGE_KIND_PUBLISHED,
+	event.NoteUnpublished: notepb.NoteChangeKind_NOTE_CHANGE_KIND_UNPUBLISHED,
+	event.NoteDeleted:     notepb.NoteChangeKind_NOTE_CHANGE_KIND_DELETED,
+}
+
+// NoteWatchPresenter implements port.NoteWatchOutputPort by sending each message on a gRPC stream.
+// Send blocks while the client's flow-control window is full, which is what holds the use case back.
+type NoteWatchPresenter struct {
+	stream notepb.NoteService_WatchNotesServer
+}
+
+var _ port.NoteWatchOutputPort = (*NoteWatchPresenter)(nil)
+
+// NewNoteWatchPresenter creates a presenter writing to stream.
+func NewNoteWatchPresenter(stream notepb.NoteService_WatchNotesServer) *NoteWatchPresenter {
+	return &NoteWatchPresenter{stream: stream}
+}
+
+// PresentSnapshotNote sends one note of the snapshot.
+func (p *NoteWatchPresenter) PresentSnapshotNote(_ context.Context, n note.WithMeta) error {
+	return p.stream.Send(&notepb.WatchNotesResponse{
+		Message: &notepb.WatchNotesResponse_SnapshotNote{SnapshotNote: toNoteResponse(n)},
+	})
+}
+
+// PresentSnapshotComplete sends the end of the snapshot.
+func (p *NoteWatchPresenter) PresentSnapshotComplete(_ context.Context, resumeToken string) error {
+	return p.stream.Send(&notepb.WatchNotesResponse{
+		ResumeToken: resumeToken,
+		Message:     &notepb.WatchNotesResponse_SnapshotComplete{SnapshotComplete: &notepb.SnapshotComplete{}},
+	})
+}
+
+// PresentNoteChange sends a change with the current note.
+func (p *NoteWatchPresenter) PresentNoteChange(_ context.Context, env event.Envelope, current *note.WithMeta) error {
+	change := toNoteChange(env, noteChangeKinds[env.Event.Name])
+	if current != nil {
+		change.Note = toNoteResponse(*current)
+	}
+	return p.sendChange(env, change)
+}
+
+// PresentNoteRemoved sends a tombstone without the note.
+func (p *NoteWatchPresenter) PresentNoteRemoved(_ context.Context, env event.Envelope) error {
+	return p.sendChange(env, toNoteChange(env, notepb.NoteChangeKind_NOTE_CHANGE_KIND_REMOVED))
+}
+
+func (p *NoteWatchPresenter) sendChange(env event.Envelope, change *notepb.NoteChange) error {
+	return p.stream.Send(&notepb.WatchNotesResponse{
+		ResumeToken: env.ID,
+		Message:     &notepb.WatchNotesResponse_Change{Change: change},
+	})
+}
+
+func toNoteChange(env event.Envelope, kind notepb.NoteChangeKind) *notepb.NoteChange {
+	return &notepb.NoteChange{
+		Kind:       kind,
+		NoteId:     env.Event.AggregateID,
+		ActorId:    env.Event.ActorID,
+		OccurredAt: timestamppb.New(env.Event.OccurredAt),
+	}
+}
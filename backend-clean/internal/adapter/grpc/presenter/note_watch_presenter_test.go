@@ -0,0 +1,74 @@
+package presenter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+type watchStream struct {
+	grpc.ServerStream
+	sent []*notepb.WatchNotesResponse
+	err  error
+}
+
+func (s *watchStream) Send(res *notepb.WatchNotesResponse) error {
+	s.sent = append(s.sent, res)
+	return s.err
+}
+
+func TestNoteWatchPresenter(t *testing.T) {
+	n := note.WithMeta{Note: note.Note{ID: "note-1", Status: note.StatusDraft}}
+	deleted := event.Envelope{ID: "s-2", Event: event.Event{Name: event.NoteDeleted, AggregateID: "note-1", ActorID: "owner-1"}}
+	published := event.Envelope{ID: "s-3", Event: event.Event{Name: event.NotePublished, AggregateID: "note-1"}}
+	transferred := event.Envelope{ID: "s-4", Event: event.Event{Name: event.NoteUpdated, AggregateID: "note-1", ActorID: "owner-1"}}
+
+	stream := &watchStream{}
+	p := NewNoteWatchPresenter(stream)
+	ctx := context.Background()
+	if err := p.PresentSnapshotNote(ctx, n); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.PresentSnapshotComplete(ctx, "s-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.PresentNoteChange(ctx, published, &n); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.PresentNoteChange(ctx, deleted, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.PresentNoteRemoved(ctx, transferred); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stream.sent) != 5 {
+		t.Fatalf("sent %d messages, want 5", len(stream.sent))
+	}
+	if got := stream.sent[0].GetSnapshotNote(); got.GetId() != "note-1" || got.GetStatus() != notepb.NoteStatus_NOTE_STATUS_DRAFT || stream.sent[0].GetResumeToken() != "" {
+		t.Fatalf("unexpected snapshot note: %v", stream.sent[0])
+	}
+	if stream.sent[1].GetSnapshotComplete() == nil || stream.sent[1].GetResumeToken() != "s-1" {
+		t.Fatalf("unexpected snapshot complete: %v", stream.sent[1])
+	}
+	if c := stream.sent[2].GetChange(); c.GetKind() != notepb.NoteChangeKind_NOTE_CHANGE_KIND_PUBLISHED || c.GetNote().GetId() != "note-1" || stream.sent[2].GetResumeToken() != "s-3" {
+		t.Fatalf("unexpected change: %v", stream.sent[2])
+	}
+	if c := stream.sent[3].GetChange(); c.GetKind() != notepb.NoteChangeKind_NOTE_CHANGE_KIND_DELETED || c.GetNote() != nil || c.GetActorId() != "owner-1" {
+		t.Fatalf("unexpected delete: %v", stream.sent[3])
+	}
+	if c := stream.sent[4].GetChange(); c.GetKind() != notepb.NoteChangeKind_NOTE_CHANGE_KIND_REMOVED || c.GetNote() != nil || stream.sent[4].GetResumeToken() != "s-4" {
+		t.Fatalf("unexpected removal: %v", stream.sent[4])
+	}
+
+	stream.err = errors.New("stream closed")
+	if err := p.PresentSnapshotComplete(ctx, "s-5"); !errors.Is(err, stream.err) {
+		t.Fatalf("want send error, got %v", err)
+	}
+}
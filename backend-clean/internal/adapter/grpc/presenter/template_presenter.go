@@ -0,0 +1,209 @@
+package presenter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+var visibilities = map[template.Visibility]templatepb.TemplateVisibility{
+	template.VisibilityPrivate:  templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PRIVATE,
+	template.VisibilityUnlisted: templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_UNLISTED,
+	template.VisibilityPublic:   templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_PUBLIC,
+}
+
+var fieldTypes = map[template.FieldType]templatepb.FieldType{
+	template.FieldTypeText:      templatepb.FieldType_FIELD_TYPE_TEXT,
+	template.FieldTypeMarkdown:  templatepb.FieldType_FIELD_TYPE_MARKDOWN,
+	template.FieldTypeURL:       templatepb.FieldType_FIELD_TYPE_URL,
+	template.FieldTypeNumber:    templatepb.FieldType_FIELD_TYPE_NUMBER,
+	template.FieldTypeDate:      templatepb.FieldType_FIELD_TYPE_DATE,
+	template.FieldTypeSelect:    templatepb.FieldType_FIELD_TYPE_SELECT,
+	template.FieldTypeChecklist: templatepb.FieldType_FIELD_TYPE_CHECKLIST,
+}
+
+var fieldChangeKinds = map[template.FieldChangeKind]templatepb.FieldChangeKind{
+	template.FieldAdded:     templatepb.FieldChangeKind_FIELD_CHANGE_KIND_ADDED,
+	template.FieldRemoved:   templatepb.FieldChangeKind_FIELD_CHANGE_KIND_REMOVED,
+	template.FieldRenamed:   templatepb.FieldChangeKind_FIELD_CHANGE_KIND_RENAMED,
+	template.FieldReordered: templatepb.FieldChangeKind_FIELD_CHANGE_KIND_REORDERED,
+	template.FieldModified:  templatepb.FieldChangeKind_FIELD_CHANGE_KIND_MODIFIED,
+}
+
+// TemplatePresenter implements port.TemplateOutputPort for gRPC.
+type TemplatePresenter struct {
+	mu       sync.RWMutex
+	template *templatepb.TemplateResponse
+	changes  *templatepb.TemplateChangeReport
+	list     []*templatepb.TemplateResponse
+	deleted  bool
+}
+
+var _ port.TemplateOutputPort = (*TemplatePresenter)(nil)
+
+// NewTemplatePresenter creates a new gRPC template presenter.
+func NewTemplatePresenter() *TemplatePresenter {
+	return &TemplatePresenter{}
+}
+
+// PresentTemplateList converts domain templates to gRPC responses and stores them.
+func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates []template.WithUsage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]*templatepb.TemplateResponse, 0, len(templates))
+	for _, t := range templates {
+		res = append(res, toTemplateResponse(t))
+	}
+	p.list = res
+	return nil
+}
+
+// PresentTemplate converts a domain template to a gRPC response and stores it.
+func (p *TemplatePresenter) PresentTemplate(_ context.Context, tpl *template.WithUsage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.template = toTemplateResponse(*tpl)
+	return nil
+}
+
+// PresentTemplateDeleted marks delete success.
+func (p *TemplatePresenter) PresentTemplateDeleted(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.deleted = true
+	return nil
+}
+
+// PresentTemplateChanges stores the change report of a template update.
+func (p *TemplatePresenter) PresentTemplateChanges(_ context.Context, report template.ChangeReport) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fields := make([]*templatepb.FieldChange, 0, len(report.Fields))
+	for _, c := range report.Fields {
+		kinds := make([]templatepb.FieldChangeKind, 0, len(c.Kinds))
+		for _, k := range c.Kinds {
+			kinds = append(kinds, fieldChangeKinds[k])
+		}
+		fields = append(fields, &templatepb.FieldChange{
+			FieldId:         emptyToNil(c.FieldID),
+			PreviousFieldId: emptyToNil(c.PreviousFieldID),
+			Label:           c.Label,
+			PreviousLabel:   emptyToNil(c.PreviousLabel),
+			Kinds:           kinds,
+		})
+	}
+	p.changes = &templatepb.TemplateChangeReport{
+		PreviousVersion: int32(report.PreviousVersion), //nolint:gosec
+		Version:         int32(report.Version),         //nolint:gosec
+		Fields:          fields,
+	}
+	return nil
+}
+
+// Template returns the stored template response, including the change report after an update.
+func (p *TemplatePresenter) Template() *templatepb.TemplateResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.template != nil && p.changes != nil {
+		p.template.Changes = p.changes
+	}
+	return p.template
+}
+
+// Templates returns the stored template list response.
+func (p *TemplatePresenter) Templates() *templatepb.ListTemplatesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &templatepb.ListTemplatesResponse{Templates: p.list}
+}
+
+// DeleteResponse returns the deletion response.
+func (p *TemplatePresenter) DeleteResponse() *templatepb.DeleteTemplateResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &templatepb.DeleteTemplateResponse{Success: p.deleted}
+}
+
+func toTemplateResponse(t template.WithUsage) *templatepb.TemplateResponse {
+	fields := make([]*templatepb.Field, 0, len(t.Template.Fields))
+	for _, f := range t.Template.Fields {
+		fields = append(fields, &templatepb.Field{
+			Id:          f.ID,
+			Label:       f.Label,
+			Order:       int32(f.Order), //nolint:gosec
+			IsRequired:  f.IsRequired,
+			Type:        fieldTypes[f.Type],
+			Options:     toFieldOptions(f.Options),
+			MinLength:   toInt32Ptr(f.MinLength),
+			MaxLength:   toInt32Ptr(f.MaxLength),
+			Pattern:     emptyToNil(f.Pattern),
+			Placeholder: emptyToNil(f.Placeholder),
+			HelpText:    emptyToNil(f.HelpText),
+		})
+	}
+	return &templatepb.TemplateResponse{
+		Id:      t.Template.ID,
+		Name:    t.Template.Name,
+		OwnerId: t.Template.OwnerID,
+		Owner: &templatepb.AccountSummary{
+			Id:        t.Owner.ID,
+			FirstName: t.Owner.FirstName,
+			LastName:  t.Owner.LastName,
+			Thumbnail: t.Owner.Thumbnail,
+		},
+		Visibility: visibilities[t.Template.Visibility],
+		Version:    int32(t.Template.Version), //nolint:gosec
+		Fields:     fields,
+		IsUsed:     t.IsUsed,
+		Usage: &templatepb.TemplateUsage{
+			NoteCount:      int32(t.Usage.NoteCount),      //nolint:gosec
+			PublishedCount: int32(t.Usage.PublishedCount), //nolint:gosec
+			AuthorCount:    int32(t.Usage.AuthorCount),    //nolint:gosec
+			LastUsedAt:     toTimestamp(t.Usage.LastUsedAt),
+		},
+		ForkedFromId:        emptyToNil(t.Template.ForkedFromID),
+		ForkCount:           int32(t.ForkCount), //nolint:gosec
+		Deprecated:          t.Template.IsDeprecated(),
+		DeprecatedAt:        toTimestamp(t.Template.DeprecatedAt),
+		SuccessorTemplateId: emptyToNil(t.Template.SuccessorID),
+		MatchedFieldIds:     t.MatchedFieldIDs,
+		UpdatedAt:           timestamppb.New(t.Template.UpdatedAt),
+	}
+}
+
+func toFieldOptions(o template.FieldOptions) *templatepb.FieldOptions {
+	return &templatepb.FieldOptions{Min: o.Min, Max: o.Max, Choices: o.Choices}
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v) //nolint:gosec
+	return &i
+}
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,121 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestTemplatePresenter_TableDriven(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	maxLen := 20
+	tests := []struct {
+		name    string
+		tpl     template.WithUsage
+		changes *template.ChangeReport
+		check   func(t *testing.T, got *templatepb.TemplateResponse)
+	}{
+		{
+			name: "[Success] template with fields and usage",
+			tpl: template.WithUsage{
+				Template: template.Template{
+					ID:         "tpl-1",
+					Name:       "Daily",
+					OwnerID:    "owner-1",
+					Version:    3,
+					Visibility: template.VisibilityUnlisted,
+					Fields: []template.Field{
+						{ID: "f1", Label: "Mood", Order: 1, Type: template.FieldTypeSelect, Options: template.FieldOptions{Choices: []string{"good", "bad"}}},
+						{ID: "f2", Label: "Memo", Order: 2, Type: template.FieldTypeText, MaxLength: &maxLen},
+					},
+					UpdatedAt: now,
+				},
+				IsUsed: true,
+				Usage:  template.Usage{NoteCount: 4, PublishedCount: 1, AuthorCount: 2, LastUsedAt: &now},
+				Owner:  template.Owner{ID: "owner-1", FirstName: "Taro"},
+			},
+			check: func(t *testing.T, got *templatepb.TemplateResponse) {
+				if got.GetVisibility() != templatepb.TemplateVisibility_TEMPLATE_VISIBILITY_UNLISTED || got.GetVersion() != 3 {
+					t.Fatalf("unexpected template: %+v", got)
+				}
+				if got.GetFields()[0].GetType() != templatepb.FieldType_FIELD_TYPE_SELECT || len(got.GetFields()[0].GetOptions().GetChoices()) != 2 {
+					t.Fatalf("unexpected select field: %+v", got.GetFields()[0])
+				}
+				if got.GetFields()[1].MaxLength == nil || got.GetFields()[1].GetMaxLength() != 20 || got.GetFields()[1].MinLength != nil {
+					t.Fatalf("unexpected text field: %+v", got.GetFields()[1])
+				}
+				if got.GetUsage().GetNoteCount() != 4 || !got.GetUsage().GetLastUsedAt().AsTime().Equal(now) {
+					t.Fatalf("unexpected usage: %+v", got.GetUsage())
+				}
+				if got.GetDeprecated() || got.DeprecatedAt != nil || got.SuccessorTemplateId != nil || got.Changes != nil {
+					t.Fatalf("unexpected deprecation or changes: %+v", got)
+				}
+			},
+		},
+		{
+			name: "[Success] deprecated template with successor",
+			tpl: template.WithUsage{
+				Template: template.Template{ID: "tpl-1", DeprecatedAt: &now, SuccessorID: "tpl-2"},
+			},
+			check: func(t *testing.T, got *templatepb.TemplateResponse) {
+				if !got.GetDeprecated() || got.GetSuccessorTemplateId() != "tpl-2" || !got.GetDeprecatedAt().AsTime().Equal(now) {
+					t.Fatalf("unexpected deprecation: %+v", got)
+				}
+			},
+		},
+		{
+			name: "[Success] update includes change report",
+			tpl:  template.WithUsage{Template: template.Template{ID: "tpl-1", Version: 2}},
+			changes: &template.ChangeReport{PreviousVersion: 1, Version: 2, Fields: []template.FieldChange{
+				{FieldID: "f2", PreviousFieldID: "f1", Label: "Memo", PreviousLabel: "Note", Kinds: []template.FieldChangeKind{template.FieldRenamed}},
+			}},
+			check: func(t *testing.T, got *templatepb.TemplateResponse) {
+				c := got.GetChanges()
+				if c.GetPreviousVersion() != 1 || len(c.GetFields()) != 1 {
+					t.Fatalf("unexpected changes: %+v", c)
+				}
+				if c.GetFields()[0].GetPreviousLabel() != "Note" || c.GetFields()[0].GetKinds()[0] != templatepb.FieldChangeKind_FIELD_CHANGE_KIND_RENAMED {
+					t.Fatalf("unexpected field change: %+v", c.GetFields()[0])
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewTemplatePresenter()
+			if err := p.PresentTemplate(context.Background(), &tt.tpl); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.changes != nil {
+				if err := p.PresentTemplateChanges(context.Background(), *tt.changes); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			tt.check(t, p.Template())
+		})
+	}
+}
+
+func TestTemplatePresenter_ListAndDelete(t *testing.T) {
+	p := NewTemplatePresenter()
+	if err := p.PresentTemplateList(context.Background(), []template.WithUsage{
+		{Template: template.Template{ID: "tpl-1"}, MatchedFieldIDs: []string{"f1"}},
+		{Template: template.Template{ID: "tpl-2"}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list := p.Templates().GetTemplates()
+	if len(list) != 2 || list[0].GetMatchedFieldIds()[0] != "f1" {
+		t.Fatalf("unexpected list: %+v", list)
+	}
+	if err := p.PresentTemplateDeleted(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !p.DeleteResponse().GetSuccess() {
+		t.Fatalf("expected success after delete")
+	}
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// multipartOverhead allows room for form boundaries and fields on top of the file size limit.
+const multipartOverhead = 1 << 20
+
+// AttachmentController handles note attachment HTTP endpoints.
+type AttachmentController struct {
+	inputFactory          func(attachmentRepo port.AttachmentRepository, noteRepo port.NoteRepository, blobs port.BlobStore, output port.AttachmentOutputPort) port.AttachmentInputPort
+	outputFactory         func() *presenter.AttachmentPresenter
+	attachmentRepoFactory func() port.AttachmentRepository
+	noteRepoFactory       func() port.NoteRepository
+	blobFactory           func() port.BlobStore
+}
+
+// NewAttachmentController creates AttachmentController.
+func NewAttachmentController(
+	inputFactory func(attachmentRepo port.AttachmentRepository, noteRepo port.NoteRepository, blobs port.BlobStore, output port.AttachmentOutputPort) port.AttachmentInputPort,
+	outputFactory func() *presenter.AttachmentPresenter,
+	attachmentRepoFactory func() port.AttachmentRepository,
+	noteRepoFactory func() port.NoteRepository,
+	blobFactory func() port.BlobStore,
+) *AttachmentController {
+	return &AttachmentController{
+		inputFactory:          inputFactory,
+		outputFactory:         outputFactory,
+		attachmentRepoFactory: attachmentRepoFactory,
+		noteRepoFactory:       noteRepoFactory,
+		blobFactory:           blobFactory,
+	}
+}
+
+// List handles GET /notes/:noteId/attachments.
+func (c *AttachmentController) List(ctx echo.Context, noteID string, params openapi.AttachmentsListAttachmentsParams) error {
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), noteID, valueOrEmpty(params.ViewerId)); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Attachments())
+}
+
+// Upload handles POST /notes/:noteId/attachments.
+func (c *AttachmentController) Upload(ctx echo.Context, noteID string, params openapi.AttachmentsUploadAttachmentParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, attachment.MaxSize+multipartOverhead)
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	defer func() { _ = file.Close() }()
+	contentType, content, err := sniffContentType(file)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	var sectionID *string
+	if v := strings.TrimSpace(ctx.FormValue("sectionId")); v != "" {
+		sectionID = &v
+	}
+
+	input, p := c.newIO()
+	err = input.Upload(req.Context(), port.AttachmentUploadInput{
+		NoteID:      noteID,
+		SectionID:   sectionID,
+		OwnerID:     ownerID,
+		FileName:    filepath.Base(fh.Filename),
+		ContentType: contentType,
+		Size:        fh.Size,
+		Content:     content,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Attachment())
+}
+
+// Download handles GET /notes/:noteId/attachments/:attachmentId.
+func (c *AttachmentController) Download(ctx echo.Context, noteID, attachmentID string, params openapi.AttachmentsDownloadAttachmentParams) error {
+	input, p := c.newIO()
+	if err := input.Download(ctx.Request().Context(), noteID, attachmentID, valueOrEmpty(params.ViewerId)); err != nil {
+		return handleError(ctx, err)
+	}
+	a, content := p.Content()
+	defer func() { _ = content.Close() }()
+	// images render inline in notes; everything else is downloaded
+	disposition := "attachment"
+	if strings.HasPrefix(a.ContentType, "image/") {
+		disposition = "inline"
+	}
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": a.FileName}))
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	return ctx.Stream(http.StatusOK, a.ContentType, content)
+}
+
+// Delete handles DELETE /notes/:noteId/attachments/:attachmentId.
+func (c *AttachmentController) Delete(ctx echo.Context, noteID, attachmentID string, params openapi.AttachmentsDeleteAttachmentParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), noteID, attachmentID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+func (c *AttachmentController) newIO() (port.AttachmentInputPort, *presenter.AttachmentPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.attachmentRepoFactory(), c.noteRepoFactory(), c.blobFactory(), output)
+	return input, output
+}
+
+// sniffContentType detects the content type from the leading bytes instead of trusting the client.
+func sniffContentType(r io.Reader) (string, io.Reader, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", nil, err
+	}
+	head = head[:n]
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), r), nil
+}
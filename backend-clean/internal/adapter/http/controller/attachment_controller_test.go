@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newAttachmentController(input *ctrlmock.AttachmentInputStub) *AttachmentController {
+	p := presenter.NewAttachmentPresenter()
+	return NewAttachmentController(
+		func(attachmentRepo port.AttachmentRepository, noteRepo port.NoteRepository, blobs port.BlobStore, output port.AttachmentOutputPort) port.AttachmentInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.AttachmentPresenter { return p },
+		func() port.AttachmentRepository { return nil },
+		func() port.NoteRepository { return nil },
+		func() port.BlobStore { return nil },
+	)
+}
+
+func multipartBody(t *testing.T, fileName string, content []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	if fileName != "" {
+		part, err := w.CreateFormFile("file", fileName)
+		if err != nil {
+			t.Fatalf("create form file: %v", err)
+		}
+		_, _ = part.Write(content)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close writer: %v", err)
+	}
+	return body, w.FormDataContentType()
+}
+
+func TestAttachmentController_Upload(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	tests := []struct {
+		name            string
+		ownerID         string
+		fileName        string
+		inErr           error
+		wantStatus      int
+		wantContentType string
+		wantFileName    string
+	}{
+		{
+			name:            "[Success] upload sniffs content type",
+			ownerID:         "owner",
+			fileName:        "../shot.png",
+			wantStatus:      http.StatusOK,
+			wantContentType: "image/png",
+			wantFileName:    "shot.png",
+		},
+		{name: "[Fail] owner missing", ownerID: "", fileName: "shot.png", wantStatus: http.StatusForbidden},
+		{name: "[Fail] file missing", ownerID: "owner", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] too large", ownerID: "owner", fileName: "shot.png", inErr: domainerr.ErrAttachmentTooLarge, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] note not found", ownerID: "owner", fileName: "shot.png", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.AttachmentInputStub{Err: tt.inErr}
+			ctrl := newAttachmentController(input)
+
+			body, contentType := multipartBody(t, tt.fileName, png)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/attachments", body)
+			req.Header.Set(echo.HeaderContentType, contentType)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Upload(c, "n1", openapi.AttachmentsUploadAttachmentParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantContentType != "" {
+				if input.Uploaded == nil {
+					t.Fatal("upload not called")
+				}
+				if input.Uploaded.ContentType != tt.wantContentType {
+					t.Fatalf("content type = %q, want %q", input.Uploaded.ContentType, tt.wantContentType)
+				}
+				if input.Uploaded.FileName != tt.wantFileName {
+					t.Fatalf("file name = %q, want %q", input.Uploaded.FileName, tt.wantFileName)
+				}
+			}
+		})
+	}
+}
+
+func TestAttachmentController_Download(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] download attachment", wantStatus: http.StatusOK},
+		{name: "[Fail] draft not visible", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.AttachmentInputStub{Err: tt.inErr, Content: "%PDF-1.4"}
+			ctrl := newAttachmentController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/notes/n1/attachments/a1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Download(c, "n1", "a1", openapi.AttachmentsDownloadAttachmentParams{})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := rec.Body.String(); got != "%PDF-1.4" {
+				t.Fatalf("body = %q", got)
+			}
+			if got := rec.Header().Get(echo.HeaderContentDisposition); got != `attachment; filename=report.pdf` {
+				t.Fatalf("content disposition = %q", got)
+			}
+			if got := rec.Header().Get(echo.HeaderXContentTypeOptions); got != "nosniff" {
+				t.Fatalf("nosniff header = %q", got)
+			}
+		})
+	}
+}
+
+func TestAttachmentController_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] delete attachment", ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] not owner", ownerID: "other", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newAttachmentController(&ctrlmock.AttachmentInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/notes/n1/attachments/a1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Delete(c, "n1", "a1", openapi.AttachmentsDeleteAttachmentParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
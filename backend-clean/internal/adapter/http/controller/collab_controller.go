@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/websocket"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CollabController handles live editing sessions over WebSocket.
+type CollabController struct {
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, hub port.CollabHub, output port.CollabOutputPort) port.CollabInputPort
+	outputFactory   func(w io.Writer) *presenter.CollabPresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+	txFactory       func() port.TxManager
+	hubFactory      func() port.CollabHub
+}
+
+// NewCollabController creates CollabController.
+func NewCollabController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, hub port.CollabHub, output port.CollabOutputPort) port.CollabInputPort,
+	outputFactory func(w io.Writer) *presenter.CollabPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+	hubFactory func() port.CollabHub,
+) *CollabController {
+	return &CollabController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		tplRepoFactory:  tplRepoFactory,
+		txFactory:       txFactory,
+		hubFactory:      hubFactory,
+	}
+}
+
+// Collaborate handles GET /notes/:noteId/collab. The connection is upgraded before the session
+// starts, so a refused join is reported as an error message before the socket closes.
+func (c *CollabController) Collaborate(ctx echo.Context, noteID string, params openapi.NotesCollaborateOnNoteParams) error {
+	websocket.Server{Handler: func(ws *websocket.Conn) {
+		sessionCtx, cancel := context.WithCancel(ctx.Request().Context())
+		defer cancel()
+		commands := make(chan port.CollabCommand)
+		go readCollabCommands(sessionCtx, ws, commands)
+
+		output := c.outputFactory(ws)
+		input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), c.hubFactory(), output)
+		if err := input.Collaborate(sessionCtx, port.CollabInput{
+			NoteID:    noteID,
+			AccountID: params.AccountId,
+			Commands:  commands,
+		}); err != nil {
+			// the socket may already be gone, in which case there is nobody left to tell
+			_ = output.PresentCollabError(sessionCtx, err)
+		}
+	}}.ServeHTTP(ctx.Response(), ctx.Request())
+	return nil
+}
+
+// readCollabCommands decodes frames until the socket closes, then closes commands.
+// A frame that is not a command is passed on empty so the session reports it.
+func readCollabCommands(ctx context.Context, ws *websocket.Conn, commands chan<- port.CollabCommand) {
+	defer close(commands)
+	for {
+		var frame []byte
+		if err := websocket.Message.Receive(ws, &frame); err != nil {
+			return
+		}
+		var msg openapi.ModelsCollabCommand
+		cmd := port.CollabCommand{}
+		if json.Unmarshal(frame, &msg) == nil {
+			cmd = toCollabCommand(msg)
+		}
+		select {
+		case commands <- cmd:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func toCollabCommand(msg openapi.ModelsCollabCommand) port.CollabCommand {
+	cmd := port.CollabCommand{
+		Type:      port.CollabCommandType(msg.Type),
+		SectionID: valueOrEmpty(msg.SectionId),
+		Content:   valueOrEmpty(msg.Content),
+		Title:     valueOrEmpty(msg.Title),
+	}
+	if msg.Sections != nil {
+		for _, s := range *msg.Sections {
+			cmd.Sections = append(cmd.Sections, port.SectionUpdateInput{SectionID: s.Id, Content: s.Content})
+		}
+	}
+	return cmd
+}
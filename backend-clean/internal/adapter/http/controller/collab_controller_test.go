@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/websocket"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newCollabServer(t *testing.T, input *ctrlmock.CollabInputStub) *httptest.Server {
+	t.Helper()
+	ctrl := NewCollabController(
+		func(_ port.NoteRepository, _ port.TemplateRepository, _ port.TxManager, _ port.CollabHub, output port.CollabOutputPort) port.CollabInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewCollabPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+		func() port.CollabHub { return nil },
+	)
+	e := echo.New()
+	e.GET("/api/notes/:noteId/collab", func(c echo.Context) error {
+		return ctrl.Collaborate(c, c.Param("noteId"), openapi.NotesCollaborateOnNoteParams{AccountId: c.QueryParam("accountId")})
+	})
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func dialCollab(t *testing.T, srv *httptest.Server) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/notes/note-1/collab?accountId=owner-1"
+	ws, err := websocket.Dial(url, "", srv.URL)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	return ws
+}
+
+func receiveCollab(t *testing.T, ws *websocket.Conn) openapi.ModelsCollabMessage {
+	t.Helper()
+	var msg openapi.ModelsCollabMessage
+	if err := websocket.JSON.Receive(ws, &msg); err != nil {
+		t.Fatalf("receive: %v", err)
+	}
+	return msg
+}
+
+func TestCollabController_Collaborate(t *testing.T) {
+	tests := []struct {
+		name   string
+		frames []string
+		want   []port.CollabCommand
+	}{
+		{
+			name: "[Success] commands are decoded",
+			frames: []string{
+				`{"type":"lock","sectionId":"s1"}`,
+				`{"type":"save","title":"ADR","sections":[{"id":"s1","content":"done"}]}`,
+			},
+			want: []port.CollabCommand{
+				{Type: port.CollabLock, SectionID: "s1"},
+				{Type: port.CollabSave, Title: "ADR", Sections: []port.SectionUpdateInput{{SectionID: "s1", Content: "done"}}},
+			},
+		},
+		{
+			name:   "[Success] malformed frame is passed on empty",
+			frames: []string{`not json`},
+			want:   []port.CollabCommand{{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.CollabInputStub{}
+			ws := dialCollab(t, newCollabServer(t, input))
+			for _, f := range tt.frames {
+				if err := websocket.Message.Send(ws, f); err != nil {
+					t.Fatalf("send: %v", err)
+				}
+				if msg := receiveCollab(t, ws); msg.Type != openapi.ModelsCollabMessageTypeChanged {
+					t.Fatalf("unexpected message: %+v", msg)
+				}
+			}
+			_ = ws.Close()
+
+			if input.Input.NoteID != "note-1" || input.Input.AccountID != "owner-1" {
+				t.Fatalf("unexpected input: %+v", input.Input)
+			}
+			got, _ := json.Marshal(input.Commands)
+			want, _ := json.Marshal(tt.want)
+			if string(got) != string(want) {
+				t.Fatalf("want commands %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestCollabController_RefusedJoin(t *testing.T) {
+	input := &ctrlmock.CollabInputStub{Err: domainerr.ErrUnauthorized}
+	ws := dialCollab(t, newCollabServer(t, input))
+	defer ws.Close()
+
+	msg := receiveCollab(t, ws)
+	if msg.Type != openapi.ModelsCollabMessageTypeError || msg.Error == nil || *msg.Error != domainerr.ErrUnauthorized.Error() {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+	var frame string
+	if err := websocket.Message.Receive(ws, &frame); err == nil {
+		t.Fatalf("expected the socket to close, got %q", frame)
+	}
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DigestController handles digest email settings HTTP endpoints.
+type DigestController struct {
+	inputFactory  func(repo port.DigestRepository, output port.DigestOutputPort) port.DigestInputPort
+	outputFactory func() *presenter.DigestPresenter
+	repoFactory   func() port.DigestRepository
+}
+
+// NewDigestController creates DigestController.
+func NewDigestController(
+	inputFactory func(repo port.DigestRepository, output port.DigestOutputPort) port.DigestInputPort,
+	outputFactory func() *presenter.DigestPresenter,
+	repoFactory func() port.DigestRepository,
+) *DigestController {
+	return &DigestController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+	}
+}
+
+// GetSettings handles GET /digests/settings.
+func (c *DigestController) GetSettings(ctx echo.Context, params openapi.DigestsGetDigestSettingsParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.GetSettings(ctx.Request().Context(), accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Settings())
+}
+
+// UpdateSettings handles PUT /digests/settings.
+func (c *DigestController) UpdateSettings(ctx echo.Context, params openapi.DigestsUpdateDigestSettingsParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	var body openapi.ModelsUpdateDigestSettingsRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	input, p := c.newIO()
+	err := input.UpdateSettings(ctx.Request().Context(), port.DigestSettingsInput{AccountID: accountID, Frequency: digest.Frequency(body.Frequency)})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Settings())
+}
+
+// ConfirmUnsubscribe handles GET /digests/unsubscribe, the link in digest bodies. It only shows
+// a page whose button posts the token to Unsubscribe.
+func (c *DigestController) ConfirmUnsubscribe(ctx echo.Context, params openapi.DigestsConfirmUnsubscribeDigestParams) error {
+	action := ctx.Request().URL.Path + "?" + url.Values{"token": {params.Token}}.Encode()
+	page, err := presenter.RenderUnsubscribePage(action)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.HTMLBlob(http.StatusOK, page)
+}
+
+// Unsubscribe handles POST /digests/unsubscribe. The signed token stands in for a session;
+// it is a POST so that link scanners fetching the URL do not unsubscribe anyone.
+func (c *DigestController) Unsubscribe(ctx echo.Context, params openapi.DigestsUnsubscribeDigestParams) error {
+	input, p := c.newIO()
+	if err := input.Unsubscribe(ctx.Request().Context(), params.Token); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Settings())
+}
+
+func (c *DigestController) newIO() (port.DigestInputPort, *presenter.DigestPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newDigestController(input *ctrlmock.DigestInputStub) *DigestController {
+	p := presenter.NewDigestPresenter()
+	return NewDigestController(
+		func(repo port.DigestRepository, output port.DigestOutputPort) port.DigestInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.DigestPresenter { return p },
+		func() port.DigestRepository { return nil },
+	)
+}
+
+func TestDigestController_UpdateSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		body       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] daily", accountID: "acc-1", body: `{"frequency":"daily"}`, wantStatus: http.StatusOK},
+		{name: "[Fail] account missing", accountID: "", body: `{"frequency":"daily"}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid body", accountID: "acc-1", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] unknown frequency", accountID: "acc-1", body: `{"frequency":"hourly"}`, inErr: domainerr.ErrInvalidDigestFrequency, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.DigestInputStub{Err: tt.inErr}
+			ctrl := newDigestController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/digests/settings", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.UpdateSettings(c, openapi.DigestsUpdateDigestSettingsParams{AccountId: tt.accountID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp openapi.ModelsDigestSettingsResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp.Frequency != openapi.ModelsDigestFrequencyDaily || input.Settings.AccountID != "acc-1" {
+				t.Fatalf("unexpected response: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestDigestController_Unsubscribe(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] unsubscribed", wantStatus: http.StatusOK},
+		{name: "[Fail] invalid token", inErr: domainerr.ErrInvalidUnsubscribeToken, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.DigestInputStub{Err: tt.inErr}
+			ctrl := newDigestController(input)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/api/digests/unsubscribe?token=abc", strings.NewReader("List-Unsubscribe=One-Click")), rec)
+
+			_ = ctrl.Unsubscribe(c, openapi.DigestsUnsubscribeDigestParams{Token: "abc"})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if input.Token != "abc" {
+				t.Fatalf("token = %q", input.Token)
+			}
+			if tt.wantStatus == http.StatusOK && !strings.Contains(rec.Body.String(), `"frequency":"off"`) {
+				t.Fatalf("unexpected response: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestDigestController_ConfirmUnsubscribe(t *testing.T) {
+	input := &ctrlmock.DigestInputStub{}
+	ctrl := newDigestController(input)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/digests/unsubscribe?token=a%2Bb", nil), rec)
+
+	_ = ctrl.ConfirmUnsubscribe(c, openapi.DigestsConfirmUnsubscribeDigestParams{Token: "a+b"})
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), echo.MIMETextHTML) {
+		t.Fatalf("status = %d, content type = %q", rec.Code, rec.Header().Get(echo.HeaderContentType))
+	}
+	if !strings.Contains(rec.Body.String(), `method="post" action="/api/digests/unsubscribe?token=a%2Bb"`) {
+		t.Fatalf("page does not post the token: %s", rec.Body.String())
+	}
+	if input.Token != "" {
+		t.Fatal("opening the page must not unsubscribe")
+	}
+}
+
+func TestDigestController_GetSettings(t *testing.T) {
+	ctrl := newDigestController(&ctrlmock.DigestInputStub{})
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/digests/settings?accountId=acc-1", nil), rec)
+
+	_ = ctrl.GetSettings(c, openapi.DigestsGetDigestSettingsParams{AccountId: "acc-1"})
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"frequency":"weekly"`) {
+		t.Fatalf("unexpected response: %d %s", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// FollowController handles follow and activity feed HTTP endpoints.
+type FollowController struct {
+	inputFactory       func(follows port.FollowRepository, accounts port.AccountRepository, output port.FollowOutputPort) port.FollowInputPort
+	outputFactory      func() *presenter.FollowPresenter
+	followRepoFactory  func() port.FollowRepository
+	accountRepoFactory func() port.AccountRepository
+}
+
+// NewFollowController creates FollowController.
+func NewFollowController(
+	inputFactory func(follows port.FollowRepository, accounts port.AccountRepository, output port.FollowOutputPort) port.FollowInputPort,
+	outputFactory func() *presenter.FollowPresenter,
+	followRepoFactory func() port.FollowRepository,
+	accountRepoFactory func() port.AccountRepository,
+) *FollowController {
+	return &FollowController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		followRepoFactory:  followRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+	}
+}
+
+// Follow handles POST /accounts/:accountId/follow.
+func (c *FollowController) Follow(ctx echo.Context, accountID string, params openapi.AccountsFollowAccountParams) error {
+	followerID := strings.TrimSpace(params.FollowerId)
+	if followerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Follow(ctx.Request().Context(), followerID, accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Followee())
+}
+
+// Unfollow handles DELETE /accounts/:accountId/follow.
+func (c *FollowController) Unfollow(ctx echo.Context, accountID string, params openapi.AccountsUnfollowAccountParams) error {
+	followerID := strings.TrimSpace(params.FollowerId)
+	if followerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Unfollow(ctx.Request().Context(), followerID, accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Followee())
+}
+
+// Feed handles GET /feed.
+func (c *FollowController) Feed(ctx echo.Context, params openapi.FeedGetFeedParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	feedInput := port.FeedInput{AccountID: accountID, Cursor: valueOrEmpty(params.Cursor)}
+	if params.Limit != nil {
+		feedInput.Limit = int(*params.Limit)
+	}
+	input, p := c.newIO()
+	if err := input.Feed(ctx.Request().Context(), feedInput); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Feed())
+}
+
+func (c *FollowController) newIO() (port.FollowInputPort, *presenter.FollowPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.followRepoFactory(), c.accountRepoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newFollowController(input *ctrlmock.FollowInputStub) *FollowController {
+	p := presenter.NewFollowPresenter()
+	return NewFollowController(
+		func(follows port.FollowRepository, accounts port.AccountRepository, output port.FollowOutputPort) port.FollowInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.FollowPresenter { return p },
+		func() port.FollowRepository { return nil },
+		func() port.AccountRepository { return nil },
+	)
+}
+
+func TestFollowController_Follow(t *testing.T) {
+	tests := []struct {
+		name       string
+		followerID string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] follow", followerID: " reader ", wantStatus: http.StatusOK},
+		{name: "[Fail] follower missing", followerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] follow self", followerID: "author", inErr: domainerr.ErrCannotFollowSelf, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] account not found", followerID: "reader", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.FollowInputStub{Err: tt.inErr}
+			ctrl := newFollowController(input)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/api/accounts/author/follow", nil), rec)
+
+			_ = ctrl.Follow(c, "author", openapi.AccountsFollowAccountParams{FollowerId: tt.followerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp openapi.ModelsAccountResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp.Id != "author" || resp.FollowerCount != 1 || input.FollowerID != "reader" {
+				t.Fatalf("unexpected response: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestFollowController_Feed(t *testing.T) {
+	cursor := "abc"
+	limit := int32(10)
+	tests := []struct {
+		name       string
+		params     openapi.FeedGetFeedParams
+		inErr      error
+		wantLimit  int
+		wantStatus int
+	}{
+		{name: "[Success] first page", params: openapi.FeedGetFeedParams{AccountId: "reader"}, wantStatus: http.StatusOK},
+		{name: "[Success] next page", params: openapi.FeedGetFeedParams{AccountId: "reader", Cursor: &cursor, Limit: &limit}, wantLimit: 10, wantStatus: http.StatusOK},
+		{name: "[Fail] account missing", params: openapi.FeedGetFeedParams{}, wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid cursor", params: openapi.FeedGetFeedParams{AccountId: "reader", Cursor: &cursor}, inErr: domainerr.ErrInvalidCursor, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] invalid page size", params: openapi.FeedGetFeedParams{AccountId: "reader", Limit: &limit}, inErr: domainerr.ErrInvalidPageSize, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.FollowInputStub{Err: tt.inErr}
+			ctrl := newFollowController(input)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/feed", nil), rec)
+
+			_ = ctrl.Feed(c, tt.params)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if input.FeedInput.Limit != tt.wantLimit || (input.FeedInput.Cursor != "") != (tt.params.Cursor != nil) {
+				t.Fatalf("unexpected input: %+v", input.FeedInput)
+			}
+			var resp openapi.ModelsFeedPage
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || len(resp.Items) != 1 || resp.NextCursor == nil {
+				t.Fatalf("unexpected response: %s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -13,7 +13,13 @@ import (
 )
 
 func handleError(ctx echo.Context, err error) error {
+	var verr *domainerr.ValidationError
+	var derr *domainerr.DeprecatedTemplateError
 	switch {
+	case errors.As(err, &verr):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error(), FieldErrors: toFieldErrors(verr)})
+	case errors.As(err, &derr):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error(), SuccessorTemplateId: emptyToNil(derr.SuccessorID)})
 	case errors.Is(err, domainerr.ErrNotFound):
 		return ctx.JSON(http.StatusNotFound, openapi.ModelsNotFoundError{Code: openapi.ModelsNotFoundErrorCodeNOTFOUND, Message: err.Error()})
 	case errors.Is(err, domainerr.ErrUnauthorized):
@@ -22,11 +28,63 @@ func handleError(ctx echo.Context, err error) error {
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
 	case errors.Is(err, domainerr.ErrInvalidStatus) || errors.Is(err, domainerr.ErrInvalidStatusChange) || errors.Is(err, domainerr.ErrInvalidTemplateField):
 		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrInvalidFieldType) || errors.Is(err, domainerr.ErrInvalidFieldOptions) || errors.Is(err, domainerr.ErrInvalidFieldConstraints) || errors.Is(err, domainerr.ErrInvalidSectionContent):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrAttachmentNameRequired) || errors.Is(err, domainerr.ErrAttachmentTooLarge) || errors.Is(err, domainerr.ErrUnsupportedContentType):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrImportInvalidArchive) || errors.Is(err, domainerr.ErrImportTooLarge) || errors.Is(err, domainerr.ErrImportNoDocuments):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrUnknownField) || errors.Is(err, domainerr.ErrInvalidFieldMapping) || errors.Is(err, domainerr.ErrInvalidFieldRemovalPolicy) || errors.Is(err, domainerr.ErrFieldHasContent) || errors.Is(err, domainerr.ErrNoteUpToDate) || errors.Is(err, domainerr.ErrNoteSameTemplate):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrCSVInvalid) || errors.Is(err, domainerr.ErrCSVHeaderInvalid):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrInvalidVisibility) || errors.Is(err, domainerr.ErrTemplateUsedByOthers) || errors.Is(err, domainerr.ErrInvalidSuccessor):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrBundleInvalid) || errors.Is(err, domainerr.ErrBundleEmpty) || errors.Is(err, domainerr.ErrBundleTooLarge) || errors.Is(err, domainerr.ErrInvalidBundleFormat) || errors.Is(err, domainerr.ErrInvalidConflictMode):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrBatchEmpty) || errors.Is(err, domainerr.ErrBatchTooLarge) || errors.Is(err, domainerr.ErrInvalidBatchMode):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrInvalidWebhookURL) || errors.Is(err, domainerr.ErrWebhookTargetNotAllowed) || errors.Is(err, domainerr.ErrWebhookSecretTooShort) || errors.Is(err, domainerr.ErrInvalidWebhookEvent):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrInvalidNotificationKind):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrCannotFollowSelf) || errors.Is(err, domainerr.ErrInvalidCursor) || errors.Is(err, domainerr.ErrInvalidPageSize):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
+	case errors.Is(err, domainerr.ErrInvalidDigestFrequency) || errors.Is(err, domainerr.ErrInvalidUnsubscribeToken):
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
 	default:
 		return ctx.JSON(http.StatusInternalServerError, openapi.ModelsErrorResponse{Code: "INTERNAL_ERROR", Message: err.Error()})
 	}
 }
 
+func toFieldErrors(verr *domainerr.ValidationError) *[]openapi.ModelsFieldError {
+	res := make([]openapi.ModelsFieldError, 0, len(verr.Fields))
+	for _, f := range verr.Fields {
+		res = append(res, openapi.ModelsFieldError{
+			FieldId: f.FieldID,
+			Label:   f.Label,
+			Code:    fieldErrorCode(f.Err),
+			Message: f.Err.Error(),
+		})
+	}
+	return &res
+}
+
+func fieldErrorCode(err error) openapi.ModelsFieldErrorCode {
+	switch {
+	case errors.Is(err, domainerr.ErrRequiredFieldEmpty):
+		return openapi.ModelsFieldErrorCodeREQUIRED
+	case errors.Is(err, domainerr.ErrContentTooShort):
+		return openapi.ModelsFieldErrorCodeTOOSHORT
+	case errors.Is(err, domainerr.ErrContentTooLong):
+		return openapi.ModelsFieldErrorCodeTOOLONG
+	case errors.Is(err, domainerr.ErrContentPatternMismatch):
+		return openapi.ModelsFieldErrorCodePATTERNMISMATCH
+	default:
+		return openapi.ModelsFieldErrorCodeINVALIDCONTENT
+	}
+}
+
 func currentAccountID(ctx echo.Context) (string, error) {
 	id := ctx.Request().Header.Get("X-Account-ID")
 	if strings.TrimSpace(id) == "" {
@@ -41,3 +99,10 @@ func valueOrEmpty(s *string) string {
 	}
 	return *s
 }
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
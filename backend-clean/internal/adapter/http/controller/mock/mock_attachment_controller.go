@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AttachmentInputStub is a lightweight stub for attachment use case input.
+type AttachmentInputStub struct {
+	Err    error
+	Output port.AttachmentOutputPort
+	// Uploaded records the last upload input.
+	Uploaded *port.AttachmentUploadInput
+	// Content is returned as the body of downloads.
+	Content string
+}
+
+func (s *AttachmentInputStub) Upload(ctx context.Context, input port.AttachmentUploadInput) error {
+	s.Uploaded = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentAttachment(ctx, &attachment.Attachment{ID: "att-1", NoteID: input.NoteID, FileName: input.FileName, ContentType: input.ContentType, Size: input.Size})
+	}
+	return s.Err
+}
+
+func (s *AttachmentInputStub) List(ctx context.Context, noteID, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentAttachmentList(ctx, []attachment.Attachment{{ID: "att-1", NoteID: noteID}})
+	}
+	return s.Err
+}
+
+func (s *AttachmentInputStub) Download(ctx context.Context, noteID, id, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		a := &attachment.Attachment{ID: id, NoteID: noteID, FileName: "report.pdf", ContentType: "application/pdf", Size: int64(len(s.Content))}
+		_ = s.Output.PresentAttachmentContent(ctx, a, io.NopCloser(strings.NewReader(s.Content)))
+	}
+	return s.Err
+}
+
+func (s *AttachmentInputStub) Delete(ctx context.Context, noteID, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentAttachmentDeleted(ctx)
+	}
+	return s.Err
+}
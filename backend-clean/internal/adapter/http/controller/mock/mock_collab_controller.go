@@ -0,0 +1,33 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CollabInputStub is a lightweight stub for collaboration use case input.
+// It echoes every command back as a changed event so tests can see what was decoded.
+type CollabInputStub struct {
+	Err    error
+	Output port.CollabOutputPort
+	// Input records the last session input.
+	Input *port.CollabInput
+	// Commands records the commands received before the session ended.
+	Commands []port.CollabCommand
+}
+
+func (s *CollabInputStub) Collaborate(ctx context.Context, input port.CollabInput) error {
+	s.Input = &input
+	if s.Err != nil {
+		return s.Err
+	}
+	for cmd := range input.Commands {
+		s.Commands = append(s.Commands, cmd)
+		if err := s.Output.PresentCollabEvent(ctx, collab.Event{Type: collab.EventChanged, SectionID: cmd.SectionID, Content: cmd.Content}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DigestInputStub is a lightweight stub for digest use case input.
+type DigestInputStub struct {
+	Err    error
+	Output port.DigestOutputPort
+	// Settings records the last settings update input.
+	Settings *port.DigestSettingsInput
+	// Token records the last unsubscribe token.
+	Token string
+}
+
+func (s *DigestInputStub) GetSettings(ctx context.Context, accountID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDigestSettings(ctx, &digest.Settings{AccountID: accountID, Frequency: digest.DefaultFrequency})
+	}
+	return s.Err
+}
+
+func (s *DigestInputStub) UpdateSettings(ctx context.Context, input port.DigestSettingsInput) error {
+	s.Settings = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDigestSettings(ctx, &digest.Settings{AccountID: input.AccountID, Frequency: input.Frequency})
+	}
+	return s.Err
+}
+
+func (s *DigestInputStub) Unsubscribe(ctx context.Context, token string) error {
+	s.Token = token
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDigestSettings(ctx, &digest.Settings{AccountID: "acc-1", Frequency: digest.FrequencyOff})
+	}
+	return s.Err
+}
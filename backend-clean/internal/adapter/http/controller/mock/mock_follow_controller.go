@@ -0,0 +1,43 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// FollowInputStub is a lightweight stub for follow use case input.
+type FollowInputStub struct {
+	Err    error
+	Output port.FollowOutputPort
+	// FollowerID records the follower of the last follow or unfollow.
+	FollowerID string
+	// FeedInput records the last feed input.
+	FeedInput *port.FeedInput
+}
+
+func (s *FollowInputStub) Follow(ctx context.Context, followerID, followeeID string) error {
+	s.FollowerID = followerID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentFollowee(ctx, &account.Account{ID: followeeID, FollowerCount: 1})
+	}
+	return s.Err
+}
+
+func (s *FollowInputStub) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	s.FollowerID = followerID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentFollowee(ctx, &account.Account{ID: followeeID})
+	}
+	return s.Err
+}
+
+func (s *FollowInputStub) Feed(ctx context.Context, input port.FeedInput) error {
+	s.FeedInput = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentFeed(ctx, feed.Page{Items: []feed.Item{{SubjectID: "note-1", Kind: feed.KindNotePublished}}, NextCursor: "next"})
+	}
+	return s.Err
+}
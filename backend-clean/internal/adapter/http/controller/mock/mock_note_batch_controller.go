@@ -0,0 +1,47 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteBatchInputStub is a lightweight stub for batch note use case input.
+type NoteBatchInputStub struct {
+	Err    error
+	Output port.NoteBatchOutputPort
+	// Last records the last batch input.
+	Last *port.NoteBatchInput
+	// NewOwnerID records the last transfer target.
+	NewOwnerID string
+}
+
+func (s *NoteBatchInputStub) Publish(ctx context.Context, input port.NoteBatchInput) error {
+	return s.present(ctx, input)
+}
+
+func (s *NoteBatchInputStub) Unpublish(ctx context.Context, input port.NoteBatchInput) error {
+	return s.present(ctx, input)
+}
+
+func (s *NoteBatchInputStub) Delete(ctx context.Context, input port.NoteBatchInput) error {
+	return s.present(ctx, input)
+}
+
+func (s *NoteBatchInputStub) TransferOwnership(ctx context.Context, input port.NoteBatchTransferInput) error {
+	s.NewOwnerID = input.NewOwnerID
+	return s.present(ctx, input.NoteBatchInput)
+}
+
+func (s *NoteBatchInputStub) present(ctx context.Context, input port.NoteBatchInput) error {
+	s.Last = &input
+	if s.Output != nil && s.Err == nil {
+		results := make([]note.BatchItemResult, 0, len(input.IDs))
+		for _, id := range input.IDs {
+			results = append(results, note.BatchItemResult{ID: id})
+		}
+		_ = s.Output.PresentNoteBatch(ctx, input.Mode, results)
+	}
+	return s.Err
+}
@@ -13,6 +13,10 @@ type NoteInputStub struct {
 	Output   port.NoteOutputPort
 	Notes    []note.WithMeta
 	NoteResp *note.WithMeta
+	// Upgraded records the last upgrade input.
+	Upgraded port.NoteUpgradeInput
+	// Retemplated records the last re-template input.
+	Retemplated port.NoteRetemplateInput
 }
 
 func (s *NoteInputStub) List(ctx context.Context, filters note.Filters) error {
@@ -22,6 +26,13 @@ func (s *NoteInputStub) List(ctx context.Context, filters note.Filters) error {
 	return s.Err
 }
 
+func (s *NoteInputStub) ListByTemplate(ctx context.Context, templateID, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteList(ctx, s.Notes)
+	}
+	return s.Err
+}
+
 func (s *NoteInputStub) Get(ctx context.Context, id string) error {
 	if s.Output != nil && s.Err == nil {
 		resp := s.NoteResp
@@ -60,3 +71,23 @@ func (s *NoteInputStub) Delete(ctx context.Context, id, ownerID string) error {
 	}
 	return s.Err
 }
+
+func (s *NoteInputStub) Export(ctx context.Context, id, viewerID string) error {
+	return s.Get(ctx, id)
+}
+
+func (s *NoteInputStub) Upgrade(ctx context.Context, input port.NoteUpgradeInput) error {
+	s.Upgraded = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Retemplate(ctx context.Context, input port.NoteRetemplateInput) error {
+	s.Retemplated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID, TemplateID: input.TemplateID}})
+	}
+	return s.Err
+}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCSVInputStub is a lightweight stub for note CSV use case input.
+type NoteCSVInputStub struct {
+	Err    error
+	Output port.NoteCSVOutputPort
+	// Notes are presented on export.
+	Notes []note.WithMeta
+	// Fields are presented on export.
+	Fields []template.Field
+	// Imported records the last import input.
+	Imported port.NoteCSVImportInput
+}
+
+func (s *NoteCSVInputStub) Export(ctx context.Context, _, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteCSV(ctx, s.Fields, s.Notes)
+	}
+	return s.Err
+}
+
+func (s *NoteCSVInputStub) Import(ctx context.Context, input port.NoteCSVImportInput) error {
+	s.Imported = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteCSVImport(ctx, []note.CSVRowResult{{Line: 2, NoteID: "note-1", Created: true}})
+	}
+	return s.Err
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteImportInputStub is a lightweight stub for note import use case input.
+type NoteImportInputStub struct {
+	Err    error
+	Output port.NoteImportOutputPort
+	// Imported records the last import input.
+	Imported *port.NoteImportInput
+}
+
+func (s *NoteImportInputStub) Import(ctx context.Context, input port.NoteImportInput) error {
+	s.Imported = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteImport(ctx, input.DryRun, []note.ImportResult{{FileName: input.FileName, Title: "Imported"}})
+	}
+	return s.Err
+}
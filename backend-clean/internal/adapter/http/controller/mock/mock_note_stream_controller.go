@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteStreamInputStub is a lightweight stub for note stream use case input.
+type NoteStreamInputStub struct {
+	Err    error
+	Output port.NoteStreamOutputPort
+	// Input records the last stream input.
+	Input *port.NoteStreamInput
+	// Events are presented before the stream ends.
+	Events []event.Envelope
+}
+
+func (s *NoteStreamInputStub) Stream(ctx context.Context, input port.NoteStreamInput) error {
+	s.Input = &input
+	for _, env := range s.Events {
+		if err := s.Output.PresentNoteEvent(ctx, env); err != nil {
+			return err
+		}
+	}
+	return s.Err
+}
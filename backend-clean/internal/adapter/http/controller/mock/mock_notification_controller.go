@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotificationInputStub is a lightweight stub for notification use case input.
+type NotificationInputStub struct {
+	Err    error
+	Output port.NotificationOutputPort
+	// UnreadOnly records the filter of the last list call.
+	UnreadOnly bool
+	// Preferences records the last preferences update input.
+	Preferences *port.NotificationPreferencesInput
+}
+
+func (s *NotificationInputStub) List(ctx context.Context, recipientID string, unreadOnly bool) error {
+	s.UnreadOnly = unreadOnly
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNotificationList(ctx, []notification.Notification{{ID: "n-1", RecipientID: recipientID, Kind: notification.KindNoteTransferred}})
+	}
+	return s.Err
+}
+
+func (s *NotificationInputStub) CountUnread(ctx context.Context, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentUnreadCount(ctx, 2)
+	}
+	return s.Err
+}
+
+func (s *NotificationInputStub) MarkRead(ctx context.Context, id, recipientID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNotification(ctx, &notification.Notification{ID: id, RecipientID: recipientID, Kind: notification.KindNoteTransferred})
+	}
+	return s.Err
+}
+
+func (s *NotificationInputStub) MarkAllRead(ctx context.Context, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentMarkedAllRead(ctx, 2)
+	}
+	return s.Err
+}
+
+func (s *NotificationInputStub) GetPreferences(ctx context.Context, accountID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNotificationPreferences(ctx, &notification.Preferences{AccountID: accountID})
+	}
+	return s.Err
+}
+
+func (s *NotificationInputStub) UpdatePreferences(ctx context.Context, input port.NotificationPreferencesInput) error {
+	s.Preferences = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNotificationPreferences(ctx, &notification.Preferences{AccountID: input.AccountID, MutedKinds: input.MutedKinds})
+	}
+	return s.Err
+}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateBundleInputStub is a lightweight stub for template bundle use case input.
+type TemplateBundleInputStub struct {
+	Err    error
+	Output port.TemplateBundleOutputPort
+	// Templates are presented on export.
+	Templates []template.Template
+	// Exported records the last export input.
+	Exported port.TemplateBundleExportInput
+	// Imported records the last import input.
+	Imported port.TemplateBundleImportInput
+}
+
+func (s *TemplateBundleInputStub) Export(ctx context.Context, input port.TemplateBundleExportInput) error {
+	s.Exported = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplateBundle(ctx, template.NewBundle(s.Templates))
+	}
+	return s.Err
+}
+
+func (s *TemplateBundleInputStub) Import(ctx context.Context, input port.TemplateBundleImportInput) error {
+	s.Imported = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplateBundleImport(ctx, []template.BundleImportResult{{Index: 0, Name: "Daily", TemplateID: "tpl-1", Action: template.BundleActionCreated}})
+	}
+	return s.Err
+}
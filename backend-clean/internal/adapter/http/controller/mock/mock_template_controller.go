@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
@@ -15,13 +16,20 @@ type TemplateInputStub struct {
 
 func (s *TemplateInputStub) List(ctx context.Context, filters template.Filters) error { return s.Err }
 
-func (s *TemplateInputStub) Get(ctx context.Context, id string) error {
+func (s *TemplateInputStub) Get(ctx context.Context, id, _ string) error {
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: id}})
 	}
 	return s.Err
 }
 
+func (s *TemplateInputStub) GetVersion(ctx context.Context, id string, version int, _ string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: id, Version: version}})
+	}
+	return s.Err
+}
+
 func (s *TemplateInputStub) Create(ctx context.Context, input port.TemplateCreateInput) error {
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: input.Name, OwnerID: input.OwnerID}})
@@ -36,6 +44,28 @@ func (s *TemplateInputStub) Update(ctx context.Context, input port.TemplateUpdat
 	return s.Err
 }
 
+func (s *TemplateInputStub) Fork(ctx context.Context, input port.TemplateForkInput) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: "tpl-2", Name: input.Name, OwnerID: input.OwnerID, ForkedFromID: input.ID}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) Deprecate(ctx context.Context, input port.TemplateDeprecateInput) error {
+	if s.Output != nil && s.Err == nil {
+		at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: input.ID, OwnerID: input.OwnerID, DeprecatedAt: &at, SuccessorID: input.SuccessorID}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) Undeprecate(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: id, OwnerID: ownerID}})
+	}
+	return s.Err
+}
+
 func (s *TemplateInputStub) Delete(ctx context.Context, id, ownerID string) error {
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplateDeleted(ctx)
@@ -0,0 +1,69 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookInputStub is a lightweight stub for webhook use case input.
+type WebhookInputStub struct {
+	Err    error
+	Output port.WebhookOutputPort
+	// Created records the last create input.
+	Created *port.WebhookCreateInput
+	// Updated records the last update input.
+	Updated *port.WebhookUpdateInput
+}
+
+func (s *WebhookInputStub) List(ctx context.Context, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookList(ctx, []webhook.Subscription{{ID: "wh-1", OwnerID: ownerID}})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Get(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &webhook.Subscription{ID: id, OwnerID: ownerID})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	s.Created = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &webhook.Subscription{ID: "wh-1", OwnerID: input.OwnerID, URL: input.URL, Secret: input.Secret, Events: input.Events, Active: true})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	s.Updated = &input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &webhook.Subscription{ID: input.ID, OwnerID: input.OwnerID, URL: input.URL, Events: input.Events, Active: input.Active})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Delete(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) ListDeliveries(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookDeliveries(ctx, []webhook.Delivery{{ID: "dl-1", SubscriptionID: id, Status: webhook.DeliverySucceeded}})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Redeliver(ctx context.Context, id, deliveryID, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookDelivery(ctx, &webhook.Delivery{ID: "dl-2", SubscriptionID: id, Status: webhook.DeliveryPending, RedeliveryOf: deliveryID})
+	}
+	return s.Err
+}
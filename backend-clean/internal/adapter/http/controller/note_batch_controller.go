@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteBatchController handles batch note HTTP endpoints.
+type NoteBatchController struct {
+	inputFactory       func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteBatchOutputPort) port.NoteBatchInputPort
+	outputFactory      func() *presenter.NoteBatchPresenter
+	noteRepoFactory    func() port.NoteRepository
+	accountRepoFactory func() port.AccountRepository
+	txFactory          func() port.TxManager
+}
+
+// NewNoteBatchController creates NoteBatchController.
+func NewNoteBatchController(
+	inputFactory func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteBatchOutputPort) port.NoteBatchInputPort,
+	outputFactory func() *presenter.NoteBatchPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *NoteBatchController {
+	return &NoteBatchController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		noteRepoFactory:    noteRepoFactory,
+		accountRepoFactory: accountRepoFactory,
+		txFactory:          txFactory,
+	}
+}
+
+// Publish handles POST /notes/batch/publish.
+func (c *NoteBatchController) Publish(ctx echo.Context, params openapi.NotesBatchPublishNotesParams) error {
+	return c.handle(ctx, params.OwnerId, func(input port.NoteBatchInputPort, in port.NoteBatchInput) error {
+		return input.Publish(ctx.Request().Context(), in)
+	})
+}
+
+// Unpublish handles POST /notes/batch/unpublish.
+func (c *NoteBatchController) Unpublish(ctx echo.Context, params openapi.NotesBatchUnpublishNotesParams) error {
+	return c.handle(ctx, params.OwnerId, func(input port.NoteBatchInputPort, in port.NoteBatchInput) error {
+		return input.Unpublish(ctx.Request().Context(), in)
+	})
+}
+
+// Delete handles POST /notes/batch/delete.
+func (c *NoteBatchController) Delete(ctx echo.Context, params openapi.NotesBatchDeleteNotesParams) error {
+	return c.handle(ctx, params.OwnerId, func(input port.NoteBatchInputPort, in port.NoteBatchInput) error {
+		return input.Delete(ctx.Request().Context(), in)
+	})
+}
+
+// Transfer handles POST /notes/batch/transfer.
+func (c *NoteBatchController) Transfer(ctx echo.Context, params openapi.NotesBatchTransferNotesParams) error {
+	var body openapi.ModelsTransferNotesRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	err := input.TransferOwnership(ctx.Request().Context(), port.NoteBatchTransferInput{
+		NoteBatchInput: toNoteBatchInput(body.NoteIds, body.Mode, ownerID),
+		NewOwnerID:     strings.TrimSpace(body.NewOwnerId),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Response())
+}
+
+func (c *NoteBatchController) handle(ctx echo.Context, owner string, run func(input port.NoteBatchInputPort, in port.NoteBatchInput) error) error {
+	var body openapi.ModelsBatchNoteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID := strings.TrimSpace(owner)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := run(input, toNoteBatchInput(body.NoteIds, body.Mode, ownerID)); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Response())
+}
+
+func (c *NoteBatchController) newIO() (port.NoteBatchInputPort, *presenter.NoteBatchPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
+	return input, output
+}
+
+func toNoteBatchInput(ids []string, mode *openapi.ModelsBatchMode, ownerID string) port.NoteBatchInput {
+	in := port.NoteBatchInput{IDs: ids, OwnerID: ownerID}
+	if mode != nil {
+		in.Mode = note.BatchMode(*mode)
+	}
+	return in
+}
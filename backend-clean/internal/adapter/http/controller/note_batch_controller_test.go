@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteBatchTestController(input *ctrlmock.NoteBatchInputStub, p *presenter.NoteBatchPresenter) *NoteBatchController {
+	return NewNoteBatchController(
+		func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteBatchOutputPort) port.NoteBatchInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.NoteBatchPresenter { return p },
+		func() port.NoteRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestNoteBatchController_Publish(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		body       string
+		inErr      error
+		wantStatus int
+		wantMode   note.BatchMode
+	}{
+		{name: "[Success] default mode", ownerID: "owner", body: `{"noteIds":["n1","n2"]}`, wantStatus: http.StatusOK},
+		{name: "[Success] best effort", ownerID: "owner", body: `{"noteIds":["n1"],"mode":"best_effort"}`, wantStatus: http.StatusOK, wantMode: note.BatchBestEffort},
+		{name: "[Fail] owner missing", ownerID: " ", body: `{"noteIds":["n1"]}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid body", ownerID: "owner", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] too many notes", ownerID: "owner", body: `{"noteIds":["n1"]}`, inErr: domainerr.ErrBatchTooLarge, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] empty batch", ownerID: "owner", body: `{"noteIds":[]}`, inErr: domainerr.ErrBatchEmpty, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteBatchInputStub{Err: tt.inErr}
+			p := presenter.NewNoteBatchPresenter()
+			ctrl := newNoteBatchTestController(input, p)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/notes/batch/publish", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Publish(c, openapi.NotesBatchPublishNotesParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if input.Last.OwnerID != "owner" || input.Last.Mode != tt.wantMode {
+				t.Fatalf("unexpected input: %+v", input.Last)
+			}
+			if int(p.Response().Succeeded) != len(input.Last.IDs) {
+				t.Fatalf("unexpected response: %+v", p.Response())
+			}
+		})
+	}
+}
+
+func TestNoteBatchController_Transfer(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] transfer", body: `{"noteIds":["n1"],"newOwnerId":" acc-2 "}`, wantStatus: http.StatusOK},
+		{name: "[Fail] new owner not found", body: `{"noteIds":["n1"],"newOwnerId":"missing"}`, inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] invalid body", body: `[]`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteBatchInputStub{Err: tt.inErr}
+			ctrl := newNoteBatchTestController(input, presenter.NewNoteBatchPresenter())
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/notes/batch/transfer", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Transfer(c, openapi.NotesBatchTransferNotesParams{OwnerId: "owner"})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && input.NewOwnerID != "acc-2" {
+				t.Fatalf("newOwnerID = %q", input.NewOwnerID)
+			}
+		})
+	}
+}
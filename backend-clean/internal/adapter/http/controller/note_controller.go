@@ -2,6 +2,7 @@
 package controller
 
 import (
+	"mime"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -18,6 +20,7 @@ import (
 type NoteController struct {
 	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort
 	outputFactory   func() *presenter.NotePresenter
+	exportFactory   func(format string) presenter.NoteExportPresenter
 	noteRepoFactory func() port.NoteRepository
 	tplRepoFactory  func() port.TemplateRepository
 	txFactory       func() port.TxManager
@@ -27,6 +30,7 @@ type NoteController struct {
 func NewNoteController(
 	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort,
 	outputFactory func() *presenter.NotePresenter,
+	exportFactory func(format string) presenter.NoteExportPresenter,
 	noteRepoFactory func() port.NoteRepository,
 	tplRepoFactory func() port.TemplateRepository,
 	txFactory func() port.TxManager,
@@ -34,6 +38,7 @@ func NewNoteController(
 	return &NoteController{
 		inputFactory:    inputFactory,
 		outputFactory:   outputFactory,
+		exportFactory:   exportFactory,
 		noteRepoFactory: noteRepoFactory,
 		tplRepoFactory:  tplRepoFactory,
 		txFactory:       txFactory,
@@ -61,6 +66,15 @@ func (c *NoteController) List(ctx echo.Context, params openapi.NotesListNotesPar
 	return ctx.JSON(http.StatusOK, p.Notes())
 }
 
+// ListByTemplate handles GET /templates/:id/notes.
+func (c *NoteController) ListByTemplate(ctx echo.Context, templateID string, params openapi.TemplatesListTemplateNotesParams) error {
+	input, p := c.newIO()
+	if err := input.ListByTemplate(ctx.Request().Context(), templateID, valueOrEmpty(params.ViewerId)); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Notes())
+}
+
 // GetByID handles GET /notes/:id.
 func (c *NoteController) GetByID(ctx echo.Context, noteID string) error {
 	input, p := c.newIO()
@@ -131,6 +145,82 @@ func (c *NoteController) Update(ctx echo.Context, noteID string, params openapi.
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
+// Upgrade handles moving a note to the latest template version.
+// Upgrade handles POST /notes/:id/upgrade.
+func (c *NoteController) Upgrade(ctx echo.Context, noteID string, params openapi.NotesUpgradeNoteParams) error {
+	var body openapi.ModelsUpgradeNoteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	mapping, err := toFieldMapping(body.FieldMapping)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Upgrade(ctx.Request().Context(), port.NoteUpgradeInput{
+		ID:           noteID,
+		OwnerID:      ownerID,
+		Mapping:      mapping,
+		FieldRemoval: toFieldRemovalPolicy(params.FieldRemoval),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// Retemplate handles POST /notes/:id/retemplate.
+func (c *NoteController) Retemplate(ctx echo.Context, noteID string, params openapi.NotesRetemplateNoteParams) error {
+	var body openapi.ModelsRetemplateNoteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	mapping, err := toFieldMapping(body.FieldMapping)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Retemplate(ctx.Request().Context(), port.NoteRetemplateInput{
+		ID:           noteID,
+		OwnerID:      ownerID,
+		TemplateID:   strings.TrimSpace(body.TemplateId),
+		Mapping:      mapping,
+		FieldRemoval: toFieldRemovalPolicy(params.FieldRemoval),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// toFieldMapping converts mapping entries; a source field may be mapped only once.
+func toFieldMapping(entries []openapi.ModelsFieldMappingEntry) (note.FieldMapping, error) {
+	mapping := make(note.FieldMapping, len(entries))
+	for _, m := range entries {
+		if _, dup := mapping[m.FromFieldId]; dup {
+			return nil, domainerr.ErrInvalidFieldMapping
+		}
+		mapping[m.FromFieldId] = m.ToFieldId
+	}
+	return mapping, nil
+}
+
+// toFieldRemovalPolicy leaves the policy empty when the query parameter is absent so the use case applies its default.
+func toFieldRemovalPolicy(p *openapi.ModelsFieldRemovalPolicy) template.FieldRemovalPolicy {
+	if p == nil {
+		return ""
+	}
+	return template.FieldRemovalPolicy(*p)
+}
+
 // Delete handles deleting a note.
 // Delete handles DELETE /notes/:id.
 func (c *NoteController) Delete(ctx echo.Context, noteID string, params openapi.NotesDeleteNoteParams) error {
@@ -183,6 +273,20 @@ func (c *NoteController) Unpublish(ctx echo.Context, noteID string, params opena
 	return ctx.JSON(http.StatusOK, p.Note())
 }
 
+// Export handles GET /notes/:id/export.
+func (c *NoteController) Export(ctx echo.Context, noteID string, params openapi.NotesExportNoteParams) error {
+	p := c.exportFactory(string(params.Format))
+	if p == nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "unsupported format"})
+	}
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), p)
+	if err := input.Export(ctx.Request().Context(), noteID, valueOrEmpty(params.ViewerId)); err != nil {
+		return handleError(ctx, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": "note-" + noteID + p.FileExtension()}))
+	return ctx.Blob(http.StatusOK, p.ContentType(), p.Body())
+}
+
 func (c *NoteController) newIO() (port.NoteInputPort, *presenter.NotePresenter) {
 	output := c.outputFactory()
 	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), output)
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"maps"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -20,6 +22,7 @@ func TestNoteController_Create(t *testing.T) {
 	tests := []struct {
 		name       string
 		body       string
+		inErr      error
 		wantStatus int
 		wantBody   string
 	}{
@@ -34,18 +37,26 @@ func TestNoteController_Create(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantBody:   "invalid body",
 		},
+		{
+			name:       "[Fail] deprecated template",
+			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001","ownerId":"00000000-0000-0000-0000-000000000002","sections":[]}`,
+			inErr:      &domainerr.DeprecatedTemplateError{TemplateID: "tpl-1", SuccessorID: "tpl-2"},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"successorTemplateId":"tpl-2"`,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := presenter.NewNotePresenter()
-			input := &ctrlmock.NoteInputStub{}
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
 				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -86,6 +97,7 @@ func TestNoteController_List(t *testing.T) {
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -99,6 +111,42 @@ func TestNoteController_List(t *testing.T) {
 	}
 }
 
+func TestNoteController_ListByTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list template notes", wantStatus: http.StatusOK, wantBody: `"id":"n1"`},
+		{name: "[Fail] template not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Notes: []note.WithMeta{{Note: note.Note{ID: "n1"}}}, Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := httptest.NewRequest(http.MethodGet, "/api/templates/t1/notes", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.ListByTemplate(c, "t1", openapi.TemplatesListTemplateNotesParams{})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
 func TestNoteController_Get(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -121,6 +169,7 @@ func TestNoteController_Get(t *testing.T) {
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -145,6 +194,14 @@ func TestNoteController_Update(t *testing.T) {
 	}{
 		{name: "[Success] update note", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, params: openapi.NotesUpdateNoteParams{OwnerId: "owner"}, wantStatus: http.StatusOK},
 		{name: "[Fail] missing owner", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, params: openapi.NotesUpdateNoteParams{OwnerId: ""}, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{
+			name:       "[Fail] field constraint violation",
+			body:       `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`,
+			params:     openapi.NotesUpdateNoteParams{OwnerId: "owner"},
+			inErr:      &domainerr.ValidationError{Fields: []domainerr.FieldError{{FieldID: "f1", Label: "Cause", Err: domainerr.ErrContentTooShort}}},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"fieldErrors":[{"code":"TOO_SHORT","fieldId":"f1","label":"Cause"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -158,6 +215,7 @@ func TestNoteController_Update(t *testing.T) {
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -172,6 +230,140 @@ func TestNoteController_Update(t *testing.T) {
 	}
 }
 
+func TestNoteController_Upgrade(t *testing.T) {
+	deleteContent := openapi.ModelsFieldRemovalPolicyDeleteContent
+	tests := []struct {
+		name        string
+		body        string
+		ownerID     string
+		removal     *openapi.ModelsFieldRemovalPolicy
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantMapping note.FieldMapping
+		wantRemoval template.FieldRemovalPolicy
+	}{
+		{
+			name:        "[Success] upgrade note",
+			body:        `{"fieldMapping":[{"fromFieldId":"old-1","toFieldId":"new-1"}]}`,
+			ownerID:     "owner",
+			wantStatus:  http.StatusOK,
+			wantMapping: note.FieldMapping{"old-1": "new-1"},
+		},
+		{
+			name:        "[Success] passes the field removal policy",
+			body:        `{"fieldMapping":[]}`,
+			ownerID:     "owner",
+			removal:     &deleteContent,
+			wantStatus:  http.StatusOK,
+			wantRemoval: template.FieldRemovalDeleteContent,
+		},
+		{name: "[Fail] missing owner", body: `{"fieldMapping":[]}`, ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] bind error", body: `{`, ownerID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{
+			name:       "[Fail] duplicate source field",
+			body:       `{"fieldMapping":[{"fromFieldId":"old-1","toFieldId":"new-1"},{"fromFieldId":"old-1","toFieldId":"new-2"}]}`,
+			ownerID:    "owner",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidFieldMapping.Error(),
+		},
+		{name: "[Fail] unmapped field has content", body: `{"fieldMapping":[]}`, ownerID: "owner", inErr: domainerr.ErrFieldHasContent, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrFieldHasContent.Error()},
+		{name: "[Fail] already latest", body: `{"fieldMapping":[]}`, ownerID: "owner", inErr: domainerr.ErrNoteUpToDate, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrNoteUpToDate.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/upgrade", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Upgrade(c, "n1", openapi.NotesUpgradeNoteParams{OwnerId: tt.ownerID, FieldRemoval: tt.removal})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantMapping != nil && !maps.Equal(input.Upgraded.Mapping, tt.wantMapping) {
+				t.Fatalf("mapping = %v, want %v", input.Upgraded.Mapping, tt.wantMapping)
+			}
+			if input.Upgraded.FieldRemoval != tt.wantRemoval {
+				t.Fatalf("field removal = %q, want %q", input.Upgraded.FieldRemoval, tt.wantRemoval)
+			}
+		})
+	}
+}
+
+func TestNoteController_Retemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		ownerID     string
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantMapping note.FieldMapping
+	}{
+		{
+			name:        "[Success] retemplate note",
+			body:        `{"templateId":"tpl-2","fieldMapping":[{"fromFieldId":"old-1","toFieldId":"new-1"}]}`,
+			ownerID:     "owner",
+			wantStatus:  http.StatusOK,
+			wantMapping: note.FieldMapping{"old-1": "new-1"},
+		},
+		{name: "[Fail] missing owner", body: `{"templateId":"tpl-2","fieldMapping":[]}`, ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] bind error", body: `{`, ownerID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{
+			name:       "[Fail] duplicate source field",
+			body:       `{"templateId":"tpl-2","fieldMapping":[{"fromFieldId":"old-1","toFieldId":"new-1"},{"fromFieldId":"old-1","toFieldId":"new-2"}]}`,
+			ownerID:    "owner",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidFieldMapping.Error(),
+		},
+		{name: "[Fail] same template", body: `{"templateId":"tpl-1","fieldMapping":[]}`, ownerID: "owner", inErr: domainerr.ErrNoteSameTemplate, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrNoteSameTemplate.Error()},
+		{name: "[Fail] template not found", body: `{"templateId":"missing","fieldMapping":[]}`, ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/retemplate", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Retemplate(c, "n1", openapi.NotesRetemplateNoteParams{OwnerId: tt.ownerID})
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantMapping != nil {
+				if !maps.Equal(input.Retemplated.Mapping, tt.wantMapping) || input.Retemplated.TemplateID != "tpl-2" {
+					t.Fatalf("unexpected input: %+v", input.Retemplated)
+				}
+			}
+		})
+	}
+}
+
 func TestNoteController_Publish(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -196,6 +388,7 @@ func TestNoteController_Publish(t *testing.T) {
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -234,6 +427,7 @@ func TestNoteController_Unpublish(t *testing.T) {
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -271,6 +465,7 @@ func TestNoteController_Delete(t *testing.T) {
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
+				func(string) presenter.NoteExportPresenter { return nil },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
@@ -283,3 +478,56 @@ func TestNoteController_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestNoteController_Export(t *testing.T) {
+	tests := []struct {
+		name            string
+		format          openapi.ModelsExportFormat
+		inErr           error
+		wantStatus      int
+		wantContentType string
+	}{
+		{name: "[Success] markdown", format: openapi.ModelsExportFormatMarkdown, wantStatus: http.StatusOK, wantContentType: "text/markdown; charset=utf-8"},
+		{name: "[Success] html", format: openapi.ModelsExportFormatHtml, wantStatus: http.StatusOK, wantContentType: "text/html; charset=utf-8"},
+		{name: "[Fail] unsupported format", format: "pdf", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] draft not visible", format: openapi.ModelsExportFormatMarkdown, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return presenter.NewNotePresenter() },
+				func(format string) presenter.NoteExportPresenter {
+					switch format {
+					case "markdown":
+						return presenter.NewMarkdownNotePresenter()
+					case "html":
+						return presenter.NewHTMLNotePresenter()
+					}
+					return nil
+				},
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/notes/n1/export", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Export(c, "n1", openapi.NotesExportNoteParams{Format: tt.format})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantContentType != "" && rec.Header().Get(echo.HeaderContentType) != tt.wantContentType {
+				t.Fatalf("content type = %q, want %q", rec.Header().Get(echo.HeaderContentType), tt.wantContentType)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCSVController handles CSV export and import of template notes.
+type NoteCSVController struct {
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteCSVOutputPort) port.NoteCSVInputPort
+	outputFactory   func() *presenter.NoteCSVPresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+	txFactory       func() port.TxManager
+}
+
+// NewNoteCSVController creates NoteCSVController.
+func NewNoteCSVController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteCSVOutputPort) port.NoteCSVInputPort,
+	outputFactory func() *presenter.NoteCSVPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+) *NoteCSVController {
+	return &NoteCSVController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		tplRepoFactory:  tplRepoFactory,
+		txFactory:       txFactory,
+	}
+}
+
+// Export handles GET /templates/:id/notes.csv.
+func (c *NoteCSVController) Export(ctx echo.Context, templateID string, params openapi.TemplatesExportTemplateNotesCsvParams) error {
+	input, p := c.newIO()
+	if err := input.Export(ctx.Request().Context(), templateID, valueOrEmpty(params.ViewerId)); err != nil {
+		return handleError(ctx, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": "notes-" + templateID + ".csv"}))
+	return ctx.Blob(http.StatusOK, "text/csv; charset=utf-8", p.Body())
+}
+
+// Import handles POST /templates/:id/notes.csv.
+func (c *NoteCSVController) Import(ctx echo.Context, templateID string, params openapi.TemplatesImportTemplateNotesCsvParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, note.MaxImportUploadSize+multipartOverhead)
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	defer func() { _ = file.Close() }()
+	content, err := io.ReadAll(io.LimitReader(file, note.MaxImportUploadSize+1))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+
+	input, p := c.newIO()
+	err = input.Import(req.Context(), port.NoteCSVImportInput{
+		TemplateID: templateID,
+		OwnerID:    ownerID,
+		Content:    content,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Response())
+}
+
+func (c *NoteCSVController) newIO() (port.NoteCSVInputPort, *presenter.NoteCSVPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteCSVTestController(input *ctrlmock.NoteCSVInputStub) *NoteCSVController {
+	return NewNoteCSVController(
+		func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteCSVOutputPort) port.NoteCSVInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewNoteCSVPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestNoteCSVController_Export(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] export", wantStatus: http.StatusOK},
+		{name: "[Fail] template not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteCSVInputStub{Err: tt.inErr, Fields: []template.Field{{ID: "f1", Label: "Context"}}}
+			ctrl := newNoteCSVTestController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/templates/tpl-1/notes.csv", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Export(c, "tpl-1", openapi.TemplatesExportTemplateNotesCsvParams{})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if ct := rec.Header().Get(echo.HeaderContentType); !strings.HasPrefix(ct, "text/csv") {
+				t.Fatalf("content type = %q", ct)
+			}
+			if cd := rec.Header().Get(echo.HeaderContentDisposition); !strings.Contains(cd, "notes-tpl-1.csv") {
+				t.Fatalf("content disposition = %q", cd)
+			}
+			if !strings.HasPrefix(rec.Body.String(), "id,title,status,template_version,author,created_at,updated_at,Context\n") {
+				t.Fatalf("body = %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestNoteCSVController_Import(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		withFile   bool
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] import", ownerID: "owner", withFile: true, wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", ownerID: "", withFile: true, wantStatus: http.StatusForbidden},
+		{name: "[Fail] file missing", ownerID: "owner", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] invalid header", ownerID: "owner", withFile: true, inErr: domainerr.ErrCSVHeaderInvalid, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteCSVInputStub{Err: tt.inErr}
+			ctrl := newNoteCSVTestController(input)
+
+			body := &bytes.Buffer{}
+			w := multipart.NewWriter(body)
+			if tt.withFile {
+				part, _ := w.CreateFormFile("file", "notes.csv")
+				_, _ = part.Write([]byte("title,Context\nA,b\n"))
+			}
+			_ = w.Close()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/templates/tpl-1/notes.csv", body)
+			req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Import(c, "tpl-1", openapi.TemplatesImportTemplateNotesCsvParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && (input.Imported.TemplateID != "tpl-1" || string(input.Imported.Content) != "title,Context\nA,b\n") {
+				t.Fatalf("unexpected input: %+v", input.Imported)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteImportController handles note import HTTP endpoints.
+type NoteImportController struct {
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteImportOutputPort) port.NoteImportInputPort
+	outputFactory   func() *presenter.NoteImportPresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+	txFactory       func() port.TxManager
+}
+
+// NewNoteImportController creates NoteImportController.
+func NewNoteImportController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteImportOutputPort) port.NoteImportInputPort,
+	outputFactory func() *presenter.NoteImportPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+) *NoteImportController {
+	return &NoteImportController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		tplRepoFactory:  tplRepoFactory,
+		txFactory:       txFactory,
+	}
+}
+
+// Import handles POST /notes/import.
+func (c *NoteImportController) Import(ctx echo.Context, params openapi.NotesImportNotesParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, note.MaxImportUploadSize+multipartOverhead)
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	defer func() { _ = file.Close() }()
+	content, err := io.ReadAll(io.LimitReader(file, note.MaxImportUploadSize+1))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	var aliases map[string][]string
+	if raw := strings.TrimSpace(ctx.FormValue("aliases")); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &aliases); err != nil {
+			return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid aliases"})
+		}
+	}
+
+	input, p := c.newIO()
+	err = input.Import(req.Context(), port.NoteImportInput{
+		TemplateID: strings.TrimSpace(ctx.FormValue("templateId")),
+		OwnerID:    ownerID,
+		FileName:   fh.Filename,
+		Content:    content,
+		Aliases:    aliases,
+		DryRun:     params.DryRun != nil && *params.DryRun,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Response())
+}
+
+func (c *NoteImportController) newIO() (port.NoteImportInputPort, *presenter.NoteImportPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), output)
+	return input, output
+}
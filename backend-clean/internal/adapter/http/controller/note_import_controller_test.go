@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestNoteImportController_Import(t *testing.T) {
+	dryRun := true
+	tests := []struct {
+		name        string
+		ownerID     string
+		aliases     string
+		dryRun      *bool
+		inErr       error
+		wantStatus  int
+		wantAliases int
+	}{
+		{name: "[Success] import with aliases", ownerID: "owner", aliases: `{"Context":["Background"]}`, wantStatus: http.StatusOK, wantAliases: 1},
+		{name: "[Success] dry run", ownerID: "owner", dryRun: &dryRun, wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid aliases", ownerID: "owner", aliases: `[1]`, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] broken archive", ownerID: "owner", inErr: domainerr.ErrImportInvalidArchive, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] template not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteImportInputStub{Err: tt.inErr}
+			p := presenter.NewNoteImportPresenter()
+			ctrl := NewNoteImportController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteImportOutputPort) port.NoteImportInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NoteImportPresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+
+			body := &bytes.Buffer{}
+			w := multipart.NewWriter(body)
+			part, _ := w.CreateFormFile("file", "adr.md")
+			_, _ = part.Write([]byte("# ADR\n## Context\nwhy\n"))
+			_ = w.WriteField("templateId", "tpl-1")
+			if tt.aliases != "" {
+				_ = w.WriteField("aliases", tt.aliases)
+			}
+			_ = w.Close()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/notes/import", body)
+			req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Import(c, openapi.NotesImportNotesParams{OwnerId: tt.ownerID, DryRun: tt.dryRun})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if input.Imported.TemplateID != "tpl-1" || input.Imported.FileName != "adr.md" || len(input.Imported.Aliases) != tt.wantAliases {
+				t.Fatalf("unexpected input: %+v", input.Imported)
+			}
+			if p.Response().DryRun != (tt.dryRun != nil) {
+				t.Fatalf("dryRun = %v", p.Response().DryRun)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteStreamController handles the live note change stream.
+type NoteStreamController struct {
+	inputFactory  func(bus port.EventBus, output port.NoteStreamOutputPort) port.NoteStreamInputPort
+	outputFactory func(w http.ResponseWriter) *presenter.NoteStreamPresenter
+	busFactory    func() port.EventBus
+}
+
+// NewNoteStreamController creates NoteStreamController.
+func NewNoteStreamController(
+	inputFactory func(bus port.EventBus, output port.NoteStreamOutputPort) port.NoteStreamInputPort,
+	outputFactory func(w http.ResponseWriter) *presenter.NoteStreamPresenter,
+	busFactory func() port.EventBus,
+) *NoteStreamController {
+	return &NoteStreamController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		busFactory:    busFactory,
+	}
+}
+
+// Stream handles GET /notes/events. It holds the connection open until the client goes away
+// or the server shuts down.
+func (c *NoteStreamController) Stream(ctx echo.Context, params openapi.NotesStreamNoteEventsParams) error {
+	res := ctx.Response()
+	header := res.Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	// keep reverse proxies from buffering the stream
+	header.Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	output := c.outputFactory(res)
+	input := c.inputFactory(c.busFactory(), output)
+	// the response is already committed, so a failed write only means the client is gone
+	_ = input.Stream(ctx.Request().Context(), port.NoteStreamInput{
+		Filter: event.StreamFilter{
+			ViewerID:   valueOrEmpty(params.ViewerId),
+			OwnerID:    valueOrEmpty(params.OwnerId),
+			TemplateID: valueOrEmpty(params.TemplateId),
+		},
+		LastEventID: valueOrEmpty(params.LastEventID),
+	})
+	return nil
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteStreamController(input *ctrlmock.NoteStreamInputStub) *NoteStreamController {
+	return NewNoteStreamController(
+		func(bus port.EventBus, output port.NoteStreamOutputPort) port.NoteStreamInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewNoteStreamPresenter,
+		func() port.EventBus { return nil },
+	)
+}
+
+func TestNoteStreamController_Stream(t *testing.T) {
+	viewer, lastID := "owner-1", "abc-3"
+	env := event.Envelope{ID: "abc-4", Event: event.Event{Name: event.NoteUpdated, AggregateID: "note-1"}}
+	tests := []struct {
+		name   string
+		params openapi.NotesStreamNoteEventsParams
+		inErr  error
+		want   event.StreamFilter
+		wantID string
+	}{
+		{name: "[Success] stream with filters and resume", params: openapi.NotesStreamNoteEventsParams{ViewerId: &viewer, OwnerId: &viewer, LastEventID: &lastID}, want: event.StreamFilter{ViewerID: viewer, OwnerID: viewer}, wantID: lastID},
+		{name: "[Success] anonymous stream"},
+		{name: "[Success] write error after commit is not reported", inErr: errors.New("broken pipe")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteStreamInputStub{Err: tt.inErr, Events: []event.Envelope{env}}
+			ctrl := newNoteStreamController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/notes/events", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if err := ctrl.Stream(c, tt.params); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rec.Code != http.StatusOK || rec.Header().Get(echo.HeaderContentType) != "text/event-stream" || rec.Header().Get(echo.HeaderCacheControl) != "no-cache" {
+				t.Fatalf("unexpected response: %d %v", rec.Code, rec.Header())
+			}
+			if input.Input.Filter != tt.want || input.Input.LastEventID != tt.wantID {
+				t.Fatalf("unexpected input: %+v", input.Input)
+			}
+			if !strings.Contains(rec.Body.String(), "id: abc-4\nevent: note.updated\n") {
+				t.Fatalf("unexpected body: %q", rec.Body.String())
+			}
+		})
+	}
+}
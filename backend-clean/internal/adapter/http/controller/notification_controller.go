@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotificationController handles notification center HTTP endpoints.
+type NotificationController struct {
+	inputFactory  func(repo port.NotificationRepository, output port.NotificationOutputPort) port.NotificationInputPort
+	outputFactory func() *presenter.NotificationPresenter
+	repoFactory   func() port.NotificationRepository
+}
+
+// NewNotificationController creates NotificationController.
+func NewNotificationController(
+	inputFactory func(repo port.NotificationRepository, output port.NotificationOutputPort) port.NotificationInputPort,
+	outputFactory func() *presenter.NotificationPresenter,
+	repoFactory func() port.NotificationRepository,
+) *NotificationController {
+	return &NotificationController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+	}
+}
+
+// List handles GET /notifications.
+func (c *NotificationController) List(ctx echo.Context, params openapi.NotificationsListNotificationsParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	unreadOnly := params.UnreadOnly != nil && *params.UnreadOnly
+	if err := input.List(ctx.Request().Context(), accountID, unreadOnly); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Notifications())
+}
+
+// UnreadCount handles GET /notifications/unread-count.
+func (c *NotificationController) UnreadCount(ctx echo.Context, params openapi.NotificationsGetUnreadNotificationCountParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.CountUnread(ctx.Request().Context(), accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Count())
+}
+
+// MarkRead handles POST /notifications/:notificationId/read.
+func (c *NotificationController) MarkRead(ctx echo.Context, notificationID string, params openapi.NotificationsMarkNotificationReadParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.MarkRead(ctx.Request().Context(), notificationID, accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Notification())
+}
+
+// MarkAllRead handles POST /notifications/read-all.
+func (c *NotificationController) MarkAllRead(ctx echo.Context, params openapi.NotificationsMarkAllNotificationsReadParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.MarkAllRead(ctx.Request().Context(), accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Count())
+}
+
+// GetPreferences handles GET /notifications/preferences.
+func (c *NotificationController) GetPreferences(ctx echo.Context, params openapi.NotificationsGetNotificationPreferencesParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.GetPreferences(ctx.Request().Context(), accountID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Preferences())
+}
+
+// UpdatePreferences handles PUT /notifications/preferences.
+func (c *NotificationController) UpdatePreferences(ctx echo.Context, params openapi.NotificationsUpdateNotificationPreferencesParams) error {
+	accountID := strings.TrimSpace(params.AccountId)
+	if accountID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	var body openapi.ModelsUpdateNotificationPreferencesRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	muted := make([]notification.Kind, 0, len(body.MutedKinds))
+	for _, k := range body.MutedKinds {
+		muted = append(muted, notification.Kind(k))
+	}
+	input, p := c.newIO()
+	err := input.UpdatePreferences(ctx.Request().Context(), port.NotificationPreferencesInput{AccountID: accountID, MutedKinds: muted})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Preferences())
+}
+
+func (c *NotificationController) newIO() (port.NotificationInputPort, *presenter.NotificationPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNotificationController(input *ctrlmock.NotificationInputStub) *NotificationController {
+	p := presenter.NewNotificationPresenter()
+	return NewNotificationController(
+		func(repo port.NotificationRepository, output port.NotificationOutputPort) port.NotificationInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.NotificationPresenter { return p },
+		func() port.NotificationRepository { return nil },
+	)
+}
+
+func TestNotificationController_List(t *testing.T) {
+	unreadOnly := true
+	tests := []struct {
+		name       string
+		accountID  string
+		unreadOnly *bool
+		wantUnread bool
+		wantStatus int
+	}{
+		{name: "[Success] list all", accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Success] list unread", accountID: "owner", unreadOnly: &unreadOnly, wantUnread: true, wantStatus: http.StatusOK},
+		{name: "[Fail] account missing", accountID: " ", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NotificationInputStub{}
+			ctrl := newNotificationController(input)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/notifications", nil), rec)
+
+			_ = ctrl.List(c, openapi.NotificationsListNotificationsParams{AccountId: tt.accountID, UnreadOnly: tt.unreadOnly})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && input.UnreadOnly != tt.wantUnread {
+				t.Fatalf("unreadOnly = %v, want %v", input.UnreadOnly, tt.wantUnread)
+			}
+		})
+	}
+}
+
+func TestNotificationController_MarkRead(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] mark read", wantStatus: http.StatusOK},
+		{name: "[Fail] not recipient", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newNotificationController(&ctrlmock.NotificationInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/api/notifications/n-1/read", nil), rec)
+
+			_ = ctrl.MarkRead(c, "n-1", openapi.NotificationsMarkNotificationReadParams{AccountId: "owner"})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestNotificationController_MarkAllRead(t *testing.T) {
+	ctrl := newNotificationController(&ctrlmock.NotificationInputStub{})
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodPost, "/api/notifications/read-all", nil), rec)
+
+	_ = ctrl.MarkAllRead(c, openapi.NotificationsMarkAllNotificationsReadParams{AccountId: "owner"})
+	var resp openapi.ModelsNotificationCountResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || rec.Code != http.StatusOK || resp.Count != 2 {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestNotificationController_UpdatePreferences(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] mute kind", body: `{"mutedKinds":["template.deprecated"]}`, wantStatus: http.StatusOK},
+		{name: "[Fail] invalid body", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] unknown kind", body: `{"mutedKinds":["comment.created"]}`, inErr: domainerr.ErrInvalidNotificationKind, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NotificationInputStub{Err: tt.inErr}
+			ctrl := newNotificationController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/notifications/preferences", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.UpdatePreferences(c, openapi.NotificationsUpdateNotificationPreferencesParams{AccountId: "owner"})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if len(input.Preferences.MutedKinds) != 1 || input.Preferences.MutedKinds[0] != notification.KindTemplateDeprecated {
+				t.Fatalf("unexpected input: %+v", input.Preferences)
+			}
+		})
+	}
+}
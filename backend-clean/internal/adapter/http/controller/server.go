@@ -8,14 +8,25 @@ import (
 
 // Server implements the OpenAPI ServerInterface by delegating to domain-specific controllers.
 type Server struct {
-	account  *AccountController
-	note     *NoteController
-	template *TemplateController
+	account      *AccountController
+	note         *NoteController
+	noteImport   *NoteImportController
+	noteBatch    *NoteBatchController
+	noteCSV      *NoteCSVController
+	template     *TemplateController
+	bundle       *TemplateBundleController
+	attachment   *AttachmentController
+	webhook      *WebhookController
+	noteStream   *NoteStreamController
+	collab       *CollabController
+	notification *NotificationController
+	follow       *FollowController
+	digest       *DigestController
 }
 
 // NewServer wires controller dependencies to generated ServerInterface.
-func NewServer(ac *AccountController, nc *NoteController, tc *TemplateController) *Server {
-	return &Server{account: ac, note: nc, template: tc}
+func NewServer(ac *AccountController, nc *NoteController, nic *NoteImportController, nbc *NoteBatchController, ncc *NoteCSVController, tc *TemplateController, tbc *TemplateBundleController, atc *AttachmentController, wc *WebhookController, nsc *NoteStreamController, cc *CollabController, ntc *NotificationController, fc *FollowController, dc *DigestController) *Server {
+	return &Server{account: ac, note: nc, noteImport: nic, noteBatch: nbc, noteCSV: ncc, template: tc, bundle: tbc, attachment: atc, webhook: wc, noteStream: nsc, collab: cc, notification: ntc, follow: fc, digest: dc}
 }
 
 // AccountsCreateOrGetAccount handles POST /api/accounts/auth.
@@ -43,6 +54,46 @@ func (s *Server) NotesListNotes(ctx echo.Context, params openapi.NotesListNotesP
 	return s.note.List(ctx, params)
 }
 
+// NotesStreamNoteEvents handles GET /api/notes/events.
+func (s *Server) NotesStreamNoteEvents(ctx echo.Context, params openapi.NotesStreamNoteEventsParams) error {
+	return s.noteStream.Stream(ctx, params)
+}
+
+// NotesCollaborateOnNote handles GET /api/notes/:noteId/collab.
+func (s *Server) NotesCollaborateOnNote(ctx echo.Context, noteID string, params openapi.NotesCollaborateOnNoteParams) error {
+	return s.collab.Collaborate(ctx, noteID, params)
+}
+
+// NotesExportNote handles GET /api/notes/:noteId/export.
+func (s *Server) NotesExportNote(ctx echo.Context, noteId string, params openapi.NotesExportNoteParams) error { //nolint:revive
+	return s.note.Export(ctx, noteId, params)
+}
+
+// NotesImportNotes handles POST /api/notes/import.
+func (s *Server) NotesImportNotes(ctx echo.Context, params openapi.NotesImportNotesParams) error {
+	return s.noteImport.Import(ctx, params)
+}
+
+// NotesBatchPublishNotes handles POST /api/notes/batch/publish.
+func (s *Server) NotesBatchPublishNotes(ctx echo.Context, params openapi.NotesBatchPublishNotesParams) error {
+	return s.noteBatch.Publish(ctx, params)
+}
+
+// NotesBatchUnpublishNotes handles POST /api/notes/batch/unpublish.
+func (s *Server) NotesBatchUnpublishNotes(ctx echo.Context, params openapi.NotesBatchUnpublishNotesParams) error {
+	return s.noteBatch.Unpublish(ctx, params)
+}
+
+// NotesBatchDeleteNotes handles POST /api/notes/batch/delete.
+func (s *Server) NotesBatchDeleteNotes(ctx echo.Context, params openapi.NotesBatchDeleteNotesParams) error {
+	return s.noteBatch.Delete(ctx, params)
+}
+
+// NotesBatchTransferNotes handles POST /api/notes/batch/transfer.
+func (s *Server) NotesBatchTransferNotes(ctx echo.Context, params openapi.NotesBatchTransferNotesParams) error {
+	return s.noteBatch.Transfer(ctx, params)
+}
+
 // NotesCreateNote handles POST /api/notes.
 func (s *Server) NotesCreateNote(ctx echo.Context) error {
 	return s.note.Create(ctx)
@@ -58,6 +109,16 @@ func (s *Server) NotesGetNoteById(ctx echo.Context, noteId string) error { //nol
 	return s.note.GetByID(ctx, noteId)
 }
 
+// NotesUpgradeNote handles POST /api/notes/:noteId/upgrade.
+func (s *Server) NotesUpgradeNote(ctx echo.Context, noteId string, params openapi.NotesUpgradeNoteParams) error { //nolint:revive
+	return s.note.Upgrade(ctx, noteId, params)
+}
+
+// NotesRetemplateNote handles POST /api/notes/:noteId/retemplate.
+func (s *Server) NotesRetemplateNote(ctx echo.Context, noteId string, params openapi.NotesRetemplateNoteParams) error { //nolint:revive
+	return s.note.Retemplate(ctx, noteId, params)
+}
+
 // NotesUpdateNote handles PUT /api/notes/:noteId.
 // NotesUpdateNote handles PUT /api/notes/:id.
 func (s *Server) NotesUpdateNote(ctx echo.Context, noteId string, params openapi.NotesUpdateNoteParams) error { //nolint:revive
@@ -76,6 +137,26 @@ func (s *Server) NotesUnpublishNote(ctx echo.Context, noteId string, params open
 	return s.note.Unpublish(ctx, noteId, params)
 }
 
+// AttachmentsListAttachments handles GET /api/notes/:noteId/attachments.
+func (s *Server) AttachmentsListAttachments(ctx echo.Context, noteId string, params openapi.AttachmentsListAttachmentsParams) error { //nolint:revive
+	return s.attachment.List(ctx, noteId, params)
+}
+
+// AttachmentsUploadAttachment handles POST /api/notes/:noteId/attachments.
+func (s *Server) AttachmentsUploadAttachment(ctx echo.Context, noteId string, params openapi.AttachmentsUploadAttachmentParams) error { //nolint:revive
+	return s.attachment.Upload(ctx, noteId, params)
+}
+
+// AttachmentsDownloadAttachment handles GET /api/notes/:noteId/attachments/:attachmentId.
+func (s *Server) AttachmentsDownloadAttachment(ctx echo.Context, noteId string, attachmentId string, params openapi.AttachmentsDownloadAttachmentParams) error { //nolint:revive
+	return s.attachment.Download(ctx, noteId, attachmentId, params)
+}
+
+// AttachmentsDeleteAttachment handles DELETE /api/notes/:noteId/attachments/:attachmentId.
+func (s *Server) AttachmentsDeleteAttachment(ctx echo.Context, noteId string, attachmentId string, params openapi.AttachmentsDeleteAttachmentParams) error { //nolint:revive
+	return s.attachment.Delete(ctx, noteId, attachmentId, params)
+}
+
 // TemplatesListTemplates handles GET /api/templates.
 func (s *Server) TemplatesListTemplates(ctx echo.Context, params openapi.TemplatesListTemplatesParams) error {
 	return s.template.List(ctx, params)
@@ -91,9 +172,39 @@ func (s *Server) TemplatesDeleteTemplate(ctx echo.Context, templateId string, pa
 	return s.template.Delete(ctx, templateId, params)
 }
 
+// TemplatesForkTemplate handles POST /api/templates/:id/fork.
+func (s *Server) TemplatesForkTemplate(ctx echo.Context, templateId string, params openapi.TemplatesForkTemplateParams) error { //nolint:revive
+	return s.template.Fork(ctx, templateId, params)
+}
+
+// TemplatesDeprecateTemplate handles POST /api/templates/:id/deprecation.
+func (s *Server) TemplatesDeprecateTemplate(ctx echo.Context, templateId string, params openapi.TemplatesDeprecateTemplateParams) error { //nolint:revive
+	return s.template.Deprecate(ctx, templateId, params)
+}
+
+// TemplatesUndeprecateTemplate handles DELETE /api/templates/:id/deprecation.
+func (s *Server) TemplatesUndeprecateTemplate(ctx echo.Context, templateId string, params openapi.TemplatesUndeprecateTemplateParams) error { //nolint:revive
+	return s.template.Undeprecate(ctx, templateId, params)
+}
+
+// TemplatesExportTemplateBundle handles GET /api/templates/bundle.
+func (s *Server) TemplatesExportTemplateBundle(ctx echo.Context, params openapi.TemplatesExportTemplateBundleParams) error {
+	return s.bundle.Export(ctx, params)
+}
+
+// TemplatesImportTemplateBundle handles POST /api/templates/bundle.
+func (s *Server) TemplatesImportTemplateBundle(ctx echo.Context, params openapi.TemplatesImportTemplateBundleParams) error {
+	return s.bundle.Import(ctx, params)
+}
+
+// TemplatesGetTemplateVersion handles GET /api/templates/:id/versions/:version.
+func (s *Server) TemplatesGetTemplateVersion(ctx echo.Context, templateId string, version int32, params openapi.TemplatesGetTemplateVersionParams) error { //nolint:revive
+	return s.template.GetVersion(ctx, templateId, version, params)
+}
+
 // TemplatesGetTemplateById handles GET /api/templates/:id.
-func (s *Server) TemplatesGetTemplateById(ctx echo.Context, templateId string) error { //nolint:revive
-	return s.template.GetByID(ctx, templateId)
+func (s *Server) TemplatesGetTemplateById(ctx echo.Context, templateId string, params openapi.TemplatesGetTemplateByIdParams) error { //nolint:revive
+	return s.template.GetByID(ctx, templateId, params)
 }
 
 // TemplatesUpdateTemplate handles PUT /api/templates/:templateId.
@@ -101,3 +212,118 @@ func (s *Server) TemplatesGetTemplateById(ctx echo.Context, templateId string) e
 func (s *Server) TemplatesUpdateTemplate(ctx echo.Context, templateId string, params openapi.TemplatesUpdateTemplateParams) error { //nolint:revive
 	return s.template.Update(ctx, templateId, params)
 }
+
+// TemplatesListTemplateNotes handles GET /api/templates/:id/notes.
+func (s *Server) TemplatesListTemplateNotes(ctx echo.Context, templateId string, params openapi.TemplatesListTemplateNotesParams) error { //nolint:revive
+	return s.note.ListByTemplate(ctx, templateId, params)
+}
+
+// TemplatesExportTemplateNotesCsv handles GET /api/templates/:id/notes.csv.
+func (s *Server) TemplatesExportTemplateNotesCsv(ctx echo.Context, templateId string, params openapi.TemplatesExportTemplateNotesCsvParams) error { //nolint:revive
+	return s.noteCSV.Export(ctx, templateId, params)
+}
+
+// TemplatesImportTemplateNotesCsv handles POST /api/templates/:id/notes.csv.
+func (s *Server) TemplatesImportTemplateNotesCsv(ctx echo.Context, templateId string, params openapi.TemplatesImportTemplateNotesCsvParams) error { //nolint:revive
+	return s.noteCSV.Import(ctx, templateId, params)
+}
+
+// WebhooksListWebhooks handles GET /api/webhooks.
+func (s *Server) WebhooksListWebhooks(ctx echo.Context, params openapi.WebhooksListWebhooksParams) error {
+	return s.webhook.List(ctx, params)
+}
+
+// WebhooksCreateWebhook handles POST /api/webhooks.
+func (s *Server) WebhooksCreateWebhook(ctx echo.Context, params openapi.WebhooksCreateWebhookParams) error {
+	return s.webhook.Create(ctx, params)
+}
+
+// WebhooksGetWebhook handles GET /api/webhooks/:webhookId.
+func (s *Server) WebhooksGetWebhook(ctx echo.Context, webhookId string, params openapi.WebhooksGetWebhookParams) error { //nolint:revive
+	return s.webhook.GetByID(ctx, webhookId, params)
+}
+
+// WebhooksUpdateWebhook handles PUT /api/webhooks/:webhookId.
+func (s *Server) WebhooksUpdateWebhook(ctx echo.Context, webhookId string, params openapi.WebhooksUpdateWebhookParams) error { //nolint:revive
+	return s.webhook.Update(ctx, webhookId, params)
+}
+
+// WebhooksDeleteWebhook handles DELETE /api/webhooks/:webhookId.
+func (s *Server) WebhooksDeleteWebhook(ctx echo.Context, webhookId string, params openapi.WebhooksDeleteWebhookParams) error { //nolint:revive
+	return s.webhook.Delete(ctx, webhookId, params)
+}
+
+// WebhooksListWebhookDeliveries handles GET /api/webhooks/:webhookId/deliveries.
+func (s *Server) WebhooksListWebhookDeliveries(ctx echo.Context, webhookId string, params openapi.WebhooksListWebhookDeliveriesParams) error { //nolint:revive
+	return s.webhook.ListDeliveries(ctx, webhookId, params)
+}
+
+// WebhooksRedeliverWebhookDelivery handles POST /api/webhooks/:webhookId/deliveries/:deliveryId/redeliver.
+func (s *Server) WebhooksRedeliverWebhookDelivery(ctx echo.Context, webhookId string, deliveryId string, params openapi.WebhooksRedeliverWebhookDeliveryParams) error { //nolint:revive
+	return s.webhook.Redeliver(ctx, webhookId, deliveryId, params)
+}
+
+// NotificationsListNotifications handles GET /api/notifications.
+func (s *Server) NotificationsListNotifications(ctx echo.Context, params openapi.NotificationsListNotificationsParams) error {
+	return s.notification.List(ctx, params)
+}
+
+// NotificationsGetUnreadNotificationCount handles GET /api/notifications/unread-count.
+func (s *Server) NotificationsGetUnreadNotificationCount(ctx echo.Context, params openapi.NotificationsGetUnreadNotificationCountParams) error {
+	return s.notification.UnreadCount(ctx, params)
+}
+
+// NotificationsMarkNotificationRead handles POST /api/notifications/:notificationId/read.
+func (s *Server) NotificationsMarkNotificationRead(ctx echo.Context, notificationId string, params openapi.NotificationsMarkNotificationReadParams) error { //nolint:revive
+	return s.notification.MarkRead(ctx, notificationId, params)
+}
+
+// NotificationsMarkAllNotificationsRead handles POST /api/notifications/read-all.
+func (s *Server) NotificationsMarkAllNotificationsRead(ctx echo.Context, params openapi.NotificationsMarkAllNotificationsReadParams) error {
+	return s.notification.MarkAllRead(ctx, params)
+}
+
+// NotificationsGetNotificationPreferences handles GET /api/notifications/preferences.
+func (s *Server) NotificationsGetNotificationPreferences(ctx echo.Context, params openapi.NotificationsGetNotificationPreferencesParams) error {
+	return s.notification.GetPreferences(ctx, params)
+}
+
+// NotificationsUpdateNotificationPreferences handles PUT /api/notifications/preferences.
+func (s *Server) NotificationsUpdateNotificationPreferences(ctx echo.Context, params openapi.NotificationsUpdateNotificationPreferencesParams) error {
+	return s.notification.UpdatePreferences(ctx, params)
+}
+
+// AccountsFollowAccount handles POST /api/accounts/:accountId/follow.
+func (s *Server) AccountsFollowAccount(ctx echo.Context, accountId string, params openapi.AccountsFollowAccountParams) error { //nolint:revive
+	return s.follow.Follow(ctx, accountId, params)
+}
+
+// AccountsUnfollowAccount handles DELETE /api/accounts/:accountId/follow.
+func (s *Server) AccountsUnfollowAccount(ctx echo.Context, accountId string, params openapi.AccountsUnfollowAccountParams) error { //nolint:revive
+	return s.follow.Unfollow(ctx, accountId, params)
+}
+
+// FeedGetFeed handles GET /api/feed.
+func (s *Server) FeedGetFeed(ctx echo.Context, params openapi.FeedGetFeedParams) error {
+	return s.follow.Feed(ctx, params)
+}
+
+// DigestsGetDigestSettings handles GET /api/digests/settings.
+func (s *Server) DigestsGetDigestSettings(ctx echo.Context, params openapi.DigestsGetDigestSettingsParams) error {
+	return s.digest.GetSettings(ctx, params)
+}
+
+// DigestsUpdateDigestSettings handles PUT /api/digests/settings.
+func (s *Server) DigestsUpdateDigestSettings(ctx echo.Context, params openapi.DigestsUpdateDigestSettingsParams) error {
+	return s.digest.UpdateSettings(ctx, params)
+}
+
+// DigestsConfirmUnsubscribeDigest handles GET /api/digests/unsubscribe.
+func (s *Server) DigestsConfirmUnsubscribeDigest(ctx echo.Context, params openapi.DigestsConfirmUnsubscribeDigestParams) error {
+	return s.digest.ConfirmUnsubscribe(ctx, params)
+}
+
+// DigestsUnsubscribeDigest handles POST /api/digests/unsubscribe.
+func (s *Server) DigestsUnsubscribeDigest(ctx echo.Context, params openapi.DigestsUnsubscribeDigestParams) error {
+	return s.digest.Unsubscribe(ctx, params)
+}
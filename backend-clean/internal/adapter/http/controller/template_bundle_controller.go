@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateBundleController handles bundle export and import of templates.
+type TemplateBundleController struct {
+	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort
+	outputFactory func() *presenter.TemplateBundlePresenter
+	repoFactory   func() port.TemplateRepository
+	txFactory     func() port.TxManager
+}
+
+// NewTemplateBundleController creates TemplateBundleController.
+func NewTemplateBundleController(
+	inputFactory func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort,
+	outputFactory func() *presenter.TemplateBundlePresenter,
+	repoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+) *TemplateBundleController {
+	return &TemplateBundleController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+		txFactory:     txFactory,
+	}
+}
+
+// bundleContentTypes maps bundle formats to response content types.
+var bundleContentTypes = map[template.BundleFormat]string{
+	template.BundleFormatJSON: "application/json; charset=utf-8",
+	template.BundleFormatYAML: "application/yaml; charset=utf-8",
+}
+
+// Export handles GET /templates/bundle.
+func (c *TemplateBundleController) Export(ctx echo.Context, params openapi.TemplatesExportTemplateBundleParams) error {
+	format := template.BundleFormatJSON
+	if params.Format != nil {
+		format = template.BundleFormat(*params.Format)
+	}
+	if err := format.Validate(); err != nil {
+		return handleError(ctx, err)
+	}
+	var ids []string
+	if params.Ids != nil {
+		ids = *params.Ids
+	}
+	input, p := c.newIO()
+	err := input.Export(ctx.Request().Context(), port.TemplateBundleExportInput{
+		IDs:      ids,
+		OwnerID:  strings.TrimSpace(valueOrEmpty(params.OwnerId)),
+		ViewerID: valueOrEmpty(params.ViewerId),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	doc, err := p.Document(format)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": "templates." + string(format)}))
+	return ctx.Blob(http.StatusOK, bundleContentTypes[format], doc)
+}
+
+// Import handles POST /templates/bundle.
+func (c *TemplateBundleController) Import(ctx echo.Context, params openapi.TemplatesImportTemplateBundleParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, template.MaxBundleSize+multipartOverhead)
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	defer func() { _ = file.Close() }()
+	content, err := io.ReadAll(io.LimitReader(file, template.MaxBundleSize+1))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	var mode template.ConflictMode
+	if params.OnConflict != nil {
+		mode = template.ConflictMode(*params.OnConflict)
+	}
+
+	input, p := c.newIO()
+	err = input.Import(req.Context(), port.TemplateBundleImportInput{
+		OwnerID:    ownerID,
+		Content:    content,
+		OnConflict: mode,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Response())
+}
+
+func (c *TemplateBundleController) newIO() (port.TemplateBundleInputPort, *presenter.TemplateBundlePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), c.txFactory(), output)
+	return input, output
+}
@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTemplateBundleTestController(input *ctrlmock.TemplateBundleInputStub) *TemplateBundleController {
+	return NewTemplateBundleController(
+		func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewTemplateBundlePresenter,
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestTemplateBundleController_Export(t *testing.T) {
+	yaml := openapi.ModelsTemplateBundleFormat("yaml")
+	xml := openapi.ModelsTemplateBundleFormat("xml")
+	tests := []struct {
+		name        string
+		format      *openapi.ModelsTemplateBundleFormat
+		inErr       error
+		wantStatus  int
+		wantType    string
+		wantPrefix  string
+		wantFileExt string
+	}{
+		{name: "[Success] json by default", wantStatus: http.StatusOK, wantType: "application/json", wantPrefix: "{\n  \"version\": 1", wantFileExt: "templates.json"},
+		{name: "[Success] yaml", format: &yaml, wantStatus: http.StatusOK, wantType: "application/yaml", wantPrefix: "version: 1\n", wantFileExt: "templates.yaml"},
+		{name: "[Fail] unknown format", format: &xml, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] template not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateBundleInputStub{Err: tt.inErr, Templates: []template.Template{{Name: "Daily", Fields: []template.Field{{Label: "Body", Order: 1}}}}}
+			ctrl := newTemplateBundleTestController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/templates/bundle", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			ids := []string{"tpl-1"}
+			_ = ctrl.Export(c, openapi.TemplatesExportTemplateBundleParams{Ids: &ids, Format: tt.format})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if len(input.Exported.IDs) != 1 || input.Exported.IDs[0] != "tpl-1" {
+				t.Fatalf("unexpected input: %+v", input.Exported)
+			}
+			if ct := rec.Header().Get(echo.HeaderContentType); !strings.HasPrefix(ct, tt.wantType) {
+				t.Fatalf("content type = %q", ct)
+			}
+			if cd := rec.Header().Get(echo.HeaderContentDisposition); !strings.Contains(cd, tt.wantFileExt) {
+				t.Fatalf("content disposition = %q", cd)
+			}
+			if !strings.HasPrefix(rec.Body.String(), tt.wantPrefix) {
+				t.Fatalf("body = %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTemplateBundleController_Import(t *testing.T) {
+	rename := openapi.ModelsTemplateConflictMode("rename")
+	tests := []struct {
+		name       string
+		ownerID    string
+		withFile   bool
+		onConflict *openapi.ModelsTemplateConflictMode
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] import", ownerID: "owner", withFile: true, onConflict: &rename, wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", ownerID: "", withFile: true, wantStatus: http.StatusForbidden},
+		{name: "[Fail] file missing", ownerID: "owner", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] invalid bundle", ownerID: "owner", withFile: true, inErr: domainerr.ErrBundleInvalid, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TemplateBundleInputStub{Err: tt.inErr}
+			ctrl := newTemplateBundleTestController(input)
+
+			body := &bytes.Buffer{}
+			w := multipart.NewWriter(body)
+			if tt.withFile {
+				part, _ := w.CreateFormFile("file", "templates.yaml")
+				_, _ = part.Write([]byte("version: 1\n"))
+			}
+			_ = w.Close()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/templates/bundle", body)
+			req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Import(c, openapi.TemplatesImportTemplateBundleParams{OwnerId: tt.ownerID, OnConflict: tt.onConflict})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if input.Imported.OnConflict != template.ConflictRename || string(input.Imported.Content) != "version: 1\n" {
+				t.Fatalf("unexpected input: %+v", input.Imported)
+			}
+			if !strings.Contains(rec.Body.String(), `"created":1`) {
+				t.Fatalf("body = %q", rec.Body.String())
+			}
+		})
+	}
+}
@@ -40,8 +40,11 @@ func NewTemplateController(
 // List handles GET /templates.
 func (c *TemplateController) List(ctx echo.Context, params openapi.TemplatesListTemplatesParams) error {
 	filters := template.Filters{
-		Query:   params.Q,
-		OwnerID: params.OwnerId,
+		Query:             params.Q,
+		OwnerID:           params.OwnerId,
+		FieldLabel:        params.FieldLabel,
+		ViewerID:          params.ViewerId,
+		IncludeDeprecated: params.IncludeDeprecated != nil && *params.IncludeDeprecated,
 	}
 	input, p := c.newIO()
 	if err := input.List(ctx.Request().Context(), filters); err != nil {
@@ -51,9 +54,18 @@ func (c *TemplateController) List(ctx echo.Context, params openapi.TemplatesList
 }
 
 // GetByID handles GET /templates/:id.
-func (c *TemplateController) GetByID(ctx echo.Context, templateID string) error {
+func (c *TemplateController) GetByID(ctx echo.Context, templateID string, params openapi.TemplatesGetTemplateByIdParams) error {
 	input, p := c.newIO()
-	if err := input.Get(ctx.Request().Context(), templateID); err != nil {
+	if err := input.Get(ctx.Request().Context(), templateID, valueOrEmpty(params.ViewerId)); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// GetVersion handles GET /templates/:id/versions/:version.
+func (c *TemplateController) GetVersion(ctx echo.Context, templateID string, version int32, params openapi.TemplatesGetTemplateVersionParams) error {
+	input, p := c.newIO()
+	if err := input.GetVersion(ctx.Request().Context(), templateID, int(version), valueOrEmpty(params.ViewerId)); err != nil {
 		return handleError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, p.Template())
@@ -69,16 +81,24 @@ func (c *TemplateController) Create(ctx echo.Context) error {
 	fields := make([]template.Field, 0, len(body.Fields))
 	for _, f := range body.Fields {
 		fields = append(fields, template.Field{
-			Label:      f.Label,
-			Order:      int(f.Order),
-			IsRequired: f.IsRequired,
+			Label:       f.Label,
+			Order:       int(f.Order),
+			IsRequired:  f.IsRequired,
+			Type:        toFieldType(f.Type),
+			Options:     toFieldOptions(f.Options),
+			MinLength:   toIntPtr(f.MinLength),
+			MaxLength:   toIntPtr(f.MaxLength),
+			Pattern:     valueOrEmpty(f.Pattern),
+			Placeholder: valueOrEmpty(f.Placeholder),
+			HelpText:    valueOrEmpty(f.HelpText),
 		})
 	}
 	input, p := c.newIO()
 	err := input.Create(ctx.Request().Context(), port.TemplateCreateInput{
-		Name:    body.Name,
-		OwnerID: ownerID,
-		Fields:  fields,
+		Name:       body.Name,
+		OwnerID:    ownerID,
+		Fields:     fields,
+		Visibility: toVisibility(body.Visibility),
 	})
 	if err != nil {
 		return handleError(ctx, err)
@@ -99,18 +119,70 @@ func (c *TemplateController) Update(ctx echo.Context, templateID string, params
 	fields := make([]template.Field, 0, len(body.Fields))
 	for _, f := range body.Fields {
 		fields = append(fields, template.Field{
-			ID:         valueOrEmpty(f.Id),
-			Label:      f.Label,
-			Order:      int(f.Order),
-			IsRequired: f.IsRequired,
+			ID:          valueOrEmpty(f.Id),
+			Label:       f.Label,
+			Order:       int(f.Order),
+			IsRequired:  f.IsRequired,
+			Type:        toFieldType(f.Type),
+			Options:     toFieldOptions(f.Options),
+			MinLength:   toIntPtr(f.MinLength),
+			MaxLength:   toIntPtr(f.MaxLength),
+			Pattern:     valueOrEmpty(f.Pattern),
+			Placeholder: valueOrEmpty(f.Placeholder),
+			HelpText:    valueOrEmpty(f.HelpText),
 		})
 	}
 	input, p := c.newIO()
 	err := input.Update(ctx.Request().Context(), port.TemplateUpdateInput{
+		ID:         templateID,
+		Name:       body.Name,
+		Fields:     fields,
+		OwnerID:    ownerID,
+		Visibility: toVisibility(body.Visibility),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// Fork handles POST /templates/:id/fork.
+func (c *TemplateController) Fork(ctx echo.Context, templateID string, params openapi.TemplatesForkTemplateParams) error {
+	var body openapi.ModelsForkTemplateRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	err := input.Fork(ctx.Request().Context(), port.TemplateForkInput{
 		ID:      templateID,
-		Name:    body.Name,
-		Fields:  fields,
 		OwnerID: ownerID,
+		Name:    strings.TrimSpace(valueOrEmpty(body.Name)),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// Deprecate handles POST /templates/:id/deprecation.
+func (c *TemplateController) Deprecate(ctx echo.Context, templateID string, params openapi.TemplatesDeprecateTemplateParams) error {
+	var body openapi.ModelsDeprecateTemplateRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	err := input.Deprecate(ctx.Request().Context(), port.TemplateDeprecateInput{
+		ID:          templateID,
+		OwnerID:     ownerID,
+		SuccessorID: strings.TrimSpace(valueOrEmpty(body.SuccessorTemplateId)),
 	})
 	if err != nil {
 		return handleError(ctx, err)
@@ -118,6 +190,19 @@ func (c *TemplateController) Update(ctx echo.Context, templateID string, params
 	return ctx.JSON(http.StatusOK, p.Template())
 }
 
+// Undeprecate handles DELETE /templates/:id/deprecation.
+func (c *TemplateController) Undeprecate(ctx echo.Context, templateID string, params openapi.TemplatesUndeprecateTemplateParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Undeprecate(ctx.Request().Context(), templateID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
 // Delete handles DELETE /templates/:id.
 func (c *TemplateController) Delete(ctx echo.Context, templateID string, params openapi.TemplatesDeleteTemplateParams) error {
 	ownerID := strings.TrimSpace(params.OwnerId)
@@ -131,6 +216,39 @@ func (c *TemplateController) Delete(ctx echo.Context, templateID string, params
 	return ctx.JSON(http.StatusOK, p.DeleteResponse())
 }
 
+func toFieldType(t *openapi.ModelsFieldType) template.FieldType {
+	if t == nil {
+		return ""
+	}
+	return template.FieldType(*t)
+}
+
+func toVisibility(v *openapi.ModelsTemplateVisibility) template.Visibility {
+	if v == nil {
+		return ""
+	}
+	return template.Visibility(*v)
+}
+
+func toFieldOptions(o *openapi.ModelsFieldOptions) template.FieldOptions {
+	if o == nil {
+		return template.FieldOptions{}
+	}
+	opts := template.FieldOptions{Min: o.Min, Max: o.Max}
+	if o.Choices != nil {
+		opts.Choices = *o.Choices
+	}
+	return opts
+}
+
+func toIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
 func (c *TemplateController) newIO() (port.TemplateInputPort, *presenter.TemplatePresenter) {
 	output := c.outputFactory()
 	input := c.inputFactory(c.repoFactory(), c.txFactory(), output)
@@ -125,7 +125,7 @@ func TestTemplateController_Get(t *testing.T) {
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.GetByID(c, "t1")
+			_ = ctrl.GetByID(c, "t1", openapi.TemplatesGetTemplateByIdParams{})
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
@@ -173,6 +173,13 @@ func TestTemplateController_Update(t *testing.T) {
 			inErr:      domainerr.ErrNotFound,
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name:       "[Fail] invalid field options",
+			body:       `{"name":"updated","fields":[{"id":"f1","label":"Priority","order":1,"isRequired":true,"type":"select"}]}`,
+			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: "owner"},
+			inErr:      domainerr.ErrInvalidFieldOptions,
+			wantStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,6 +198,115 @@ func TestTemplateController_Update(t *testing.T) {
 	}
 }
 
+func TestTemplateController_Fork(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+		wantName   string
+	}{
+		{name: "[Success] fork with name", body: `{"name":" Mine "}`, ownerID: "owner", wantStatus: http.StatusOK, wantName: "Mine"},
+		{name: "[Success] fork keeping name", body: `{}`, ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", body: `{}`, ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid body", body: `{`, ownerID: "owner", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] not found", body: `{}`, ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTemplatePresenter()
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := NewTemplateController(
+				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TemplatePresenter { return p },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/templates/t1/fork", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Fork(c, "t1", openapi.TemplatesForkTemplateParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK {
+				resp := p.Template()
+				if resp.Name != tt.wantName || resp.ForkedFromId == nil || *resp.ForkedFromId != "t1" {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateController_Deprecate(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		body          string
+		ownerID       string
+		inErr         error
+		wantStatus    int
+		wantDeprecate bool
+		wantSuccessor string
+	}{
+		{name: "[Success] deprecate with successor", method: http.MethodPost, body: `{"successorTemplateId":" t2 "}`, ownerID: "owner", wantStatus: http.StatusOK, wantDeprecate: true, wantSuccessor: "t2"},
+		{name: "[Success] deprecate without successor", method: http.MethodPost, body: `{}`, ownerID: "owner", wantStatus: http.StatusOK, wantDeprecate: true},
+		{name: "[Success] undeprecate", method: http.MethodDelete, ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", method: http.MethodPost, body: `{}`, ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid body", method: http.MethodPost, body: `{`, ownerID: "owner", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] invalid successor", method: http.MethodPost, body: `{"successorTemplateId":"t1"}`, ownerID: "owner", inErr: domainerr.ErrInvalidSuccessor, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] undeprecate not owner", method: http.MethodDelete, ownerID: "other", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTemplatePresenter()
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := NewTemplateController(
+				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TemplatePresenter { return p },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+			)
+
+			req := httptest.NewRequest(tt.method, "/api/templates/t1/deprecation", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if tt.method == http.MethodPost {
+				_ = ctrl.Deprecate(c, "t1", openapi.TemplatesDeprecateTemplateParams{OwnerId: tt.ownerID})
+			} else {
+				_ = ctrl.Undeprecate(c, "t1", openapi.TemplatesUndeprecateTemplateParams{OwnerId: tt.ownerID})
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			resp := p.Template()
+			if resp.Deprecated != tt.wantDeprecate || valueOrEmpty(resp.SuccessorTemplateId) != tt.wantSuccessor {
+				t.Fatalf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
 func TestTemplateController_Delete(t *testing.T) {
 	tests := []struct {
 		name       string
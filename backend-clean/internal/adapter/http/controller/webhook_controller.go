@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookController handles webhook subscription HTTP endpoints.
+type WebhookController struct {
+	inputFactory  func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort
+	outputFactory func() *presenter.WebhookPresenter
+	repoFactory   func() port.WebhookRepository
+}
+
+// NewWebhookController creates WebhookController.
+func NewWebhookController(
+	inputFactory func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort,
+	outputFactory func() *presenter.WebhookPresenter,
+	repoFactory func() port.WebhookRepository,
+) *WebhookController {
+	return &WebhookController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+	}
+}
+
+// List handles GET /webhooks.
+func (c *WebhookController) List(ctx echo.Context, params openapi.WebhooksListWebhooksParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhooks())
+}
+
+// Create handles POST /webhooks.
+func (c *WebhookController) Create(ctx echo.Context, params openapi.WebhooksCreateWebhookParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	var body openapi.ModelsCreateWebhookRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	input, p := c.newIO()
+	err := input.Create(ctx.Request().Context(), port.WebhookCreateInput{
+		OwnerID: ownerID,
+		URL:     strings.TrimSpace(body.Url),
+		Secret:  body.Secret,
+		Events:  toEventNames(body.Events),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// GetByID handles GET /webhooks/:webhookId.
+func (c *WebhookController) GetByID(ctx echo.Context, webhookID string, params openapi.WebhooksGetWebhookParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Get(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Update handles PUT /webhooks/:webhookId.
+func (c *WebhookController) Update(ctx echo.Context, webhookID string, params openapi.WebhooksUpdateWebhookParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	var body openapi.ModelsUpdateWebhookRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+	}
+	input, p := c.newIO()
+	err := input.Update(ctx.Request().Context(), port.WebhookUpdateInput{
+		ID:      webhookID,
+		OwnerID: ownerID,
+		URL:     strings.TrimSpace(body.Url),
+		Secret:  valueOrEmpty(body.Secret),
+		Events:  toEventNames(body.Events),
+		Active:  body.Active,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Delete handles DELETE /webhooks/:webhookId.
+func (c *WebhookController) Delete(ctx echo.Context, webhookID string, params openapi.WebhooksDeleteWebhookParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// ListDeliveries handles GET /webhooks/:webhookId/deliveries.
+func (c *WebhookController) ListDeliveries(ctx echo.Context, webhookID string, params openapi.WebhooksListWebhookDeliveriesParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.ListDeliveries(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Deliveries())
+}
+
+// Redeliver handles POST /webhooks/:webhookId/deliveries/:deliveryId/redeliver.
+func (c *WebhookController) Redeliver(ctx echo.Context, webhookID, deliveryID string, params openapi.WebhooksRedeliverWebhookDeliveryParams) error {
+	ownerID := strings.TrimSpace(params.OwnerId)
+	if ownerID == "" {
+		return handleError(ctx, domainerr.ErrUnauthorized)
+	}
+	input, p := c.newIO()
+	if err := input.Redeliver(ctx.Request().Context(), webhookID, deliveryID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Delivery())
+}
+
+func (c *WebhookController) newIO() (port.WebhookInputPort, *presenter.WebhookPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), output)
+	return input, output
+}
+
+func toEventNames(events *[]openapi.ModelsWebhookEvent) []event.Name {
+	if events == nil {
+		return nil
+	}
+	res := make([]event.Name, 0, len(*events))
+	for _, e := range *events {
+		res = append(res, event.Name(e))
+	}
+	return res
+}
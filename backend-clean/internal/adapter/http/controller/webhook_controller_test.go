@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newWebhookController(input *ctrlmock.WebhookInputStub) *WebhookController {
+	p := presenter.NewWebhookPresenter()
+	return NewWebhookController(
+		func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+			input.Output = output
+			return input
+		},
+		func() *presenter.WebhookPresenter { return p },
+		func() port.WebhookRepository { return nil },
+	)
+}
+
+func TestWebhookController_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		body       string
+		inErr      error
+		wantStatus int
+	}{
+		{
+			name:       "[Success] create webhook",
+			ownerID:    "owner",
+			body:       `{"url":" https://example.com/hook ","secret":"0123456789abcdef","events":["note.created"]}`,
+			wantStatus: http.StatusOK,
+		},
+		{name: "[Fail] owner missing", ownerID: "", body: `{}`, wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid body", ownerID: "owner", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "[Fail] invalid url", ownerID: "owner", body: `{"url":"nope","secret":"0123456789abcdef"}`, inErr: domainerr.ErrInvalidWebhookURL, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WebhookInputStub{Err: tt.inErr}
+			ctrl := newWebhookController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/webhooks", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Create(c, openapi.WebhooksCreateWebhookParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if input.Created.URL != "https://example.com/hook" || len(input.Created.Events) != 1 || input.Created.Events[0] != event.NoteCreated {
+				t.Fatalf("unexpected input: %+v", input.Created)
+			}
+			if strings.Contains(rec.Body.String(), "secret") {
+				t.Fatalf("secret leaked: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookController_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantSecret string
+		wantStatus int
+	}{
+		{name: "[Success] keep secret", body: `{"url":"https://example.com/hook","active":false}`, wantStatus: http.StatusOK},
+		{name: "[Success] rotate secret", body: `{"url":"https://example.com/hook","secret":"fedcba9876543210","active":true}`, wantSecret: "fedcba9876543210", wantStatus: http.StatusOK},
+		{name: "[Fail] not owner", body: `{"url":"https://example.com/hook","active":true}`, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] short secret", body: `{"url":"https://example.com/hook","secret":"x","active":true}`, inErr: domainerr.ErrWebhookSecretTooShort, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WebhookInputStub{Err: tt.inErr}
+			ctrl := newWebhookController(input)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/webhooks/wh-1", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Update(c, "wh-1", openapi.WebhooksUpdateWebhookParams{OwnerId: "owner"})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && input.Updated.Secret != tt.wantSecret {
+				t.Fatalf("secret = %q, want %q", input.Updated.Secret, tt.wantSecret)
+			}
+		})
+	}
+}
+
+func TestWebhookController_Redeliver(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] redeliver", ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] delivery not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newWebhookController(&ctrlmock.WebhookInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/webhooks/wh-1/deliveries/dl-1/redeliver", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Redeliver(c, "wh-1", "dl-1", openapi.WebhooksRedeliverWebhookDeliveryParams{OwnerId: tt.ownerID})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got openapi.ModelsWebhookDeliveryResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || got.RedeliveryOf == nil || *got.RedeliveryOf != "dl-1" {
+				t.Fatalf("unexpected body: %s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -18,6 +18,92 @@ const (
 	ModelsBadRequestErrorCodeBADREQUEST ModelsBadRequestErrorCode = "BAD_REQUEST"
 )
 
+// Defines values for ModelsBatchMode.
+const (
+	ModelsBatchModeAllOrNothing ModelsBatchMode = "all_or_nothing"
+	ModelsBatchModeBestEffort   ModelsBatchMode = "best_effort"
+)
+
+// Defines values for ModelsCollabCommandType.
+const (
+	ModelsCollabCommandTypeChange ModelsCollabCommandType = "change"
+	ModelsCollabCommandTypeLock   ModelsCollabCommandType = "lock"
+	ModelsCollabCommandTypeSave   ModelsCollabCommandType = "save"
+	ModelsCollabCommandTypeUnlock ModelsCollabCommandType = "unlock"
+)
+
+// Defines values for ModelsCollabMessageType.
+const (
+	ModelsCollabMessageTypeChanged  ModelsCollabMessageType = "changed"
+	ModelsCollabMessageTypeError    ModelsCollabMessageType = "error"
+	ModelsCollabMessageTypeJoined   ModelsCollabMessageType = "joined"
+	ModelsCollabMessageTypeLocked   ModelsCollabMessageType = "locked"
+	ModelsCollabMessageTypePresence ModelsCollabMessageType = "presence"
+	ModelsCollabMessageTypeSaved    ModelsCollabMessageType = "saved"
+	ModelsCollabMessageTypeUnlocked ModelsCollabMessageType = "unlocked"
+)
+
+// Defines values for ModelsCollabMode.
+const (
+	ModelsCollabModeEditing ModelsCollabMode = "editing"
+	ModelsCollabModeViewing ModelsCollabMode = "viewing"
+)
+
+// Defines values for ModelsDigestFrequency.
+const (
+	ModelsDigestFrequencyDaily  ModelsDigestFrequency = "daily"
+	ModelsDigestFrequencyOff    ModelsDigestFrequency = "off"
+	ModelsDigestFrequencyWeekly ModelsDigestFrequency = "weekly"
+)
+
+// Defines values for ModelsExportFormat.
+const (
+	ModelsExportFormatHtml     ModelsExportFormat = "html"
+	ModelsExportFormatMarkdown ModelsExportFormat = "markdown"
+)
+
+// Defines values for ModelsFeedItemKind.
+const (
+	ModelsFeedItemKindNotePublished   ModelsFeedItemKind = "note.published"
+	ModelsFeedItemKindNoteUpdated     ModelsFeedItemKind = "note.updated"
+	ModelsFeedItemKindTemplateCreated ModelsFeedItemKind = "template.created"
+)
+
+// Defines values for ModelsFieldChangeKind.
+const (
+	ModelsFieldChangeKindAdded     ModelsFieldChangeKind = "added"
+	ModelsFieldChangeKindModified  ModelsFieldChangeKind = "modified"
+	ModelsFieldChangeKindRemoved   ModelsFieldChangeKind = "removed"
+	ModelsFieldChangeKindRenamed   ModelsFieldChangeKind = "renamed"
+	ModelsFieldChangeKindReordered ModelsFieldChangeKind = "reordered"
+)
+
+// Defines values for ModelsFieldErrorCode.
+const (
+	ModelsFieldErrorCodeINVALIDCONTENT  ModelsFieldErrorCode = "INVALID_CONTENT"
+	ModelsFieldErrorCodePATTERNMISMATCH ModelsFieldErrorCode = "PATTERN_MISMATCH"
+	ModelsFieldErrorCodeREQUIRED        ModelsFieldErrorCode = "REQUIRED"
+	ModelsFieldErrorCodeTOOLONG         ModelsFieldErrorCode = "TOO_LONG"
+	ModelsFieldErrorCodeTOOSHORT        ModelsFieldErrorCode = "TOO_SHORT"
+)
+
+// Defines values for ModelsFieldRemovalPolicy.
+const (
+	ModelsFieldRemovalPolicyDeleteContent ModelsFieldRemovalPolicy = "delete_content"
+	ModelsFieldRemovalPolicyReject        ModelsFieldRemovalPolicy = "reject"
+)
+
+// Defines values for ModelsFieldType.
+const (
+	ModelsFieldTypeChecklist ModelsFieldType = "checklist"
+	ModelsFieldTypeDate      ModelsFieldType = "date"
+	ModelsFieldTypeMarkdown  ModelsFieldType = "markdown"
+	ModelsFieldTypeNumber    ModelsFieldType = "number"
+	ModelsFieldTypeSelect    ModelsFieldType = "select"
+	ModelsFieldTypeText      ModelsFieldType = "text"
+	ModelsFieldTypeUrl       ModelsFieldType = "url"
+)
+
 // Defines values for ModelsForbiddenErrorCode.
 const (
 	ModelsForbiddenErrorCodeFORBIDDEN ModelsForbiddenErrorCode = "FORBIDDEN"
@@ -28,17 +114,78 @@ const (
 	ModelsNotFoundErrorCodeNOTFOUND ModelsNotFoundErrorCode = "NOT_FOUND"
 )
 
+// Defines values for ModelsNoteEventName.
+const (
+	ModelsNoteEventNameNoteCreated     ModelsNoteEventName = "note.created"
+	ModelsNoteEventNameNoteDeleted     ModelsNoteEventName = "note.deleted"
+	ModelsNoteEventNameNotePublished   ModelsNoteEventName = "note.published"
+	ModelsNoteEventNameNoteUnpublished ModelsNoteEventName = "note.unpublished"
+	ModelsNoteEventNameNoteUpdated     ModelsNoteEventName = "note.updated"
+)
+
 // Defines values for ModelsNoteStatus.
 const (
 	ModelsNoteStatusDraft   ModelsNoteStatus = "Draft"
 	ModelsNoteStatusPublish ModelsNoteStatus = "Publish"
 )
 
+// Defines values for ModelsNotificationKind.
+const (
+	ModelsNotificationKindNoteTransferred       ModelsNotificationKind = "note.transferred"
+	ModelsNotificationKindTemplateDeprecated    ModelsNotificationKind = "template.deprecated"
+	ModelsNotificationKindTemplateNotePublished ModelsNotificationKind = "template.note_published"
+)
+
+// Defines values for ModelsTemplateBundleAction.
+const (
+	ModelsTemplateBundleActionCreated ModelsTemplateBundleAction = "created"
+	ModelsTemplateBundleActionRenamed ModelsTemplateBundleAction = "renamed"
+	ModelsTemplateBundleActionSkipped ModelsTemplateBundleAction = "skipped"
+	ModelsTemplateBundleActionUpdated ModelsTemplateBundleAction = "updated"
+)
+
+// Defines values for ModelsTemplateBundleFormat.
+const (
+	ModelsTemplateBundleFormatJson ModelsTemplateBundleFormat = "json"
+	ModelsTemplateBundleFormatYaml ModelsTemplateBundleFormat = "yaml"
+)
+
+// Defines values for ModelsTemplateConflictMode.
+const (
+	ModelsTemplateConflictModeRename ModelsTemplateConflictMode = "rename"
+	ModelsTemplateConflictModeSkip   ModelsTemplateConflictMode = "skip"
+	ModelsTemplateConflictModeUpdate ModelsTemplateConflictMode = "update"
+)
+
+// Defines values for ModelsTemplateVisibility.
+const (
+	ModelsTemplateVisibilityPrivate  ModelsTemplateVisibility = "private"
+	ModelsTemplateVisibilityPublic   ModelsTemplateVisibility = "public"
+	ModelsTemplateVisibilityUnlisted ModelsTemplateVisibility = "unlisted"
+)
+
 // Defines values for ModelsUnauthorizedErrorCode.
 const (
 	ModelsUnauthorizedErrorCodeUNAUTHORIZED ModelsUnauthorizedErrorCode = "UNAUTHORIZED"
 )
 
+// Defines values for ModelsWebhookDeliveryStatus.
+const (
+	ModelsWebhookDeliveryStatusFailed    ModelsWebhookDeliveryStatus = "failed"
+	ModelsWebhookDeliveryStatusPending   ModelsWebhookDeliveryStatus = "pending"
+	ModelsWebhookDeliveryStatusSucceeded ModelsWebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for ModelsWebhookEvent.
+const (
+	ModelsWebhookEventNoteCreated     ModelsWebhookEvent = "note.created"
+	ModelsWebhookEventNoteDeleted     ModelsWebhookEvent = "note.deleted"
+	ModelsWebhookEventNotePublished   ModelsWebhookEvent = "note.published"
+	ModelsWebhookEventNoteUnpublished ModelsWebhookEvent = "note.unpublished"
+	ModelsWebhookEventNoteUpdated     ModelsWebhookEvent = "note.updated"
+	ModelsWebhookEventTemplateChanged ModelsWebhookEvent = "template.changed"
+)
+
 // ModelsAccount アカウント情報
 type ModelsAccount struct {
 	// CreatedAt 作成日時
@@ -80,6 +227,12 @@ type ModelsAccountResponse struct {
 	// FirstName 名前
 	FirstName string `json:"firstName"`
 
+	// FollowerCount フォロワー数
+	FollowerCount int32 `json:"followerCount"`
+
+	// FollowingCount フォロー数
+	FollowingCount int32 `json:"followingCount"`
+
 	// FullName フルネーム
 	FullName string `json:"fullName"`
 
@@ -114,26 +267,206 @@ type ModelsAccountSummary struct {
 	Thumbnail *string `json:"thumbnail,omitempty"`
 }
 
+// ModelsAttachmentResponse 添付ファイル
+type ModelsAttachmentResponse struct {
+	// ContentType コンテンツタイプ
+	ContentType string `json:"contentType"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FileName ファイル名
+	FileName string `json:"fileName"`
+
+	// Id 添付ファイルID
+	Id string `json:"id"`
+
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
+
+	// SectionId 紐づくセクションID
+	SectionId *string `json:"sectionId,omitempty"`
+
+	// Size サイズ（バイト）
+	Size int64 `json:"size"`
+}
+
 // ModelsBadRequestError Bad Request エラー
 type ModelsBadRequestError struct {
 	Code    ModelsBadRequestErrorCode `json:"code"`
 	Details interface{}               `json:"details,omitempty"`
-	Message string                    `json:"message"`
+
+	// FieldErrors フィールド単位の検証エラー
+	FieldErrors *[]ModelsFieldError `json:"fieldErrors,omitempty"`
+	Message     string              `json:"message"`
+
+	// SuccessorTemplateId 後継テンプレートID（非推奨のテンプレートでノートを作成しようとした場合）
+	SuccessorTemplateId *string `json:"successorTemplateId,omitempty"`
 }
 
 // ModelsBadRequestErrorCode defines model for ModelsBadRequestError.Code.
 type ModelsBadRequestErrorCode string
 
+// ModelsBatchItemResult ノートごとの一括操作結果
+type ModelsBatchItemResult struct {
+	// Error 失敗理由
+	Error *string `json:"error,omitempty"`
+
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
+
+	// Success 反映されたかどうか
+	Success bool `json:"success"`
+}
+
+// ModelsBatchMode 一括操作のモード
+type ModelsBatchMode string
+
+// ModelsBatchNoteRequest ノート一括操作リクエスト
+type ModelsBatchNoteRequest struct {
+	// Mode モード（省略時は all_or_nothing）
+	Mode *ModelsBatchMode `json:"mode,omitempty"`
+
+	// NoteIds 対象ノートID（最大100件）
+	NoteIds []string `json:"noteIds"`
+}
+
+// ModelsBatchNoteResponse ノート一括操作結果
+type ModelsBatchNoteResponse struct {
+	// Failed 失敗件数
+	Failed int32 `json:"failed"`
+
+	// Mode モード
+	Mode ModelsBatchMode `json:"mode"`
+
+	// Results ノートごとの結果
+	Results []ModelsBatchItemResult `json:"results"`
+
+	// Succeeded 成功件数
+	Succeeded int32 `json:"succeeded"`
+}
+
+// ModelsCollabCommand 共同編集でクライアントが送るメッセージ（WebSocket のテキストフレーム）
+type ModelsCollabCommand struct {
+	// Content 編集中の内容（change）
+	Content *string `json:"content,omitempty"`
+
+	// SectionId 対象セクションID（lock / unlock / change）
+	SectionId *string `json:"sectionId,omitempty"`
+
+	// Sections 保存するセクション（save、ロック中のセクションのみ）
+	Sections *[]ModelsUpdateSectionRequest `json:"sections,omitempty"`
+
+	// Title 新しいタイトル（save、省略時は現在のタイトル）
+	Title *string `json:"title,omitempty"`
+
+	// Type コマンド種別
+	Type ModelsCollabCommandType `json:"type"`
+}
+
+// ModelsCollabCommandType 共同編集でクライアントが送るコマンド種別（lock・change・save はノートの所有者のみ、他の閲覧者は参加のみ）
+type ModelsCollabCommandType string
+
+// ModelsCollabLock セクションのロック
+type ModelsCollabLock struct {
+	// AccountId ロックしているアカウントID
+	AccountId string `json:"accountId"`
+
+	// ConnId ロックしている接続ID
+	ConnId string `json:"connId"`
+
+	// ExpiresAt 有効期限（lock / change で延長）
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// SectionId セクションID
+	SectionId string `json:"sectionId"`
+}
+
+// ModelsCollabMessage 共同編集でサーバーが送るメッセージ（WebSocket のテキストフレーム）
+type ModelsCollabMessage struct {
+	// AccountId 操作したアカウントID
+	AccountId *string `json:"accountId,omitempty"`
+
+	// ConnId 接続ID（joined では自分の接続、それ以外は操作した接続）
+	ConnId *string `json:"connId,omitempty"`
+
+	// Content 編集中の内容（changed）
+	Content *string `json:"content,omitempty"`
+
+	// Error エラーメッセージ（error）
+	Error *string `json:"error,omitempty"`
+
+	// ExpiresAt ロックの有効期限（locked）
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Locks ロック一覧（joined）
+	Locks *[]ModelsCollabLock `json:"locks,omitempty"`
+
+	// Note ノート（joined / saved）
+	Note *ModelsNoteResponse `json:"note,omitempty"`
+
+	// Participants 参加者一覧（joined / presence）
+	Participants *[]ModelsCollabParticipant `json:"participants,omitempty"`
+
+	// SectionId 対象セクションID
+	SectionId *string `json:"sectionId,omitempty"`
+
+	// Type メッセージ種別
+	Type ModelsCollabMessageType `json:"type"`
+}
+
+// ModelsCollabMessageType 共同編集でサーバーが送るメッセージ種別
+type ModelsCollabMessageType string
+
+// ModelsCollabMode 参加者の状態
+type ModelsCollabMode string
+
+// ModelsCollabParticipant 共同編集の参加者（接続単位）
+type ModelsCollabParticipant struct {
+	// AccountId アカウントID
+	AccountId string `json:"accountId"`
+
+	// ConnId 接続ID
+	ConnId string `json:"connId"`
+
+	// JoinedAt 参加日時
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// Mode 状態
+	Mode ModelsCollabMode `json:"mode"`
+}
+
 // ModelsCreateFieldRequest テンプレートフィールド作成リクエスト
 type ModelsCreateFieldRequest struct {
+	// HelpText ヘルプテキスト
+	HelpText *string `json:"helpText,omitempty"`
+
 	// IsRequired 必須フラグ
 	IsRequired bool `json:"isRequired"`
 
 	// Label ラベル
 	Label string `json:"label"`
 
+	// MaxLength 最大文字数（text / markdown / url）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（text / markdown / url）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options タイプ別オプション
+	Options *ModelsFieldOptions `json:"options,omitempty"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder プレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type 入力タイプ（省略時はtext）
+	Type *ModelsFieldType `json:"type,omitempty"`
 }
 
 // ModelsCreateNoteRequest ノート作成リクエスト
@@ -188,6 +521,57 @@ type ModelsCreateTemplateRequest struct {
 
 	// OwnerId 所有者ID
 	OwnerId openapi_types.UUID `json:"ownerId"`
+
+	// Visibility 公開範囲（省略時は public）
+	Visibility *ModelsTemplateVisibility `json:"visibility,omitempty"`
+}
+
+// ModelsCreateWebhookRequest Webhook登録リクエスト
+type ModelsCreateWebhookRequest struct {
+	// Events 購読イベント（省略時はすべてのイベント）
+	Events *[]ModelsWebhookEvent `json:"events,omitempty"`
+
+	// Secret 署名用シークレット（16文字以上、レスポンスには含まれない）
+	Secret string `json:"secret"`
+
+	// Url 配信先URL（公開アドレスの http/https のみ、リダイレクトは追従しない）
+	Url string `json:"url"`
+}
+
+// ModelsCsvRowResult 行ごとの CSV インポート結果
+type ModelsCsvRowResult struct {
+	// Created 新規作成された場合は true
+	Created bool `json:"created"`
+
+	// Error 検証エラー
+	Error *string `json:"error,omitempty"`
+
+	// Line CSV 上の行番号（ヘッダーが 1 行目）
+	Line int32 `json:"line"`
+
+	// NoteId 作成または更新されたノートID
+	NoteId *string `json:"noteId,omitempty"`
+}
+
+// ModelsDeprecateTemplateRequest テンプレート非推奨化リクエスト
+type ModelsDeprecateTemplateRequest struct {
+	// SuccessorTemplateId 後継テンプレートID（省略時は後継なし）
+	SuccessorTemplateId *string `json:"successorTemplateId,omitempty"`
+}
+
+// ModelsDigestFrequency ダイジェストメールの配信頻度
+type ModelsDigestFrequency string
+
+// ModelsDigestSettingsResponse ダイジェストメール設定
+type ModelsDigestSettingsResponse struct {
+	// AccountId アカウントID
+	AccountId string `json:"accountId"`
+
+	// Frequency 配信頻度（未設定の場合は weekly）
+	Frequency ModelsDigestFrequency `json:"frequency"`
+
+	// LastSentAt 最後に配信した日時（未配信の場合は省略）
+	LastSentAt *time.Time `json:"lastSentAt,omitempty"`
 }
 
 // ModelsErrorResponse 共通エラーレスポンス
@@ -202,8 +586,47 @@ type ModelsErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ModelsExportFormat ノートのエクスポート形式
+type ModelsExportFormat string
+
+// ModelsFeedItem フィード項目（ノート・テンプレートごとに最新の活動のみ）
+type ModelsFeedItem struct {
+	// Author 作成者
+	Author ModelsAccountSummary `json:"author"`
+
+	// Kind 項目の種類
+	Kind ModelsFeedItemKind `json:"kind"`
+
+	// OccurredAt 活動日時
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// SubjectId 対象ID（ノートまたはテンプレート）
+	SubjectId string `json:"subjectId"`
+
+	// TemplateId ノートのテンプレートID（テンプレートの場合は省略）
+	TemplateId *string `json:"templateId,omitempty"`
+
+	// Title 対象タイトル
+	Title string `json:"title"`
+}
+
+// ModelsFeedItemKind フィード項目の種類
+type ModelsFeedItemKind string
+
+// ModelsFeedPage フィード（新しい順）
+type ModelsFeedPage struct {
+	// Items フィード項目
+	Items []ModelsFeedItem `json:"items"`
+
+	// NextCursor 次のページのカーソル（最後のページでは省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
 // ModelsField テンプレートフィールド
 type ModelsField struct {
+	// HelpText ヘルプテキスト
+	HelpText *string `json:"helpText,omitempty"`
+
 	// Id フィールドID
 	Id string `json:"id"`
 
@@ -213,10 +636,94 @@ type ModelsField struct {
 	// Label ラベル
 	Label string `json:"label"`
 
+	// MaxLength 最大文字数（text / markdown / url）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（text / markdown / url）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options タイプ別オプション
+	Options ModelsFieldOptions `json:"options"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder プレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type 入力タイプ
+	Type ModelsFieldType `json:"type"`
+}
+
+// ModelsFieldChange フィールドごとの変更
+type ModelsFieldChange struct {
+	// FieldId 新バージョンのフィールドID（削除時は省略）
+	FieldId *string `json:"fieldId,omitempty"`
+
+	// Kinds 変更の種類
+	Kinds []ModelsFieldChangeKind `json:"kinds"`
+
+	// Label フィールドラベル
+	Label string `json:"label"`
+
+	// PreviousFieldId 旧バージョンのフィールドID（追加時は省略）
+	PreviousFieldId *string `json:"previousFieldId,omitempty"`
+
+	// PreviousLabel 変更前のラベル（ラベル変更時のみ）
+	PreviousLabel *string `json:"previousLabel,omitempty"`
+}
+
+// ModelsFieldChangeKind フィールド変更の種類
+type ModelsFieldChangeKind string
+
+// ModelsFieldError フィールド単位の検証エラー
+type ModelsFieldError struct {
+	// Code エラーコード
+	Code ModelsFieldErrorCode `json:"code"`
+
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
+
+	// Label フィールドラベル
+	Label string `json:"label"`
+
+	// Message エラーメッセージ
+	Message string `json:"message"`
+}
+
+// ModelsFieldErrorCode フィールド単位の検証エラーコード
+type ModelsFieldErrorCode string
+
+// ModelsFieldMappingEntry 旧バージョンのフィールドから最新バージョンのフィールドへの対応
+type ModelsFieldMappingEntry struct {
+	// FromFieldId ノートが使用しているバージョンのフィールドID
+	FromFieldId string `json:"fromFieldId"`
+
+	// ToFieldId 最新バージョンのフィールドID
+	ToFieldId string `json:"toFieldId"`
 }
 
+// ModelsFieldOptions フィールドのタイプ別オプション
+type ModelsFieldOptions struct {
+	// Choices 選択肢（select / checklist）
+	Choices *[]string `json:"choices,omitempty"`
+
+	// Max 最大値（number）
+	Max *float64 `json:"max,omitempty"`
+
+	// Min 最小値（number）
+	Min *float64 `json:"min,omitempty"`
+}
+
+// ModelsFieldRemovalPolicy 移行時に対応付けのないフィールドのノート内容の扱い
+type ModelsFieldRemovalPolicy string
+
+// ModelsFieldType フィールドの入力タイプ
+type ModelsFieldType string
+
 // ModelsForbiddenError Forbidden エラー
 type ModelsForbiddenError struct {
 	Code    ModelsForbiddenErrorCode `json:"code"`
@@ -226,6 +733,102 @@ type ModelsForbiddenError struct {
 // ModelsForbiddenErrorCode defines model for ModelsForbiddenError.Code.
 type ModelsForbiddenErrorCode string
 
+// ModelsForkTemplateRequest テンプレートフォークリクエスト
+type ModelsForkTemplateRequest struct {
+	// Name フォーク後のテンプレート名（省略時はフォーク元の名前）
+	Name *string `json:"name,omitempty"`
+}
+
+// ModelsImportFileResult ファイルごとのインポート結果
+type ModelsImportFileResult struct {
+	// Error 検証エラー
+	Error *string `json:"error,omitempty"`
+
+	// FileName ファイル名（zip 内のパス）
+	FileName string `json:"fileName"`
+
+	// MissingRequiredFields 内容が見つからなかった必須フィールドのラベル
+	MissingRequiredFields []string `json:"missingRequiredFields"`
+
+	// NoteId 作成されたノートID（ドライラン・失敗時は未設定）
+	NoteId *string `json:"noteId,omitempty"`
+
+	// Title ノートタイトル
+	Title string `json:"title"`
+
+	// UnmatchedHeadings どのフィールドにも一致しなかった見出し
+	UnmatchedHeadings []string `json:"unmatchedHeadings"`
+}
+
+// ModelsImportNotesCsvRequest ノート CSV インポートリクエスト
+type ModelsImportNotesCsvRequest struct {
+	// File CSV ファイル（1 行目はヘッダー。id 列が空の行は新規作成、指定した行は更新）
+	File openapi_types.File `json:"file"`
+}
+
+// ModelsImportNotesCsvResponse ノート CSV インポート結果
+type ModelsImportNotesCsvResponse struct {
+	// Created 作成件数
+	Created int32 `json:"created"`
+
+	// Failed 失敗件数
+	Failed int32 `json:"failed"`
+
+	// Results 行ごとの結果
+	Results []ModelsCsvRowResult `json:"results"`
+
+	// Updated 更新件数
+	Updated int32 `json:"updated"`
+}
+
+// ModelsImportNotesRequest ノートインポートリクエスト
+type ModelsImportNotesRequest struct {
+	// Aliases 見出しの別名（JSON: フィールドのラベルまたはID → 見出しの配列）
+	Aliases *string `json:"aliases,omitempty"`
+
+	// File Markdown ファイル（.md）または Markdown を含む zip
+	File openapi_types.File `json:"file"`
+
+	// TemplateId 取り込み先テンプレートID
+	TemplateId string `json:"templateId"`
+}
+
+// ModelsImportNotesResponse ノートインポート結果
+type ModelsImportNotesResponse struct {
+	// DryRun ドライランかどうか
+	DryRun bool `json:"dryRun"`
+
+	// Results ファイルごとの結果
+	Results []ModelsImportFileResult `json:"results"`
+}
+
+// ModelsImportTemplateBundleRequest テンプレートバンドルインポートリクエスト
+type ModelsImportTemplateBundleRequest struct {
+	// File JSON または YAML のバンドルファイル（"{" で始まる場合は JSON として読む）
+	File openapi_types.File `json:"file"`
+}
+
+// ModelsImportTemplateBundleResponse テンプレートバンドルインポート結果
+type ModelsImportTemplateBundleResponse struct {
+	// Created 作成件数（名前を変えたものを除く）
+	Created int32 `json:"created"`
+
+	// Failed 失敗件数
+	Failed int32 `json:"failed"`
+
+	// Renamed 名前を変えて作成した件数
+	Renamed int32 `json:"renamed"`
+
+	// Results テンプレートごとの結果
+	Results []ModelsTemplateBundleResult `json:"results"`
+
+	// Skipped スキップ件数
+	Skipped int32 `json:"skipped"`
+
+	// Updated 更新件数
+	Updated int32 `json:"updated"`
+}
+
 // ModelsNotFoundError Not Found エラー
 type ModelsNotFoundError struct {
 	Code    ModelsNotFoundErrorCode `json:"code"`
@@ -235,6 +838,9 @@ type ModelsNotFoundError struct {
 // ModelsNotFoundErrorCode defines model for ModelsNotFoundError.Code.
 type ModelsNotFoundErrorCode string
 
+// ModelsNoteEventName ノート変更イベント名
+type ModelsNoteEventName string
+
 // ModelsNoteFilters ノートフィルター（クエリパラメータ）
 type ModelsNoteFilters struct {
 	// OwnerId 所有者IDフィルター
@@ -276,6 +882,9 @@ type ModelsNoteResponse struct {
 	// TemplateName テンプレート名
 	TemplateName string `json:"templateName"`
 
+	// TemplateVersion 作成時に使用したテンプレートバージョン
+	TemplateVersion int32 `json:"templateVersion"`
+
 	// Title タイトル
 	Title string `json:"title"`
 
@@ -286,40 +895,244 @@ type ModelsNoteResponse struct {
 // ModelsNoteStatus ノートのステータス
 type ModelsNoteStatus string
 
-// ModelsSection セクション（ノートの各項目）
-type ModelsSection struct {
-	// Content 内容
-	Content string `json:"content"`
+// ModelsNoteStreamEvent ノート変更ストリームで送られるイベントのデータ（SSE の data フィールド）
+type ModelsNoteStreamEvent struct {
+	// ActorId 操作したアカウントID
+	ActorId string `json:"actorId"`
 
-	// FieldId フィールドID
-	FieldId string `json:"fieldId"`
+	// Event イベント名（SSE の event フィールドと同じ）
+	Event ModelsNoteEventName `json:"event"`
 
-	// FieldLabel フィールドラベル
-	FieldLabel string `json:"fieldLabel"`
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
 
-	// Id セクションID
-	Id string `json:"id"`
+	// OccurredAt 発生日時
+	OccurredAt time.Time `json:"occurredAt"`
 
-	// IsRequired 必須項目かどうか
-	IsRequired bool `json:"isRequired"`
+	// OwnerId 所有者ID
+	OwnerId string `json:"ownerId"`
+
+	// Status 変更後のステータス（削除の場合は削除前のステータス）
+	Status ModelsNoteStatus `json:"status"`
+
+	// TemplateId テンプレートID
+	TemplateId string `json:"templateId"`
+
+	// Title ノートタイトル
+	Title string `json:"title"`
 }
 
-// ModelsSuccessResponse 成功レスポンス（削除など）
-type ModelsSuccessResponse struct {
-	Success bool `json:"success"`
+// ModelsNotificationCountResponse 通知件数
+type ModelsNotificationCountResponse struct {
+	// Count 件数
+	Count int32 `json:"count"`
 }
 
-// ModelsTemplateResponse テンプレートレスポンス
-type ModelsTemplateResponse struct {
-	// Fields フィールド一覧
-	Fields []ModelsField `json:"fields"`
+// ModelsNotificationKind 通知の種類
+type ModelsNotificationKind string
 
-	// Id テンプレートID
-	Id string `json:"id"`
+// ModelsNotificationPreferencesResponse 通知設定
+type ModelsNotificationPreferencesResponse struct {
+	// AccountId アカウントID
+	AccountId string `json:"accountId"`
+
+	// MutedKinds ミュートしている通知の種類
+	MutedKinds []ModelsNotificationKind `json:"mutedKinds"`
+}
+
+// ModelsNotificationResponse 通知
+type ModelsNotificationResponse struct {
+	// ActorId 通知のきっかけになった操作者ID（アカウント削除済みの場合は省略）
+	ActorId *string `json:"actorId,omitempty"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id 通知ID
+	Id string `json:"id"`
+
+	// Kind 通知の種類
+	Kind ModelsNotificationKind `json:"kind"`
+
+	// ReadAt 既読日時（未読の場合は省略）
+	ReadAt *time.Time `json:"readAt,omitempty"`
+
+	// RecipientId 受信者ID
+	RecipientId string `json:"recipientId"`
+
+	// SubjectId 対象ID（ノートまたはテンプレート）
+	SubjectId string `json:"subjectId"`
+
+	// SubjectTitle 通知時点の対象タイトル
+	SubjectTitle string `json:"subjectTitle"`
+}
+
+// ModelsRetemplateNoteRequest ノートの別テンプレートへの移行リクエスト
+type ModelsRetemplateNoteRequest struct {
+	// FieldMapping フィールド対応（移行先テンプレートの最新バージョンのフィールドIDへ対応付ける）
+	FieldMapping []ModelsFieldMappingEntry `json:"fieldMapping"`
+
+	// TemplateId 移行先テンプレートID（最新バージョンへ移行する）
+	TemplateId string `json:"templateId"`
+}
+
+// ModelsSection セクション（ノートの各項目）
+type ModelsSection struct {
+	// Content 内容
+	Content string `json:"content"`
+
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
+
+	// FieldLabel フィールドラベル
+	FieldLabel string `json:"fieldLabel"`
+
+	// FieldOptions フィールドのタイプ別オプション
+	FieldOptions ModelsFieldOptions `json:"fieldOptions"`
+
+	// FieldType フィールドの入力タイプ
+	FieldType ModelsFieldType `json:"fieldType"`
+
+	// Id セクションID
+	Id string `json:"id"`
+
+	// IsRequired 必須項目かどうか
+	IsRequired bool `json:"isRequired"`
+}
+
+// ModelsSuccessResponse 成功レスポンス（削除など）
+type ModelsSuccessResponse struct {
+	Success bool `json:"success"`
+}
+
+// ModelsTemplateBundle テンプレートバンドル（ID・所有者・バージョンを含まない持ち運び可能な形式。JSON と YAML で同じキー）
+type ModelsTemplateBundle struct {
+	// Templates テンプレート一覧
+	Templates []ModelsTemplateBundleTemplate `json:"templates"`
+
+	// Version 形式バージョン（現在は 1）
+	Version int32 `json:"version"`
+}
+
+// ModelsTemplateBundleAction バンドル内テンプレートのインポート結果
+type ModelsTemplateBundleAction string
+
+// ModelsTemplateBundleField バンドル内のフィールド
+type ModelsTemplateBundleField struct {
+	// Choices 選択肢（select / checklist）
+	Choices *[]string `json:"choices,omitempty"`
+
+	// HelpText ヘルプテキスト
+	HelpText *string `json:"helpText,omitempty"`
+
+	// Label ラベル
+	Label string `json:"label"`
+
+	// Max 最大値（number）
+	Max *float64 `json:"max,omitempty"`
+
+	// MaxLength 最大文字数（text / markdown / url）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// Min 最小値（number）
+	Min *float64 `json:"min,omitempty"`
+
+	// MinLength 最小文字数（text / markdown / url）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Order 表示順序
+	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder プレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Required 必須フラグ
+	Required bool `json:"required"`
+
+	// Type 入力タイプ（省略時は text）
+	Type *ModelsFieldType `json:"type,omitempty"`
+}
+
+// ModelsTemplateBundleFormat テンプレートバンドルの形式
+type ModelsTemplateBundleFormat string
+
+// ModelsTemplateBundleResult テンプレートごとのバンドルインポート結果
+type ModelsTemplateBundleResult struct {
+	// Action 実行内容（失敗時は省略）
+	Action *ModelsTemplateBundleAction `json:"action,omitempty"`
+
+	// Error 検証エラー
+	Error *string `json:"error,omitempty"`
+
+	// Index バンドル内の位置（0 始まり）
+	Index int32 `json:"index"`
+
+	// Name 保存されたテンプレート名
+	Name string `json:"name"`
+
+	// TemplateId 作成・更新・スキップされたテンプレートID
+	TemplateId *string `json:"templateId,omitempty"`
+}
+
+// ModelsTemplateBundleTemplate バンドル内のテンプレート
+type ModelsTemplateBundleTemplate struct {
+	// Fields フィールド一覧
+	Fields []ModelsTemplateBundleField `json:"fields"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+
+	// Visibility 公開範囲（省略時は作成時 public、更新時は変更しない）
+	Visibility *ModelsTemplateVisibility `json:"visibility,omitempty"`
+}
+
+// ModelsTemplateChangeReport テンプレート変更レポート
+type ModelsTemplateChangeReport struct {
+	// Fields 変更されたフィールド
+	Fields []ModelsFieldChange `json:"fields"`
+
+	// PreviousVersion 変更前のバージョン
+	PreviousVersion int32 `json:"previousVersion"`
+
+	// Version 変更後のバージョン
+	Version int32 `json:"version"`
+}
+
+// ModelsTemplateConflictMode 同名テンプレートが既にある場合のインポート方法
+type ModelsTemplateConflictMode string
+
+// ModelsTemplateResponse テンプレートレスポンス
+type ModelsTemplateResponse struct {
+	// Changes 変更レポート（テンプレート更新時のみ）
+	Changes *ModelsTemplateChangeReport `json:"changes,omitempty"`
+
+	// Deprecated 非推奨フラグ（非推奨のテンプレートでは新しいノートを作成できない）
+	Deprecated bool `json:"deprecated"`
+
+	// DeprecatedAt 非推奨にした日時（非推奨でない場合は省略）
+	DeprecatedAt *time.Time `json:"deprecatedAt,omitempty"`
+
+	// Fields フィールド一覧
+	Fields []ModelsField `json:"fields"`
+
+	// ForkCount このテンプレートからの公開フォーク数（非公開・限定公開のフォークは数えない）
+	ForkCount int32 `json:"forkCount"`
+
+	// ForkedFromId フォーク元テンプレートID（フォークでない場合・フォーク元削除後は省略）
+	ForkedFromId *string `json:"forkedFromId,omitempty"`
+
+	// Id テンプレートID
+	Id string `json:"id"`
 
 	// IsUsed 使用中フラグ
 	IsUsed bool `json:"isUsed"`
 
+	// MatchedFieldIds 検索条件に一致したフィールドID（q または fieldLabel 指定時の一覧のみ）
+	MatchedFieldIds *[]string `json:"matchedFieldIds,omitempty"`
+
 	// Name テンプレート名
 	Name string `json:"name"`
 
@@ -329,8 +1142,50 @@ type ModelsTemplateResponse struct {
 	// OwnerId 所有者ID
 	OwnerId string `json:"ownerId"`
 
+	// SuccessorTemplateId 後継テンプレートID（非推奨でない場合・未指定の場合は省略）
+	SuccessorTemplateId *string `json:"successorTemplateId,omitempty"`
+
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Usage 使用状況
+	Usage ModelsTemplateUsage `json:"usage"`
+
+	// Version バージョン（フィールド一覧が属するバージョン）
+	Version int32 `json:"version"`
+
+	// Visibility 公開範囲
+	Visibility ModelsTemplateVisibility `json:"visibility"`
+}
+
+// ModelsTemplateUsage テンプレートの使用状況（全バージョン合計）
+type ModelsTemplateUsage struct {
+	// AuthorCount ノート作成者数
+	AuthorCount int32 `json:"authorCount"`
+
+	// LastUsedAt 最後にノートが作成された日時（未使用時は省略）
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// NoteCount ノート数
+	NoteCount int32 `json:"noteCount"`
+
+	// PublishedCount 公開ノート数
+	PublishedCount int32 `json:"publishedCount"`
+}
+
+// ModelsTemplateVisibility テンプレートの公開範囲
+type ModelsTemplateVisibility string
+
+// ModelsTransferNotesRequest ノート所有者一括移譲リクエスト
+type ModelsTransferNotesRequest struct {
+	// Mode モード（省略時は all_or_nothing）
+	Mode *ModelsBatchMode `json:"mode,omitempty"`
+
+	// NewOwnerId 新しい所有者ID
+	NewOwnerId string `json:"newOwnerId"`
+
+	// NoteIds 対象ノートID（最大100件）
+	NoteIds []string `json:"noteIds"`
 }
 
 // ModelsUnauthorizedError Unauthorized エラー
@@ -342,8 +1197,17 @@ type ModelsUnauthorizedError struct {
 // ModelsUnauthorizedErrorCode defines model for ModelsUnauthorizedError.Code.
 type ModelsUnauthorizedErrorCode string
 
+// ModelsUpdateDigestSettingsRequest ダイジェストメール設定更新リクエスト
+type ModelsUpdateDigestSettingsRequest struct {
+	// Frequency 配信頻度
+	Frequency ModelsDigestFrequency `json:"frequency"`
+}
+
 // ModelsUpdateFieldRequest フィールド更新リクエスト
 type ModelsUpdateFieldRequest struct {
+	// HelpText ヘルプテキスト
+	HelpText *string `json:"helpText,omitempty"`
+
 	// Id フィールドID（既存フィールドの場合は必須）
 	Id *string `json:"id,omitempty"`
 
@@ -353,8 +1217,26 @@ type ModelsUpdateFieldRequest struct {
 	// Label ラベル
 	Label string `json:"label"`
 
+	// MaxLength 最大文字数（text / markdown / url）
+	MaxLength *int32 `json:"maxLength,omitempty"`
+
+	// MinLength 最小文字数（text / markdown / url）
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Options タイプ別オプション
+	Options *ModelsFieldOptions `json:"options,omitempty"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Pattern 内容全体が一致すべき正規表現
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Placeholder プレースホルダー
+	Placeholder *string `json:"placeholder,omitempty"`
+
+	// Type 入力タイプ（省略時はtext）
+	Type *ModelsFieldType `json:"type,omitempty"`
 }
 
 // ModelsUpdateNoteRequest ノート更新リクエスト
@@ -369,6 +1251,12 @@ type ModelsUpdateNoteRequest struct {
 	Title string `json:"title"`
 }
 
+// ModelsUpdateNotificationPreferencesRequest 通知設定更新リクエスト
+type ModelsUpdateNotificationPreferencesRequest struct {
+	// MutedKinds ミュートする通知の種類（空の場合はすべて受け取る）
+	MutedKinds []ModelsNotificationKind `json:"mutedKinds"`
+}
+
 // ModelsUpdateSectionRequest セクション更新リクエスト
 type ModelsUpdateSectionRequest struct {
 	// Content 内容
@@ -388,6 +1276,111 @@ type ModelsUpdateTemplateRequest struct {
 
 	// Name テンプレート名
 	Name string `json:"name"`
+
+	// Visibility 公開範囲（省略時は変更しない）
+	Visibility *ModelsTemplateVisibility `json:"visibility,omitempty"`
+}
+
+// ModelsUpdateWebhookRequest Webhook更新リクエスト
+type ModelsUpdateWebhookRequest struct {
+	// Active 有効フラグ
+	Active bool `json:"active"`
+
+	// Events 購読イベント（省略時はすべてのイベント）
+	Events *[]ModelsWebhookEvent `json:"events,omitempty"`
+
+	// Secret 署名用シークレット（省略時は現在の値を維持）
+	Secret *string `json:"secret,omitempty"`
+
+	// Url 配信先URL（公開アドレスの http/https のみ、リダイレクトは追従しない）
+	Url string `json:"url"`
+}
+
+// ModelsUpgradeNoteRequest ノートのテンプレートバージョン移行リクエスト
+type ModelsUpgradeNoteRequest struct {
+	// FieldMapping フィールド対応（対応のない新フィールドは空になる。対応のない旧フィールドに内容があれば fieldRemoval が delete_content のときのみ破棄する）
+	FieldMapping []ModelsFieldMappingEntry `json:"fieldMapping"`
+}
+
+// ModelsUploadAttachmentRequest 添付ファイルアップロードリクエスト
+type ModelsUploadAttachmentRequest struct {
+	// File ファイル本体（最大10MB、画像・PDF・テキストのみ）
+	File openapi_types.File `json:"file"`
+
+	// SectionId 紐づけるセクションID
+	SectionId *string `json:"sectionId,omitempty"`
+}
+
+// ModelsWebhookDeliveryResponse Webhook配信履歴
+type ModelsWebhookDeliveryResponse struct {
+	// Attempts 試行回数
+	Attempts int32 `json:"attempts"`
+
+	// CompletedAt 完了日時（成功または失敗確定時）
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Event イベント名
+	Event ModelsWebhookEvent `json:"event"`
+
+	// EventId イベントID
+	EventId string `json:"eventId"`
+
+	// Id 配信ID（X-Webhook-Delivery ヘッダーの値）
+	Id string `json:"id"`
+
+	// LastError 最後の試行のエラー
+	LastError *string `json:"lastError,omitempty"`
+
+	// LatencyMs 最後の試行の所要時間（ミリ秒）
+	LatencyMs *int32 `json:"latencyMs,omitempty"`
+
+	// Payload 送信したJSONペイロード
+	Payload string `json:"payload"`
+
+	// RedeliveryOf 再配信元の配信ID
+	RedeliveryOf *string `json:"redeliveryOf,omitempty"`
+
+	// ResponseStatus 最後の試行のHTTPステータス
+	ResponseStatus *int32 `json:"responseStatus,omitempty"`
+
+	// Status 配信ステータス
+	Status ModelsWebhookDeliveryStatus `json:"status"`
+
+	// WebhookId Webhook ID
+	WebhookId string `json:"webhookId"`
+}
+
+// ModelsWebhookDeliveryStatus Webhook配信ステータス
+type ModelsWebhookDeliveryStatus string
+
+// ModelsWebhookEvent Webhookで購読できるイベント
+type ModelsWebhookEvent string
+
+// ModelsWebhookResponse Webhook購読
+type ModelsWebhookResponse struct {
+	// Active 有効フラグ
+	Active bool `json:"active"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events 購読イベント（空の場合はすべてのイベント）
+	Events []ModelsWebhookEvent `json:"events"`
+
+	// Id Webhook ID
+	Id string `json:"id"`
+
+	// OwnerId 所有者ID
+	OwnerId string `json:"ownerId"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url 配信先URL
+	Url string `json:"url"`
 }
 
 // AccountsGetAccountByEmailParams defines parameters for AccountsGetAccountByEmail.
@@ -395,6 +1388,54 @@ type AccountsGetAccountByEmailParams struct {
 	Email string `form:"email" json:"email"`
 }
 
+// AccountsUnfollowAccountParams defines parameters for AccountsUnfollowAccount.
+type AccountsUnfollowAccountParams struct {
+	// FollowerId フォローを解除するアカウントID
+	FollowerId string `form:"followerId" json:"followerId"`
+}
+
+// AccountsFollowAccountParams defines parameters for AccountsFollowAccount.
+type AccountsFollowAccountParams struct {
+	// FollowerId フォローするアカウントID
+	FollowerId string `form:"followerId" json:"followerId"`
+}
+
+// DigestsGetDigestSettingsParams defines parameters for DigestsGetDigestSettings.
+type DigestsGetDigestSettingsParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+}
+
+// DigestsUpdateDigestSettingsParams defines parameters for DigestsUpdateDigestSettings.
+type DigestsUpdateDigestSettingsParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+}
+
+// DigestsConfirmUnsubscribeDigestParams defines parameters for DigestsConfirmUnsubscribeDigest.
+type DigestsConfirmUnsubscribeDigestParams struct {
+	// Token メールに記載された配信停止トークン
+	Token string `form:"token" json:"token"`
+}
+
+// DigestsUnsubscribeDigestParams defines parameters for DigestsUnsubscribeDigest.
+type DigestsUnsubscribeDigestParams struct {
+	// Token メールに記載された配信停止トークン
+	Token string `form:"token" json:"token"`
+}
+
+// FeedGetFeedParams defines parameters for FeedGetFeed.
+type FeedGetFeedParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+
+	// Cursor 前のページの nextCursor（省略時は最新から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（1〜50、省略時は20）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // NotesListNotesParams defines parameters for NotesListNotes.
 type NotesListNotesParams struct {
 	// Q タイトルキーワード検索
@@ -410,6 +1451,54 @@ type NotesListNotesParams struct {
 	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
 }
 
+// NotesBatchDeleteNotesParams defines parameters for NotesBatchDeleteNotes.
+type NotesBatchDeleteNotesParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// NotesBatchPublishNotesParams defines parameters for NotesBatchPublishNotes.
+type NotesBatchPublishNotesParams struct {
+	// OwnerId 所有者ID（公開権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// NotesBatchTransferNotesParams defines parameters for NotesBatchTransferNotes.
+type NotesBatchTransferNotesParams struct {
+	// OwnerId 現在の所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// NotesBatchUnpublishNotesParams defines parameters for NotesBatchUnpublishNotes.
+type NotesBatchUnpublishNotesParams struct {
+	// OwnerId 所有者ID（公開権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// NotesStreamNoteEventsParams defines parameters for NotesStreamNoteEvents.
+type NotesStreamNoteEventsParams struct {
+	// ViewerId 閲覧者ID（下書きノートのイベントは所有者のみ受信）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+
+	// TemplateId テンプレートIDフィルター
+	TemplateId *string `form:"templateId,omitempty" json:"templateId,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// LastEventID 最後に受信したイベントID（取りこぼしを再送、再送できない場合は reset イベントを送信）
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// NotesImportNotesParams defines parameters for NotesImportNotes.
+type NotesImportNotesParams struct {
+	// OwnerId 所有者ID
+	OwnerId string `form:"ownerId" json:"ownerId"`
+
+	// DryRun true の場合は保存せず結果のみ返す
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // NotesDeleteNoteParams defines parameters for NotesDeleteNote.
 type NotesDeleteNoteParams struct {
 	// OwnerId 所有者ID（権限チェック用）
@@ -422,52 +1511,317 @@ type NotesUpdateNoteParams struct {
 	OwnerId string `form:"ownerId" json:"ownerId"`
 }
 
+// AttachmentsListAttachmentsParams defines parameters for AttachmentsListAttachments.
+type AttachmentsListAttachmentsParams struct {
+	// ViewerId 閲覧者ID（下書きノートの権限チェック用）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
+// AttachmentsUploadAttachmentParams defines parameters for AttachmentsUploadAttachment.
+type AttachmentsUploadAttachmentParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// AttachmentsDeleteAttachmentParams defines parameters for AttachmentsDeleteAttachment.
+type AttachmentsDeleteAttachmentParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// AttachmentsDownloadAttachmentParams defines parameters for AttachmentsDownloadAttachment.
+type AttachmentsDownloadAttachmentParams struct {
+	// ViewerId 閲覧者ID（下書きノートの権限チェック用）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
+// NotesCollaborateOnNoteParams defines parameters for NotesCollaborateOnNote.
+type NotesCollaborateOnNoteParams struct {
+	// AccountId 参加するアカウントID（下書きノートは所有者のみ、保存は所有者のみ）
+	AccountId string `form:"accountId" json:"accountId"`
+}
+
+// NotesExportNoteParams defines parameters for NotesExportNote.
+type NotesExportNoteParams struct {
+	// Format エクスポート形式
+	Format ModelsExportFormat `form:"format" json:"format"`
+
+	// ViewerId 閲覧者ID（下書きノートの権限チェック用）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
 // NotesPublishNoteParams defines parameters for NotesPublishNote.
 type NotesPublishNoteParams struct {
 	// OwnerId 所有者ID（公開権限チェック用）
 	OwnerId string `form:"ownerId" json:"ownerId"`
 }
 
+// NotesRetemplateNoteParams defines parameters for NotesRetemplateNote.
+type NotesRetemplateNoteParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+
+	// FieldRemoval 対応付けのないフィールドのノート内容の扱い（既定は reject）
+	FieldRemoval *ModelsFieldRemovalPolicy `form:"fieldRemoval,omitempty" json:"fieldRemoval,omitempty"`
+}
+
 // NotesUnpublishNoteParams defines parameters for NotesUnpublishNote.
 type NotesUnpublishNoteParams struct {
 	// OwnerId 所有者ID（公開権限チェック用）
 	OwnerId string `form:"ownerId" json:"ownerId"`
 }
 
-// TemplatesListTemplatesParams defines parameters for TemplatesListTemplates.
-type TemplatesListTemplatesParams struct {
-	// Q テンプレート名のキーワード検索
-	Q *string `form:"q,omitempty" json:"q,omitempty"`
+// NotesUpgradeNoteParams defines parameters for NotesUpgradeNote.
+type NotesUpgradeNoteParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
 
-	// OwnerId 所有者IDフィルター
-	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+	// FieldRemoval 対応付けのないフィールドのノート内容の扱い（既定は reject）
+	FieldRemoval *ModelsFieldRemovalPolicy `form:"fieldRemoval,omitempty" json:"fieldRemoval,omitempty"`
 }
 
-// TemplatesDeleteTemplateParams defines parameters for TemplatesDeleteTemplate.
-type TemplatesDeleteTemplateParams struct {
-	OwnerId string `form:"ownerId" json:"ownerId"`
+// NotificationsListNotificationsParams defines parameters for NotificationsListNotifications.
+type NotificationsListNotificationsParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+
+	// UnreadOnly true の場合は未読のみ返す
+	UnreadOnly *bool `form:"unreadOnly,omitempty" json:"unreadOnly,omitempty"`
 }
 
-// TemplatesUpdateTemplateParams defines parameters for TemplatesUpdateTemplate.
-type TemplatesUpdateTemplateParams struct {
-	OwnerId string `form:"ownerId" json:"ownerId"`
+// NotificationsGetNotificationPreferencesParams defines parameters for NotificationsGetNotificationPreferences.
+type NotificationsGetNotificationPreferencesParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
 }
 
-// AccountsCreateOrGetAccountJSONRequestBody defines body for AccountsCreateOrGetAccount for application/json ContentType.
-type AccountsCreateOrGetAccountJSONRequestBody = ModelsCreateOrGetAccountRequest
+// NotificationsUpdateNotificationPreferencesParams defines parameters for NotificationsUpdateNotificationPreferences.
+type NotificationsUpdateNotificationPreferencesParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+}
 
-// NotesCreateNoteJSONRequestBody defines body for NotesCreateNote for application/json ContentType.
-type NotesCreateNoteJSONRequestBody = ModelsCreateNoteRequest
+// NotificationsMarkAllNotificationsReadParams defines parameters for NotificationsMarkAllNotificationsRead.
+type NotificationsMarkAllNotificationsReadParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+}
+
+// NotificationsGetUnreadNotificationCountParams defines parameters for NotificationsGetUnreadNotificationCount.
+type NotificationsGetUnreadNotificationCountParams struct {
+	// AccountId アカウントID
+	AccountId string `form:"accountId" json:"accountId"`
+}
+
+// NotificationsMarkNotificationReadParams defines parameters for NotificationsMarkNotificationRead.
+type NotificationsMarkNotificationReadParams struct {
+	// AccountId アカウントID（権限チェック用）
+	AccountId string `form:"accountId" json:"accountId"`
+}
+
+// TemplatesListTemplatesParams defines parameters for TemplatesListTemplates.
+type TemplatesListTemplatesParams struct {
+	// Q テンプレート名・フィールドラベルのキーワード検索
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// FieldLabel フィールドラベルの完全一致フィルター
+	FieldLabel *string `form:"fieldLabel,omitempty" json:"fieldLabel,omitempty"`
+
+	// ViewerId 閲覧者ID（指定時は閲覧者自身の非公開テンプレートも対象にする）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+
+	// IncludeDeprecated 非推奨のテンプレートも含める（省略時は含めない）
+	IncludeDeprecated *bool `form:"includeDeprecated,omitempty" json:"includeDeprecated,omitempty"`
+}
+
+// TemplatesExportTemplateBundleParams defines parameters for TemplatesExportTemplateBundle.
+type TemplatesExportTemplateBundleParams struct {
+	// Ids エクスポートするテンプレートID（指定順。省略時は ownerId のテンプレート）
+	Ids *[]string `form:"ids,omitempty" json:"ids,omitempty"`
+
+	// OwnerId 所有者ID（ids 省略時にこの所有者のテンプレートをすべて出力）
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// ViewerId 閲覧者ID（指定時は閲覧者自身の非公開テンプレートも対象にする）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+
+	// Format 形式（省略時は json）
+	Format *ModelsTemplateBundleFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// TemplatesImportTemplateBundleParams defines parameters for TemplatesImportTemplateBundle.
+type TemplatesImportTemplateBundleParams struct {
+	// OwnerId 所有者ID
+	OwnerId string `form:"ownerId" json:"ownerId"`
+
+	// OnConflict 同名テンプレートが既にある場合の扱い（省略時は skip）
+	OnConflict *ModelsTemplateConflictMode `form:"onConflict,omitempty" json:"onConflict,omitempty"`
+}
+
+// TemplatesDeleteTemplateParams defines parameters for TemplatesDeleteTemplate.
+type TemplatesDeleteTemplateParams struct {
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// TemplatesGetTemplateByIdParams defines parameters for TemplatesGetTemplateById.
+type TemplatesGetTemplateByIdParams struct {
+	// ViewerId 閲覧者ID（指定時は閲覧者自身の非公開テンプレートも対象にする）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
+// TemplatesUpdateTemplateParams defines parameters for TemplatesUpdateTemplate.
+type TemplatesUpdateTemplateParams struct {
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// TemplatesUndeprecateTemplateParams defines parameters for TemplatesUndeprecateTemplate.
+type TemplatesUndeprecateTemplateParams struct {
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// TemplatesDeprecateTemplateParams defines parameters for TemplatesDeprecateTemplate.
+type TemplatesDeprecateTemplateParams struct {
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// TemplatesForkTemplateParams defines parameters for TemplatesForkTemplate.
+type TemplatesForkTemplateParams struct {
+	// OwnerId フォーク後の所有者ID
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// TemplatesListTemplateNotesParams defines parameters for TemplatesListTemplateNotes.
+type TemplatesListTemplateNotesParams struct {
+	// ViewerId 閲覧者ID（指定時は閲覧者自身の下書きノートも含める）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
+// TemplatesExportTemplateNotesCsvParams defines parameters for TemplatesExportTemplateNotesCsv.
+type TemplatesExportTemplateNotesCsvParams struct {
+	// ViewerId 閲覧者ID（指定時は閲覧者自身の下書きノートも含める）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
+// TemplatesImportTemplateNotesCsvParams defines parameters for TemplatesImportTemplateNotesCsv.
+type TemplatesImportTemplateNotesCsvParams struct {
+	// OwnerId 所有者ID
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// TemplatesGetTemplateVersionParams defines parameters for TemplatesGetTemplateVersion.
+type TemplatesGetTemplateVersionParams struct {
+	// ViewerId 閲覧者ID（指定時は閲覧者自身の非公開テンプレートも対象にする）
+	ViewerId *string `form:"viewerId,omitempty" json:"viewerId,omitempty"`
+}
+
+// WebhooksListWebhooksParams defines parameters for WebhooksListWebhooks.
+type WebhooksListWebhooksParams struct {
+	// OwnerId 所有者ID
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// WebhooksCreateWebhookParams defines parameters for WebhooksCreateWebhook.
+type WebhooksCreateWebhookParams struct {
+	// OwnerId 所有者ID
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// WebhooksDeleteWebhookParams defines parameters for WebhooksDeleteWebhook.
+type WebhooksDeleteWebhookParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// WebhooksGetWebhookParams defines parameters for WebhooksGetWebhook.
+type WebhooksGetWebhookParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// WebhooksUpdateWebhookParams defines parameters for WebhooksUpdateWebhook.
+type WebhooksUpdateWebhookParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// WebhooksListWebhookDeliveriesParams defines parameters for WebhooksListWebhookDeliveries.
+type WebhooksListWebhookDeliveriesParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// WebhooksRedeliverWebhookDeliveryParams defines parameters for WebhooksRedeliverWebhookDelivery.
+type WebhooksRedeliverWebhookDeliveryParams struct {
+	// OwnerId 所有者ID（権限チェック用）
+	OwnerId string `form:"ownerId" json:"ownerId"`
+}
+
+// AccountsCreateOrGetAccountJSONRequestBody defines body for AccountsCreateOrGetAccount for application/json ContentType.
+type AccountsCreateOrGetAccountJSONRequestBody = ModelsCreateOrGetAccountRequest
+
+// DigestsUpdateDigestSettingsJSONRequestBody defines body for DigestsUpdateDigestSettings for application/json ContentType.
+type DigestsUpdateDigestSettingsJSONRequestBody = ModelsUpdateDigestSettingsRequest
+
+// NotesCreateNoteJSONRequestBody defines body for NotesCreateNote for application/json ContentType.
+type NotesCreateNoteJSONRequestBody = ModelsCreateNoteRequest
+
+// NotesBatchDeleteNotesJSONRequestBody defines body for NotesBatchDeleteNotes for application/json ContentType.
+type NotesBatchDeleteNotesJSONRequestBody = ModelsBatchNoteRequest
+
+// NotesBatchPublishNotesJSONRequestBody defines body for NotesBatchPublishNotes for application/json ContentType.
+type NotesBatchPublishNotesJSONRequestBody = ModelsBatchNoteRequest
+
+// NotesBatchTransferNotesJSONRequestBody defines body for NotesBatchTransferNotes for application/json ContentType.
+type NotesBatchTransferNotesJSONRequestBody = ModelsTransferNotesRequest
+
+// NotesBatchUnpublishNotesJSONRequestBody defines body for NotesBatchUnpublishNotes for application/json ContentType.
+type NotesBatchUnpublishNotesJSONRequestBody = ModelsBatchNoteRequest
+
+// NotesImportNotesMultipartRequestBody defines body for NotesImportNotes for multipart/form-data ContentType.
+type NotesImportNotesMultipartRequestBody = ModelsImportNotesRequest
 
 // NotesUpdateNoteJSONRequestBody defines body for NotesUpdateNote for application/json ContentType.
 type NotesUpdateNoteJSONRequestBody = ModelsUpdateNoteRequest
 
+// AttachmentsUploadAttachmentMultipartRequestBody defines body for AttachmentsUploadAttachment for multipart/form-data ContentType.
+type AttachmentsUploadAttachmentMultipartRequestBody = ModelsUploadAttachmentRequest
+
+// NotesRetemplateNoteJSONRequestBody defines body for NotesRetemplateNote for application/json ContentType.
+type NotesRetemplateNoteJSONRequestBody = ModelsRetemplateNoteRequest
+
+// NotesUpgradeNoteJSONRequestBody defines body for NotesUpgradeNote for application/json ContentType.
+type NotesUpgradeNoteJSONRequestBody = ModelsUpgradeNoteRequest
+
+// NotificationsUpdateNotificationPreferencesJSONRequestBody defines body for NotificationsUpdateNotificationPreferences for application/json ContentType.
+type NotificationsUpdateNotificationPreferencesJSONRequestBody = ModelsUpdateNotificationPreferencesRequest
+
 // TemplatesCreateTemplateJSONRequestBody defines body for TemplatesCreateTemplate for application/json ContentType.
 type TemplatesCreateTemplateJSONRequestBody = ModelsCreateTemplateRequest
 
+// TemplatesImportTemplateBundleMultipartRequestBody defines body for TemplatesImportTemplateBundle for multipart/form-data ContentType.
+type TemplatesImportTemplateBundleMultipartRequestBody = ModelsImportTemplateBundleRequest
+
 // TemplatesUpdateTemplateJSONRequestBody defines body for TemplatesUpdateTemplate for application/json ContentType.
 type TemplatesUpdateTemplateJSONRequestBody = ModelsUpdateTemplateRequest
 
+// TemplatesDeprecateTemplateJSONRequestBody defines body for TemplatesDeprecateTemplate for application/json ContentType.
+type TemplatesDeprecateTemplateJSONRequestBody = ModelsDeprecateTemplateRequest
+
+// TemplatesForkTemplateJSONRequestBody defines body for TemplatesForkTemplate for application/json ContentType.
+type TemplatesForkTemplateJSONRequestBody = ModelsForkTemplateRequest
+
+// TemplatesImportTemplateNotesCsvMultipartRequestBody defines body for TemplatesImportTemplateNotesCsv for multipart/form-data ContentType.
+type TemplatesImportTemplateNotesCsvMultipartRequestBody = ModelsImportNotesCsvRequest
+
+// WebhooksCreateWebhookJSONRequestBody defines body for WebhooksCreateWebhook for application/json ContentType.
+type WebhooksCreateWebhookJSONRequestBody = ModelsCreateWebhookRequest
+
+// WebhooksUpdateWebhookJSONRequestBody defines body for WebhooksUpdateWebhook for application/json ContentType.
+type WebhooksUpdateWebhookJSONRequestBody = ModelsUpdateWebhookRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Create or get account via OAuth
@@ -482,12 +1836,51 @@ type ServerInterface interface {
 	// Get account by ID
 	// (GET /api/accounts/{accountId})
 	AccountsGetAccountById(ctx echo.Context, accountId string) error
+	// Unfollow account
+	// (DELETE /api/accounts/{accountId}/follow)
+	AccountsUnfollowAccount(ctx echo.Context, accountId string, params AccountsUnfollowAccountParams) error
+	// Follow account
+	// (POST /api/accounts/{accountId}/follow)
+	AccountsFollowAccount(ctx echo.Context, accountId string, params AccountsFollowAccountParams) error
+	// Get digest settings
+	// (GET /api/digests/settings)
+	DigestsGetDigestSettings(ctx echo.Context, params DigestsGetDigestSettingsParams) error
+	// Update digest settings
+	// (PUT /api/digests/settings)
+	DigestsUpdateDigestSettings(ctx echo.Context, params DigestsUpdateDigestSettingsParams) error
+	// Confirm unsubscribing from digests
+	// (GET /api/digests/unsubscribe)
+	DigestsConfirmUnsubscribeDigest(ctx echo.Context, params DigestsConfirmUnsubscribeDigestParams) error
+	// Unsubscribe from digests
+	// (POST /api/digests/unsubscribe)
+	DigestsUnsubscribeDigest(ctx echo.Context, params DigestsUnsubscribeDigestParams) error
+	// Get activity feed
+	// (GET /api/feed)
+	FeedGetFeed(ctx echo.Context, params FeedGetFeedParams) error
 	// Get notes list
 	// (GET /api/notes)
 	NotesListNotes(ctx echo.Context, params NotesListNotesParams) error
 	// Create note
 	// (POST /api/notes)
 	NotesCreateNote(ctx echo.Context) error
+	// Delete notes in batch
+	// (POST /api/notes/batch/delete)
+	NotesBatchDeleteNotes(ctx echo.Context, params NotesBatchDeleteNotesParams) error
+	// Publish notes in batch
+	// (POST /api/notes/batch/publish)
+	NotesBatchPublishNotes(ctx echo.Context, params NotesBatchPublishNotesParams) error
+	// Transfer notes in batch
+	// (POST /api/notes/batch/transfer)
+	NotesBatchTransferNotes(ctx echo.Context, params NotesBatchTransferNotesParams) error
+	// Unpublish notes in batch
+	// (POST /api/notes/batch/unpublish)
+	NotesBatchUnpublishNotes(ctx echo.Context, params NotesBatchUnpublishNotesParams) error
+	// Stream note changes
+	// (GET /api/notes/events)
+	NotesStreamNoteEvents(ctx echo.Context, params NotesStreamNoteEventsParams) error
+	// Import notes from Markdown
+	// (POST /api/notes/import)
+	NotesImportNotes(ctx echo.Context, params NotesImportNotesParams) error
 	// Delete note
 	// (DELETE /api/notes/{noteId})
 	NotesDeleteNote(ctx echo.Context, noteId string, params NotesDeleteNoteParams) error
@@ -497,27 +1890,117 @@ type ServerInterface interface {
 	// Update note
 	// (PUT /api/notes/{noteId})
 	NotesUpdateNote(ctx echo.Context, noteId string, params NotesUpdateNoteParams) error
+	// Get attachments of note
+	// (GET /api/notes/{noteId}/attachments)
+	AttachmentsListAttachments(ctx echo.Context, noteId string, params AttachmentsListAttachmentsParams) error
+	// Upload attachment
+	// (POST /api/notes/{noteId}/attachments)
+	AttachmentsUploadAttachment(ctx echo.Context, noteId string, params AttachmentsUploadAttachmentParams) error
+	// Delete attachment
+	// (DELETE /api/notes/{noteId}/attachments/{attachmentId})
+	AttachmentsDeleteAttachment(ctx echo.Context, noteId string, attachmentId string, params AttachmentsDeleteAttachmentParams) error
+	// Download attachment
+	// (GET /api/notes/{noteId}/attachments/{attachmentId})
+	AttachmentsDownloadAttachment(ctx echo.Context, noteId string, attachmentId string, params AttachmentsDownloadAttachmentParams) error
+	// Collaborate on note
+	// (GET /api/notes/{noteId}/collab)
+	NotesCollaborateOnNote(ctx echo.Context, noteId string, params NotesCollaborateOnNoteParams) error
+	// Export note
+	// (GET /api/notes/{noteId}/export)
+	NotesExportNote(ctx echo.Context, noteId string, params NotesExportNoteParams) error
 	// Publish note
 	// (POST /api/notes/{noteId}/publish)
 	NotesPublishNote(ctx echo.Context, noteId string, params NotesPublishNoteParams) error
+	// Move note to another template
+	// (POST /api/notes/{noteId}/retemplate)
+	NotesRetemplateNote(ctx echo.Context, noteId string, params NotesRetemplateNoteParams) error
 	// Unpublish note
 	// (POST /api/notes/{noteId}/unpublish)
 	NotesUnpublishNote(ctx echo.Context, noteId string, params NotesUnpublishNoteParams) error
+	// Upgrade note to latest template version
+	// (POST /api/notes/{noteId}/upgrade)
+	NotesUpgradeNote(ctx echo.Context, noteId string, params NotesUpgradeNoteParams) error
+	// Get notifications
+	// (GET /api/notifications)
+	NotificationsListNotifications(ctx echo.Context, params NotificationsListNotificationsParams) error
+	// Get notification preferences
+	// (GET /api/notifications/preferences)
+	NotificationsGetNotificationPreferences(ctx echo.Context, params NotificationsGetNotificationPreferencesParams) error
+	// Update notification preferences
+	// (PUT /api/notifications/preferences)
+	NotificationsUpdateNotificationPreferences(ctx echo.Context, params NotificationsUpdateNotificationPreferencesParams) error
+	// Mark all notifications read
+	// (POST /api/notifications/read-all)
+	NotificationsMarkAllNotificationsRead(ctx echo.Context, params NotificationsMarkAllNotificationsReadParams) error
+	// Get unread notification count
+	// (GET /api/notifications/unread-count)
+	NotificationsGetUnreadNotificationCount(ctx echo.Context, params NotificationsGetUnreadNotificationCountParams) error
+	// Mark notification read
+	// (POST /api/notifications/{notificationId}/read)
+	NotificationsMarkNotificationRead(ctx echo.Context, notificationId string, params NotificationsMarkNotificationReadParams) error
 	// Get templates list
 	// (GET /api/templates)
 	TemplatesListTemplates(ctx echo.Context, params TemplatesListTemplatesParams) error
 	// Create template
 	// (POST /api/templates)
 	TemplatesCreateTemplate(ctx echo.Context) error
+	// Export templates as bundle
+	// (GET /api/templates/bundle)
+	TemplatesExportTemplateBundle(ctx echo.Context, params TemplatesExportTemplateBundleParams) error
+	// Import templates from bundle
+	// (POST /api/templates/bundle)
+	TemplatesImportTemplateBundle(ctx echo.Context, params TemplatesImportTemplateBundleParams) error
 	// Delete template
 	// (DELETE /api/templates/{templateId})
 	TemplatesDeleteTemplate(ctx echo.Context, templateId string, params TemplatesDeleteTemplateParams) error
 	// Get template by ID
 	// (GET /api/templates/{templateId})
-	TemplatesGetTemplateById(ctx echo.Context, templateId string) error
+	TemplatesGetTemplateById(ctx echo.Context, templateId string, params TemplatesGetTemplateByIdParams) error
 	// Update template
 	// (PUT /api/templates/{templateId})
 	TemplatesUpdateTemplate(ctx echo.Context, templateId string, params TemplatesUpdateTemplateParams) error
+	// Undeprecate template
+	// (DELETE /api/templates/{templateId}/deprecation)
+	TemplatesUndeprecateTemplate(ctx echo.Context, templateId string, params TemplatesUndeprecateTemplateParams) error
+	// Deprecate template
+	// (POST /api/templates/{templateId}/deprecation)
+	TemplatesDeprecateTemplate(ctx echo.Context, templateId string, params TemplatesDeprecateTemplateParams) error
+	// Fork template
+	// (POST /api/templates/{templateId}/fork)
+	TemplatesForkTemplate(ctx echo.Context, templateId string, params TemplatesForkTemplateParams) error
+	// Get notes using template
+	// (GET /api/templates/{templateId}/notes)
+	TemplatesListTemplateNotes(ctx echo.Context, templateId string, params TemplatesListTemplateNotesParams) error
+	// Export template notes as CSV
+	// (GET /api/templates/{templateId}/notes.csv)
+	TemplatesExportTemplateNotesCsv(ctx echo.Context, templateId string, params TemplatesExportTemplateNotesCsvParams) error
+	// Import template notes from CSV
+	// (POST /api/templates/{templateId}/notes.csv)
+	TemplatesImportTemplateNotesCsv(ctx echo.Context, templateId string, params TemplatesImportTemplateNotesCsvParams) error
+	// Get template version
+	// (GET /api/templates/{templateId}/versions/{version})
+	TemplatesGetTemplateVersion(ctx echo.Context, templateId string, version int32, params TemplatesGetTemplateVersionParams) error
+	// Get webhooks
+	// (GET /api/webhooks)
+	WebhooksListWebhooks(ctx echo.Context, params WebhooksListWebhooksParams) error
+	// Create webhook
+	// (POST /api/webhooks)
+	WebhooksCreateWebhook(ctx echo.Context, params WebhooksCreateWebhookParams) error
+	// Delete webhook
+	// (DELETE /api/webhooks/{webhookId})
+	WebhooksDeleteWebhook(ctx echo.Context, webhookId string, params WebhooksDeleteWebhookParams) error
+	// Get webhook by ID
+	// (GET /api/webhooks/{webhookId})
+	WebhooksGetWebhook(ctx echo.Context, webhookId string, params WebhooksGetWebhookParams) error
+	// Update webhook
+	// (PUT /api/webhooks/{webhookId})
+	WebhooksUpdateWebhook(ctx echo.Context, webhookId string, params WebhooksUpdateWebhookParams) error
+	// Get webhook deliveries
+	// (GET /api/webhooks/{webhookId}/deliveries)
+	WebhooksListWebhookDeliveries(ctx echo.Context, webhookId string, params WebhooksListWebhookDeliveriesParams) error
+	// Redeliver webhook delivery
+	// (POST /api/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	WebhooksRedeliverWebhookDelivery(ctx echo.Context, webhookId string, deliveryId string, params WebhooksRedeliverWebhookDeliveryParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -530,114 +2013,1133 @@ func (w *ServerInterfaceWrapper) AccountsCreateOrGetAccount(ctx echo.Context) er
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsCreateOrGetAccount(ctx)
+	err = w.Handler.AccountsCreateOrGetAccount(ctx)
+	return err
+}
+
+// AccountsGetAccountByEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetAccountByEmail(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsGetAccountByEmailParams
+	// ------------- Required query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "email", ctx.QueryParams(), &params.Email)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetAccountByEmail(ctx, params)
+	return err
+}
+
+// AccountsGetCurrentAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetCurrentAccount(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetCurrentAccount(ctx)
+	return err
+}
+
+// AccountsGetAccountById converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetAccountById(ctx, accountId)
+	return err
+}
+
+// AccountsUnfollowAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsUnfollowAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsUnfollowAccountParams
+	// ------------- Required query parameter "followerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "followerId", ctx.QueryParams(), &params.FollowerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter followerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsUnfollowAccount(ctx, accountId, params)
+	return err
+}
+
+// AccountsFollowAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsFollowAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsFollowAccountParams
+	// ------------- Required query parameter "followerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "followerId", ctx.QueryParams(), &params.FollowerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter followerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsFollowAccount(ctx, accountId, params)
+	return err
+}
+
+// DigestsGetDigestSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) DigestsGetDigestSettings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DigestsGetDigestSettingsParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DigestsGetDigestSettings(ctx, params)
+	return err
+}
+
+// DigestsUpdateDigestSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) DigestsUpdateDigestSettings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DigestsUpdateDigestSettingsParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DigestsUpdateDigestSettings(ctx, params)
+	return err
+}
+
+// DigestsConfirmUnsubscribeDigest converts echo context to params.
+func (w *ServerInterfaceWrapper) DigestsConfirmUnsubscribeDigest(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DigestsConfirmUnsubscribeDigestParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DigestsConfirmUnsubscribeDigest(ctx, params)
+	return err
+}
+
+// DigestsUnsubscribeDigest converts echo context to params.
+func (w *ServerInterfaceWrapper) DigestsUnsubscribeDigest(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DigestsUnsubscribeDigestParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DigestsUnsubscribeDigest(ctx, params)
+	return err
+}
+
+// FeedGetFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) FeedGetFeed(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FeedGetFeedParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FeedGetFeed(ctx, params)
+	return err
+}
+
+// NotesListNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNotes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesListNotesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "templateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNotes(ctx, params)
+	return err
+}
+
+// NotesCreateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesCreateNote(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesCreateNote(ctx)
+	return err
+}
+
+// NotesBatchDeleteNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesBatchDeleteNotes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesBatchDeleteNotesParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesBatchDeleteNotes(ctx, params)
+	return err
+}
+
+// NotesBatchPublishNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesBatchPublishNotes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesBatchPublishNotesParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesBatchPublishNotes(ctx, params)
+	return err
+}
+
+// NotesBatchTransferNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesBatchTransferNotes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesBatchTransferNotesParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesBatchTransferNotes(ctx, params)
+	return err
+}
+
+// NotesBatchUnpublishNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesBatchUnpublishNotes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesBatchUnpublishNotesParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesBatchUnpublishNotes(ctx, params)
+	return err
+}
+
+// NotesStreamNoteEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesStreamNoteEvents(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesStreamNoteEventsParams
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "templateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesStreamNoteEvents(ctx, params)
+	return err
+}
+
+// NotesImportNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesImportNotes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesImportNotesParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesImportNotes(ctx, params)
+	return err
+}
+
+// NotesDeleteNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesDeleteNoteParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDeleteNote(ctx, noteId, params)
+	return err
+}
+
+// NotesGetNoteById converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesGetNoteById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesGetNoteById(ctx, noteId)
+	return err
+}
+
+// NotesUpdateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUpdateNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUpdateNoteParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUpdateNote(ctx, noteId, params)
+	return err
+}
+
+// AttachmentsListAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentsListAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AttachmentsListAttachmentsParams
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AttachmentsListAttachments(ctx, noteId, params)
+	return err
+}
+
+// AttachmentsUploadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentsUploadAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AttachmentsUploadAttachmentParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AttachmentsUploadAttachment(ctx, noteId, params)
+	return err
+}
+
+// AttachmentsDeleteAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentsDeleteAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AttachmentsDeleteAttachmentParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AttachmentsDeleteAttachment(ctx, noteId, attachmentId, params)
+	return err
+}
+
+// AttachmentsDownloadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentsDownloadAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AttachmentsDownloadAttachmentParams
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AttachmentsDownloadAttachment(ctx, noteId, attachmentId, params)
+	return err
+}
+
+// NotesCollaborateOnNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesCollaborateOnNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesCollaborateOnNoteParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesCollaborateOnNote(ctx, noteId, params)
+	return err
+}
+
+// NotesExportNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesExportNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesExportNoteParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesExportNote(ctx, noteId, params)
+	return err
+}
+
+// NotesPublishNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesPublishNoteParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesPublishNote(ctx, noteId, params)
+	return err
+}
+
+// NotesRetemplateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesRetemplateNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesRetemplateNoteParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "fieldRemoval" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fieldRemoval", ctx.QueryParams(), &params.FieldRemoval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldRemoval: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesRetemplateNote(ctx, noteId, params)
+	return err
+}
+
+// NotesUnpublishNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUnpublishNoteParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUnpublishNote(ctx, noteId, params)
+	return err
+}
+
+// NotesUpgradeNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUpgradeNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUpgradeNoteParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "fieldRemoval" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fieldRemoval", ctx.QueryParams(), &params.FieldRemoval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldRemoval: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUpgradeNote(ctx, noteId, params)
+	return err
+}
+
+// NotificationsListNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationsListNotifications(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsListNotificationsParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// ------------- Optional query parameter "unreadOnly" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "unreadOnly", ctx.QueryParams(), &params.UnreadOnly)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unreadOnly: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotificationsListNotifications(ctx, params)
+	return err
+}
+
+// NotificationsGetNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationsGetNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsGetNotificationPreferencesParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotificationsGetNotificationPreferences(ctx, params)
+	return err
+}
+
+// NotificationsUpdateNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationsUpdateNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsUpdateNotificationPreferencesParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotificationsUpdateNotificationPreferences(ctx, params)
+	return err
+}
+
+// NotificationsMarkAllNotificationsRead converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationsMarkAllNotificationsRead(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsMarkAllNotificationsReadParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotificationsMarkAllNotificationsRead(ctx, params)
+	return err
+}
+
+// NotificationsGetUnreadNotificationCount converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationsGetUnreadNotificationCount(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsGetUnreadNotificationCountParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotificationsGetUnreadNotificationCount(ctx, params)
+	return err
+}
+
+// NotificationsMarkNotificationRead converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationsMarkNotificationRead(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "notificationId" -------------
+	var notificationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", ctx.Param("notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsMarkNotificationReadParams
+	// ------------- Required query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "accountId", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotificationsMarkNotificationRead(ctx, notificationId, params)
+	return err
+}
+
+// TemplatesListTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTemplates(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesListTemplatesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "fieldLabel" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fieldLabel", ctx.QueryParams(), &params.FieldLabel)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldLabel: %s", err))
+	}
+
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "includeDeprecated" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "includeDeprecated", ctx.QueryParams(), &params.IncludeDeprecated)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeDeprecated: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesListTemplates(ctx, params)
+	return err
+}
+
+// TemplatesCreateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesCreateTemplate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesCreateTemplate(ctx)
+	return err
+}
+
+// TemplatesExportTemplateBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesExportTemplateBundle(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesExportTemplateBundleParams
+	// ------------- Optional query parameter "ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ids", ctx.QueryParams(), &params.Ids)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesExportTemplateBundle(ctx, params)
+	return err
+}
+
+// TemplatesImportTemplateBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesImportTemplateBundle(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesImportTemplateBundleParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "onConflict" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "onConflict", ctx.QueryParams(), &params.OnConflict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter onConflict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesImportTemplateBundle(ctx, params)
 	return err
 }
 
-// AccountsGetAccountByEmail converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetAccountByEmail(ctx echo.Context) error {
+// TemplatesDeleteTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AccountsGetAccountByEmailParams
-	// ------------- Required query parameter "email" -------------
+	var params TemplatesDeleteTemplateParams
+	// ------------- Required query parameter "ownerId" -------------
 
-	err = runtime.BindQueryParameter("form", false, true, "email", ctx.QueryParams(), &params.Email)
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetAccountByEmail(ctx, params)
+	err = w.Handler.TemplatesDeleteTemplate(ctx, templateId, params)
 	return err
 }
 
-// AccountsGetCurrentAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetCurrentAccount(ctx echo.Context) error {
+// TemplatesGetTemplateById converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesGetTemplateById(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetCurrentAccount(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
 
-// AccountsGetAccountById converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "accountId" -------------
-	var accountId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesGetTemplateByIdParams
+	// ------------- Optional query parameter "viewerId" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetAccountById(ctx, accountId)
+	err = w.Handler.TemplatesGetTemplateById(ctx, templateId, params)
 	return err
 }
 
-// NotesListNotes converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesListNotes(ctx echo.Context) error {
+// TemplatesUpdateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesListNotesParams
-	// ------------- Optional query parameter "q" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesUpdateTemplateParams
+	// ------------- Required query parameter "ownerId" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
 	}
 
-	// ------------- Optional query parameter "templateId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesUpdateTemplate(ctx, templateId, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+// TemplatesUndeprecateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesUndeprecateTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
-	// ------------- Optional query parameter "ownerId" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesUndeprecateTemplateParams
+	// ------------- Required query parameter "ownerId" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesListNotes(ctx, params)
+	err = w.Handler.TemplatesUndeprecateTemplate(ctx, templateId, params)
 	return err
 }
 
-// NotesCreateNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesCreateNote(ctx echo.Context) error {
+// TemplatesDeprecateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesDeprecateTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesDeprecateTemplateParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesCreateNote(ctx)
+	err = w.Handler.TemplatesDeprecateTemplate(ctx, templateId, params)
 	return err
 }
 
-// NotesDeleteNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
+// TemplatesForkTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesForkTemplate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesDeleteNoteParams
+	var params TemplatesForkTemplateParams
 	// ------------- Required query parameter "ownerId" -------------
 
 	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
@@ -646,64 +3148,73 @@ func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesDeleteNote(ctx, noteId, params)
+	err = w.Handler.TemplatesForkTemplate(ctx, templateId, params)
 	return err
 }
 
-// NotesGetNoteById converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesGetNoteById(ctx echo.Context) error {
+// TemplatesListTemplateNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTemplateNotes(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesListTemplateNotesParams
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesGetNoteById(ctx, noteId)
+	err = w.Handler.TemplatesListTemplateNotes(ctx, templateId, params)
 	return err
 }
 
-// NotesUpdateNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesUpdateNote(ctx echo.Context) error {
+// TemplatesExportTemplateNotesCsv converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesExportTemplateNotesCsv(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesUpdateNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	var params TemplatesExportTemplateNotesCsvParams
+	// ------------- Optional query parameter "viewerId" -------------
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesUpdateNote(ctx, noteId, params)
+	err = w.Handler.TemplatesExportTemplateNotesCsv(ctx, templateId, params)
 	return err
 }
 
-// NotesPublishNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
+// TemplatesImportTemplateNotesCsv converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesImportTemplateNotesCsv(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesPublishNoteParams
+	var params TemplatesImportTemplateNotesCsvParams
 	// ------------- Required query parameter "ownerId" -------------
 
 	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
@@ -712,23 +3223,49 @@ func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesPublishNote(ctx, noteId, params)
+	err = w.Handler.TemplatesImportTemplateNotesCsv(ctx, templateId, params)
 	return err
 }
 
-// NotesUnpublishNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
+// TemplatesGetTemplateVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesGetTemplateVersion(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", ctx.Param("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesUnpublishNoteParams
+	var params TemplatesGetTemplateVersionParams
+	// ------------- Optional query parameter "viewerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "viewerId", ctx.QueryParams(), &params.ViewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter viewerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesGetTemplateVersion(ctx, templateId, version, params)
+	return err
+}
+
+// WebhooksListWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksListWebhooks(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksListWebhooksParams
 	// ------------- Required query parameter "ownerId" -------------
 
 	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
@@ -737,57 +3274,91 @@ func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesUnpublishNote(ctx, noteId, params)
+	err = w.Handler.WebhooksListWebhooks(ctx, params)
 	return err
 }
 
-// TemplatesListTemplates converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesListTemplates(ctx echo.Context) error {
+// WebhooksCreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksCreateWebhook(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesListTemplatesParams
-	// ------------- Optional query parameter "q" -------------
+	var params WebhooksCreateWebhookParams
+	// ------------- Required query parameter "ownerId" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
 	}
 
-	// ------------- Optional query parameter "ownerId" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhooksCreateWebhook(ctx, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+// WebhooksDeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksDeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksDeleteWebhookParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesListTemplates(ctx, params)
+	err = w.Handler.WebhooksDeleteWebhook(ctx, webhookId, params)
 	return err
 }
 
-// TemplatesCreateTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesCreateTemplate(ctx echo.Context) error {
+// WebhooksGetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksGetWebhook(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksGetWebhookParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesCreateTemplate(ctx)
+	err = w.Handler.WebhooksGetWebhook(ctx, webhookId, params)
 	return err
 }
 
-// TemplatesDeleteTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error {
+// WebhooksUpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksUpdateWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesDeleteTemplateParams
+	var params WebhooksUpdateWebhookParams
 	// ------------- Required query parameter "ownerId" -------------
 
 	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
@@ -796,39 +3367,56 @@ func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesDeleteTemplate(ctx, templateId, params)
+	err = w.Handler.WebhooksUpdateWebhook(ctx, webhookId, params)
 	return err
 }
 
-// TemplatesGetTemplateById converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesGetTemplateById(ctx echo.Context) error {
+// WebhooksListWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksListWebhookDeliveries(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksListWebhookDeliveriesParams
+	// ------------- Required query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesGetTemplateById(ctx, templateId)
+	err = w.Handler.WebhooksListWebhookDeliveries(ctx, webhookId, params)
 	return err
 }
 
-// TemplatesUpdateTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error {
+// WebhooksRedeliverWebhookDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksRedeliverWebhookDelivery(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", ctx.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesUpdateTemplateParams
+	var params WebhooksRedeliverWebhookDeliveryParams
 	// ------------- Required query parameter "ownerId" -------------
 
 	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
@@ -837,7 +3425,7 @@ func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesUpdateTemplate(ctx, templateId, params)
+	err = w.Handler.WebhooksRedeliverWebhookDelivery(ctx, webhookId, deliveryId, params)
 	return err
 }
 
@@ -873,17 +3461,60 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/accounts/by-email", wrapper.AccountsGetAccountByEmail)
 	router.GET(baseURL+"/api/accounts/me", wrapper.AccountsGetCurrentAccount)
 	router.GET(baseURL+"/api/accounts/:accountId", wrapper.AccountsGetAccountById)
+	router.DELETE(baseURL+"/api/accounts/:accountId/follow", wrapper.AccountsUnfollowAccount)
+	router.POST(baseURL+"/api/accounts/:accountId/follow", wrapper.AccountsFollowAccount)
+	router.GET(baseURL+"/api/digests/settings", wrapper.DigestsGetDigestSettings)
+	router.PUT(baseURL+"/api/digests/settings", wrapper.DigestsUpdateDigestSettings)
+	router.GET(baseURL+"/api/digests/unsubscribe", wrapper.DigestsConfirmUnsubscribeDigest)
+	router.POST(baseURL+"/api/digests/unsubscribe", wrapper.DigestsUnsubscribeDigest)
+	router.GET(baseURL+"/api/feed", wrapper.FeedGetFeed)
 	router.GET(baseURL+"/api/notes", wrapper.NotesListNotes)
 	router.POST(baseURL+"/api/notes", wrapper.NotesCreateNote)
+	router.POST(baseURL+"/api/notes/batch/delete", wrapper.NotesBatchDeleteNotes)
+	router.POST(baseURL+"/api/notes/batch/publish", wrapper.NotesBatchPublishNotes)
+	router.POST(baseURL+"/api/notes/batch/transfer", wrapper.NotesBatchTransferNotes)
+	router.POST(baseURL+"/api/notes/batch/unpublish", wrapper.NotesBatchUnpublishNotes)
+	router.GET(baseURL+"/api/notes/events", wrapper.NotesStreamNoteEvents)
+	router.POST(baseURL+"/api/notes/import", wrapper.NotesImportNotes)
 	router.DELETE(baseURL+"/api/notes/:noteId", wrapper.NotesDeleteNote)
 	router.GET(baseURL+"/api/notes/:noteId", wrapper.NotesGetNoteById)
 	router.PUT(baseURL+"/api/notes/:noteId", wrapper.NotesUpdateNote)
+	router.GET(baseURL+"/api/notes/:noteId/attachments", wrapper.AttachmentsListAttachments)
+	router.POST(baseURL+"/api/notes/:noteId/attachments", wrapper.AttachmentsUploadAttachment)
+	router.DELETE(baseURL+"/api/notes/:noteId/attachments/:attachmentId", wrapper.AttachmentsDeleteAttachment)
+	router.GET(baseURL+"/api/notes/:noteId/attachments/:attachmentId", wrapper.AttachmentsDownloadAttachment)
+	router.GET(baseURL+"/api/notes/:noteId/collab", wrapper.NotesCollaborateOnNote)
+	router.GET(baseURL+"/api/notes/:noteId/export", wrapper.NotesExportNote)
 	router.POST(baseURL+"/api/notes/:noteId/publish", wrapper.NotesPublishNote)
+	router.POST(baseURL+"/api/notes/:noteId/retemplate", wrapper.NotesRetemplateNote)
 	router.POST(baseURL+"/api/notes/:noteId/unpublish", wrapper.NotesUnpublishNote)
+	router.POST(baseURL+"/api/notes/:noteId/upgrade", wrapper.NotesUpgradeNote)
+	router.GET(baseURL+"/api/notifications", wrapper.NotificationsListNotifications)
+	router.GET(baseURL+"/api/notifications/preferences", wrapper.NotificationsGetNotificationPreferences)
+	router.PUT(baseURL+"/api/notifications/preferences", wrapper.NotificationsUpdateNotificationPreferences)
+	router.POST(baseURL+"/api/notifications/read-all", wrapper.NotificationsMarkAllNotificationsRead)
+	router.GET(baseURL+"/api/notifications/unread-count", wrapper.NotificationsGetUnreadNotificationCount)
+	router.POST(baseURL+"/api/notifications/:notificationId/read", wrapper.NotificationsMarkNotificationRead)
 	router.GET(baseURL+"/api/templates", wrapper.TemplatesListTemplates)
 	router.POST(baseURL+"/api/templates", wrapper.TemplatesCreateTemplate)
+	router.GET(baseURL+"/api/templates/bundle", wrapper.TemplatesExportTemplateBundle)
+	router.POST(baseURL+"/api/templates/bundle", wrapper.TemplatesImportTemplateBundle)
 	router.DELETE(baseURL+"/api/templates/:templateId", wrapper.TemplatesDeleteTemplate)
 	router.GET(baseURL+"/api/templates/:templateId", wrapper.TemplatesGetTemplateById)
 	router.PUT(baseURL+"/api/templates/:templateId", wrapper.TemplatesUpdateTemplate)
+	router.DELETE(baseURL+"/api/templates/:templateId/deprecation", wrapper.TemplatesUndeprecateTemplate)
+	router.POST(baseURL+"/api/templates/:templateId/deprecation", wrapper.TemplatesDeprecateTemplate)
+	router.POST(baseURL+"/api/templates/:templateId/fork", wrapper.TemplatesForkTemplate)
+	router.GET(baseURL+"/api/templates/:templateId/notes", wrapper.TemplatesListTemplateNotes)
+	router.GET(baseURL+"/api/templates/:templateId/notes.csv", wrapper.TemplatesExportTemplateNotesCsv)
+	router.POST(baseURL+"/api/templates/:templateId/notes.csv", wrapper.TemplatesImportTemplateNotesCsv)
+	router.GET(baseURL+"/api/templates/:templateId/versions/:version", wrapper.TemplatesGetTemplateVersion)
+	router.GET(baseURL+"/api/webhooks", wrapper.WebhooksListWebhooks)
+	router.POST(baseURL+"/api/webhooks", wrapper.WebhooksCreateWebhook)
+	router.DELETE(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksDeleteWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksGetWebhook)
+	router.PUT(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksUpdateWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookId/deliveries", wrapper.WebhooksListWebhookDeliveries)
+	router.POST(baseURL+"/api/webhooks/:webhookId/deliveries/:deliveryId/redeliver", wrapper.WebhooksRedeliverWebhookDelivery)
 
 }
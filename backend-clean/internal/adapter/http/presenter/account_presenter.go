@@ -25,21 +25,8 @@ func NewAccountPresenter() *AccountPresenter {
 
 // PresentAccount stores converted account response.
 func (p *AccountPresenter) PresentAccount(_ context.Context, a *account.Account) error {
-	var lastLogin time.Time
-	if a.LastLoginAt != nil {
-		lastLogin = *a.LastLoginAt
-	}
-	p.account = &openapi.ModelsAccountResponse{
-		Id:          a.ID,
-		Email:       a.Email.String(),
-		FirstName:   a.FirstName,
-		LastName:    a.LastName,
-		FullName:    strings.TrimSpace(a.FirstName + " " + a.LastName),
-		Thumbnail:   strPtrOrNil(a.Thumbnail),
-		LastLoginAt: lastLogin,
-		CreatedAt:   a.CreatedAt,
-		UpdatedAt:   a.UpdatedAt,
-	}
+	resp := toAccountResponse(a)
+	p.account = &resp
 	return nil
 }
 
@@ -48,6 +35,26 @@ func (p *AccountPresenter) Response() *openapi.ModelsAccountResponse {
 	return p.account
 }
 
+func toAccountResponse(a *account.Account) openapi.ModelsAccountResponse {
+	var lastLogin time.Time
+	if a.LastLoginAt != nil {
+		lastLogin = *a.LastLoginAt
+	}
+	return openapi.ModelsAccountResponse{
+		Id:             a.ID,
+		Email:          a.Email.String(),
+		FirstName:      a.FirstName,
+		LastName:       a.LastName,
+		FullName:       strings.TrimSpace(a.FirstName + " " + a.LastName),
+		Thumbnail:      strPtrOrNil(a.Thumbnail),
+		LastLoginAt:    lastLogin,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+		FollowerCount:  int32(a.FollowerCount),  //nolint:gosec
+		FollowingCount: int32(a.FollowingCount), //nolint:gosec
+	}
+}
+
 func strPtrOrNil(s string) *string {
 	if s == "" {
 		return nil
@@ -12,29 +12,35 @@ func TestAccountPresenter_PresentAccount(t *testing.T) {
 	now := time.Now()
 	last := now.Add(-time.Hour)
 	tests := []struct {
-		name         string
-		acc          *account.Account
-		wantID       string
-		wantEmail    string
-		wantFullName string
-		wantThumb    *string
+		name          string
+		acc           *account.Account
+		wantID        string
+		wantEmail     string
+		wantFullName  string
+		wantThumb     *string
+		wantFollowers int32
+		wantFollowing int32
 	}{
 		{
 			name: "[Success] full info",
 			acc: &account.Account{
-				ID:          "acc-1",
-				Email:       "user@example.com",
-				FirstName:   "Taro",
-				LastName:    "Yamada",
-				Thumbnail:   "thumb",
-				LastLoginAt: &last,
-				CreatedAt:   now,
-				UpdatedAt:   now,
+				ID:             "acc-1",
+				Email:          "user@example.com",
+				FirstName:      "Taro",
+				LastName:       "Yamada",
+				Thumbnail:      "thumb",
+				LastLoginAt:    &last,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+				FollowerCount:  3,
+				FollowingCount: 1,
 			},
-			wantID:       "acc-1",
-			wantEmail:    "user@example.com",
-			wantFullName: "Taro Yamada",
-			wantThumb:    strPtr("thumb"),
+			wantID:        "acc-1",
+			wantEmail:     "user@example.com",
+			wantFullName:  "Taro Yamada",
+			wantThumb:     strPtr("thumb"),
+			wantFollowers: 3,
+			wantFollowing: 1,
 		},
 		{
 			name: "[Success] empty names",
@@ -57,6 +63,9 @@ func TestAccountPresenter_PresentAccount(t *testing.T) {
 			if resp == nil || resp.Id != tt.wantID || resp.Email != tt.wantEmail || resp.FullName != tt.wantFullName {
 				t.Fatalf("unexpected response: %+v", resp)
 			}
+			if resp.FollowerCount != tt.wantFollowers || resp.FollowingCount != tt.wantFollowing {
+				t.Fatalf("counts = %d/%d, want %d/%d", resp.FollowerCount, resp.FollowingCount, tt.wantFollowers, tt.wantFollowing)
+			}
 			if tt.wantThumb != nil {
 				if resp.Thumbnail == nil || *resp.Thumbnail != *tt.wantThumb {
 					t.Fatalf("thumbnail mismatch")
@@ -0,0 +1,88 @@
+package presenter
+
+import (
+	"context"
+	"io"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AttachmentPresenter converts attachment domain models to OpenAPI responses.
+type AttachmentPresenter struct {
+	attachment *openapi.ModelsAttachmentResponse
+	list       []openapi.ModelsAttachmentResponse
+	content    *attachment.Attachment
+	reader     io.ReadCloser
+	deleted    bool
+}
+
+var _ port.AttachmentOutputPort = (*AttachmentPresenter)(nil)
+
+// NewAttachmentPresenter creates an AttachmentPresenter.
+func NewAttachmentPresenter() *AttachmentPresenter {
+	return &AttachmentPresenter{}
+}
+
+// PresentAttachment stores single attachment response.
+func (p *AttachmentPresenter) PresentAttachment(_ context.Context, a *attachment.Attachment) error {
+	resp := toAttachmentResponse(*a)
+	p.attachment = &resp
+	return nil
+}
+
+// PresentAttachmentList stores attachment list response.
+func (p *AttachmentPresenter) PresentAttachmentList(_ context.Context, attachments []attachment.Attachment) error {
+	res := make([]openapi.ModelsAttachmentResponse, 0, len(attachments))
+	for _, a := range attachments {
+		res = append(res, toAttachmentResponse(a))
+	}
+	p.list = res
+	return nil
+}
+
+// PresentAttachmentContent stores attachment content to stream.
+func (p *AttachmentPresenter) PresentAttachmentContent(_ context.Context, a *attachment.Attachment, content io.ReadCloser) error {
+	p.content = a
+	p.reader = content
+	return nil
+}
+
+// PresentAttachmentDeleted marks delete success.
+func (p *AttachmentPresenter) PresentAttachmentDeleted(_ context.Context) error {
+	p.deleted = true
+	return nil
+}
+
+// Attachment returns the last attachment response.
+func (p *AttachmentPresenter) Attachment() *openapi.ModelsAttachmentResponse {
+	return p.attachment
+}
+
+// Attachments returns the attachment list response.
+func (p *AttachmentPresenter) Attachments() []openapi.ModelsAttachmentResponse {
+	return p.list
+}
+
+// Content returns the attachment metadata and content reader; the caller must close the reader.
+func (p *AttachmentPresenter) Content() (*attachment.Attachment, io.ReadCloser) {
+	return p.content, p.reader
+}
+
+// DeleteResponse returns deletion success response.
+func (p *AttachmentPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deleted}
+}
+
+func toAttachmentResponse(a attachment.Attachment) openapi.ModelsAttachmentResponse {
+	return openapi.ModelsAttachmentResponse{
+		Id:          a.ID,
+		NoteId:      a.NoteID,
+		SectionId:   a.SectionID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedAt:   a.CreatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+)
+
+func TestAttachmentPresenter_TableDriven(t *testing.T) {
+	now := time.Now()
+	single := &attachment.Attachment{ID: "att-1", NoteID: "note-1", SectionID: strPtr("sec-1"), FileName: "a.png", ContentType: "image/png", Size: 3, CreatedAt: now}
+	tests := []struct {
+		name   string
+		action string
+	}{
+		{name: "[Success] single", action: "single"},
+		{name: "[Success] list", action: "list"},
+		{name: "[Success] content", action: "content"},
+		{name: "[Success] deleted", action: "deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewAttachmentPresenter()
+			ctx := context.Background()
+			switch tt.action {
+			case "single":
+				_ = p.PresentAttachment(ctx, single)
+				resp := p.Attachment()
+				if resp == nil || resp.Id != "att-1" || resp.SectionId == nil || *resp.SectionId != "sec-1" || resp.Size != 3 {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+			case "list":
+				_ = p.PresentAttachmentList(ctx, []attachment.Attachment{*single, {ID: "att-2"}})
+				if len(p.Attachments()) != 2 {
+					t.Fatalf("unexpected list: %+v", p.Attachments())
+				}
+			case "content":
+				_ = p.PresentAttachmentContent(ctx, single, io.NopCloser(strings.NewReader("abc")))
+				a, rc := p.Content()
+				if a != single || rc == nil {
+					t.Fatalf("unexpected content: %+v %v", a, rc)
+				}
+			case "deleted":
+				_ = p.PresentAttachmentDeleted(ctx)
+				if !p.DeleteResponse().Success {
+					t.Fatalf("expected success")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CollabPresenter writes session messages as JSON, one Write per message so a WebSocket
+// connection sends each as its own frame.
+type CollabPresenter struct {
+	w io.Writer
+}
+
+var _ port.CollabOutputPort = (*CollabPresenter)(nil)
+
+// NewCollabPresenter creates a CollabPresenter writing to w.
+func NewCollabPresenter(w io.Writer) *CollabPresenter {
+	return &CollabPresenter{w: w}
+}
+
+// PresentCollabJoined writes the joined message with the note and the session as it stands.
+func (p *CollabPresenter) PresentCollabJoined(_ context.Context, joined port.CollabJoined) error {
+	n := toNoteResponse(*joined.Note)
+	participants := toCollabParticipants(joined.Participants)
+	locks := make([]openapi.ModelsCollabLock, 0, len(joined.Locks))
+	for _, l := range joined.Locks {
+		locks = append(locks, openapi.ModelsCollabLock{
+			SectionId: l.SectionID,
+			ConnId:    l.ConnID,
+			AccountId: l.AccountID,
+			ExpiresAt: l.ExpiresAt,
+		})
+	}
+	return p.write(openapi.ModelsCollabMessage{
+		Type:         openapi.ModelsCollabMessageTypeJoined,
+		ConnId:       &joined.ConnID,
+		Participants: &participants,
+		Locks:        &locks,
+		Note:         &n,
+	})
+}
+
+// PresentCollabEvent writes an event from another participant or the hub.
+func (p *CollabPresenter) PresentCollabEvent(_ context.Context, e collab.Event) error {
+	msg := openapi.ModelsCollabMessage{
+		Type:      openapi.ModelsCollabMessageType(e.Type),
+		ConnId:    strPtrOrNil(e.ConnID),
+		AccountId: strPtrOrNil(e.AccountID),
+		SectionId: strPtrOrNil(e.SectionID),
+		ExpiresAt: timePtrOrNil(e.ExpiresAt),
+	}
+	switch e.Type {
+	case collab.EventChanged:
+		msg.Content = &e.Content
+	case collab.EventPresence:
+		participants := toCollabParticipants(e.Participants)
+		msg.Participants = &participants
+	case collab.EventSaved:
+		if e.Note != nil {
+			n := toNoteResponse(*e.Note)
+			msg.Note = &n
+		}
+	}
+	return p.write(msg)
+}
+
+// PresentCollabError writes why a command was rejected.
+func (p *CollabPresenter) PresentCollabError(_ context.Context, err error) error {
+	text := err.Error()
+	return p.write(openapi.ModelsCollabMessage{Type: openapi.ModelsCollabMessageTypeError, Error: &text})
+}
+
+func (p *CollabPresenter) write(msg openapi.ModelsCollabMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = p.w.Write(data)
+	return err
+}
+
+func toCollabParticipants(ps []collab.Participant) []openapi.ModelsCollabParticipant {
+	out := make([]openapi.ModelsCollabParticipant, 0, len(ps))
+	for _, p := range ps {
+		out = append(out, openapi.ModelsCollabParticipant{
+			ConnId:    p.ConnID,
+			AccountId: p.AccountID,
+			Mode:      openapi.ModelsCollabMode(p.Mode),
+			JoinedAt:  p.JoinedAt,
+		})
+	}
+	return out
+}
+
+func timePtrOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
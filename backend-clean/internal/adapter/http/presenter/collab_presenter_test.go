@@ -0,0 +1,85 @@
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestCollabPresenter_TableDriven(t *testing.T) {
+	at := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	alice := collab.Participant{ConnID: "conn-1", AccountID: "alice", Mode: collab.ModeEditing, JoinedAt: at}
+	tests := []struct {
+		name    string
+		present func(p *CollabPresenter) error
+		want    string
+	}{
+		{
+			name: "[Success] joined",
+			present: func(p *CollabPresenter) error {
+				return p.PresentCollabJoined(context.Background(), port.CollabJoined{
+					ConnID:       "conn-2",
+					Note:         &note.WithMeta{Note: note.Note{ID: "note-1", Title: "ADR", OwnerID: "alice", Status: note.StatusDraft, CreatedAt: at, UpdatedAt: at}},
+					Participants: []collab.Participant{alice},
+					Locks:        []collab.Lock{{SectionID: "s1", ConnID: "conn-1", AccountID: "alice", ExpiresAt: at}},
+				})
+			},
+			want: `{"connId":"conn-2","locks":[{"accountId":"alice","connId":"conn-1","expiresAt":"2026-10-19T12:00:00Z","sectionId":"s1"}],` +
+				`"note":{"createdAt":"2026-10-19T12:00:00Z","id":"note-1","owner":{"firstName":"","id":"alice","lastName":""},"ownerId":"alice","sections":[],"status":"Draft","templateId":"","templateName":"","templateVersion":0,"title":"ADR","updatedAt":"2026-10-19T12:00:00Z"},` +
+				`"participants":[{"accountId":"alice","connId":"conn-1","joinedAt":"2026-10-19T12:00:00Z","mode":"editing"}],"type":"joined"}`,
+		},
+		{
+			name: "[Success] changed",
+			present: func(p *CollabPresenter) error {
+				return p.PresentCollabEvent(context.Background(), collab.Event{Type: collab.EventChanged, NoteID: "note-1", ConnID: "conn-1", AccountID: "alice", SectionID: "s1", Content: "typing"})
+			},
+			want: `{"accountId":"alice","connId":"conn-1","content":"typing","sectionId":"s1","type":"changed"}`,
+		},
+		{
+			name: "[Success] expired lock",
+			present: func(p *CollabPresenter) error {
+				return p.PresentCollabEvent(context.Background(), collab.Event{Type: collab.EventUnlocked, NoteID: "note-1", SectionID: "s1"})
+			},
+			want: `{"sectionId":"s1","type":"unlocked"}`,
+		},
+		{
+			name: "[Success] locked",
+			present: func(p *CollabPresenter) error {
+				return p.PresentCollabEvent(context.Background(), collab.Event{Type: collab.EventLocked, ConnID: "conn-1", AccountID: "alice", SectionID: "s1", ExpiresAt: at})
+			},
+			want: `{"accountId":"alice","connId":"conn-1","expiresAt":"2026-10-19T12:00:00Z","sectionId":"s1","type":"locked"}`,
+		},
+		{
+			name: "[Success] presence",
+			present: func(p *CollabPresenter) error {
+				return p.PresentCollabEvent(context.Background(), collab.Event{Type: collab.EventPresence, Participants: []collab.Participant{alice}})
+			},
+			want: `{"participants":[{"accountId":"alice","connId":"conn-1","joinedAt":"2026-10-19T12:00:00Z","mode":"editing"}],"type":"presence"}`,
+		},
+		{
+			name: "[Success] error",
+			present: func(p *CollabPresenter) error {
+				return p.PresentCollabError(context.Background(), errors.New("section is locked by another editor"))
+			},
+			want: `{"error":"section is locked by another editor","type":"error"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.present(NewCollabPresenter(&buf)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Fatalf("want\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
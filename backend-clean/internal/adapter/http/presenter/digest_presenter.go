@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DigestPresenter converts digest settings to OpenAPI responses.
+type DigestPresenter struct {
+	settings *openapi.ModelsDigestSettingsResponse
+}
+
+var _ port.DigestOutputPort = (*DigestPresenter)(nil)
+
+// NewDigestPresenter creates a DigestPresenter.
+func NewDigestPresenter() *DigestPresenter {
+	return &DigestPresenter{}
+}
+
+// PresentDigestSettings stores digest settings response.
+func (p *DigestPresenter) PresentDigestSettings(_ context.Context, s *digest.Settings) error {
+	p.settings = &openapi.ModelsDigestSettingsResponse{
+		AccountId:  s.AccountID,
+		Frequency:  openapi.ModelsDigestFrequency(s.Frequency),
+		LastSentAt: s.LastSentAt,
+	}
+	return nil
+}
+
+// Settings returns the digest settings response.
+func (p *DigestPresenter) Settings() *openapi.ModelsDigestSettingsResponse {
+	return p.settings
+}
+
+// unsubscribePage asks before turning the digest off, so mail scanners following the link in a
+// digest do not unsubscribe anyone. The button posts to the same URL the List-Unsubscribe header uses.
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="UTF-8"><title>Mini Notion ダイジェストの配信停止</title></head>
+<body style="font-family: sans-serif;">
+<p id="message">Mini Notion のダイジェストメールの配信を停止しますか？</p>
+<form id="unsubscribe" method="post" action="{{.}}"><button type="submit">配信を停止する</button></form>
+<script>
+document.getElementById("unsubscribe").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const res = await fetch(e.target.action, { method: "POST" });
+  document.getElementById("message").textContent = res.ok ? "配信を停止しました。" : "配信を停止できませんでした。リンクが正しいか確認してください。";
+  if (res.ok) e.target.remove();
+});
+</script>
+</body>
+</html>
+`))
+
+// RenderUnsubscribePage renders the confirmation page posting to action.
+func RenderUnsubscribePage(action string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := unsubscribePage.Execute(&buf, action); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+)
+
+func TestDigestPresenter_PresentDigestSettings(t *testing.T) {
+	sent := time.Now()
+	tests := []struct {
+		name     string
+		settings digest.Settings
+		wantSent bool
+	}{
+		{name: "[Success] sent before", settings: digest.Settings{AccountID: "acc-1", Frequency: digest.FrequencyDaily, LastSentAt: &sent}, wantSent: true},
+		{name: "[Success] never sent", settings: digest.Settings{AccountID: "acc-1", Frequency: digest.FrequencyWeekly}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewDigestPresenter()
+			_ = p.PresentDigestSettings(context.Background(), &tt.settings)
+			resp := p.Settings()
+			if resp == nil || resp.AccountId != "acc-1" || string(resp.Frequency) != string(tt.settings.Frequency) || (resp.LastSentAt != nil) != tt.wantSent {
+				t.Fatalf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
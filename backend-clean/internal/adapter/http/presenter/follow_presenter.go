@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// FollowPresenter converts follows and feed pages to OpenAPI responses.
+type FollowPresenter struct {
+	followee *openapi.ModelsAccountResponse
+	feed     *openapi.ModelsFeedPage
+}
+
+var _ port.FollowOutputPort = (*FollowPresenter)(nil)
+
+// NewFollowPresenter creates a FollowPresenter.
+func NewFollowPresenter() *FollowPresenter {
+	return &FollowPresenter{}
+}
+
+// PresentFollowee stores the followed or unfollowed account response.
+func (p *FollowPresenter) PresentFollowee(_ context.Context, followee *account.Account) error {
+	resp := toAccountResponse(followee)
+	p.followee = &resp
+	return nil
+}
+
+// PresentFeed stores the feed page response.
+func (p *FollowPresenter) PresentFeed(_ context.Context, page feed.Page) error {
+	items := make([]openapi.ModelsFeedItem, 0, len(page.Items))
+	for _, item := range page.Items {
+		items = append(items, openapi.ModelsFeedItem{
+			Kind:       openapi.ModelsFeedItemKind(item.Kind),
+			SubjectId:  item.SubjectID,
+			Title:      item.Title,
+			TemplateId: strPtrOrNil(item.TemplateID),
+			Author: openapi.ModelsAccountSummary{
+				Id:        item.AuthorID,
+				FirstName: item.Author.FirstName,
+				LastName:  item.Author.LastName,
+				Thumbnail: strPtrOrNil(item.Author.Thumbnail),
+			},
+			OccurredAt: item.OccurredAt,
+		})
+	}
+	p.feed = &openapi.ModelsFeedPage{Items: items, NextCursor: strPtrOrNil(page.NextCursor)}
+	return nil
+}
+
+// Followee returns the followed or unfollowed account response.
+func (p *FollowPresenter) Followee() *openapi.ModelsAccountResponse {
+	return p.followee
+}
+
+// Feed returns the feed page response.
+func (p *FollowPresenter) Feed() *openapi.ModelsFeedPage {
+	return p.feed
+}
@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+)
+
+func TestFollowPresenter_TableDriven(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		action string
+	}{
+		{name: "[Success] followee", action: "followee"},
+		{name: "[Success] feed page", action: "page"},
+		{name: "[Success] last feed page", action: "last"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewFollowPresenter()
+			ctx := context.Background()
+			switch tt.action {
+			case "followee":
+				_ = p.PresentFollowee(ctx, &account.Account{ID: "author", Email: "a@example.com", FollowerCount: 2})
+				resp := p.Followee()
+				if resp == nil || resp.Id != "author" || resp.FollowerCount != 2 {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+			case "page":
+				_ = p.PresentFeed(ctx, feed.Page{
+					Items: []feed.Item{
+						{SubjectID: "note-1", Kind: feed.KindNotePublished, AuthorID: "author", Title: "ADR", TemplateID: "tpl-1", OccurredAt: now, Author: feed.Author{FirstName: "Taro"}},
+						{SubjectID: "tpl-2", Kind: feed.KindTemplateCreated, AuthorID: "author", Title: "RFC", OccurredAt: now},
+					},
+					NextCursor: "next",
+				})
+				resp := p.Feed()
+				if resp == nil || len(resp.Items) != 2 || resp.NextCursor == nil || *resp.NextCursor != "next" {
+					t.Fatalf("unexpected page: %+v", resp)
+				}
+				if resp.Items[0].Kind != "note.published" || resp.Items[0].TemplateId == nil || resp.Items[0].Author.FirstName != "Taro" || resp.Items[1].TemplateId != nil {
+					t.Fatalf("unexpected items: %+v", resp.Items)
+				}
+			case "last":
+				_ = p.PresentFeed(ctx, feed.Page{})
+				resp := p.Feed()
+				if resp == nil || resp.Items == nil || resp.NextCursor != nil {
+					t.Fatalf("unexpected page: %+v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteBatchPresenter converts batch results to OpenAPI responses.
+type NoteBatchPresenter struct {
+	response openapi.ModelsBatchNoteResponse
+}
+
+var _ port.NoteBatchOutputPort = (*NoteBatchPresenter)(nil)
+
+// NewNoteBatchPresenter creates a new NoteBatchPresenter.
+func NewNoteBatchPresenter() *NoteBatchPresenter {
+	return &NoteBatchPresenter{}
+}
+
+// PresentNoteBatch stores per-note batch results.
+func (p *NoteBatchPresenter) PresentNoteBatch(_ context.Context, mode note.BatchMode, results []note.BatchItemResult) error {
+	res := openapi.ModelsBatchNoteResponse{
+		Mode:    openapi.ModelsBatchMode(mode),
+		Results: make([]openapi.ModelsBatchItemResult, 0, len(results)),
+	}
+	for _, r := range results {
+		item := openapi.ModelsBatchItemResult{NoteId: r.ID, Success: r.Err == nil}
+		if r.Err != nil {
+			msg := r.Err.Error()
+			item.Error = &msg
+			res.Failed++
+		} else {
+			res.Succeeded++
+		}
+		res.Results = append(res.Results, item)
+	}
+	p.response = res
+	return nil
+}
+
+// Response returns the batch response.
+func (p *NoteBatchPresenter) Response() openapi.ModelsBatchNoteResponse {
+	return p.response
+}
@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteBatchPresenter(t *testing.T) {
+	p := NewNoteBatchPresenter()
+	err := p.PresentNoteBatch(context.Background(), note.BatchBestEffort, []note.BatchItemResult{
+		{ID: "n1"},
+		{ID: "n2", Err: domainerr.ErrUnauthorized},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res := p.Response()
+	if res.Mode != "best_effort" || res.Succeeded != 1 || res.Failed != 1 {
+		t.Fatalf("unexpected summary: %+v", res)
+	}
+	if !res.Results[0].Success || res.Results[0].Error != nil {
+		t.Fatalf("unexpected success item: %+v", res.Results[0])
+	}
+	if res.Results[1].Success || res.Results[1].Error == nil || *res.Results[1].Error != domainerr.ErrUnauthorized.Error() {
+		t.Fatalf("unexpected failed item: %+v", res.Results[1])
+	}
+}
@@ -0,0 +1,75 @@
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCSVPresenter renders template notes as CSV and converts CSV import results to OpenAPI responses.
+type NoteCSVPresenter struct {
+	buf      bytes.Buffer
+	response openapi.ModelsImportNotesCsvResponse
+}
+
+var _ port.NoteCSVOutputPort = (*NoteCSVPresenter)(nil)
+
+// NewNoteCSVPresenter creates a new NoteCSVPresenter.
+func NewNoteCSVPresenter() *NoteCSVPresenter {
+	return &NoteCSVPresenter{}
+}
+
+// PresentNoteCSV writes a header row and one row per note.
+func (p *NoteCSVPresenter) PresentNoteCSV(_ context.Context, fields []template.Field, notes []note.WithMeta) error {
+	w := csv.NewWriter(&p.buf)
+	if err := w.Write(note.CSVHeader(fields)); err != nil {
+		return err
+	}
+	for _, n := range notes {
+		if err := w.Write(note.CSVRecord(fields, n)); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// PresentNoteCSVImport stores the per-row import results.
+func (p *NoteCSVPresenter) PresentNoteCSVImport(_ context.Context, results []note.CSVRowResult) error {
+	res := openapi.ModelsImportNotesCsvResponse{Results: make([]openapi.ModelsCsvRowResult, 0, len(results))}
+	for _, r := range results {
+		item := openapi.ModelsCsvRowResult{
+			Line:    int32(r.Line), //nolint:gosec
+			NoteId:  emptyToNil(r.NoteID),
+			Created: r.Created,
+		}
+		switch {
+		case r.Err != nil:
+			msg := r.Err.Error()
+			item.Error = &msg
+			res.Failed++
+		case r.Created:
+			res.Created++
+		default:
+			res.Updated++
+		}
+		res.Results = append(res.Results, item)
+	}
+	p.response = res
+	return nil
+}
+
+// Body returns the rendered CSV document.
+func (p *NoteCSVPresenter) Body() []byte {
+	return p.buf.Bytes()
+}
+
+// Response returns the CSV import response.
+func (p *NoteCSVPresenter) Response() openapi.ModelsImportNotesCsvResponse {
+	return p.response
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestNoteCSVPresenter_PresentNoteCSV(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	fields := []template.Field{{ID: "f1", Label: "Context", Order: 1}, {ID: "f2", Label: "Decision", Order: 2}}
+	notes := []note.WithMeta{{
+		Note:           note.Note{ID: "n1", Title: "Use, \"quotes\"", Status: note.StatusDraft, CreatedAt: at, UpdatedAt: at},
+		OwnerFirstName: "Ada",
+		Sections: []note.SectionWithField{
+			{Section: note.Section{FieldID: "f1", Content: "a\nb"}},
+			{Section: note.Section{FieldID: "f2", Content: "c"}},
+		},
+	}}
+	p := NewNoteCSVPresenter()
+	if err := p.PresentNoteCSV(context.Background(), fields, notes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "id,title,status,template_version,author,created_at,updated_at,Context,Decision\n" +
+		"n1,\"Use, \"\"quotes\"\"\",Draft,0,Ada,2026-01-02T03:04:05Z,2026-01-02T03:04:05Z,\"a\nb\",c\n"
+	if got := string(p.Body()); got != want {
+		t.Fatalf("body = %q, want %q", got, want)
+	}
+}
+
+func TestNoteCSVPresenter_PresentNoteCSVImport(t *testing.T) {
+	p := NewNoteCSVPresenter()
+	err := p.PresentNoteCSVImport(context.Background(), []note.CSVRowResult{
+		{Line: 2, NoteID: "n1", Created: true},
+		{Line: 3, NoteID: "n2"},
+		{Line: 4, Err: domainerr.ErrTitleRequired},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res := p.Response()
+	if res.Created != 1 || res.Updated != 1 || res.Failed != 1 || len(res.Results) != 3 {
+		t.Fatalf("unexpected summary: %+v", res)
+	}
+	if res.Results[2].NoteId != nil || res.Results[2].Error == nil || res.Results[2].Line != 4 {
+		t.Fatalf("unexpected failed row: %+v", res.Results[2])
+	}
+}
@@ -0,0 +1,257 @@
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"slices"
+	"strings"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	domaintemplate "immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteExportPresenter renders notes into a downloadable document.
+type NoteExportPresenter interface {
+	port.NoteOutputPort
+	Body() []byte
+	ContentType() string
+	FileExtension() string
+}
+
+// MarkdownNotePresenter renders notes as Markdown documents.
+type MarkdownNotePresenter struct {
+	buf bytes.Buffer
+}
+
+// HTMLNotePresenter renders notes as sanitized HTML documents.
+// Note content is never interpreted as markup; all user text is escaped by html/template.
+type HTMLNotePresenter struct {
+	buf bytes.Buffer
+}
+
+var (
+	_ NoteExportPresenter = (*MarkdownNotePresenter)(nil)
+	_ NoteExportPresenter = (*HTMLNotePresenter)(nil)
+)
+
+// NewMarkdownNotePresenter creates a new MarkdownNotePresenter.
+func NewMarkdownNotePresenter() *MarkdownNotePresenter {
+	return &MarkdownNotePresenter{}
+}
+
+// NewHTMLNotePresenter creates a new HTMLNotePresenter.
+func NewHTMLNotePresenter() *HTMLNotePresenter {
+	return &HTMLNotePresenter{}
+}
+
+// PresentNoteList renders notes one after another separated by rules.
+func (p *MarkdownNotePresenter) PresentNoteList(ctx context.Context, notes []note.WithMeta) error {
+	for i := range notes {
+		if i > 0 {
+			p.buf.WriteString("\n---\n\n")
+		}
+		if err := p.PresentNote(ctx, &notes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PresentNote renders a single note.
+func (p *MarkdownNotePresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
+	doc := toExportDocument(*n)
+	fmt.Fprintf(&p.buf, "# %s\n\n", singleLine(doc.Title))
+	fmt.Fprintf(&p.buf, "- Author: %s\n", singleLine(doc.Author))
+	fmt.Fprintf(&p.buf, "- Template: %s\n", singleLine(doc.Template))
+	fmt.Fprintf(&p.buf, "- Updated: %s\n", doc.Updated)
+	for _, s := range doc.Sections {
+		fmt.Fprintf(&p.buf, "\n## %s\n\n", singleLine(s.Label))
+		switch {
+		case s.Content == "":
+			p.buf.WriteString("_(empty)_\n")
+		case s.Checklist != nil:
+			for _, item := range s.Checklist {
+				mark := " "
+				if item.Checked {
+					mark = "x"
+				}
+				fmt.Fprintf(&p.buf, "- [%s] %s\n", mark, item.Label)
+			}
+		case s.URL != "":
+			fmt.Fprintf(&p.buf, "<%s>\n", s.URL)
+		default:
+			p.buf.WriteString(strings.TrimRight(s.Content, "\n"))
+			p.buf.WriteString("\n")
+		}
+	}
+	return nil
+}
+
+// PresentNoteDeleted is a no-op for exports.
+func (p *MarkdownNotePresenter) PresentNoteDeleted(_ context.Context) error {
+	return nil
+}
+
+// Body returns the rendered document.
+func (p *MarkdownNotePresenter) Body() []byte {
+	return p.buf.Bytes()
+}
+
+// ContentType returns the MIME type of the document.
+func (p *MarkdownNotePresenter) ContentType() string {
+	return "text/markdown; charset=utf-8"
+}
+
+// FileExtension returns the file extension of the document.
+func (p *MarkdownNotePresenter) FileExtension() string {
+	return ".md"
+}
+
+var noteHTMLTemplate = template.Must(template.New("note").Parse(`<article class="note">
+<h1>{{.Title}}</h1>
+<dl class="note-meta">
+<dt>Author</dt><dd>{{.Author}}</dd>
+<dt>Template</dt><dd>{{.Template}}</dd>
+<dt>Updated</dt><dd><time datetime="{{.Updated}}">{{.Updated}}</time></dd>
+</dl>
+{{- range .Sections}}
+<section>
+<h2>{{.Label}}</h2>
+{{- if eq .Content ""}}
+<p><em>(empty)</em></p>
+{{- else if .Checklist}}
+<ul class="checklist">
+{{- range .Checklist}}
+<li><input type="checkbox" disabled{{if .Checked}} checked{{end}}> {{.Label}}</li>
+{{- end}}
+</ul>
+{{- else if .URL}}
+<p><a href="{{.URL}}" rel="nofollow noopener noreferrer">{{.URL}}</a></p>
+{{- else}}
+{{- range .Paragraphs}}
+<p>{{range $i, $line := .}}{{if $i}}<br>{{end}}{{$line}}{{end}}</p>
+{{- end}}
+{{- end}}
+</section>
+{{- end}}
+</article>
+`))
+
+// PresentNoteList renders notes one after another.
+func (p *HTMLNotePresenter) PresentNoteList(ctx context.Context, notes []note.WithMeta) error {
+	for i := range notes {
+		if err := p.PresentNote(ctx, &notes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PresentNote renders a single note.
+func (p *HTMLNotePresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
+	return noteHTMLTemplate.Execute(&p.buf, toExportDocument(*n))
+}
+
+// PresentNoteDeleted is a no-op for exports.
+func (p *HTMLNotePresenter) PresentNoteDeleted(_ context.Context) error {
+	return nil
+}
+
+// Body returns the rendered document.
+func (p *HTMLNotePresenter) Body() []byte {
+	return p.buf.Bytes()
+}
+
+// ContentType returns the MIME type of the document.
+func (p *HTMLNotePresenter) ContentType() string {
+	return "text/html; charset=utf-8"
+}
+
+// FileExtension returns the file extension of the document.
+func (p *HTMLNotePresenter) FileExtension() string {
+	return ".html"
+}
+
+type exportDocument struct {
+	Title    string
+	Author   string
+	Template string
+	Updated  string
+	Sections []exportSection
+}
+
+type exportSection struct {
+	Label      string
+	Content    string
+	URL        string
+	Checklist  []exportChecklistItem
+	Paragraphs [][]string
+}
+
+type exportChecklistItem struct {
+	Label   string
+	Checked bool
+}
+
+// toExportDocument flattens a note into format-neutral parts with sections in field order.
+func toExportDocument(n note.WithMeta) exportDocument {
+	sections := slices.Clone(n.Sections)
+	slices.SortStableFunc(sections, func(a, b note.SectionWithField) int {
+		return a.FieldOrder - b.FieldOrder
+	})
+	doc := exportDocument{
+		Title:    n.Note.Title,
+		Author:   strings.TrimSpace(n.OwnerFirstName + " " + n.OwnerLastName),
+		Template: n.TemplateName,
+		Updated:  n.Note.UpdatedAt.UTC().Format(time.DateOnly),
+		Sections: make([]exportSection, 0, len(sections)),
+	}
+	for _, s := range sections {
+		es := exportSection{Label: s.FieldLabel, Content: s.Section.Content}
+		switch s.FieldType {
+		case domaintemplate.FieldTypeURL:
+			es.URL = s.Section.Content
+		case domaintemplate.FieldTypeChecklist:
+			es.Checklist = toChecklistItems(s.Options.Choices, s.Section.Content)
+		}
+		es.Paragraphs = toParagraphs(s.Section.Content)
+		doc.Sections = append(doc.Sections, es)
+	}
+	return doc
+}
+
+// toChecklistItems lists every choice with its checked state; nil if content is not a JSON array.
+func toChecklistItems(choices []string, content string) []exportChecklistItem {
+	var checked []string
+	if err := json.Unmarshal([]byte(content), &checked); err != nil {
+		return nil
+	}
+	items := make([]exportChecklistItem, 0, len(choices))
+	for _, c := range choices {
+		items = append(items, exportChecklistItem{Label: c, Checked: slices.Contains(checked, c)})
+	}
+	return items
+}
+
+// toParagraphs splits content on blank lines, keeping single line breaks within a paragraph.
+func toParagraphs(content string) [][]string {
+	var paragraphs [][]string
+	for _, block := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		block = strings.Trim(block, "\n")
+		if block == "" {
+			continue
+		}
+		paragraphs = append(paragraphs, strings.Split(block, "\n"))
+	}
+	return paragraphs
+}
+
+// singleLine keeps headings and list items intact when values contain line breaks.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
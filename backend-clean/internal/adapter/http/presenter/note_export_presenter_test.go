@@ -0,0 +1,130 @@
+package presenter
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func exportFixture(body string) *note.WithMeta {
+	return &note.WithMeta{
+		Note: note.Note{
+			ID:        "note-1",
+			Title:     "Design review",
+			UpdatedAt: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+		},
+		TemplateName:   "ADR",
+		OwnerFirstName: "Taro",
+		OwnerLastName:  "Yamada",
+		// sections arrive out of field order on purpose
+		Sections: []note.SectionWithField{
+			{
+				Section:    note.Section{ID: "s2", FieldID: "f2", Content: `["Tests"]`},
+				FieldLabel: "Checks",
+				FieldOrder: 3,
+				FieldType:  template.FieldTypeChecklist,
+				Options:    template.FieldOptions{Choices: []string{"Tests", "Docs"}},
+			},
+			{
+				Section:    note.Section{ID: "s1", FieldID: "f1", Content: body},
+				FieldLabel: "Context",
+				FieldOrder: 1,
+				FieldType:  template.FieldTypeMarkdown,
+			},
+			{
+				Section:    note.Section{ID: "s3", FieldID: "f3", Content: "https://example.com/pr/1"},
+				FieldLabel: "Link",
+				FieldOrder: 2,
+				FieldType:  template.FieldTypeURL,
+			},
+		},
+	}
+}
+
+func TestMarkdownNotePresenter(t *testing.T) {
+	p := NewMarkdownNotePresenter()
+	if err := p.PresentNote(context.Background(), exportFixture("We need **caching**.")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `# Design review
+
+- Author: Taro Yamada
+- Template: ADR
+- Updated: 2026-10-19
+
+## Context
+
+We need **caching**.
+
+## Link
+
+<https://example.com/pr/1>
+
+## Checks
+
+- [x] Tests
+- [ ] Docs
+`
+	if got := string(p.Body()); got != want {
+		t.Fatalf("markdown mismatch:\n%s", got)
+	}
+	if p.ContentType() != "text/markdown; charset=utf-8" || p.FileExtension() != ".md" {
+		t.Fatalf("unexpected content type %q / extension %q", p.ContentType(), p.FileExtension())
+	}
+}
+
+func TestHTMLNotePresenter(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "[Success] renders sections in field order",
+			body: "line1\nline2\n\nnext",
+			want: []string{
+				"<h1>Design review</h1>",
+				"<dd>Taro Yamada</dd>",
+				"<p>line1<br>line2</p>",
+				"<p>next</p>",
+				`<a href="https://example.com/pr/1" rel="nofollow noopener noreferrer">`,
+				`<input type="checkbox" disabled checked> Tests`,
+				`<input type="checkbox" disabled> Docs`,
+			},
+		},
+		{
+			name:    "[Success] escapes markup in content",
+			body:    `<script>alert(1)</script><img src=x onerror=alert(1)>`,
+			want:    []string{"&lt;script&gt;alert(1)&lt;/script&gt;"},
+			notWant: []string{"<script>", "<img"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewHTMLNotePresenter()
+			if err := p.PresentNote(context.Background(), exportFixture(tt.body)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := string(p.Body())
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Fatalf("missing %q in:\n%s", w, got)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(got, w) {
+					t.Fatalf("unexpected %q in:\n%s", w, got)
+				}
+			}
+			if strings.Index(got, "Context") > strings.Index(got, "Link") || strings.Index(got, "Link") > strings.Index(got, "Checks") {
+				t.Fatalf("sections not in field order:\n%s", got)
+			}
+		})
+	}
+}
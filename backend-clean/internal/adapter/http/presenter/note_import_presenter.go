@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteImportPresenter converts note import results to OpenAPI responses.
+type NoteImportPresenter struct {
+	response openapi.ModelsImportNotesResponse
+}
+
+var _ port.NoteImportOutputPort = (*NoteImportPresenter)(nil)
+
+// NewNoteImportPresenter creates a new NoteImportPresenter.
+func NewNoteImportPresenter() *NoteImportPresenter {
+	return &NoteImportPresenter{}
+}
+
+// PresentNoteImport stores the per-file import results.
+func (p *NoteImportPresenter) PresentNoteImport(_ context.Context, dryRun bool, results []note.ImportResult) error {
+	res := make([]openapi.ModelsImportFileResult, 0, len(results))
+	for _, r := range results {
+		item := openapi.ModelsImportFileResult{
+			FileName:              r.FileName,
+			Title:                 r.Title,
+			NoteId:                emptyToNil(r.NoteID),
+			UnmatchedHeadings:     nonNilStrings(r.UnmatchedHeadings),
+			MissingRequiredFields: nonNilStrings(r.MissingRequired),
+		}
+		if r.Err != nil {
+			msg := r.Err.Error()
+			item.Error = &msg
+		}
+		res = append(res, item)
+	}
+	p.response = openapi.ModelsImportNotesResponse{DryRun: dryRun, Results: res}
+	return nil
+}
+
+// Response returns the import response.
+func (p *NoteImportPresenter) Response() openapi.ModelsImportNotesResponse {
+	return p.response
+}
+
+// nonNilStrings keeps empty lists as [] in JSON.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
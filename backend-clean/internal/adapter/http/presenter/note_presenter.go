@@ -65,19 +65,22 @@ func toNoteResponse(n note.WithMeta) openapi.ModelsNoteResponse {
 	sections := make([]openapi.ModelsSection, 0, len(n.Sections))
 	for _, s := range n.Sections {
 		sections = append(sections, openapi.ModelsSection{
-			Id:         s.Section.ID,
-			FieldId:    s.Section.FieldID,
-			FieldLabel: s.FieldLabel,
-			Content:    s.Section.Content,
-			IsRequired: s.IsRequired,
+			Id:           s.Section.ID,
+			FieldId:      s.Section.FieldID,
+			FieldLabel:   s.FieldLabel,
+			Content:      s.Section.Content,
+			IsRequired:   s.IsRequired,
+			FieldType:    openapi.ModelsFieldType(s.FieldType),
+			FieldOptions: toFieldOptionsResponse(s.Options),
 		})
 	}
 	return openapi.ModelsNoteResponse{
-		Id:           n.Note.ID,
-		Title:        n.Note.Title,
-		TemplateId:   n.Note.TemplateID,
-		TemplateName: n.TemplateName,
-		OwnerId:      n.Note.OwnerID,
+		Id:              n.Note.ID,
+		Title:           n.Note.Title,
+		TemplateId:      n.Note.TemplateID,
+		TemplateName:    n.TemplateName,
+		TemplateVersion: int32(n.Note.TemplateVersion), //nolint:gosec
+		OwnerId:         n.Note.OwnerID,
 		Owner: openapi.ModelsAccountSummary{
 			Id:        n.Note.OwnerID,
 			FirstName: n.OwnerFirstName,
@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// streamResetEvent is the SSE event telling a resuming client to reload instead of waiting for missed events.
+const streamResetEvent = "reset"
+
+// NoteStreamPresenter writes note events to a response as Server-Sent Events, flushing each message.
+type NoteStreamPresenter struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+var _ port.NoteStreamOutputPort = (*NoteStreamPresenter)(nil)
+
+// NewNoteStreamPresenter creates a NoteStreamPresenter writing to w.
+func NewNoteStreamPresenter(w http.ResponseWriter) *NoteStreamPresenter {
+	return &NoteStreamPresenter{w: w, rc: http.NewResponseController(w)}
+}
+
+// PresentNoteEvent writes one event with its ID so the client can resume after it.
+func (p *NoteStreamPresenter) PresentNoteEvent(_ context.Context, env event.Envelope) error {
+	e := env.Event
+	data, err := json.Marshal(openapi.ModelsNoteStreamEvent{
+		Event:      openapi.ModelsNoteEventName(e.Name),
+		NoteId:     e.AggregateID,
+		Title:      e.Data["title"],
+		OwnerId:    e.Data["ownerId"],
+		TemplateId: e.Data["templateId"],
+		Status:     openapi.ModelsNoteStatus(e.Data["status"]),
+		ActorId:    e.ActorID,
+		OccurredAt: e.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+	return p.write(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", env.ID, e.Name, data))
+}
+
+// PresentStreamReset writes a reset event.
+func (p *NoteStreamPresenter) PresentStreamReset(_ context.Context) error {
+	return p.write("event: " + streamResetEvent + "\ndata: {}\n\n")
+}
+
+// PresentHeartbeat writes a comment line, which clients ignore.
+func (p *NoteStreamPresenter) PresentHeartbeat(_ context.Context) error {
+	return p.write(": heartbeat\n\n")
+}
+
+func (p *NoteStreamPresenter) write(msg string) error {
+	if _, err := p.w.Write([]byte(msg)); err != nil {
+		return err
+	}
+	return p.rc.Flush()
+}
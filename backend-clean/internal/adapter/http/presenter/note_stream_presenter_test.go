@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestNoteStreamPresenter_TableDriven(t *testing.T) {
+	at := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	env := event.Envelope{ID: "abc-7", Event: event.Event{
+		ID: "ev-1", Name: event.NotePublished, AggregateID: "note-1", ActorID: "owner-1", OccurredAt: at,
+		Data: map[string]string{"title": "ADR", "ownerId": "owner-1", "templateId": "tpl-1", "status": "Publish"},
+	}}
+	tests := []struct {
+		name   string
+		action string
+		want   string
+	}{
+		{
+			name:   "[Success] note event",
+			action: "event",
+			want: "id: abc-7\nevent: note.published\n" +
+				`data: {"actorId":"owner-1","event":"note.published","noteId":"note-1","occurredAt":"2026-10-19T12:00:00Z","ownerId":"owner-1","status":"Publish","templateId":"tpl-1","title":"ADR"}` + "\n\n",
+		},
+		{name: "[Success] reset", action: "reset", want: "event: reset\ndata: {}\n\n"},
+		{name: "[Success] heartbeat", action: "heartbeat", want: ": heartbeat\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			p := NewNoteStreamPresenter(rec)
+			ctx := context.Background()
+			var err error
+			switch tt.action {
+			case "event":
+				err = p.PresentNoteEvent(ctx, env)
+			case "reset":
+				err = p.PresentStreamReset(ctx)
+			case "heartbeat":
+				err = p.PresentHeartbeat(ctx)
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := rec.Body.String(); got != tt.want {
+				t.Fatalf("body = %q, want %q", got, tt.want)
+			}
+			if !rec.Flushed {
+				t.Fatal("message not flushed")
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotificationPresenter converts notification domain models to OpenAPI responses.
+type NotificationPresenter struct {
+	notification *openapi.ModelsNotificationResponse
+	list         []openapi.ModelsNotificationResponse
+	count        openapi.ModelsNotificationCountResponse
+	preferences  *openapi.ModelsNotificationPreferencesResponse
+}
+
+var _ port.NotificationOutputPort = (*NotificationPresenter)(nil)
+
+// NewNotificationPresenter creates a NotificationPresenter.
+func NewNotificationPresenter() *NotificationPresenter {
+	return &NotificationPresenter{}
+}
+
+// PresentNotificationList stores notification list response.
+func (p *NotificationPresenter) PresentNotificationList(_ context.Context, notifications []notification.Notification) error {
+	res := make([]openapi.ModelsNotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		res = append(res, toNotificationResponse(n))
+	}
+	p.list = res
+	return nil
+}
+
+// PresentNotification stores single notification response.
+func (p *NotificationPresenter) PresentNotification(_ context.Context, n *notification.Notification) error {
+	resp := toNotificationResponse(*n)
+	p.notification = &resp
+	return nil
+}
+
+// PresentUnreadCount stores the unread count response.
+func (p *NotificationPresenter) PresentUnreadCount(_ context.Context, count int) error {
+	p.count = openapi.ModelsNotificationCountResponse{Count: int32(count)} //nolint:gosec
+	return nil
+}
+
+// PresentMarkedAllRead stores how many notifications were marked read.
+func (p *NotificationPresenter) PresentMarkedAllRead(_ context.Context, count int) error {
+	p.count = openapi.ModelsNotificationCountResponse{Count: int32(count)} //nolint:gosec
+	return nil
+}
+
+// PresentNotificationPreferences stores preferences response.
+func (p *NotificationPresenter) PresentNotificationPreferences(_ context.Context, prefs *notification.Preferences) error {
+	muted := make([]openapi.ModelsNotificationKind, 0, len(prefs.MutedKinds))
+	for _, k := range prefs.MutedKinds {
+		muted = append(muted, openapi.ModelsNotificationKind(k))
+	}
+	p.preferences = &openapi.ModelsNotificationPreferencesResponse{AccountId: prefs.AccountID, MutedKinds: muted}
+	return nil
+}
+
+// Notification returns the last notification response.
+func (p *NotificationPresenter) Notification() *openapi.ModelsNotificationResponse {
+	return p.notification
+}
+
+// Notifications returns the notification list response.
+func (p *NotificationPresenter) Notifications() []openapi.ModelsNotificationResponse {
+	return p.list
+}
+
+// Count returns the last count response.
+func (p *NotificationPresenter) Count() openapi.ModelsNotificationCountResponse {
+	return p.count
+}
+
+// Preferences returns the preferences response.
+func (p *NotificationPresenter) Preferences() *openapi.ModelsNotificationPreferencesResponse {
+	return p.preferences
+}
+
+func toNotificationResponse(n notification.Notification) openapi.ModelsNotificationResponse {
+	resp := openapi.ModelsNotificationResponse{
+		Id:           n.ID,
+		RecipientId:  n.RecipientID,
+		Kind:         openapi.ModelsNotificationKind(n.Kind),
+		SubjectId:    n.SubjectID,
+		SubjectTitle: n.SubjectTitle,
+		ReadAt:       n.ReadAt,
+		CreatedAt:    n.CreatedAt,
+	}
+	if n.ActorID != "" {
+		resp.ActorId = &n.ActorID
+	}
+	return resp
+}
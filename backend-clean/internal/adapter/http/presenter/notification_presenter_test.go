@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/notification"
+)
+
+func TestNotificationPresenter_TableDriven(t *testing.T) {
+	now := time.Now()
+	unread := notification.Notification{ID: "n-1", RecipientID: "owner-1", Kind: notification.KindNoteTransferred, ActorID: "actor-1", SubjectID: "note-1", SubjectTitle: "ADR", CreatedAt: now}
+	tests := []struct {
+		name   string
+		action string
+	}{
+		{name: "[Success] single", action: "single"},
+		{name: "[Success] list", action: "list"},
+		{name: "[Success] counts", action: "counts"},
+		{name: "[Success] preferences", action: "preferences"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewNotificationPresenter()
+			ctx := context.Background()
+			switch tt.action {
+			case "single":
+				read := unread
+				read.ReadAt = &now
+				_ = p.PresentNotification(ctx, &read)
+				resp := p.Notification()
+				if resp == nil || resp.Kind != "note.transferred" || resp.ActorId == nil || *resp.ActorId != "actor-1" || resp.ReadAt == nil {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+			case "list":
+				_ = p.PresentNotificationList(ctx, []notification.Notification{unread, {ID: "n-2"}})
+				list := p.Notifications()
+				if len(list) != 2 || list[0].ReadAt != nil || list[1].ActorId != nil {
+					t.Fatalf("unexpected list: %+v", list)
+				}
+			case "counts":
+				_ = p.PresentUnreadCount(ctx, 4)
+				if p.Count().Count != 4 {
+					t.Fatalf("unexpected count: %+v", p.Count())
+				}
+				_ = p.PresentMarkedAllRead(ctx, 3)
+				if p.Count().Count != 3 {
+					t.Fatalf("unexpected count: %+v", p.Count())
+				}
+			case "preferences":
+				_ = p.PresentNotificationPreferences(ctx, &notification.Preferences{AccountID: "owner-1"})
+				resp := p.Preferences()
+				if resp == nil || resp.AccountId != "owner-1" || resp.MutedKinds == nil {
+					t.Fatalf("unexpected preferences: %+v", resp)
+				}
+			}
+		})
+	}
+}
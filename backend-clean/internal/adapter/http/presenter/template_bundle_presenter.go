@@ -0,0 +1,72 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateBundlePresenter renders template bundles and converts bundle import results to OpenAPI responses.
+type TemplateBundlePresenter struct {
+	bundle   template.Bundle
+	response openapi.ModelsImportTemplateBundleResponse
+}
+
+var _ port.TemplateBundleOutputPort = (*TemplateBundlePresenter)(nil)
+
+// NewTemplateBundlePresenter creates a new TemplateBundlePresenter.
+func NewTemplateBundlePresenter() *TemplateBundlePresenter {
+	return &TemplateBundlePresenter{}
+}
+
+// PresentTemplateBundle stores the bundle to render.
+func (p *TemplateBundlePresenter) PresentTemplateBundle(_ context.Context, bundle template.Bundle) error {
+	p.bundle = bundle
+	return nil
+}
+
+// PresentTemplateBundleImport stores the per-template import results.
+func (p *TemplateBundlePresenter) PresentTemplateBundleImport(_ context.Context, results []template.BundleImportResult) error {
+	res := openapi.ModelsImportTemplateBundleResponse{Results: make([]openapi.ModelsTemplateBundleResult, 0, len(results))}
+	for _, r := range results {
+		item := openapi.ModelsTemplateBundleResult{
+			Index:      int32(r.Index), //nolint:gosec
+			Name:       r.Name,
+			TemplateId: emptyToNil(r.TemplateID),
+		}
+		if r.Err != nil {
+			msg := r.Err.Error()
+			item.Error = &msg
+			res.Failed++
+			res.Results = append(res.Results, item)
+			continue
+		}
+		action := openapi.ModelsTemplateBundleAction(r.Action)
+		item.Action = &action
+		switch r.Action {
+		case template.BundleActionCreated:
+			res.Created++
+		case template.BundleActionRenamed:
+			res.Renamed++
+		case template.BundleActionUpdated:
+			res.Updated++
+		case template.BundleActionSkipped:
+			res.Skipped++
+		}
+		res.Results = append(res.Results, item)
+	}
+	p.response = res
+	return nil
+}
+
+// Document returns the bundle rendered in the given format.
+func (p *TemplateBundlePresenter) Document(format template.BundleFormat) ([]byte, error) {
+	return template.EncodeBundle(p.bundle, format)
+}
+
+// Response returns the bundle import response.
+func (p *TemplateBundlePresenter) Response() openapi.ModelsImportTemplateBundleResponse {
+	return p.response
+}
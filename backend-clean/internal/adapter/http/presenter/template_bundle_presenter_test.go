@@ -0,0 +1,47 @@
+package presenter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestTemplateBundlePresenter_Document(t *testing.T) {
+	p := NewTemplateBundlePresenter()
+	bundle := template.NewBundle([]template.Template{{ID: "tpl-1", Name: "Daily", Fields: []template.Field{{ID: "f1", Label: "Body", Order: 1}}}})
+	if err := p.PresentTemplateBundle(context.Background(), bundle); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	doc, err := p.Document(template.BundleFormatYAML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(string(doc), "version: 1\ntemplates:\n  - name: Daily\n") {
+		t.Fatalf("document = %q", doc)
+	}
+	if _, err := p.Document("xml"); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestTemplateBundlePresenter_PresentTemplateBundleImport(t *testing.T) {
+	p := NewTemplateBundlePresenter()
+	err := p.PresentTemplateBundleImport(context.Background(), []template.BundleImportResult{
+		{Index: 0, Name: "Daily (2)", TemplateID: "t1", Action: template.BundleActionRenamed},
+		{Index: 1, Name: "Weekly", TemplateID: "t2", Action: template.BundleActionSkipped},
+		{Index: 2, Name: "Broken", Err: domainerr.ErrFieldRequired},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res := p.Response()
+	if res.Renamed != 1 || res.Skipped != 1 || res.Failed != 1 || res.Created != 0 || len(res.Results) != 3 {
+		t.Fatalf("unexpected summary: %+v", res)
+	}
+	if res.Results[2].TemplateId != nil || res.Results[2].Action != nil || res.Results[2].Error == nil {
+		t.Fatalf("unexpected failed result: %+v", res.Results[2])
+	}
+}
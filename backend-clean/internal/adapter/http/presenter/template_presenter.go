@@ -12,6 +12,7 @@ import (
 // TemplatePresenter converts template domain models to OpenAPI responses.
 type TemplatePresenter struct {
 	template *openapi.ModelsTemplateResponse
+	changes  *openapi.ModelsTemplateChangeReport
 	list     []openapi.ModelsTemplateResponse
 	deleted  bool
 }
@@ -46,8 +47,35 @@ func (p *TemplatePresenter) PresentTemplateDeleted(_ context.Context) error {
 	return nil
 }
 
-// Template returns the last template response.
+// PresentTemplateChanges stores the change report of a template update.
+func (p *TemplatePresenter) PresentTemplateChanges(_ context.Context, report template.ChangeReport) error {
+	fields := make([]openapi.ModelsFieldChange, 0, len(report.Fields))
+	for _, c := range report.Fields {
+		kinds := make([]openapi.ModelsFieldChangeKind, 0, len(c.Kinds))
+		for _, k := range c.Kinds {
+			kinds = append(kinds, openapi.ModelsFieldChangeKind(k))
+		}
+		fields = append(fields, openapi.ModelsFieldChange{
+			FieldId:         emptyToNil(c.FieldID),
+			PreviousFieldId: emptyToNil(c.PreviousFieldID),
+			Label:           c.Label,
+			PreviousLabel:   emptyToNil(c.PreviousLabel),
+			Kinds:           kinds,
+		})
+	}
+	p.changes = &openapi.ModelsTemplateChangeReport{
+		PreviousVersion: int32(report.PreviousVersion), //nolint:gosec
+		Version:         int32(report.Version),         //nolint:gosec
+		Fields:          fields,
+	}
+	return nil
+}
+
+// Template returns the last template response, including the change report after an update.
 func (p *TemplatePresenter) Template() *openapi.ModelsTemplateResponse {
+	if p.template != nil && p.changes != nil {
+		p.template.Changes = p.changes
+	}
 	return p.template
 }
 
@@ -65,12 +93,24 @@ func toTemplateResponse(t template.WithUsage) openapi.ModelsTemplateResponse {
 	fields := make([]openapi.ModelsField, 0, len(t.Template.Fields))
 	for _, f := range t.Template.Fields {
 		fields = append(fields, openapi.ModelsField{
-			Id:         f.ID,
-			Label:      f.Label,
-			Order:      int32(f.Order), //nolint:gosec
-			IsRequired: f.IsRequired,
+			Id:          f.ID,
+			Label:       f.Label,
+			Order:       int32(f.Order), //nolint:gosec
+			IsRequired:  f.IsRequired,
+			Type:        openapi.ModelsFieldType(f.Type),
+			Options:     toFieldOptionsResponse(f.Options),
+			MinLength:   toInt32Ptr(f.MinLength),
+			MaxLength:   toInt32Ptr(f.MaxLength),
+			Pattern:     emptyToNil(f.Pattern),
+			Placeholder: emptyToNil(f.Placeholder),
+			HelpText:    emptyToNil(f.HelpText),
 		})
 	}
+	var matched *[]string
+	if t.MatchedFieldIDs != nil {
+		ids := append([]string{}, t.MatchedFieldIDs...)
+		matched = &ids
+	}
 	return openapi.ModelsTemplateResponse{
 		Id:      t.Template.ID,
 		Name:    t.Template.Name,
@@ -81,8 +121,46 @@ func toTemplateResponse(t template.WithUsage) openapi.ModelsTemplateResponse {
 			LastName:  t.Owner.LastName,
 			Thumbnail: t.Owner.Thumbnail,
 		},
-		Fields:    fields,
-		IsUsed:    t.IsUsed,
-		UpdatedAt: t.Template.UpdatedAt,
+		Visibility: openapi.ModelsTemplateVisibility(t.Template.Visibility),
+		Version:    int32(t.Template.Version), //nolint:gosec
+		Fields:     fields,
+		IsUsed:     t.IsUsed,
+		Usage: openapi.ModelsTemplateUsage{
+			NoteCount:      int32(t.Usage.NoteCount),      //nolint:gosec
+			PublishedCount: int32(t.Usage.PublishedCount), //nolint:gosec
+			AuthorCount:    int32(t.Usage.AuthorCount),    //nolint:gosec
+			LastUsedAt:     t.Usage.LastUsedAt,
+		},
+		ForkedFromId:        emptyToNil(t.Template.ForkedFromID),
+		ForkCount:           int32(t.ForkCount), //nolint:gosec
+		Deprecated:          t.Template.IsDeprecated(),
+		DeprecatedAt:        t.Template.DeprecatedAt,
+		SuccessorTemplateId: emptyToNil(t.Template.SuccessorID),
+		MatchedFieldIds:     matched,
+		UpdatedAt:           t.Template.UpdatedAt,
+	}
+}
+
+func toFieldOptionsResponse(o template.FieldOptions) openapi.ModelsFieldOptions {
+	res := openapi.ModelsFieldOptions{Min: o.Min, Max: o.Max}
+	if len(o.Choices) > 0 {
+		choices := append([]string(nil), o.Choices...)
+		res.Choices = &choices
+	}
+	return res
+}
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v) //nolint:gosec
+	return &i
+}
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
 	}
+	return &s
 }
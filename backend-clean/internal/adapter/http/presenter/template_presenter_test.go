@@ -25,14 +25,20 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 			action: "single",
 			single: &template.WithUsage{
 				Template: template.Template{
-					ID:      "tpl-1",
-					Name:    "Template",
-					OwnerID: "owner-1",
-					Fields:  []template.Field{{ID: "f1", Label: "Title", Order: 2, IsRequired: true}},
-					UpdatedAt: now,
+					ID:           "tpl-1",
+					Name:         "Template",
+					OwnerID:      "owner-1",
+					ForkedFromID: "tpl-0",
+					Visibility:   template.VisibilityUnlisted,
+					DeprecatedAt: &now,
+					SuccessorID:  "tpl-9",
+					Fields:       []template.Field{{ID: "f1", Label: "Title", Order: 2, IsRequired: true}},
+					UpdatedAt:    now,
 				},
-				Owner:  template.Owner{ID: "owner-1", FirstName: "Taro", LastName: "Yamada"},
-				IsUsed: true,
+				Owner:     template.Owner{ID: "owner-1", FirstName: "Taro", LastName: "Yamada"},
+				IsUsed:    true,
+				Usage:     template.Usage{NoteCount: 4, PublishedCount: 2, AuthorCount: 1, LastUsedAt: &now},
+				ForkCount: 3,
 			},
 			wantID:     "tpl-1",
 			wantOwner:  "owner-1",
@@ -41,7 +47,7 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 		{
 			name:      "[Success] list",
 			action:    "list",
-			list:      []template.WithUsage{{Template: template.Template{ID: "tpl-1"}}, {Template: template.Template{ID: "tpl-2"}}},
+			list:      []template.WithUsage{{Template: template.Template{ID: "tpl-1"}, MatchedFieldIDs: []string{"f1"}}, {Template: template.Template{ID: "tpl-2"}}},
 			wantCount: 2,
 		},
 	}
@@ -66,6 +72,18 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 				if resp.IsUsed != tt.expectUsed {
 					t.Fatalf("IsUsed mismatch")
 				}
+				if resp.Usage.NoteCount != 4 || resp.Usage.PublishedCount != 2 || resp.Usage.AuthorCount != 1 || resp.Usage.LastUsedAt == nil {
+					t.Fatalf("usage not converted: %+v", resp.Usage)
+				}
+				if resp.ForkedFromId == nil || *resp.ForkedFromId != "tpl-0" || resp.ForkCount != 3 {
+					t.Fatalf("fork lineage not converted: %+v", resp)
+				}
+				if resp.Visibility != "unlisted" {
+					t.Fatalf("visibility not converted: %v", resp.Visibility)
+				}
+				if !resp.Deprecated || resp.DeprecatedAt == nil || resp.SuccessorTemplateId == nil || *resp.SuccessorTemplateId != "tpl-9" {
+					t.Fatalf("deprecation not converted: %+v", resp)
+				}
 				if resp.UpdatedAt.IsZero() {
 					t.Fatalf("UpdatedAt not set")
 				}
@@ -77,6 +95,9 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 				if len(p.Templates()) != tt.wantCount {
 					t.Fatalf("want %d templates, got %d", tt.wantCount, len(p.Templates()))
 				}
+				if got := p.Templates(); got[0].MatchedFieldIds == nil || (*got[0].MatchedFieldIds)[0] != "f1" || got[1].MatchedFieldIds != nil {
+					t.Fatalf("matched fields not converted: %+v", got)
+				}
 			}
 		})
 	}
@@ -89,3 +110,37 @@ func TestTemplatePresenter_PresentTemplateDeleted(t *testing.T) {
 		t.Fatalf("delete flag not set")
 	}
 }
+
+func TestTemplatePresenter_PresentTemplateChanges(t *testing.T) {
+	p := NewTemplatePresenter()
+	report := template.ChangeReport{
+		Fields: []template.FieldChange{
+			{FieldID: "f1-v2", PreviousFieldID: "f1", Label: "Context", PreviousLabel: "Background", Kinds: []template.FieldChangeKind{template.FieldRenamed}},
+			{FieldID: "f2-v2", Label: "Decision", Kinds: []template.FieldChangeKind{template.FieldAdded}},
+		},
+		PreviousVersion: 1,
+		Version:         2,
+	}
+	if err := p.PresentTemplateChanges(context.Background(), report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.PresentTemplate(context.Background(), &template.WithUsage{Template: template.Template{ID: "tpl-1"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp := p.Template()
+	if resp == nil || resp.Changes == nil {
+		t.Fatalf("changes not attached: %+v", resp)
+	}
+	if len(resp.Changes.Fields) != 2 || resp.Changes.PreviousVersion != 1 || resp.Changes.Version != 2 {
+		t.Fatalf("unexpected changes: %+v", resp.Changes)
+	}
+	if resp.Changes.Fields[0].PreviousLabel == nil || *resp.Changes.Fields[0].PreviousLabel != "Background" {
+		t.Fatalf("previous label not converted: %+v", resp.Changes.Fields[0])
+	}
+	if *resp.Changes.Fields[0].PreviousFieldId != "f1" || *resp.Changes.Fields[0].FieldId != "f1-v2" {
+		t.Fatalf("field ids not converted: %+v", resp.Changes.Fields[0])
+	}
+	if resp.Changes.Fields[1].PreviousLabel != nil || resp.Changes.Fields[1].PreviousFieldId != nil || resp.Changes.Fields[1].Kinds[0] != "added" {
+		t.Fatalf("unexpected added change: %+v", resp.Changes.Fields[1])
+	}
+}
@@ -0,0 +1,135 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookPresenter converts webhook domain models to OpenAPI responses. Secrets are never presented.
+type WebhookPresenter struct {
+	webhook    *openapi.ModelsWebhookResponse
+	list       []openapi.ModelsWebhookResponse
+	delivery   *openapi.ModelsWebhookDeliveryResponse
+	deliveries []openapi.ModelsWebhookDeliveryResponse
+	deleted    bool
+}
+
+var _ port.WebhookOutputPort = (*WebhookPresenter)(nil)
+
+// NewWebhookPresenter creates a WebhookPresenter.
+func NewWebhookPresenter() *WebhookPresenter {
+	return &WebhookPresenter{}
+}
+
+// PresentWebhookList stores webhook list response.
+func (p *WebhookPresenter) PresentWebhookList(_ context.Context, subs []webhook.Subscription) error {
+	res := make([]openapi.ModelsWebhookResponse, 0, len(subs))
+	for _, s := range subs {
+		res = append(res, toWebhookResponse(s))
+	}
+	p.list = res
+	return nil
+}
+
+// PresentWebhook stores single webhook response.
+func (p *WebhookPresenter) PresentWebhook(_ context.Context, sub *webhook.Subscription) error {
+	resp := toWebhookResponse(*sub)
+	p.webhook = &resp
+	return nil
+}
+
+// PresentWebhookDeleted marks delete success.
+func (p *WebhookPresenter) PresentWebhookDeleted(_ context.Context) error {
+	p.deleted = true
+	return nil
+}
+
+// PresentWebhookDeliveries stores delivery history response.
+func (p *WebhookPresenter) PresentWebhookDeliveries(_ context.Context, deliveries []webhook.Delivery) error {
+	res := make([]openapi.ModelsWebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, toWebhookDeliveryResponse(d))
+	}
+	p.deliveries = res
+	return nil
+}
+
+// PresentWebhookDelivery stores single delivery response.
+func (p *WebhookPresenter) PresentWebhookDelivery(_ context.Context, d *webhook.Delivery) error {
+	resp := toWebhookDeliveryResponse(*d)
+	p.delivery = &resp
+	return nil
+}
+
+// Webhook returns the last webhook response.
+func (p *WebhookPresenter) Webhook() *openapi.ModelsWebhookResponse {
+	return p.webhook
+}
+
+// Webhooks returns the webhook list response.
+func (p *WebhookPresenter) Webhooks() []openapi.ModelsWebhookResponse {
+	return p.list
+}
+
+// Delivery returns the last delivery response.
+func (p *WebhookPresenter) Delivery() *openapi.ModelsWebhookDeliveryResponse {
+	return p.delivery
+}
+
+// Deliveries returns the delivery history response.
+func (p *WebhookPresenter) Deliveries() []openapi.ModelsWebhookDeliveryResponse {
+	return p.deliveries
+}
+
+// DeleteResponse returns deletion success response.
+func (p *WebhookPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deleted}
+}
+
+func toWebhookResponse(s webhook.Subscription) openapi.ModelsWebhookResponse {
+	events := make([]openapi.ModelsWebhookEvent, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, openapi.ModelsWebhookEvent(e))
+	}
+	return openapi.ModelsWebhookResponse{
+		Id:        s.ID,
+		OwnerId:   s.OwnerID,
+		Url:       s.URL,
+		Events:    events,
+		Active:    s.Active,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(d webhook.Delivery) openapi.ModelsWebhookDeliveryResponse {
+	resp := openapi.ModelsWebhookDeliveryResponse{
+		Id:          d.ID,
+		WebhookId:   d.SubscriptionID,
+		EventId:     d.EventID,
+		Event:       openapi.ModelsWebhookEvent(d.EventName),
+		Payload:     string(d.Payload),
+		Status:      openapi.ModelsWebhookDeliveryStatus(d.Status),
+		Attempts:    int32(d.Attempts), //nolint:gosec
+		CreatedAt:   d.CreatedAt,
+		CompletedAt: d.CompletedAt,
+	}
+	if d.Attempts > 0 {
+		status := int32(d.ResponseStatus)          //nolint:gosec
+		latency := int32(d.Latency.Milliseconds()) //nolint:gosec
+		if d.ResponseStatus != 0 {
+			resp.ResponseStatus = &status
+		}
+		resp.LatencyMs = &latency
+	}
+	if d.LastError != "" {
+		resp.LastError = &d.LastError
+	}
+	if d.RedeliveryOf != "" {
+		resp.RedeliveryOf = &d.RedeliveryOf
+	}
+	return resp
+}
@@ -0,0 +1,69 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+func TestWebhookPresenter_TableDriven(t *testing.T) {
+	now := time.Now()
+	sub := &webhook.Subscription{ID: "wh-1", OwnerID: "owner-1", URL: "https://example.com/hook", Secret: "0123456789abcdef", Events: []event.Name{event.NoteCreated}, Active: true, CreatedAt: now, UpdatedAt: now}
+	failed := &webhook.Delivery{
+		ID: "dl-1", SubscriptionID: "wh-1", EventID: "ev-1", EventName: event.NoteCreated, Payload: []byte(`{"a":1}`),
+		Status: webhook.DeliveryFailed, Attempts: 8, ResponseStatus: 500, Latency: 120 * time.Millisecond,
+		LastError: "unexpected response status 500", CreatedAt: now, CompletedAt: &now,
+	}
+	tests := []struct {
+		name   string
+		action string
+	}{
+		{name: "[Success] single", action: "single"},
+		{name: "[Success] list", action: "list"},
+		{name: "[Success] delivery", action: "delivery"},
+		{name: "[Success] pending redelivery", action: "redelivery"},
+		{name: "[Success] deleted", action: "deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewWebhookPresenter()
+			ctx := context.Background()
+			switch tt.action {
+			case "single":
+				_ = p.PresentWebhook(ctx, sub)
+				resp := p.Webhook()
+				if resp == nil || resp.Id != "wh-1" || resp.Url != sub.URL || len(resp.Events) != 1 || resp.Events[0] != "note.created" || !resp.Active {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+			case "list":
+				_ = p.PresentWebhookList(ctx, []webhook.Subscription{*sub, {ID: "wh-2"}})
+				if len(p.Webhooks()) != 2 || p.Webhooks()[1].Events == nil {
+					t.Fatalf("unexpected list: %+v", p.Webhooks())
+				}
+			case "delivery":
+				_ = p.PresentWebhookDeliveries(ctx, []webhook.Delivery{*failed})
+				resp := p.Deliveries()[0]
+				if resp.WebhookId != "wh-1" || resp.Payload != `{"a":1}` || resp.Status != "failed" ||
+					resp.ResponseStatus == nil || *resp.ResponseStatus != 500 || resp.LatencyMs == nil || *resp.LatencyMs != 120 ||
+					resp.LastError == nil || resp.CompletedAt == nil || resp.RedeliveryOf != nil {
+					t.Fatalf("unexpected delivery: %+v", resp)
+				}
+			case "redelivery":
+				_ = p.PresentWebhookDelivery(ctx, &webhook.Delivery{ID: "dl-2", SubscriptionID: "wh-1", Status: webhook.DeliveryPending, RedeliveryOf: "dl-1"})
+				resp := p.Delivery()
+				if resp == nil || resp.RedeliveryOf == nil || *resp.RedeliveryOf != "dl-1" || resp.ResponseStatus != nil || resp.LatencyMs != nil {
+					t.Fatalf("unexpected delivery: %+v", resp)
+				}
+			case "deleted":
+				_ = p.PresentWebhookDeleted(ctx)
+				if !p.DeleteResponse().Success {
+					t.Fatalf("expected success")
+				}
+			}
+		})
+	}
+}
@@ -16,6 +16,10 @@ type Account struct {
 	LastLoginAt       *time.Time
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	// FollowerCount is the number of accounts following this one.
+	FollowerCount int
+	// FollowingCount is the number of accounts this one follows.
+	FollowingCount int
 }
 
 // OAuthAccountInput describes account info from OAuth provider.
@@ -53,3 +53,20 @@ func UpdateProfile(current Account, input OAuthAccountInput) (Account, error) {
 	}
 	return current, Validate(current)
 }
+
+// IsNewlyRegistered reports whether the account was created by the sign-in that returned it.
+// Both timestamps are set by the insert, while a returning sign-in refreshes UpdatedAt only.
+func IsNewlyRegistered(a Account) bool {
+	return a.CreatedAt.Equal(a.UpdatedAt)
+}
+
+// ValidateFollow checks that an account follows another account.
+func ValidateFollow(followerID, followeeID string) error {
+	if strings.TrimSpace(followerID) == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if followerID == followeeID {
+		return domainerr.ErrCannotFollowSelf
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package account
 import (
 	"errors"
 	"testing"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
@@ -150,3 +151,35 @@ func TestUpdateProfile(t *testing.T) {
 }
 
 func ptr[T any](v T) *T { return &v }
+
+func TestIsNewlyRegistered(t *testing.T) {
+	now := time.Now()
+	if !IsNewlyRegistered(Account{CreatedAt: now, UpdatedAt: now}) {
+		t.Fatalf("want newly registered")
+	}
+	if IsNewlyRegistered(Account{CreatedAt: now, UpdatedAt: now.Add(time.Hour)}) {
+		t.Fatalf("want returning account")
+	}
+}
+
+func TestValidateFollow(t *testing.T) {
+	tests := []struct {
+		name      string
+		follower  string
+		followee  string
+		wantError error
+	}{
+		{name: "[Success] follow another account", follower: "a", followee: "b"},
+		{name: "[Fail] follower missing", follower: " ", followee: "b", wantError: domainerr.ErrOwnerRequired},
+		{name: "[Fail] follow self", follower: "a", followee: "a", wantError: domainerr.ErrCannotFollowSelf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFollow(tt.follower, tt.followee)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
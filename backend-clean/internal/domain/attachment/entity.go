@@ -0,0 +1,29 @@
+// Package attachment holds note attachment domain models.
+package attachment
+
+import "time"
+
+// MaxSize is the maximum attachment size in bytes.
+const MaxSize int64 = 10 << 20
+
+// AllowedContentTypes lists content types accepted for attachments.
+var AllowedContentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"text/plain",
+}
+
+// Attachment represents a file attached to a note, optionally to one of its sections.
+type Attachment struct {
+	ID          string
+	NoteID      string
+	SectionID   *string
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,32 @@
+package attachment
+
+import (
+	"mime"
+	"slices"
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// ValidateUpload checks file name, content type and size of an upload.
+func ValidateUpload(fileName, contentType string, size int64) error {
+	if strings.TrimSpace(fileName) == "" {
+		return domainerr.ErrAttachmentNameRequired
+	}
+	if size <= 0 || size > MaxSize {
+		return domainerr.ErrAttachmentTooLarge
+	}
+	if !IsAllowedContentType(contentType) {
+		return domainerr.ErrUnsupportedContentType
+	}
+	return nil
+}
+
+// IsAllowedContentType reports whether the media type (parameters ignored) is accepted.
+func IsAllowedContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(AllowedContentTypes, mediaType)
+}
@@ -0,0 +1,37 @@
+package attachment
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestValidateUpload(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		contentType string
+		size        int64
+		wantError   error
+	}{
+		{name: "[Success] png", fileName: "a.png", contentType: "image/png", size: 10},
+		{name: "[Success] text with charset", fileName: "a.txt", contentType: "text/plain; charset=utf-8", size: MaxSize},
+		{name: "[Fail] missing name", fileName: " ", contentType: "image/png", size: 10, wantError: domainerr.ErrAttachmentNameRequired},
+		{name: "[Fail] empty file", fileName: "a.png", contentType: "image/png", size: 0, wantError: domainerr.ErrAttachmentTooLarge},
+		{name: "[Fail] too large", fileName: "a.png", contentType: "image/png", size: MaxSize + 1, wantError: domainerr.ErrAttachmentTooLarge},
+		{name: "[Fail] unsupported type", fileName: "a.exe", contentType: "application/octet-stream", size: 10, wantError: domainerr.ErrUnsupportedContentType},
+		{name: "[Fail] malformed type", fileName: "a.png", contentType: "image/", size: 10, wantError: domainerr.ErrUnsupportedContentType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpload(tt.fileName, tt.contentType, tt.size)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package collab models live editing sessions on a note: who is in them and who may edit which section.
+package collab
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// LockTTL is how long a section lock lasts unless its holder renews it by locking or changing the section again.
+const LockTTL = 30 * time.Second
+
+// Mode tells other participants what someone in the session is doing.
+type Mode string
+
+// Mode constants.
+const (
+	ModeViewing Mode = "viewing"
+	ModeEditing Mode = "editing"
+)
+
+// Participant is one connection to a session. An account may join with several connections.
+type Participant struct {
+	ConnID    string
+	AccountID string
+	Mode      Mode
+	JoinedAt  time.Time
+}
+
+// Lock grants one connection the right to edit a section until ExpiresAt.
+type Lock struct {
+	SectionID string
+	ConnID    string
+	AccountID string
+	ExpiresAt time.Time
+}
+
+// EventType identifies what changed in a session.
+type EventType string
+
+// EventType constants.
+const (
+	EventPresence EventType = "presence"
+	EventLocked   EventType = "locked"
+	EventUnlocked EventType = "unlocked"
+	EventChanged  EventType = "changed"
+	EventSaved    EventType = "saved"
+)
+
+// Event is broadcast to the participants of a session. ConnID and AccountID name who caused it;
+// both are empty when a lock expired.
+type Event struct {
+	Type      EventType
+	NoteID    string
+	ConnID    string
+	AccountID string
+	// SectionID and ExpiresAt are set for lock events, SectionID and Content for changes.
+	SectionID string
+	Content   string
+	ExpiresAt time.Time
+	// Participants is set for presence events.
+	Participants []Participant
+	// Note is set for saved events.
+	Note *note.WithMeta
+}
@@ -0,0 +1,128 @@
+package collab
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// Room holds the participants and section locks of one note's session.
+type Room struct {
+	participants map[string]Participant
+	locks        map[string]Lock
+}
+
+// NewRoom creates an empty Room.
+func NewRoom() *Room {
+	return &Room{participants: map[string]Participant{}, locks: map[string]Lock{}}
+}
+
+// Join adds a participant.
+func (r *Room) Join(p Participant) {
+	r.participants[p.ConnID] = p
+}
+
+// Leave removes a participant and returns the locks it held, which are released with it.
+func (r *Room) Leave(connID string) []Lock {
+	delete(r.participants, connID)
+	var released []Lock
+	for id, l := range r.locks {
+		if l.ConnID == connID {
+			released = append(released, l)
+			delete(r.locks, id)
+		}
+	}
+	sortLocks(released)
+	return released
+}
+
+// Acquire locks a section for a participant until now+LockTTL. The holder acquiring again renews
+// the lock; a lock held by another connection can only be taken once it has expired.
+func (r *Room) Acquire(sectionID, connID string, now time.Time) (Lock, error) {
+	if strings.TrimSpace(sectionID) == "" {
+		return Lock{}, domainerr.ErrSectionsMissing
+	}
+	p, ok := r.participants[connID]
+	if !ok {
+		return Lock{}, domainerr.ErrUnauthorized
+	}
+	if l, ok := r.locks[sectionID]; ok && l.ConnID != connID && now.Before(l.ExpiresAt) {
+		return Lock{}, domainerr.ErrSectionLocked
+	}
+	l := Lock{SectionID: sectionID, ConnID: connID, AccountID: p.AccountID, ExpiresAt: now.Add(LockTTL)}
+	r.locks[sectionID] = l
+	return l, nil
+}
+
+// Release unlocks a section held by the participant.
+func (r *Room) Release(sectionID, connID string, now time.Time) (Lock, error) {
+	if !r.Holds(sectionID, connID, now) {
+		return Lock{}, domainerr.ErrSectionNotLocked
+	}
+	l := r.locks[sectionID]
+	delete(r.locks, sectionID)
+	return l, nil
+}
+
+// Holds reports whether the participant holds an unexpired lock on the section.
+func (r *Room) Holds(sectionID, connID string, now time.Time) bool {
+	l, ok := r.locks[sectionID]
+	return ok && l.ConnID == connID && now.Before(l.ExpiresAt)
+}
+
+// Expire removes and returns the locks that expired at now.
+func (r *Room) Expire(now time.Time) []Lock {
+	var expired []Lock
+	for id, l := range r.locks {
+		if !now.Before(l.ExpiresAt) {
+			expired = append(expired, l)
+			delete(r.locks, id)
+		}
+	}
+	sortLocks(expired)
+	return expired
+}
+
+// Participants returns the participants in join order. Someone holding a lock is editing.
+func (r *Room) Participants() []Participant {
+	editing := make(map[string]bool, len(r.locks))
+	for _, l := range r.locks {
+		editing[l.ConnID] = true
+	}
+	out := make([]Participant, 0, len(r.participants))
+	for _, p := range r.participants {
+		p.Mode = ModeViewing
+		if editing[p.ConnID] {
+			p.Mode = ModeEditing
+		}
+		out = append(out, p)
+	}
+	slices.SortFunc(out, func(a, b Participant) int {
+		if c := a.JoinedAt.Compare(b.JoinedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ConnID, b.ConnID)
+	})
+	return out
+}
+
+// Locks returns the current locks ordered by section.
+func (r *Room) Locks() []Lock {
+	out := make([]Lock, 0, len(r.locks))
+	for _, l := range r.locks {
+		out = append(out, l)
+	}
+	sortLocks(out)
+	return out
+}
+
+// Empty reports whether nobody is in the room.
+func (r *Room) Empty() bool {
+	return len(r.participants) == 0
+}
+
+func sortLocks(locks []Lock) {
+	slices.SortFunc(locks, func(a, b Lock) int { return strings.Compare(a.SectionID, b.SectionID) })
+}
@@ -0,0 +1,118 @@
+package collab
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+var t0 = time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestRoom() *Room {
+	r := NewRoom()
+	r.Join(Participant{ConnID: "c1", AccountID: "alice", JoinedAt: t0})
+	r.Join(Participant{ConnID: "c2", AccountID: "bob", JoinedAt: t0.Add(time.Second)})
+	return r
+}
+
+func TestRoom_Acquire(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(r *Room)
+		connID    string
+		sectionID string
+		at        time.Time
+		wantError error
+	}{
+		{name: "[Success] free section", setup: func(*Room) {}, connID: "c1", sectionID: "s1", at: t0},
+		{name: "[Success] holder renews", setup: func(r *Room) { _, _ = r.Acquire("s1", "c1", t0) }, connID: "c1", sectionID: "s1", at: t0.Add(10 * time.Second)},
+		{name: "[Success] take over expired lock", setup: func(r *Room) { _, _ = r.Acquire("s1", "c2", t0) }, connID: "c1", sectionID: "s1", at: t0.Add(LockTTL)},
+		{name: "[Fail] held by another connection", setup: func(r *Room) { _, _ = r.Acquire("s1", "c2", t0) }, connID: "c1", sectionID: "s1", at: t0.Add(time.Second), wantError: domainerr.ErrSectionLocked},
+		{name: "[Fail] not a participant", setup: func(*Room) {}, connID: "c9", sectionID: "s1", at: t0, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] section missing", setup: func(*Room) {}, connID: "c1", sectionID: " ", at: t0, wantError: domainerr.ErrSectionsMissing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRoom()
+			tt.setup(r)
+			l, err := r.Acquire(tt.sectionID, tt.connID, tt.at)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if l.ConnID != tt.connID || !l.ExpiresAt.Equal(tt.at.Add(LockTTL)) {
+				t.Fatalf("unexpected lock: %+v", l)
+			}
+			if !r.Holds(tt.sectionID, tt.connID, tt.at) {
+				t.Fatalf("expected %s to hold %s", tt.connID, tt.sectionID)
+			}
+		})
+	}
+}
+
+func TestRoom_Release(t *testing.T) {
+	r := newTestRoom()
+	_, _ = r.Acquire("s1", "c1", t0)
+	if _, err := r.Release("s1", "c2", t0); !errors.Is(err, domainerr.ErrSectionNotLocked) {
+		t.Fatalf("want ErrSectionNotLocked, got %v", err)
+	}
+	if _, err := r.Release("s1", "c1", t0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.Locks()) != 0 {
+		t.Fatalf("expected no locks, got %+v", r.Locks())
+	}
+}
+
+func TestRoom_LeaveReleasesLocks(t *testing.T) {
+	r := newTestRoom()
+	_, _ = r.Acquire("s2", "c1", t0)
+	_, _ = r.Acquire("s1", "c1", t0)
+	_, _ = r.Acquire("s3", "c2", t0)
+	released := r.Leave("c1")
+	if len(released) != 2 || released[0].SectionID != "s1" || released[1].SectionID != "s2" {
+		t.Fatalf("unexpected released locks: %+v", released)
+	}
+	if locks := r.Locks(); len(locks) != 1 || locks[0].SectionID != "s3" {
+		t.Fatalf("unexpected remaining locks: %+v", locks)
+	}
+	if ps := r.Participants(); len(ps) != 1 || ps[0].ConnID != "c2" {
+		t.Fatalf("unexpected participants: %+v", ps)
+	}
+	r.Leave("c2")
+	if !r.Empty() {
+		t.Fatalf("expected empty room")
+	}
+}
+
+func TestRoom_Expire(t *testing.T) {
+	r := newTestRoom()
+	_, _ = r.Acquire("s1", "c1", t0)
+	_, _ = r.Acquire("s2", "c2", t0.Add(10*time.Second))
+	expired := r.Expire(t0.Add(LockTTL))
+	if len(expired) != 1 || expired[0].SectionID != "s1" {
+		t.Fatalf("unexpected expired locks: %+v", expired)
+	}
+	if r.Holds("s1", "c1", t0.Add(LockTTL)) {
+		t.Fatalf("expired lock is still held")
+	}
+}
+
+func TestRoom_Participants(t *testing.T) {
+	r := newTestRoom()
+	_, _ = r.Acquire("s1", "c2", t0)
+	ps := r.Participants()
+	if len(ps) != 2 || ps[0].ConnID != "c1" || ps[1].ConnID != "c2" {
+		t.Fatalf("unexpected order: %+v", ps)
+	}
+	if ps[0].Mode != ModeViewing || ps[1].Mode != ModeEditing {
+		t.Fatalf("unexpected modes: %+v", ps)
+	}
+}
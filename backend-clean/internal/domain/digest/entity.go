@@ -0,0 +1,56 @@
+// Package digest holds the periodic email that sums up what an account missed.
+package digest
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+)
+
+// Frequency tells how often an account receives its digest.
+type Frequency string
+
+// Frequency constants.
+const (
+	FrequencyOff    Frequency = "off"
+	FrequencyDaily  Frequency = "daily"
+	FrequencyWeekly Frequency = "weekly"
+)
+
+// Frequencies lists every digest frequency.
+var Frequencies = []Frequency{FrequencyOff, FrequencyDaily, FrequencyWeekly}
+
+// DefaultFrequency applies to accounts that never changed their digest settings.
+const DefaultFrequency = FrequencyWeekly
+
+// Digest content limits.
+const (
+	// SectionLimit caps the entries listed in each section of a digest.
+	SectionLimit = 10
+	// StaleDraftAge is how long a draft stays untouched before the digest reminds its owner.
+	StaleDraftAge = 14 * 24 * time.Hour
+)
+
+// Settings are an account's digest frequency and when its last digest went out.
+type Settings struct {
+	AccountID  string
+	Frequency  Frequency
+	LastSentAt *time.Time
+	UpdatedAt  time.Time
+}
+
+// Digest is the content of one email. Notes are what followed authors published since the
+// previous digest, Pending the notifications still waiting to be read and StaleDrafts the
+// recipient's drafts nobody touched for StaleDraftAge.
+type Digest struct {
+	Recipient      account.Account
+	Frequency      Frequency
+	Since          time.Time
+	Notes          []feed.Item
+	Pending        []notification.Notification
+	StaleDrafts    []note.Note
+	UnsubscribeURL string
+}
@@ -0,0 +1,118 @@
+package digest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+)
+
+// ValidateSettings checks the account and that the frequency exists.
+func ValidateSettings(s Settings) error {
+	if strings.TrimSpace(s.AccountID) == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if !slices.Contains(Frequencies, s.Frequency) {
+		return domainerr.ErrInvalidDigestFrequency
+	}
+	return nil
+}
+
+// Period returns the time between two digests; it is zero when digests are off.
+func (f Frequency) Period() time.Duration {
+	switch f {
+	case FrequencyDaily:
+		return 24 * time.Hour
+	case FrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Due reports whether the account should receive a digest now.
+func (s Settings) Due(now time.Time) bool {
+	if s.Frequency.Period() == 0 {
+		return false
+	}
+	return s.LastSentAt == nil || now.Sub(*s.LastSentAt) >= s.Frequency.Period()
+}
+
+// Since returns the start of the period a digest sent now covers. The first digest covers one
+// period back.
+func (s Settings) Since(now time.Time) time.Time {
+	if s.LastSentAt != nil {
+		return *s.LastSentAt
+	}
+	return now.Add(-s.Frequency.Period())
+}
+
+// NewNotes keeps the feed items about notes that happened after since, newest first as given,
+// up to SectionLimit.
+func NewNotes(items []feed.Item, since time.Time) []feed.Item {
+	notes := make([]feed.Item, 0, len(items))
+	for _, it := range items {
+		if len(notes) == SectionLimit {
+			break
+		}
+		if it.Kind == feed.KindTemplateCreated || !it.OccurredAt.After(since) {
+			continue
+		}
+		notes = append(notes, it)
+	}
+	return notes
+}
+
+// Empty reports whether the digest has nothing to tell; empty digests are not sent.
+func (d Digest) Empty() bool {
+	return len(d.Notes) == 0 && len(d.Pending) == 0 && len(d.StaleDrafts) == 0
+}
+
+// Unsubscribe signs the links that turn an account's digest off without signing in.
+// BaseURL is the unsubscribe endpoint the token is appended to.
+type Unsubscribe struct {
+	Secret  string
+	BaseURL string
+}
+
+// Token returns the signed token of an account: the account ID and its HMAC-SHA256.
+func (u Unsubscribe) Token(accountID string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(accountID)) + "." + enc.EncodeToString(u.sign(accountID))
+}
+
+// URL returns the unsubscribe link of an account.
+func (u Unsubscribe) URL(accountID string) string {
+	return u.BaseURL + "?token=" + url.QueryEscape(u.Token(accountID))
+}
+
+// AccountID verifies a token and returns the account it was issued to.
+func (u Unsubscribe) AccountID(token string) (string, error) {
+	enc := base64.RawURLEncoding
+	idPart, sigPart, ok := strings.Cut(token, ".")
+	if !ok || u.Secret == "" {
+		return "", domainerr.ErrInvalidUnsubscribeToken
+	}
+	id, err := enc.DecodeString(idPart)
+	if err != nil || len(id) == 0 {
+		return "", domainerr.ErrInvalidUnsubscribeToken
+	}
+	sig, err := enc.DecodeString(sigPart)
+	if err != nil || !hmac.Equal(sig, u.sign(string(id))) {
+		return "", domainerr.ErrInvalidUnsubscribeToken
+	}
+	return string(id), nil
+}
+
+func (u Unsubscribe) sign(accountID string) []byte {
+	mac := hmac.New(sha256.New, []byte(u.Secret))
+	mac.Write([]byte("digest-unsubscribe:"))
+	mac.Write([]byte(accountID))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,124 @@
+package digest
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+)
+
+func TestValidateSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		settings  Settings
+		wantError error
+	}{
+		{name: "[Success] weekly", settings: Settings{AccountID: "a", Frequency: FrequencyWeekly}},
+		{name: "[Success] off", settings: Settings{AccountID: "a", Frequency: FrequencyOff}},
+		{name: "[Fail] unknown frequency", settings: Settings{AccountID: "a", Frequency: "hourly"}, wantError: domainerr.ErrInvalidDigestFrequency},
+		{name: "[Fail] account missing", settings: Settings{AccountID: " ", Frequency: FrequencyDaily}, wantError: domainerr.ErrOwnerRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSettings(tt.settings)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestSettings_Due(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time { t := now.Add(-d); return &t }
+	tests := []struct {
+		name      string
+		settings  Settings
+		want      bool
+		wantSince time.Time
+	}{
+		{name: "[Success] never sent", settings: Settings{Frequency: FrequencyDaily}, want: true, wantSince: now.Add(-24 * time.Hour)},
+		{name: "[Success] daily after a day", settings: Settings{Frequency: FrequencyDaily, LastSentAt: at(24 * time.Hour)}, want: true, wantSince: *at(24 * time.Hour)},
+		{name: "[Fail] daily within a day", settings: Settings{Frequency: FrequencyDaily, LastSentAt: at(23 * time.Hour)}, wantSince: *at(23 * time.Hour)},
+		{name: "[Fail] weekly after a day", settings: Settings{Frequency: FrequencyWeekly, LastSentAt: at(24 * time.Hour)}, wantSince: *at(24 * time.Hour)},
+		{name: "[Fail] off", settings: Settings{Frequency: FrequencyOff}, wantSince: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.Due(now); got != tt.want {
+				t.Fatalf("Due = %v, want %v", got, tt.want)
+			}
+			if got := tt.settings.Since(now); !got.Equal(tt.wantSince) {
+				t.Fatalf("Since = %v, want %v", got, tt.wantSince)
+			}
+		})
+	}
+}
+
+func TestNewNotes(t *testing.T) {
+	since := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	items := []feed.Item{
+		{SubjectID: "n1", Kind: feed.KindNoteUpdated, OccurredAt: since.Add(2 * time.Hour)},
+		{SubjectID: "t1", Kind: feed.KindTemplateCreated, OccurredAt: since.Add(time.Hour)},
+		{SubjectID: "n2", Kind: feed.KindNotePublished, OccurredAt: since.Add(time.Minute)},
+		{SubjectID: "n3", Kind: feed.KindNotePublished, OccurredAt: since},
+	}
+	got := NewNotes(items, since)
+	if len(got) != 2 || got[0].SubjectID != "n1" || got[1].SubjectID != "n2" {
+		t.Fatalf("unexpected notes: %+v", got)
+	}
+
+	many := make([]feed.Item, SectionLimit+5)
+	for i := range many {
+		many[i] = feed.Item{Kind: feed.KindNotePublished, OccurredAt: since.Add(time.Hour)}
+	}
+	if got := NewNotes(many, since); len(got) != SectionLimit {
+		t.Fatalf("want %d notes, got %d", SectionLimit, len(got))
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	u := Unsubscribe{Secret: "digest-secret", BaseURL: "https://api.example.com/api/digests/unsubscribe"}
+	token := u.Token("acc-1")
+	tampered := Unsubscribe{Secret: "other-secret"}.Token("acc-1")
+	_, sig, _ := strings.Cut(token, ".")
+	swapped := base64.RawURLEncoding.EncodeToString([]byte("acc-2")) + "." + sig
+	tests := []struct {
+		name      string
+		unsub     Unsubscribe
+		token     string
+		want      string
+		wantError error
+	}{
+		{name: "[Success] issued token", unsub: u, token: token, want: "acc-1"},
+		{name: "[Fail] signed with another secret", unsub: u, token: tampered, wantError: domainerr.ErrInvalidUnsubscribeToken},
+		{name: "[Fail] account swapped", unsub: u, token: swapped, wantError: domainerr.ErrInvalidUnsubscribeToken},
+		{name: "[Fail] malformed", unsub: u, token: "garbage", wantError: domainerr.ErrInvalidUnsubscribeToken},
+		{name: "[Fail] no secret configured", unsub: Unsubscribe{}, token: Unsubscribe{}.Token("acc-1"), wantError: domainerr.ErrInvalidUnsubscribeToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.unsub.AccountID(tt.token)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("got %q, %v", got, err)
+			}
+		})
+	}
+
+	if got := u.URL("acc-1"); got != u.BaseURL+"?token="+token {
+		t.Fatalf("unexpected url: %s", got)
+	}
+}
@@ -0,0 +1,20 @@
+package errors
+
+// DeprecatedTemplateError reports that a deprecated template was used for a new note.
+// SuccessorID is the template to use instead; empty when none was named.
+type DeprecatedTemplateError struct {
+	TemplateID  string
+	SuccessorID string
+}
+
+func (e *DeprecatedTemplateError) Error() string {
+	if e.SuccessorID == "" {
+		return ErrTemplateDeprecated.Error()
+	}
+	return ErrTemplateDeprecated.Error() + "; use template " + e.SuccessorID + " instead"
+}
+
+// Unwrap returns ErrTemplateDeprecated so errors.Is matches it.
+func (e *DeprecatedTemplateError) Unwrap() error {
+	return ErrTemplateDeprecated
+}
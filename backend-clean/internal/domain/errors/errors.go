@@ -26,10 +26,114 @@ var (
 	ErrFieldOrderInvalid = errors.New("field order must be greater than zero and unique")
 	// ErrFieldLabelRequired indicates field label missing.
 	ErrFieldLabelRequired = errors.New("field label is required")
+	// ErrInvalidFieldType indicates unknown field type.
+	ErrInvalidFieldType = errors.New("invalid field type")
+	// ErrInvalidFieldOptions indicates field options inconsistent with its type.
+	ErrInvalidFieldOptions = errors.New("invalid field options")
+	// ErrInvalidFieldConstraints indicates field constraints are inconsistent or unusable.
+	ErrInvalidFieldConstraints = errors.New("invalid field constraints")
 	// ErrSectionsMissing indicates sections don't match template.
 	ErrSectionsMissing = errors.New("sections do not match template fields")
 	// ErrRequiredFieldEmpty indicates required field content missing.
 	ErrRequiredFieldEmpty = errors.New("required field content is empty")
+	// ErrInvalidSectionContent indicates section content doesn't match its field type.
+	ErrInvalidSectionContent = errors.New("section content does not match field type")
+	// ErrContentTooShort indicates section content is shorter than the field minimum length.
+	ErrContentTooShort = errors.New("section content is too short")
+	// ErrContentTooLong indicates section content exceeds the field maximum length.
+	ErrContentTooLong = errors.New("section content is too long")
+	// ErrContentPatternMismatch indicates section content doesn't match the field pattern.
+	ErrContentPatternMismatch = errors.New("section content does not match pattern")
+	// ErrAttachmentNameRequired indicates attachment file name missing.
+	ErrAttachmentNameRequired = errors.New("attachment file name is required")
+	// ErrAttachmentTooLarge indicates attachment is empty or exceeds the size limit.
+	ErrAttachmentTooLarge = errors.New("attachment size is out of range")
+	// ErrUnsupportedContentType indicates attachment content type is not allowed.
+	ErrUnsupportedContentType = errors.New("unsupported attachment content type")
+	// ErrImportInvalidArchive indicates the import archive cannot be read.
+	ErrImportInvalidArchive = errors.New("import archive is invalid")
+	// ErrImportTooLarge indicates the import has too many or too large files.
+	ErrImportTooLarge = errors.New("import exceeds size limits")
+	// ErrImportNoDocuments indicates the import contains no Markdown documents.
+	ErrImportNoDocuments = errors.New("import contains no markdown documents")
+	// ErrUnknownField indicates a field ID that does not belong to the template.
+	ErrUnknownField = errors.New("field does not belong to template")
+	// ErrInvalidFieldMapping indicates a field mapping with unknown or duplicate fields.
+	ErrInvalidFieldMapping = errors.New("invalid field mapping")
+	// ErrInvalidFieldRemovalPolicy indicates unknown field removal policy.
+	ErrInvalidFieldRemovalPolicy = errors.New("invalid field removal policy")
+	// ErrFieldHasContent indicates an unmapped field still holds note content under the reject policy.
+	ErrFieldHasContent = errors.New("unmapped field has note content")
+	// ErrNoteUpToDate indicates a note already uses the latest template version.
+	ErrNoteUpToDate = errors.New("note already uses the latest template version")
+	// ErrNoteSameTemplate indicates a note re-templated onto the template it already uses.
+	ErrNoteSameTemplate = errors.New("note already uses this template")
+	// ErrCSVInvalid indicates the CSV document cannot be parsed.
+	ErrCSVInvalid = errors.New("csv is invalid")
+	// ErrCSVHeaderInvalid indicates missing, unknown or duplicate CSV columns.
+	ErrCSVHeaderInvalid = errors.New("csv header is invalid")
+	// ErrCSVColumnCount indicates a CSV row whose column count differs from the header.
+	ErrCSVColumnCount = errors.New("csv row has wrong number of columns")
+	// ErrTemplateMismatch indicates a note belongs to another template.
+	ErrTemplateMismatch = errors.New("note belongs to another template")
+	// ErrTemplateVersionMismatch indicates a note written against another version of its template.
+	ErrTemplateVersionMismatch = errors.New("note uses another template version")
+	// ErrInvalidVisibility indicates unknown template visibility.
+	ErrInvalidVisibility = errors.New("invalid template visibility")
+	// ErrTemplateUsedByOthers indicates a template other accounts' notes use cannot be made private.
+	ErrTemplateUsedByOthers = errors.New("template is used by other accounts' notes")
+	// ErrTemplateDeprecated indicates a deprecated template cannot be used for new notes.
+	ErrTemplateDeprecated = errors.New("template is deprecated")
+	// ErrInvalidSuccessor indicates a successor that is the template itself or deprecated.
+	ErrInvalidSuccessor = errors.New("successor must be another template that is not deprecated")
+	// ErrBundleInvalid indicates the template bundle cannot be parsed.
+	ErrBundleInvalid = errors.New("template bundle is invalid")
+	// ErrBundleEmpty indicates a bundle without templates.
+	ErrBundleEmpty = errors.New("template bundle has no templates")
+	// ErrBundleTooLarge indicates a bundle exceeding the size or template limit.
+	ErrBundleTooLarge = errors.New("template bundle exceeds size limits")
+	// ErrInvalidBundleFormat indicates unknown bundle format.
+	ErrInvalidBundleFormat = errors.New("invalid template bundle format")
+	// ErrInvalidConflictMode indicates unknown bundle conflict mode.
+	ErrInvalidConflictMode = errors.New("invalid conflict mode")
+	// ErrBatchEmpty indicates a batch without note IDs.
+	ErrBatchEmpty = errors.New("batch requires at least one note id")
+	// ErrBatchTooLarge indicates a batch exceeding the item limit.
+	ErrBatchTooLarge = errors.New("batch has too many note ids")
+	// ErrInvalidBatchMode indicates unknown batch mode.
+	ErrInvalidBatchMode = errors.New("invalid batch mode")
+	// ErrBatchAborted indicates an item was rolled back because another item of an all-or-nothing batch failed.
+	ErrBatchAborted = errors.New("not applied because another item failed")
+	// ErrInvalidWebhookURL indicates a webhook target that is not an absolute http(s) URL.
+	ErrInvalidWebhookURL = errors.New("webhook url must be an absolute http or https url")
+	// ErrWebhookTargetNotAllowed indicates a webhook target on a loopback, private or otherwise internal address.
+	ErrWebhookTargetNotAllowed = errors.New("webhook url must point to a public address")
+	// ErrWebhookSecretTooShort indicates a webhook secret too short to sign deliveries.
+	ErrWebhookSecretTooShort = errors.New("webhook secret must be at least 16 characters")
+	// ErrInvalidWebhookEvent indicates an event a webhook cannot subscribe to.
+	ErrInvalidWebhookEvent = errors.New("invalid webhook event")
+	// ErrSectionLocked indicates a section another editor holds the lock for.
+	ErrSectionLocked = errors.New("section is locked by another editor")
+	// ErrSectionNotLocked indicates an edit to a section without holding its lock.
+	ErrSectionNotLocked = errors.New("section lock is not held")
+	// ErrInvalidCollabCommand indicates an unknown or malformed collaboration message.
+	ErrInvalidCollabCommand = errors.New("invalid collaboration command")
+	// ErrConsumerTooSlow indicates a stream consumer fell too far behind and was disconnected.
+	ErrConsumerTooSlow = errors.New("stream consumer fell too far behind")
+	// ErrResyncRequired indicates a stream ended because changes may have been lost; the consumer takes a new snapshot.
+	ErrResyncRequired = errors.New("stream ended and may have missed changes; resync required")
+	// ErrInvalidNotificationKind indicates an unknown notification kind.
+	ErrInvalidNotificationKind = errors.New("invalid notification kind")
+	// ErrCannotFollowSelf indicates an account trying to follow itself.
+	ErrCannotFollowSelf = errors.New("an account cannot follow itself")
+	// ErrInvalidCursor indicates a pagination cursor that was not returned by a previous page.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidPageSize indicates a page size outside the allowed range.
+	ErrInvalidPageSize = errors.New("invalid page size")
+	// ErrInvalidDigestFrequency indicates an unknown digest frequency.
+	ErrInvalidDigestFrequency = errors.New("invalid digest frequency")
+	// ErrInvalidUnsubscribeToken indicates an unsubscribe link that was not signed by this server.
+	ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
 	// ErrProviderRequired indicates provider missing.
 	ErrProviderRequired = errors.New("provider is required")
 	// ErrProviderAccountRequired indicates provider account id missing.
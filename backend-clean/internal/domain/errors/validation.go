@@ -0,0 +1,41 @@
+package errors
+
+import "strings"
+
+// FieldError describes a validation failure of a single field.
+type FieldError struct {
+	FieldID string
+	Label   string
+	Err     error
+}
+
+func (e FieldError) Error() string {
+	return e.Label + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying domain error.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError aggregates per-field validation failures.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the per-field errors so errors.Is matches their causes.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		errs = append(errs, f)
+	}
+	return errs
+}
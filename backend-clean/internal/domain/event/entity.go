@@ -0,0 +1,38 @@
+// Package event holds domain events raised by changes to aggregates.
+package event
+
+import "time"
+
+// Name identifies the kind of a domain event.
+type Name string
+
+// Event names.
+const (
+	NoteCreated       Name = "note.created"
+	NoteUpdated       Name = "note.updated"
+	NotePublished     Name = "note.published"
+	NoteUnpublished   Name = "note.unpublished"
+	NoteDeleted       Name = "note.deleted"
+	TemplateChanged   Name = "template.changed"
+	AccountRegistered Name = "account.registered"
+)
+
+// Event is a change to an aggregate, recorded in the outbox together with the change itself.
+type Event struct {
+	ID          string
+	Name        Name
+	AggregateID string
+	// ActorID is the account whose action raised the event.
+	ActorID string
+	// Data holds the attributes handlers need without loading the aggregate again.
+	Data       map[string]string
+	OccurredAt time.Time
+}
+
+// Delivery is an outbox event claimed by the dispatcher.
+type Delivery struct {
+	Event Event
+	// Attempts counts the delivery attempts including the current one.
+	Attempts  int
+	LastError string
+}
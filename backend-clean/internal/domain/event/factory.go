@@ -0,0 +1,104 @@
+package event
+
+import (
+	"strconv"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// TemplateChange tells what happened to a template in a TemplateChanged event.
+type TemplateChange string
+
+// Template change constants.
+const (
+	TemplateCreated      TemplateChange = "created"
+	TemplateUpdated      TemplateChange = "updated"
+	TemplateDeprecated   TemplateChange = "deprecated"
+	TemplateUndeprecated TemplateChange = "undeprecated"
+	TemplateDeleted      TemplateChange = "deleted"
+)
+
+// NewNoteCreated records a new draft note; its owner is the actor.
+func NewNoteCreated(n note.Note) Event {
+	return noteEvent(NoteCreated, n, n.OwnerID)
+}
+
+// NewNoteUpdated records a change to the title, sections, template or owner of a note.
+func NewNoteUpdated(n note.Note, actorID string) Event {
+	return noteEvent(NoteUpdated, n, actorID)
+}
+
+// NewNoteTransferred records a note handed over from previousOwnerID to its current owner.
+func NewNoteTransferred(n note.Note, previousOwnerID, actorID string) Event {
+	e := noteEvent(NoteUpdated, n, actorID)
+	e.Data["previousOwnerId"] = previousOwnerID
+	return e
+}
+
+// NewNoteRetemplated records a note moved from previousTemplateID to its current template.
+func NewNoteRetemplated(n note.Note, previousTemplateID, actorID string) Event {
+	e := noteEvent(NoteUpdated, n, actorID)
+	e.Data["previousTemplateId"] = previousTemplateID
+	return e
+}
+
+// NewNoteStatusChanged records a note entering its current status.
+func NewNoteStatusChanged(n note.Note, actorID string) Event {
+	if n.Status == note.StatusPublish {
+		return noteEvent(NotePublished, n, actorID)
+	}
+	return noteEvent(NoteUnpublished, n, actorID)
+}
+
+// NewNoteDeleted records the deletion of a note as it was before being deleted.
+func NewNoteDeleted(n note.Note, actorID string) Event {
+	return noteEvent(NoteDeleted, n, actorID)
+}
+
+// NewTemplateChanged records a change to a template as it is after the change.
+func NewTemplateChanged(t template.Template, actorID string, change TemplateChange) Event {
+	return Event{
+		Name:        TemplateChanged,
+		AggregateID: t.ID,
+		ActorID:     actorID,
+		Data: map[string]string{
+			"change":     string(change),
+			"name":       t.Name,
+			"ownerId":    t.OwnerID,
+			"version":    strconv.Itoa(t.Version),
+			"visibility": string(t.Visibility),
+		},
+	}
+}
+
+// NewAccountRegistered records the first sign-in of an account.
+func NewAccountRegistered(a account.Account) Event {
+	return Event{
+		Name:        AccountRegistered,
+		AggregateID: a.ID,
+		ActorID:     a.ID,
+		Data: map[string]string{
+			"email":     a.Email.String(),
+			"firstName": a.FirstName,
+			"lastName":  a.LastName,
+			"provider":  a.Provider,
+		},
+	}
+}
+
+func noteEvent(name Name, n note.Note, actorID string) Event {
+	return Event{
+		Name:        name,
+		AggregateID: n.ID,
+		ActorID:     actorID,
+		Data: map[string]string{
+			"title":           n.Title,
+			"ownerId":         n.OwnerID,
+			"status":          string(n.Status),
+			"templateId":      n.TemplateID,
+			"templateVersion": strconv.Itoa(n.TemplateVersion),
+		},
+	}
+}
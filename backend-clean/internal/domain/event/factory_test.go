@@ -0,0 +1,60 @@
+package event
+
+import (
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestNoteEvents(t *testing.T) {
+	n := note.Note{ID: "n1", Title: "ADR", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 2, Status: note.StatusDraft}
+	published := n
+	published.Status = note.StatusPublish
+	tests := []struct {
+		name      string
+		event     Event
+		wantName  Name
+		wantActor string
+	}{
+		{name: "[Success] created", event: NewNoteCreated(n), wantName: NoteCreated, wantActor: "owner-1"},
+		{name: "[Success] updated", event: NewNoteUpdated(n, "owner-1"), wantName: NoteUpdated, wantActor: "owner-1"},
+		{name: "[Success] transferred", event: NewNoteTransferred(n, "owner-0", "owner-0"), wantName: NoteUpdated, wantActor: "owner-0"},
+		{name: "[Success] retemplated", event: NewNoteRetemplated(n, "tpl-0", "owner-1"), wantName: NoteUpdated, wantActor: "owner-1"},
+		{name: "[Success] published", event: NewNoteStatusChanged(published, "owner-1"), wantName: NotePublished, wantActor: "owner-1"},
+		{name: "[Success] unpublished", event: NewNoteStatusChanged(n, "owner-1"), wantName: NoteUnpublished, wantActor: "owner-1"},
+		{name: "[Success] deleted", event: NewNoteDeleted(n, "admin"), wantName: NoteDeleted, wantActor: "admin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.event.Name != tt.wantName || tt.event.AggregateID != "n1" || tt.event.ActorID != tt.wantActor {
+				t.Fatalf("unexpected event: %+v", tt.event)
+			}
+			if tt.event.Data["templateId"] != "tpl-1" || tt.event.Data["templateVersion"] != "2" || tt.event.Data["title"] != "ADR" {
+				t.Fatalf("unexpected data: %+v", tt.event.Data)
+			}
+		})
+	}
+}
+
+func TestNewTemplateChanged(t *testing.T) {
+	e := NewTemplateChanged(template.Template{ID: "tpl-1", Name: "ADR", OwnerID: "owner-1", Version: 3, Visibility: template.VisibilityPublic}, "owner-1", TemplateUpdated)
+	if e.Name != TemplateChanged || e.AggregateID != "tpl-1" || e.ActorID != "owner-1" {
+		t.Fatalf("unexpected event: %+v", e)
+	}
+	if e.Data["change"] != "updated" || e.Data["version"] != "3" || e.Data["visibility"] != "public" {
+		t.Fatalf("unexpected data: %+v", e.Data)
+	}
+}
+
+func TestNewAccountRegistered(t *testing.T) {
+	email, err := account.ParseEmail("taro@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e := NewAccountRegistered(account.Account{ID: "acc-1", Email: email, FirstName: "Taro", Provider: "google"})
+	if e.Name != AccountRegistered || e.AggregateID != "acc-1" || e.ActorID != "acc-1" || e.Data["email"] != "taro@example.com" {
+		t.Fatalf("unexpected event: %+v", e)
+	}
+}
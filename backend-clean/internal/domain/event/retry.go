@@ -0,0 +1,30 @@
+package event
+
+import "time"
+
+// RetryPolicy decides when a failed delivery is tried again and when it becomes a dead letter.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy retries for roughly an hour before giving up on an event.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 10,
+	BaseDelay:   5 * time.Second,
+	MaxDelay:    15 * time.Minute,
+}
+
+// Next returns the delay before the next attempt after attempts failed ones, doubling from
+// BaseDelay up to MaxDelay. dead is true once MaxAttempts is reached.
+func (p RetryPolicy) Next(attempts int) (delay time.Duration, dead bool) {
+	if attempts >= p.MaxAttempts {
+		return 0, true
+	}
+	delay = p.BaseDelay
+	for i := 1; i < attempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay), false
+}
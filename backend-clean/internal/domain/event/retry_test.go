@@ -0,0 +1,29 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Next(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	tests := []struct {
+		name      string
+		attempts  int
+		wantDelay time.Duration
+		wantDead  bool
+	}{
+		{name: "[Success] first failure waits the base delay", attempts: 1, wantDelay: time.Second},
+		{name: "[Success] delay doubles", attempts: 3, wantDelay: 4 * time.Second},
+		{name: "[Success] delay is capped", attempts: 4, wantDelay: 5 * time.Second},
+		{name: "[Fail] dead after max attempts", attempts: 5, wantDead: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, dead := p.Next(tt.attempts)
+			if delay != tt.wantDelay || dead != tt.wantDead {
+				t.Fatalf("Next(%d) = %v, %v; want %v, %v", tt.attempts, delay, dead, tt.wantDelay, tt.wantDead)
+			}
+		})
+	}
+}
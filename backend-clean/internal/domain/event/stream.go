@@ -0,0 +1,89 @@
+package event
+
+import (
+	"maps"
+	"slices"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteEvents lists the events streamed to live note views.
+var NoteEvents = []Name{NoteCreated, NoteUpdated, NotePublished, NoteUnpublished, NoteDeleted}
+
+// Envelope is an event as published to live subscribers. ID orders envelopes within one stream
+// and is what clients send back to resume.
+type Envelope struct {
+	ID    string
+	Event Event
+}
+
+// StreamFilter selects the note events a viewer receives. Empty fields do not filter.
+type StreamFilter struct {
+	ViewerID   string
+	OwnerID    string
+	TemplateID string
+}
+
+// Matches reports whether a note event passes the filter and is visible to the viewer.
+// Draft notes are only visible to their owner, but everyone who could see a note learns that it
+// was unpublished so it disappears from their view.
+func (f StreamFilter) Matches(e Event) bool {
+	if !slices.Contains(NoteEvents, e.Name) {
+		return false
+	}
+	ownerID := e.Data["ownerId"]
+	if f.OwnerID != "" && ownerID != f.OwnerID {
+		return false
+	}
+	if f.TemplateID != "" && e.Data["templateId"] != f.TemplateID {
+		return false
+	}
+	if e.Name == NoteUnpublished || e.Data["status"] == string(note.StatusPublish) {
+		return true
+	}
+	return f.ViewerID != "" && ownerID == f.ViewerID
+}
+
+// WatchFilter selects the note events an internal watcher receives regardless of visibility.
+// Empty fields do not filter. Publishing and unpublishing always pass a status filter, since
+// the note enters or leaves the watched status either way.
+type WatchFilter struct {
+	OwnerID    string
+	TemplateID string
+	Status     note.NoteStatus
+}
+
+// Matches reports whether a note event passes the filter.
+func (f WatchFilter) Matches(e Event) bool {
+	if !slices.Contains(NoteEvents, e.Name) {
+		return false
+	}
+	if f.OwnerID != "" && e.Data["ownerId"] != f.OwnerID {
+		return false
+	}
+	if f.TemplateID != "" && e.Data["templateId"] != f.TemplateID {
+		return false
+	}
+	if f.Status == "" || e.Name == NotePublished || e.Name == NoteUnpublished {
+		return true
+	}
+	return e.Data["status"] == string(f.Status)
+}
+
+// Left reports whether a note event moved the note out of the filter: the note passed the filter
+// with the owner or template it had before, which transfers and retemplates record, but no longer does.
+func (f WatchFilter) Left(e Event) bool {
+	prevOwner, prevTemplate := e.Data["previousOwnerId"], e.Data["previousTemplateId"]
+	if (prevOwner == "" && prevTemplate == "") || f.Matches(e) {
+		return false
+	}
+	before := e
+	before.Data = maps.Clone(e.Data)
+	if prevOwner != "" {
+		before.Data["ownerId"] = prevOwner
+	}
+	if prevTemplate != "" {
+		before.Data["templateId"] = prevTemplate
+	}
+	return f.Matches(before)
+}
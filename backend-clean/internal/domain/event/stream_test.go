@@ -0,0 +1,90 @@
+package event
+
+import (
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestStreamFilter_Matches(t *testing.T) {
+	draft := note.Note{ID: "n1", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusDraft}
+	published := draft
+	published.Status = note.StatusPublish
+	tests := []struct {
+		name   string
+		filter StreamFilter
+		event  Event
+		want   bool
+	}{
+		{name: "[Success] published note visible to anyone", filter: StreamFilter{}, event: NewNoteUpdated(published, "owner-1"), want: true},
+		{name: "[Success] draft visible to owner", filter: StreamFilter{ViewerID: "owner-1"}, event: NewNoteCreated(draft), want: true},
+		{name: "[Success] unpublish visible to anyone", filter: StreamFilter{ViewerID: "other"}, event: NewNoteStatusChanged(draft, "owner-1"), want: true},
+		{name: "[Success] deleted published note visible to anyone", filter: StreamFilter{}, event: NewNoteDeleted(published, "owner-1"), want: true},
+		{name: "[Success] owner and template filters", filter: StreamFilter{OwnerID: "owner-1", TemplateID: "tpl-1"}, event: NewNoteUpdated(published, "owner-1"), want: true},
+		{name: "[Fail] draft hidden from others", filter: StreamFilter{ViewerID: "other"}, event: NewNoteCreated(draft), want: false},
+		{name: "[Fail] draft hidden from anonymous", filter: StreamFilter{}, event: NewNoteUpdated(draft, "owner-1"), want: false},
+		{name: "[Fail] owner filter", filter: StreamFilter{OwnerID: "owner-2"}, event: NewNoteUpdated(published, "owner-1"), want: false},
+		{name: "[Fail] template filter", filter: StreamFilter{TemplateID: "tpl-2"}, event: NewNoteUpdated(published, "owner-1"), want: false},
+		{name: "[Fail] not a note event", filter: StreamFilter{ViewerID: "owner-1"}, event: NewTemplateChanged(template.Template{OwnerID: "owner-1"}, "owner-1", TemplateCreated), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.event); got != tt.want {
+				t.Fatalf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchFilter_Matches(t *testing.T) {
+	draft := note.Note{ID: "n1", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusDraft}
+	published := draft
+	published.Status = note.StatusPublish
+	tests := []struct {
+		name   string
+		filter WatchFilter
+		event  Event
+		want   bool
+	}{
+		{name: "[Success] drafts pass without a viewer", filter: WatchFilter{}, event: NewNoteCreated(draft), want: true},
+		{name: "[Success] all filters", filter: WatchFilter{OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusDraft}, event: NewNoteUpdated(draft, "owner-1"), want: true},
+		{name: "[Success] unpublish passes a publish filter", filter: WatchFilter{Status: note.StatusPublish}, event: NewNoteStatusChanged(draft, "owner-1"), want: true},
+		{name: "[Success] publish passes a draft filter", filter: WatchFilter{Status: note.StatusDraft}, event: NewNoteStatusChanged(published, "owner-1"), want: true},
+		{name: "[Success] deleted note in watched status", filter: WatchFilter{Status: note.StatusPublish}, event: NewNoteDeleted(published, "owner-1"), want: true},
+		{name: "[Fail] status filter", filter: WatchFilter{Status: note.StatusPublish}, event: NewNoteUpdated(draft, "owner-1"), want: false},
+		{name: "[Fail] owner filter", filter: WatchFilter{OwnerID: "owner-2"}, event: NewNoteUpdated(published, "owner-1"), want: false},
+		{name: "[Fail] template filter", filter: WatchFilter{TemplateID: "tpl-2"}, event: NewNoteStatusChanged(published, "owner-1"), want: false},
+		{name: "[Fail] not a note event", filter: WatchFilter{}, event: NewTemplateChanged(template.Template{OwnerID: "owner-1"}, "owner-1", TemplateCreated), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.event); got != tt.want {
+				t.Fatalf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchFilter_Left(t *testing.T) {
+	n := note.Note{ID: "n1", OwnerID: "owner-2", TemplateID: "tpl-2", Status: note.StatusDraft}
+	tests := []struct {
+		name   string
+		filter WatchFilter
+		event  Event
+		want   bool
+	}{
+		{name: "[Success] transferred away from the watched owner", filter: WatchFilter{OwnerID: "owner-1"}, event: NewNoteTransferred(n, "owner-1", "owner-1"), want: true},
+		{name: "[Success] retemplated away from the watched template", filter: WatchFilter{TemplateID: "tpl-1"}, event: NewNoteRetemplated(n, "tpl-1", "owner-2"), want: true},
+		{name: "[Fail] still matches", filter: WatchFilter{TemplateID: "tpl-2"}, event: NewNoteTransferred(n, "owner-1", "owner-1"), want: false},
+		{name: "[Fail] did not match before either", filter: WatchFilter{OwnerID: "owner-3"}, event: NewNoteTransferred(n, "owner-1", "owner-1"), want: false},
+		{name: "[Fail] plain update", filter: WatchFilter{OwnerID: "owner-1"}, event: NewNoteUpdated(n, "owner-2"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Left(tt.event); got != tt.want {
+				t.Fatalf("Left() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Package feed holds the activity feed of the authors an account follows.
+package feed
+
+import "time"
+
+// Kind tells what an author did to the subject of a feed item.
+type Kind string
+
+// Kind constants.
+const (
+	// KindNotePublished: the author published a note.
+	KindNotePublished Kind = "note.published"
+	// KindNoteUpdated: the author updated a published note.
+	KindNoteUpdated Kind = "note.updated"
+	// KindTemplateCreated: the author created a public template.
+	KindTemplateCreated Kind = "template.created"
+)
+
+// Page size limits.
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 50
+)
+
+// Item is the latest activity on a note or template; each subject appears in the feed once.
+type Item struct {
+	SubjectID string
+	Kind      Kind
+	AuthorID  string
+	Title     string
+	// TemplateID is the template a note was written with; empty for templates.
+	TemplateID string
+	OccurredAt time.Time
+	Author     Author
+}
+
+// Author is the account whose activity a feed item shows.
+type Author struct {
+	FirstName string
+	LastName  string
+	Thumbnail string
+}
+
+// Cursor points at the last item of a page; the next page starts right after it.
+type Cursor struct {
+	OccurredAt time.Time
+	SubjectID  string
+}
+
+// Page is a slice of the feed, newest first. NextCursor is empty on the last page.
+type Page struct {
+	Items      []Item
+	NextCursor string
+}
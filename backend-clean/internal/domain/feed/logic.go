@@ -0,0 +1,110 @@
+package feed
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// Change tells how an event changes the feed.
+type Change int
+
+// Change constants.
+const (
+	// ChangeNone leaves the feed as it is.
+	ChangeNone Change = iota
+	// ChangeRecord records the item as the latest activity on its subject.
+	ChangeRecord
+	// ChangeRemove removes the subject from the feed because others can no longer see it.
+	ChangeRemove
+)
+
+// FromEvent tells how an event changes the feed. Only activity others can see is recorded:
+// published notes and public templates. Items belong to the owner, not the actor, so a
+// transferred note moves to its new owner's followers.
+func FromEvent(e event.Event) (Item, Change) {
+	item := Item{
+		SubjectID:  e.AggregateID,
+		AuthorID:   e.Data["ownerId"],
+		OccurredAt: e.OccurredAt,
+	}
+	switch e.Name {
+	case event.NotePublished, event.NoteUpdated:
+		if e.Data["status"] != string(note.StatusPublish) {
+			return item, ChangeNone
+		}
+		item.Kind = KindNoteUpdated
+		if e.Name == event.NotePublished {
+			item.Kind = KindNotePublished
+		}
+		item.Title = e.Data["title"]
+		item.TemplateID = e.Data["templateId"]
+		return item, ChangeRecord
+	case event.NoteUnpublished, event.NoteDeleted:
+		return item, ChangeRemove
+	case event.TemplateChanged:
+		public := e.Data["visibility"] == string(template.VisibilityPublic)
+		switch {
+		case e.Data["change"] == string(event.TemplateDeleted) || !public:
+			return item, ChangeRemove
+		case e.Data["change"] == string(event.TemplateCreated):
+			item.Kind = KindTemplateCreated
+			item.Title = e.Data["name"]
+			return item, ChangeRecord
+		}
+	}
+	return item, ChangeNone
+}
+
+// PageSize returns the requested page size, or the default when none was requested.
+func PageSize(requested int) (int, error) {
+	if requested == 0 {
+		return DefaultPageSize, nil
+	}
+	if requested < 0 || requested > MaxPageSize {
+		return 0, domainerr.ErrInvalidPageSize
+	}
+	return requested, nil
+}
+
+// NewPage builds a page from up to size+1 items; the extra item only tells that more follow.
+func NewPage(items []Item, size int) Page {
+	if len(items) <= size {
+		return Page{Items: items}
+	}
+	items = items[:size]
+	last := items[len(items)-1]
+	return Page{Items: items, NextCursor: Cursor{OccurredAt: last.OccurredAt, SubjectID: last.SubjectID}.Encode()}
+}
+
+// Encode returns the opaque form of the cursor handed to clients.
+func (c Cursor) Encode() string {
+	raw := strconv.FormatInt(c.OccurredAt.UnixNano(), 10) + ":" + c.SubjectID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a cursor returned with a previous page; an empty string starts at the newest item.
+func ParseCursor(s string) (*Cursor, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	nanos, subjectID, ok := strings.Cut(string(raw), ":")
+	if !ok || subjectID == "" {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	return &Cursor{OccurredAt: time.Unix(0, n).UTC(), SubjectID: subjectID}, nil
+}
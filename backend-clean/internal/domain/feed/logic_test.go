@@ -0,0 +1,144 @@
+package feed
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestFromEvent(t *testing.T) {
+	published := note.Note{ID: "note-1", Title: "ADR", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusPublish}
+	draft := published
+	draft.Status = note.StatusDraft
+	public := template.Template{ID: "tpl-1", Name: "ADR template", OwnerID: "owner-1", Visibility: template.VisibilityPublic}
+	private := public
+	private.Visibility = template.VisibilityPrivate
+	tests := []struct {
+		name       string
+		event      event.Event
+		wantChange Change
+		wantKind   Kind
+		wantTitle  string
+	}{
+		{name: "[Success] published note", event: event.NewNoteStatusChanged(published, "owner-1"), wantChange: ChangeRecord, wantKind: KindNotePublished, wantTitle: "ADR"},
+		{name: "[Success] updated published note", event: event.NewNoteUpdated(published, "owner-1"), wantChange: ChangeRecord, wantKind: KindNoteUpdated, wantTitle: "ADR"},
+		{name: "[Success] updated draft is private", event: event.NewNoteUpdated(draft, "owner-1"), wantChange: ChangeNone},
+		{name: "[Success] new draft is private", event: event.NewNoteCreated(draft), wantChange: ChangeNone},
+		{name: "[Success] unpublished note is removed", event: event.NewNoteStatusChanged(draft, "owner-1"), wantChange: ChangeRemove},
+		{name: "[Success] deleted note is removed", event: event.NewNoteDeleted(published, "owner-1"), wantChange: ChangeRemove},
+		{name: "[Success] created public template", event: event.NewTemplateChanged(public, "owner-1", event.TemplateCreated), wantChange: ChangeRecord, wantKind: KindTemplateCreated, wantTitle: "ADR template"},
+		{name: "[Success] updated public template keeps its item", event: event.NewTemplateChanged(public, "owner-1", event.TemplateUpdated), wantChange: ChangeNone},
+		{name: "[Success] created private template is private", event: event.NewTemplateChanged(private, "owner-1", event.TemplateCreated), wantChange: ChangeRemove},
+		{name: "[Success] template made private is removed", event: event.NewTemplateChanged(private, "owner-1", event.TemplateUpdated), wantChange: ChangeRemove},
+		{name: "[Success] deleted template is removed", event: event.NewTemplateChanged(public, "owner-1", event.TemplateDeleted), wantChange: ChangeRemove},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, change := FromEvent(tt.event)
+			if change != tt.wantChange {
+				t.Fatalf("change = %v, want %v", change, tt.wantChange)
+			}
+			if change != ChangeRecord {
+				return
+			}
+			if item.Kind != tt.wantKind || item.Title != tt.wantTitle || item.AuthorID != "owner-1" || item.SubjectID != tt.event.AggregateID {
+				t.Fatalf("unexpected item: %+v", item)
+			}
+		})
+	}
+}
+
+func TestFromEvent_TransferredNoteBelongsToOwner(t *testing.T) {
+	n := note.Note{ID: "note-1", OwnerID: "new-owner", Status: note.StatusPublish}
+	item, change := FromEvent(event.NewNoteUpdated(n, "old-owner"))
+	if change != ChangeRecord || item.AuthorID != "new-owner" {
+		t.Fatalf("unexpected item %+v (%v)", item, change)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested int
+		want      int
+		wantError error
+	}{
+		{name: "[Success] default", requested: 0, want: DefaultPageSize},
+		{name: "[Success] requested", requested: 5, want: 5},
+		{name: "[Success] max", requested: MaxPageSize, want: MaxPageSize},
+		{name: "[Fail] too large", requested: MaxPageSize + 1, wantError: domainerr.ErrInvalidPageSize},
+		{name: "[Fail] negative", requested: -1, wantError: domainerr.ErrInvalidPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PageSize(tt.requested)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if got != tt.want {
+				t.Fatalf("size = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 123456000, time.UTC)
+	items := []Item{
+		{SubjectID: "a", OccurredAt: now},
+		{SubjectID: "b", OccurredAt: now.Add(-time.Minute)},
+		{SubjectID: "c", OccurredAt: now.Add(-2 * time.Minute)},
+	}
+
+	last := NewPage(items, 3)
+	if len(last.Items) != 3 || last.NextCursor != "" {
+		t.Fatalf("unexpected last page: %+v", last)
+	}
+
+	page := NewPage(items, 2)
+	if len(page.Items) != 2 || page.NextCursor == "" {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	cursor, err := ParseCursor(page.NextCursor)
+	if err != nil {
+		t.Fatalf("parse cursor: %v", err)
+	}
+	if cursor.SubjectID != "b" || !cursor.OccurredAt.Equal(items[1].OccurredAt) {
+		t.Fatalf("unexpected cursor: %+v", cursor)
+	}
+}
+
+func TestParseCursor(t *testing.T) {
+	valid := Cursor{OccurredAt: time.Now(), SubjectID: "note-1"}.Encode()
+	tests := []struct {
+		name      string
+		raw       string
+		wantNil   bool
+		wantError error
+	}{
+		{name: "[Success] valid cursor", raw: valid},
+		{name: "[Success] empty starts at newest", raw: "", wantNil: true},
+		{name: "[Fail] not base64", raw: "%%%", wantNil: true, wantError: domainerr.ErrInvalidCursor},
+		{name: "[Fail] missing subject", raw: Cursor{OccurredAt: time.Now()}.Encode(), wantNil: true, wantError: domainerr.ErrInvalidCursor},
+		{name: "[Fail] bad time", raw: "eDpub3RlLTE", wantNil: true, wantError: domainerr.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCursor(tt.raw)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("cursor = %+v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package note
+
+import (
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// MaxBatchSize limits the number of notes in one batch operation.
+const MaxBatchSize = 100
+
+// BatchMode controls how failures of single items affect a batch.
+type BatchMode string
+
+// BatchMode constants.
+const (
+	// BatchAllOrNothing applies every item or none of them.
+	BatchAllOrNothing BatchMode = "all_or_nothing"
+	// BatchBestEffort applies every item that passes its own checks.
+	BatchBestEffort BatchMode = "best_effort"
+)
+
+// Validate checks if the batch mode is known.
+func (m BatchMode) Validate() error {
+	if m != BatchAllOrNothing && m != BatchBestEffort {
+		return domainerr.ErrInvalidBatchMode
+	}
+	return nil
+}
+
+// BatchItemResult reports the outcome for one note of a batch; Err is nil on success.
+type BatchItemResult struct {
+	ID  string
+	Err error
+}
+
+// NormalizeBatchIDs trims and de-duplicates note IDs, keeping their order.
+func NormalizeBatchIDs(ids []string) ([]string, error) {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	if len(out) == 0 {
+		return nil, domainerr.ErrBatchEmpty
+	}
+	if len(out) > MaxBatchSize {
+		return nil, domainerr.ErrBatchTooLarge
+	}
+	return out, nil
+}
+
+// CanTransferOwnership validates handing a note over to another account.
+func CanTransferOwnership(n Note, actorID, newOwnerID string) error {
+	if err := ValidateNoteOwnership(n.OwnerID, actorID); err != nil {
+		return err
+	}
+	if strings.TrimSpace(newOwnerID) == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package note
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestNormalizeBatchIDs(t *testing.T) {
+	tooMany := make([]string, 0, MaxBatchSize+1)
+	for i := 0; i <= MaxBatchSize; i++ {
+		tooMany = append(tooMany, strconv.Itoa(i))
+	}
+	tests := []struct {
+		name      string
+		ids       []string
+		want      []string
+		wantError error
+	}{
+		{name: "[Success] trims and de-duplicates", ids: []string{" n2", "n1", "n2", ""}, want: []string{"n2", "n1"}},
+		{name: "[Fail] empty", ids: []string{"", " "}, wantError: domainerr.ErrBatchEmpty},
+		{name: "[Fail] too many", ids: tooMany, wantError: domainerr.ErrBatchTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeBatchIDs(tt.ids)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanTransferOwnership(t *testing.T) {
+	n := Note{ID: "n1", OwnerID: "owner-1"}
+	tests := []struct {
+		name       string
+		actorID    string
+		newOwnerID string
+		wantError  error
+	}{
+		{name: "[Success] owner transfers", actorID: "owner-1", newOwnerID: "owner-2"},
+		{name: "[Fail] not owner", actorID: "other", newOwnerID: "owner-2", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] new owner missing", actorID: "owner-1", newOwnerID: " ", wantError: domainerr.ErrOwnerRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CanTransferOwnership(n, tt.actorID, tt.newOwnerID)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package note
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// MaxCSVRows limits the number of notes in one CSV import.
+const MaxCSVRows = 1000
+
+// Fixed CSV columns preceding the template field columns.
+const (
+	CSVColumnID        = "id"
+	CSVColumnTitle     = "title"
+	CSVColumnStatus    = "status"
+	CSVColumnVersion   = "template_version"
+	CSVColumnAuthor    = "author"
+	CSVColumnCreatedAt = "created_at"
+	CSVColumnUpdatedAt = "updated_at"
+)
+
+var csvFixedColumns = []string{CSVColumnID, CSVColumnTitle, CSVColumnStatus, CSVColumnVersion, CSVColumnAuthor, CSVColumnCreatedAt, CSVColumnUpdatedAt}
+
+// CSVRow is one parsed CSV data row.
+type CSVRow struct {
+	// Line is the 1-based line number of the row in the document.
+	Line int
+	// ID is empty for rows that create a new note.
+	ID    string
+	Title string
+	// Sections holds one section per field column present in the header.
+	Sections []Section
+	// Err is set when the row itself is malformed.
+	Err error
+}
+
+// CSVRowResult reports the outcome of importing one CSV row.
+type CSVRowResult struct {
+	Line    int
+	NoteID  string
+	Created bool
+	Err     error
+}
+
+// CSVHeader returns the export columns: fixed columns followed by field labels in field order.
+// Fields whose label clashes with a fixed column or another label are named by field ID instead.
+func CSVHeader(fields []template.Field) []string {
+	count := make(map[string]int, len(fields)+len(csvFixedColumns))
+	for _, c := range csvFixedColumns {
+		count[c]++
+	}
+	for _, f := range fields {
+		count[normalizeHeading(f.Label)]++
+	}
+	header := slices.Clone(csvFixedColumns)
+	for _, f := range sortedFields(fields) {
+		if count[normalizeHeading(f.Label)] > 1 {
+			header = append(header, f.ID)
+			continue
+		}
+		header = append(header, f.Label)
+	}
+	return header
+}
+
+// CSVRecord returns the export values of a note matching CSVHeader.
+// Section contents are written verbatim so an export can be imported again unchanged.
+func CSVRecord(fields []template.Field, n WithMeta) []string {
+	record := []string{
+		n.Note.ID,
+		n.Note.Title,
+		string(n.Note.Status),
+		strconv.Itoa(n.Note.TemplateVersion),
+		strings.TrimSpace(n.OwnerFirstName + " " + n.OwnerLastName),
+		n.Note.CreatedAt.UTC().Format(time.RFC3339),
+		n.Note.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	contents := make(map[string]string, len(n.Sections))
+	for _, s := range n.Sections {
+		contents[s.Section.FieldID] = s.Section.Content
+	}
+	for _, f := range sortedFields(fields) {
+		record = append(record, contents[f.ID])
+	}
+	return record
+}
+
+// AlignCSVSections re-keys the sections of a note written against an earlier template version onto
+// the exported fields by label, the way ParseCSV matches headings, since each version stores its
+// fields under new IDs. Sections whose label is missing from or ambiguous in the exported fields are
+// left out; the template version column tells such rows apart.
+func AlignCSVSections(n WithMeta, pinned, exported []template.Field) WithMeta {
+	byLabel := make(map[string]string, len(exported))
+	for _, f := range exported {
+		label := normalizeHeading(f.Label)
+		if _, dup := byLabel[label]; dup {
+			byLabel[label] = ""
+			continue
+		}
+		byLabel[label] = f.ID
+	}
+	targets := make(map[string]string, len(pinned))
+	for _, f := range pinned {
+		targets[f.ID] = byLabel[normalizeHeading(f.Label)]
+	}
+	sections := make([]SectionWithField, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		if id := targets[s.Section.FieldID]; id != "" {
+			s.Section.FieldID = id
+			sections = append(sections, s)
+		}
+	}
+	n.Sections = sections
+	return n
+}
+
+// ParseCSV reads a CSV document whose header names template fields by label or ID.
+// The title column is required; status, template version, author and date columns are read-only and ignored.
+// Missing field columns leave those fields out of each row's sections. Malformed rows are
+// returned with Err set so the remaining rows can still be imported.
+func ParseCSV(fields []template.Field, content []byte) ([]CSVRow, error) {
+	// spreadsheet applications often prepend a UTF-8 BOM
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\ufeff"))))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, domainerr.ErrCSVHeaderInvalid
+	}
+	if err != nil {
+		return nil, domainerr.ErrCSVInvalid
+	}
+	columns, err := mapCSVColumns(fields, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []CSVRow
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, domainerr.ErrCSVInvalid
+		}
+		if len(rows) == MaxCSVRows {
+			return nil, domainerr.ErrImportTooLarge
+		}
+		line, _ := r.FieldPos(0)
+		row := CSVRow{Line: line}
+		if len(record) != len(header) {
+			row.Err = domainerr.ErrCSVColumnCount
+			rows = append(rows, row)
+			continue
+		}
+		for i, value := range record {
+			switch col := columns[i]; col {
+			case CSVColumnID:
+				row.ID = strings.TrimSpace(value)
+			case CSVColumnTitle:
+				row.Title = value
+			case CSVColumnStatus, CSVColumnVersion, CSVColumnAuthor, CSVColumnCreatedAt, CSVColumnUpdatedAt:
+			default:
+				row.Sections = append(row.Sections, Section{FieldID: col, Content: value})
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// mapCSVColumns resolves each header cell to a fixed column name or a field ID.
+func mapCSVColumns(fields []template.Field, header []string) ([]string, error) {
+	// fixed columns and field IDs take precedence over labels, matching CSVHeader
+	byName := make(map[string]string, len(fields)*2+len(csvFixedColumns))
+	for _, f := range fields {
+		byName[normalizeHeading(f.Label)] = f.ID
+	}
+	for _, f := range fields {
+		byName[normalizeHeading(f.ID)] = f.ID
+	}
+	for _, c := range csvFixedColumns {
+		byName[c] = c
+	}
+	columns := make([]string, 0, len(header))
+	seen := make(map[string]bool, len(header))
+	for _, h := range header {
+		col, ok := byName[normalizeHeading(h)]
+		if !ok || seen[col] {
+			return nil, domainerr.ErrCSVHeaderInvalid
+		}
+		seen[col] = true
+		columns = append(columns, col)
+	}
+	if !seen[CSVColumnTitle] {
+		return nil, domainerr.ErrCSVHeaderInvalid
+	}
+	return columns, nil
+}
+
+func sortedFields(fields []template.Field) []template.Field {
+	sorted := slices.Clone(fields)
+	slices.SortStableFunc(sorted, func(a, b template.Field) int { return a.Order - b.Order })
+	return sorted
+}
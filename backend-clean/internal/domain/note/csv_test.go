@@ -0,0 +1,112 @@
+package note
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestCSVHeader(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f2", Label: "Decision", Order: 2},
+		{ID: "f1", Label: "Context", Order: 1},
+		{ID: "f3", Label: "Status", Order: 3},
+	}
+	want := []string{"id", "title", "status", "template_version", "author", "created_at", "updated_at", "Context", "Decision", "f3"}
+	if got := CSVHeader(fields); !slices.Equal(got, want) {
+		t.Fatalf("header = %v, want %v", got, want)
+	}
+}
+
+func TestCSVRecord(t *testing.T) {
+	fields := []template.Field{{ID: "f2", Label: "Decision", Order: 2}, {ID: "f1", Label: "Context", Order: 1}}
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	n := WithMeta{
+		Note:           Note{ID: "n1", Title: "ADR", Status: StatusPublish, TemplateVersion: 2, CreatedAt: at, UpdatedAt: at},
+		OwnerFirstName: "Ada",
+		OwnerLastName:  "Lovelace",
+		Sections: []SectionWithField{
+			{Section: Section{FieldID: "f2", Content: "go"}},
+			{Section: Section{FieldID: "f1", Content: "line1\nline2"}},
+		},
+	}
+	want := []string{"n1", "ADR", "Publish", "2", "Ada Lovelace", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z", "line1\nline2", "go"}
+	if got := CSVRecord(fields, n); !slices.Equal(got, want) {
+		t.Fatalf("record = %q, want %q", got, want)
+	}
+}
+
+func TestAlignCSVSections(t *testing.T) {
+	pinned := []template.Field{{ID: "v1-f1", Label: "Context"}, {ID: "v1-f2", Label: "Decision"}, {ID: "v1-f3", Label: "Notes"}}
+	exported := []template.Field{{ID: "v2-f1", Label: "context"}, {ID: "v2-f2", Label: "Outcome"}}
+	n := WithMeta{Sections: []SectionWithField{
+		{Section: Section{FieldID: "v1-f1", Content: "why"}},
+		{Section: Section{FieldID: "v1-f2", Content: "what"}},
+	}}
+	got := AlignCSVSections(n, pinned, exported)
+	if len(got.Sections) != 1 || got.Sections[0].Section.FieldID != "v2-f1" || got.Sections[0].Section.Content != "why" {
+		t.Fatalf("sections = %+v", got.Sections)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	fields := []template.Field{{ID: "f1", Label: "Context", Order: 1}, {ID: "f2", Label: "Decision", Order: 2}}
+	tests := []struct {
+		name     string
+		content  string
+		wantErr  error
+		wantRows int
+		check    func(t *testing.T, rows []CSVRow)
+	}{
+		{
+			name:     "[Success] create and update rows",
+			content:  "\ufeffid,title,status,Context,f2\n,New,draft,\"multi\nline\",x\nn1,Old,publish,c,d\n",
+			wantRows: 2,
+			check: func(t *testing.T, rows []CSVRow) {
+				if rows[0].ID != "" || rows[0].Title != "New" || rows[0].Line != 2 {
+					t.Fatalf("unexpected first row: %+v", rows[0])
+				}
+				if rows[0].Sections[0] != (Section{FieldID: "f1", Content: "multi\nline"}) || rows[0].Sections[1].FieldID != "f2" {
+					t.Fatalf("unexpected sections: %+v", rows[0].Sections)
+				}
+				if rows[1].ID != "n1" || rows[1].Line != 4 {
+					t.Fatalf("unexpected second row: %+v", rows[1])
+				}
+			},
+		},
+		{
+			name:     "[Success] short row reported",
+			content:  "title,Context\nA\nB,b\n",
+			wantRows: 2,
+			check: func(t *testing.T, rows []CSVRow) {
+				if !errors.Is(rows[0].Err, domainerr.ErrCSVColumnCount) || rows[1].Err != nil {
+					t.Fatalf("unexpected rows: %+v", rows)
+				}
+			},
+		},
+		{name: "[Fail] empty document", content: "", wantErr: domainerr.ErrCSVHeaderInvalid},
+		{name: "[Fail] title column missing", content: "id,Context\n", wantErr: domainerr.ErrCSVHeaderInvalid},
+		{name: "[Fail] unknown column", content: "title,Other\n", wantErr: domainerr.ErrCSVHeaderInvalid},
+		{name: "[Fail] duplicate column", content: "title,Context,f1\n", wantErr: domainerr.ErrCSVHeaderInvalid},
+		{name: "[Fail] broken quoting", content: "title\n\"open\n", wantErr: domainerr.ErrCSVInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseCSV(fields, []byte(tt.content))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if len(rows) != tt.wantRows {
+				t.Fatalf("rows = %d, want %d", len(rows), tt.wantRows)
+			}
+			if tt.check != nil {
+				tt.check(t, rows)
+			}
+		})
+	}
+}
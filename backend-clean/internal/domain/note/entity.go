@@ -18,11 +18,13 @@ type Note struct {
 	ID         string
 	Title      string
 	TemplateID string
-	OwnerID    string
-	Status     NoteStatus
-	Sections   []Section
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// TemplateVersion is the template version whose fields the sections were written against.
+	TemplateVersion int
+	OwnerID         string
+	Status          NoteStatus
+	Sections        []Section
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 // Section represents note content for a field.
@@ -1,9 +1,17 @@
 package note
 
 import (
+	"encoding/json"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/template"
-	"strings"
 )
 
 // Validate checks if status is valid.
@@ -28,7 +36,9 @@ func CanChangeStatus(from, to NoteStatus) error {
 	return domainerr.ErrInvalidStatusChange
 }
 
-// ValidateSections checks that sections match template fields and required fields are filled.
+// ValidateSections checks that sections match template fields, required fields are filled
+// and content is valid for each field type and its constraints.
+// Content violations of all fields are reported together as *errors.ValidationError.
 func ValidateSections(tplFields []template.Field, sections []Section) error {
 	if len(sections) == 0 {
 		return domainerr.ErrSectionsMissing
@@ -38,6 +48,7 @@ func ValidateSections(tplFields []template.Field, sections []Section) error {
 		lookup[f.ID] = f
 	}
 	seen := make(map[string]bool)
+	var violations []domainerr.FieldError
 	for _, s := range sections {
 		f, ok := lookup[s.FieldID]
 		if !ok {
@@ -48,13 +59,82 @@ func ValidateSections(tplFields []template.Field, sections []Section) error {
 		}
 		seen[s.FieldID] = true
 		if f.IsRequired && s.Content == "" {
-			return domainerr.ErrRequiredFieldEmpty
+			violations = append(violations, domainerr.FieldError{FieldID: f.ID, Label: f.Label, Err: domainerr.ErrRequiredFieldEmpty})
+			continue
+		}
+		if err := ValidateContent(f, s.Content); err != nil {
+			violations = append(violations, domainerr.FieldError{FieldID: f.ID, Label: f.Label, Err: err})
 		}
 	}
 	// ensure all template fields are covered
 	if len(seen) != len(lookup) {
 		return domainerr.ErrSectionsMissing
 	}
+	if len(violations) > 0 {
+		return &domainerr.ValidationError{Fields: violations}
+	}
+	return nil
+}
+
+// ValidateContent checks that content is valid for the field type and constraints.
+// Empty content is always accepted here; required checks are done by ValidateSections.
+func ValidateContent(f template.Field, content string) error {
+	if content == "" {
+		return nil
+	}
+	switch f.Type {
+	case template.FieldTypeURL:
+		u, err := url.ParseRequestURI(content)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return domainerr.ErrInvalidSectionContent
+		}
+	case template.FieldTypeNumber:
+		v, err := strconv.ParseFloat(strings.TrimSpace(content), 64)
+		// ParseFloat accepts "NaN" and "Inf", which are not numbers a note can hold.
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return domainerr.ErrInvalidSectionContent
+		}
+		if (f.Options.Min != nil && v < *f.Options.Min) || (f.Options.Max != nil && v > *f.Options.Max) {
+			return domainerr.ErrInvalidSectionContent
+		}
+	case template.FieldTypeDate:
+		if _, err := time.Parse(template.DateLayout, content); err != nil {
+			return domainerr.ErrInvalidSectionContent
+		}
+	case template.FieldTypeSelect:
+		if !slices.Contains(f.Options.Choices, content) {
+			return domainerr.ErrInvalidSectionContent
+		}
+	case template.FieldTypeChecklist:
+		// checklist content is a JSON array of checked choices
+		var checked []string
+		if err := json.Unmarshal([]byte(content), &checked); err != nil {
+			return domainerr.ErrInvalidSectionContent
+		}
+		for _, c := range checked {
+			if !slices.Contains(f.Options.Choices, c) {
+				return domainerr.ErrInvalidSectionContent
+			}
+		}
+	}
+	return validateConstraints(f, content)
+}
+
+func validateConstraints(f template.Field, content string) error {
+	length := utf8.RuneCountInString(content)
+	if f.MinLength != nil && length < *f.MinLength {
+		return domainerr.ErrContentTooShort
+	}
+	if f.MaxLength != nil && length > *f.MaxLength {
+		return domainerr.ErrContentTooLong
+	}
+	re, err := f.CompilePattern()
+	if err != nil {
+		return domainerr.ErrInvalidFieldConstraints
+	}
+	if re != nil && !re.MatchString(content) {
+		return domainerr.ErrContentPatternMismatch
+	}
 	return nil
 }
 
@@ -88,3 +168,15 @@ func ValidateNoteOwnership(noteOwnerID, actorID string) error {
 	}
 	return nil
 }
+
+// ValidateNoteVisibility ensures drafts are only visible to their owner.
+// Published notes are visible to everyone.
+func ValidateNoteVisibility(n Note, viewerID string) error {
+	if n.Status == StatusPublish {
+		return nil
+	}
+	if strings.TrimSpace(viewerID) == "" || n.OwnerID != viewerID {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
@@ -53,6 +53,32 @@ func TestValidateNoteOwnership(t *testing.T) {
 	}
 }
 
+func TestValidateNoteVisibility(t *testing.T) {
+	tests := []struct {
+		name      string
+		note      Note
+		viewerID  string
+		wantError error
+	}{
+		{name: "[Success] published note for anyone", note: Note{OwnerID: "owner-1", Status: StatusPublish}},
+		{name: "[Success] draft for owner", note: Note{OwnerID: "owner-1", Status: StatusDraft}, viewerID: "owner-1"},
+		{name: "[Fail] draft for other", note: Note{OwnerID: "owner-1", Status: StatusDraft}, viewerID: "other", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] draft for anonymous", note: Note{OwnerID: "owner-1", Status: StatusDraft}, wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNoteVisibility(tt.note, tt.viewerID)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestCanChangeStatus(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -140,6 +166,79 @@ func TestValidateSections(t *testing.T) {
 			t.Fatalf("expected ErrSectionsMissing, got %v", err)
 		}
 	})
+
+	t.Run("[Fail] reports every violating field", func(t *testing.T) {
+		minLen := 10
+		fields := []template.Field{
+			{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
+			{ID: "f2", Label: "Cause", Order: 2, MinLength: &minLen},
+			{ID: "f3", Label: "Ticket", Order: 3, Pattern: `PROJ-\d+`},
+		}
+		sections := []Section{
+			{FieldID: "f1", Content: ""},
+			{FieldID: "f2", Content: "short"},
+			{FieldID: "f3", Content: "PROJ-12"},
+		}
+		err := ValidateSections(fields, sections)
+		var verr *domainerr.ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("expected ValidationError, got %v", err)
+		}
+		if len(verr.Fields) != 2 || verr.Fields[0].FieldID != "f1" || verr.Fields[1].FieldID != "f2" {
+			t.Fatalf("unexpected field errors: %+v", verr.Fields)
+		}
+		if !errors.Is(err, domainerr.ErrRequiredFieldEmpty) || !errors.Is(err, domainerr.ErrContentTooShort) {
+			t.Fatalf("expected wrapped causes, got %v", err)
+		}
+	})
+}
+
+func TestValidateContent(t *testing.T) {
+	minScore, maxScore := 1.0, 5.0
+	choices := []string{"High", "Low"}
+	minLen, maxLen := 3, 5
+	tests := []struct {
+		name      string
+		field     template.Field
+		content   string
+		wantError error
+	}{
+		{name: "[Success] empty content skips type check", field: template.Field{Type: template.FieldTypeURL}, content: ""},
+		{name: "[Success] text", field: template.Field{Type: template.FieldTypeText}, content: "anything"},
+		{name: "[Success] url", field: template.Field{Type: template.FieldTypeURL}, content: "https://example.com/doc"},
+		{name: "[Fail] url without scheme", field: template.Field{Type: template.FieldTypeURL}, content: "example.com", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] number in range", field: template.Field{Type: template.FieldTypeNumber, Options: template.FieldOptions{Min: &minScore, Max: &maxScore}}, content: "3"},
+		{name: "[Fail] number out of range", field: template.Field{Type: template.FieldTypeNumber, Options: template.FieldOptions{Min: &minScore, Max: &maxScore}}, content: "6", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] not a number", field: template.Field{Type: template.FieldTypeNumber}, content: "three", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] NaN", field: template.Field{Type: template.FieldTypeNumber}, content: "NaN", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] infinity", field: template.Field{Type: template.FieldTypeNumber}, content: "Inf", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] signed infinity", field: template.Field{Type: template.FieldTypeNumber}, content: "+Infinity", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] overflow", field: template.Field{Type: template.FieldTypeNumber}, content: "1e400", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] date", field: template.Field{Type: template.FieldTypeDate}, content: "2025-02-09"},
+		{name: "[Fail] invalid date", field: template.Field{Type: template.FieldTypeDate}, content: "2025/02/09", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] select choice", field: template.Field{Type: template.FieldTypeSelect, Options: template.FieldOptions{Choices: choices}}, content: "High"},
+		{name: "[Fail] select unknown choice", field: template.Field{Type: template.FieldTypeSelect, Options: template.FieldOptions{Choices: choices}}, content: "Mid", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] checklist", field: template.Field{Type: template.FieldTypeChecklist, Options: template.FieldOptions{Choices: choices}}, content: `["High","Low"]`},
+		{name: "[Fail] checklist unknown item", field: template.Field{Type: template.FieldTypeChecklist, Options: template.FieldOptions{Choices: choices}}, content: `["Mid"]`, wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] checklist not json", field: template.Field{Type: template.FieldTypeChecklist, Options: template.FieldOptions{Choices: choices}}, content: "High", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] length counts characters", field: template.Field{Type: template.FieldTypeText, MaxLength: &maxLen}, content: "あいうえお"},
+		{name: "[Fail] too short", field: template.Field{Type: template.FieldTypeText, MinLength: &minLen}, content: "ab", wantError: domainerr.ErrContentTooShort},
+		{name: "[Fail] too long", field: template.Field{Type: template.FieldTypeMarkdown, MaxLength: &maxLen}, content: "abcdef", wantError: domainerr.ErrContentTooLong},
+		{name: "[Success] pattern", field: template.Field{Type: template.FieldTypeText, Pattern: `PROJ-\d+`}, content: "PROJ-42"},
+		{name: "[Fail] pattern must match whole content", field: template.Field{Type: template.FieldTypeText, Pattern: `PROJ-\d+`}, content: "see PROJ-42", wantError: domainerr.ErrContentPatternMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateContent(tt.field, tt.content)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
 }
 
 func TestValidateNoteForCreate(t *testing.T) {
@@ -0,0 +1,216 @@
+package note
+
+import (
+	"path"
+	"strings"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// Import limits guard against oversized uploads and archives.
+const (
+	MaxImportUploadSize = 20 << 20
+	MaxImportFiles      = 200
+	MaxImportFileSize   = 1 << 20
+)
+
+// MarkdownDocument is a Markdown file split on headings.
+type MarkdownDocument struct {
+	Title    string
+	Preamble string
+	Sections []MarkdownSection
+}
+
+// MarkdownSection is the body text under one heading.
+type MarkdownSection struct {
+	Heading string
+	Body    string
+}
+
+// HeadingMapping is the result of matching document headings to template fields.
+type HeadingMapping struct {
+	// Sections holds one section per template field; unmatched fields have empty content.
+	Sections          []Section
+	UnmatchedHeadings []string
+	MissingRequired   []string
+}
+
+// ImportFile is one Markdown document taken from an upload.
+type ImportFile struct {
+	Name    string
+	Content string
+}
+
+// ImportResult reports the outcome of importing one file.
+type ImportResult struct {
+	FileName          string
+	Title             string
+	NoteID            string
+	UnmatchedHeadings []string
+	MissingRequired   []string
+	Err               error
+}
+
+type markdownHeading struct {
+	line  int
+	level int
+	text  string
+}
+
+// ParseMarkdown splits a Markdown document on headings.
+// A leading level-1 heading followed by deeper headings becomes the title; otherwise the
+// title falls back to the file name. Sections split on the shallowest remaining heading
+// level, so deeper headings stay inside section bodies. Headings in fenced code blocks are ignored.
+func ParseMarkdown(fileName, content string) MarkdownDocument {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	headings := scanHeadings(lines)
+
+	doc := MarkdownDocument{}
+	if len(headings) > 0 && headings[0].level == 1 && strings.TrimSpace(strings.Join(lines[:headings[0].line], "")) == "" {
+		rest := headings[1:]
+		if len(rest) == 0 || minLevel(rest) > 1 {
+			doc.Title = headings[0].text
+			lines = lines[headings[0].line+1:]
+			for i := range rest {
+				rest[i].line -= headings[0].line + 1
+			}
+			headings = rest
+		}
+	}
+	if doc.Title == "" {
+		doc.Title = strings.TrimSuffix(path.Base(fileName), path.Ext(fileName))
+	}
+	if len(headings) == 0 {
+		doc.Preamble = trimBlock(strings.Join(lines, "\n"))
+		return doc
+	}
+
+	level := minLevel(headings)
+	var splits []markdownHeading
+	for _, h := range headings {
+		if h.level == level {
+			splits = append(splits, h)
+		}
+	}
+	doc.Preamble = trimBlock(strings.Join(lines[:splits[0].line], "\n"))
+	for i, h := range splits {
+		end := len(lines)
+		if i+1 < len(splits) {
+			end = splits[i+1].line
+		}
+		doc.Sections = append(doc.Sections, MarkdownSection{
+			Heading: h.text,
+			Body:    trimBlock(strings.Join(lines[h.line+1:end], "\n")),
+		})
+	}
+	return doc
+}
+
+// MapHeadings matches document headings to template fields by label or alias.
+// Matching ignores case, surrounding whitespace and a trailing colon. Aliases are keyed by
+// field label or field ID. Repeated headings for the same field are joined.
+func MapHeadings(fields []template.Field, doc MarkdownDocument, aliases map[string][]string) HeadingMapping {
+	byName := make(map[string]string)
+	for _, f := range fields {
+		byName[normalizeHeading(f.Label)] = f.ID
+	}
+	for _, f := range fields {
+		for _, key := range []string{f.ID, f.Label} {
+			for _, a := range aliases[key] {
+				if n := normalizeHeading(a); n != "" {
+					if _, exists := byName[n]; !exists {
+						byName[n] = f.ID
+					}
+				}
+			}
+		}
+	}
+
+	contents := make(map[string][]string)
+	mapping := HeadingMapping{}
+	if doc.Preamble != "" {
+		mapping.UnmatchedHeadings = append(mapping.UnmatchedHeadings, "(text before first heading)")
+	}
+	for _, s := range doc.Sections {
+		fieldID, ok := byName[normalizeHeading(s.Heading)]
+		if !ok {
+			mapping.UnmatchedHeadings = append(mapping.UnmatchedHeadings, s.Heading)
+			continue
+		}
+		if s.Body != "" {
+			contents[fieldID] = append(contents[fieldID], s.Body)
+		}
+	}
+	for _, f := range fields {
+		content := strings.Join(contents[f.ID], "\n\n")
+		if f.IsRequired && content == "" {
+			mapping.MissingRequired = append(mapping.MissingRequired, f.Label)
+		}
+		mapping.Sections = append(mapping.Sections, Section{FieldID: f.ID, Content: content})
+	}
+	return mapping
+}
+
+func scanHeadings(lines []string) []markdownHeading {
+	var headings []markdownHeading
+	fence := ""
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if h, ok := parseHeading(line); ok {
+			h.line = i
+			headings = append(headings, h)
+		}
+	}
+	return headings
+}
+
+// parseHeading recognizes ATX headings ("## Heading"), allowing up to three leading spaces.
+func parseHeading(line string) (markdownHeading, bool) {
+	s := strings.TrimLeft(line, " ")
+	if len(line)-len(s) > 3 {
+		return markdownHeading{}, false
+	}
+	level := 0
+	for level < len(s) && s[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return markdownHeading{}, false
+	}
+	rest := s[level:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return markdownHeading{}, false
+	}
+	text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(rest), "#"))
+	if text == "" {
+		return markdownHeading{}, false
+	}
+	return markdownHeading{level: level, text: text}, true
+}
+
+func minLevel(headings []markdownHeading) int {
+	level := 7
+	for _, h := range headings {
+		level = min(level, h.level)
+	}
+	return level
+}
+
+func normalizeHeading(s string) string {
+	s = strings.TrimSuffix(strings.TrimSpace(s), ":")
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+func trimBlock(s string) string {
+	return strings.TrimRight(strings.TrimLeft(s, "\n"), "\n \t")
+}
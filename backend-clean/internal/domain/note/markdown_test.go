@@ -0,0 +1,143 @@
+package note
+
+import (
+	"slices"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	tests := []struct {
+		name         string
+		fileName     string
+		content      string
+		wantTitle    string
+		wantHeadings []string
+		wantBodies   []string
+		wantPreamble string
+	}{
+		{
+			name:         "[Success] title and sections",
+			fileName:     "adr-1.md",
+			content:      "# Use Postgres\n\n## Context\nWe need storage.\n\n### Details\nmore\n\n## Decision\nPostgres\n",
+			wantTitle:    "Use Postgres",
+			wantHeadings: []string{"Context", "Decision"},
+			wantBodies:   []string{"We need storage.\n\n### Details\nmore", "Postgres"},
+		},
+		{
+			name:         "[Success] title falls back to file name",
+			fileName:     "docs/cache-plan.md",
+			content:      "intro\n# Context\nslow\n# Decision\ncache\n",
+			wantTitle:    "cache-plan",
+			wantHeadings: []string{"Context", "Decision"},
+			wantBodies:   []string{"slow", "cache"},
+			wantPreamble: "intro",
+		},
+		{
+			name:         "[Success] headings in code fences ignored",
+			fileName:     "a.md",
+			content:      "# T\n## Context\n```sh\n# not a heading\n```\n## Decision ##\nok",
+			wantTitle:    "T",
+			wantHeadings: []string{"Context", "Decision"},
+			wantBodies:   []string{"```sh\n# not a heading\n```", "ok"},
+		},
+		{
+			name:         "[Success] no headings",
+			fileName:     "plain.markdown",
+			content:      "just text\n",
+			wantTitle:    "plain",
+			wantPreamble: "just text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := ParseMarkdown(tt.fileName, tt.content)
+			if doc.Title != tt.wantTitle {
+				t.Fatalf("title = %q, want %q", doc.Title, tt.wantTitle)
+			}
+			if doc.Preamble != tt.wantPreamble {
+				t.Fatalf("preamble = %q, want %q", doc.Preamble, tt.wantPreamble)
+			}
+			var headings, bodies []string
+			for _, s := range doc.Sections {
+				headings = append(headings, s.Heading)
+				bodies = append(bodies, s.Body)
+			}
+			if !slices.Equal(headings, tt.wantHeadings) {
+				t.Fatalf("headings = %q, want %q", headings, tt.wantHeadings)
+			}
+			if !slices.Equal(bodies, tt.wantBodies) {
+				t.Fatalf("bodies = %q, want %q", bodies, tt.wantBodies)
+			}
+		})
+	}
+}
+
+func TestMapHeadings(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f1", Label: "Context", Order: 1, IsRequired: true},
+		{ID: "f2", Label: "Decision", Order: 2, IsRequired: true},
+		{ID: "f3", Label: "Notes", Order: 3},
+	}
+	tests := []struct {
+		name          string
+		doc           MarkdownDocument
+		aliases       map[string][]string
+		wantContents  []string
+		wantUnmatched []string
+		wantMissing   []string
+	}{
+		{
+			name: "[Success] exact match ignoring case and colon",
+			doc: MarkdownDocument{Sections: []MarkdownSection{
+				{Heading: "context:", Body: "why"},
+				{Heading: "DECISION", Body: "what"},
+			}},
+			wantContents: []string{"why", "what", ""},
+		},
+		{
+			name: "[Success] aliases by label and id",
+			doc: MarkdownDocument{Sections: []MarkdownSection{
+				{Heading: "Background", Body: "why"},
+				{Heading: "Outcome", Body: "what"},
+			}},
+			aliases:      map[string][]string{"Context": {"Background"}, "f2": {"outcome"}},
+			wantContents: []string{"why", "what", ""},
+		},
+		{
+			name: "[Success] reports unmatched and missing required",
+			doc: MarkdownDocument{Preamble: "intro", Sections: []MarkdownSection{
+				{Heading: "Context", Body: "why"},
+				{Heading: "Consequences", Body: "later"},
+				{Heading: "Context", Body: "more"},
+			}},
+			wantContents:  []string{"why\n\nmore", "", ""},
+			wantUnmatched: []string{"(text before first heading)", "Consequences"},
+			wantMissing:   []string{"Decision"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MapHeadings(fields, tt.doc, tt.aliases)
+			var contents []string
+			for i, s := range m.Sections {
+				if s.FieldID != fields[i].ID {
+					t.Fatalf("section %d field = %q, want %q", i, s.FieldID, fields[i].ID)
+				}
+				contents = append(contents, s.Content)
+			}
+			if !slices.Equal(contents, tt.wantContents) {
+				t.Fatalf("contents = %q, want %q", contents, tt.wantContents)
+			}
+			if !slices.Equal(m.UnmatchedHeadings, tt.wantUnmatched) {
+				t.Fatalf("unmatched = %q, want %q", m.UnmatchedHeadings, tt.wantUnmatched)
+			}
+			if !slices.Equal(m.MissingRequired, tt.wantMissing) {
+				t.Fatalf("missing = %q, want %q", m.MissingRequired, tt.wantMissing)
+			}
+		})
+	}
+}
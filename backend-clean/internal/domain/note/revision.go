@@ -0,0 +1,42 @@
+package note
+
+import "time"
+
+// Revision is a snapshot of a note's layout taken before the note moves to another template.
+type Revision struct {
+	ID              string
+	NoteID          string
+	TemplateID      string
+	TemplateVersion int
+	Title           string
+	Sections        []RevisionSection
+	CreatedAt       time.Time
+}
+
+// RevisionSection is the content a field held when the revision was taken.
+type RevisionSection struct {
+	FieldID string
+	Label   string
+	Order   int
+	Content string
+}
+
+// NewRevision snapshots the current template, title and sections of a note.
+func NewRevision(n WithMeta) Revision {
+	sections := make([]RevisionSection, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		sections = append(sections, RevisionSection{
+			FieldID: s.Section.FieldID,
+			Label:   s.FieldLabel,
+			Order:   s.FieldOrder,
+			Content: s.Section.Content,
+		})
+	}
+	return Revision{
+		NoteID:          n.Note.ID,
+		TemplateID:      n.Note.TemplateID,
+		TemplateVersion: n.Note.TemplateVersion,
+		Title:           n.Note.Title,
+		Sections:        sections,
+	}
+}
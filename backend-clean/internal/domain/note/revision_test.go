@@ -0,0 +1,23 @@
+package note
+
+import "testing"
+
+func TestNewRevision(t *testing.T) {
+	n := WithMeta{
+		Note: Note{ID: "n1", Title: "ADR", TemplateID: "tpl-1", TemplateVersion: 3},
+		Sections: []SectionWithField{
+			{Section: Section{ID: "s1", FieldID: "f1", Content: "context"}, FieldLabel: "Context", FieldOrder: 1},
+			{Section: Section{ID: "s2", FieldID: "f2", Content: "decision"}, FieldLabel: "Decision", FieldOrder: 2},
+		},
+	}
+	rev := NewRevision(n)
+	if rev.NoteID != "n1" || rev.TemplateID != "tpl-1" || rev.TemplateVersion != 3 || rev.Title != "ADR" {
+		t.Fatalf("unexpected revision: %+v", rev)
+	}
+	if len(rev.Sections) != 2 {
+		t.Fatalf("want 2 sections, got %d", len(rev.Sections))
+	}
+	if got := rev.Sections[1]; got.FieldID != "f2" || got.Label != "Decision" || got.Order != 2 || got.Content != "decision" {
+		t.Fatalf("unexpected section: %+v", got)
+	}
+}
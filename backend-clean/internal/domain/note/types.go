@@ -1,6 +1,8 @@
 // Package note holds note domain models.
 package note
 
+import "immortal-architecture-clean/backend/internal/domain/template"
+
 // Filters for listing notes.
 type Filters struct {
 	Status     *NoteStatus
@@ -15,6 +17,8 @@ type SectionWithField struct {
 	FieldLabel string
 	FieldOrder int
 	IsRequired bool
+	FieldType  template.FieldType
+	Options    template.FieldOptions
 }
 
 // WithMeta represents a note with template metadata.
@@ -0,0 +1,76 @@
+package note
+
+import (
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// FieldMapping maps field IDs of the version a note was written against to field IDs of the target
+// version, which may belong to another template when the note is re-templated.
+type FieldMapping map[string]string
+
+// CanUpgrade checks that the template has a newer version than the one the note uses.
+func CanUpgrade(n Note, latestVersion int) error {
+	if n.TemplateVersion >= latestVersion {
+		return domainerr.ErrNoteUpToDate
+	}
+	return nil
+}
+
+// CanRetemplate checks that the note is moved to a template other than its own.
+func CanRetemplate(n Note, targetTemplateID string) error {
+	if n.TemplateID == targetTemplateID {
+		return domainerr.ErrNoteSameTemplate
+	}
+	return nil
+}
+
+// UpgradeSections moves section content along the mapping onto the fields of the target version.
+// Target fields without a mapped source start empty. Unmapped sources that still hold content
+// are rejected unless the policy allows dropping it. Each target field may receive content from
+// one source only.
+func UpgradeSections(noteID string, current []SectionWithField, target []template.Field, mapping FieldMapping, policy template.FieldRemovalPolicy) ([]Section, error) {
+	contents := make(map[string]string, len(current))
+	for _, s := range current {
+		contents[s.Section.FieldID] = s.Section.Content
+		if _, mapped := mapping[s.Section.FieldID]; !mapped && s.Section.Content != "" && policy != template.FieldRemovalDeleteContent {
+			return nil, domainerr.ErrFieldHasContent
+		}
+	}
+	targets := make(map[string]bool, len(target))
+	for _, f := range target {
+		targets[f.ID] = true
+	}
+	moved := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		content, ok := contents[from]
+		if !ok || !targets[to] {
+			return nil, domainerr.ErrInvalidFieldMapping
+		}
+		if _, dup := moved[to]; dup {
+			return nil, domainerr.ErrInvalidFieldMapping
+		}
+		moved[to] = content
+	}
+	sections := make([]Section, 0, len(target))
+	for _, f := range target {
+		sections = append(sections, Section{NoteID: noteID, FieldID: f.ID, Content: moved[f.ID]})
+	}
+	return sections, nil
+}
+
+// MovedSectionIDs pairs each section of the previous layout with the section that received its
+// content along the mapping. Sections of unmapped fields have no counterpart.
+func MovedSectionIDs(previous []SectionWithField, mapping FieldMapping, moved []SectionWithField) map[string]string {
+	byField := make(map[string]string, len(moved))
+	for _, s := range moved {
+		byField[s.Section.FieldID] = s.Section.ID
+	}
+	ids := make(map[string]string, len(previous))
+	for _, s := range previous {
+		if id, ok := byField[mapping[s.Section.FieldID]]; ok {
+			ids[s.Section.ID] = id
+		}
+	}
+	return ids
+}
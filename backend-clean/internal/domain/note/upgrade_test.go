@@ -0,0 +1,100 @@
+package note
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestCanUpgrade(t *testing.T) {
+	if err := CanUpgrade(Note{TemplateVersion: 1}, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := CanUpgrade(Note{TemplateVersion: 2}, 2); !errors.Is(err, domainerr.ErrNoteUpToDate) {
+		t.Fatalf("want ErrNoteUpToDate, got %v", err)
+	}
+}
+
+func TestCanRetemplate(t *testing.T) {
+	if err := CanRetemplate(Note{TemplateID: "tpl-1"}, "tpl-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := CanRetemplate(Note{TemplateID: "tpl-1"}, "tpl-1"); !errors.Is(err, domainerr.ErrNoteSameTemplate) {
+		t.Fatalf("want ErrNoteSameTemplate, got %v", err)
+	}
+}
+
+func TestUpgradeSections(t *testing.T) {
+	current := []SectionWithField{
+		{Section: Section{ID: "s1", FieldID: "old-1", Content: "context"}},
+		{Section: Section{ID: "s2", FieldID: "old-2", Content: "decision"}},
+	}
+	target := []template.Field{{ID: "new-1", Order: 1}, {ID: "new-2", Order: 2}, {ID: "new-3", Order: 3}}
+	drop := template.FieldRemovalDeleteContent
+	tests := []struct {
+		name    string
+		mapping FieldMapping
+		policy  template.FieldRemovalPolicy
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name:    "[Success] moves mapped content and leaves new fields empty",
+			mapping: FieldMapping{"old-1": "new-2"},
+			policy:  drop,
+			want:    map[string]string{"new-1": "", "new-2": "context", "new-3": ""},
+		},
+		{
+			name:    "[Success] moving every field with content needs no policy",
+			mapping: FieldMapping{"old-1": "new-1", "old-2": "new-3"},
+			policy:  template.FieldRemovalReject,
+			want:    map[string]string{"new-1": "context", "new-2": "", "new-3": "decision"},
+		},
+		{
+			name:   "[Success] empty mapping drops all content under delete_content",
+			policy: drop,
+			want:   map[string]string{"new-1": "", "new-2": "", "new-3": ""},
+		},
+		{name: "[Fail] unmapped field with content under reject", mapping: FieldMapping{"old-1": "new-2"}, policy: template.FieldRemovalReject, wantErr: domainerr.ErrFieldHasContent},
+		{name: "[Fail] unknown source field", mapping: FieldMapping{"other": "new-1"}, policy: drop, wantErr: domainerr.ErrInvalidFieldMapping},
+		{name: "[Fail] unknown target field", mapping: FieldMapping{"old-1": "other"}, policy: drop, wantErr: domainerr.ErrInvalidFieldMapping},
+		{name: "[Fail] two sources for one target", mapping: FieldMapping{"old-1": "new-1", "old-2": "new-1"}, policy: drop, wantErr: domainerr.ErrInvalidFieldMapping},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UpgradeSections("n1", current, target, tt.mapping, tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got) != len(target) {
+				t.Fatalf("want %d sections, got %+v", len(target), got)
+			}
+			for i, s := range got {
+				if s.ID != "" || s.NoteID != "n1" || s.FieldID != target[i].ID || s.Content != tt.want[s.FieldID] {
+					t.Fatalf("unexpected section %d: %+v", i, s)
+				}
+			}
+		})
+	}
+}
+
+func TestMovedSectionIDs(t *testing.T) {
+	previous := []SectionWithField{
+		{Section: Section{ID: "s1", FieldID: "old-1"}},
+		{Section: Section{ID: "s2", FieldID: "old-2"}},
+	}
+	moved := []SectionWithField{
+		{Section: Section{ID: "t1", FieldID: "new-1"}},
+		{Section: Section{ID: "t2", FieldID: "new-2"}},
+	}
+	got := MovedSectionIDs(previous, FieldMapping{"old-1": "new-2"}, moved)
+	if want := map[string]string{"s1": "t2"}; !maps.Equal(got, want) {
+		t.Fatalf("ids = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+// Package notification holds in-app notifications and the kinds an account chose to receive.
+package notification
+
+import "time"
+
+// Kind tells what happened to the recipient's work.
+type Kind string
+
+// Kind constants.
+const (
+	// KindTemplateNotePublished: someone published a note written with the recipient's template.
+	KindTemplateNotePublished Kind = "template.note_published"
+	// KindNoteTransferred: someone transferred a note to the recipient.
+	KindNoteTransferred Kind = "note.transferred"
+	// KindTemplateDeprecated: a template the recipient's notes use was deprecated.
+	KindTemplateDeprecated Kind = "template.deprecated"
+)
+
+// Kinds lists every notification kind.
+var Kinds = []Kind{KindTemplateNotePublished, KindNoteTransferred, KindTemplateDeprecated}
+
+// ListLimit caps the notifications listed at once.
+const ListLimit = 50
+
+// Notification tells the recipient about an event caused by another account.
+// SubjectID is the note or template the event concerns and SubjectTitle its title at the time.
+type Notification struct {
+	ID           string
+	RecipientID  string
+	Kind         Kind
+	ActorID      string
+	SubjectID    string
+	SubjectTitle string
+	// EventID is the domain event the notification came from.
+	EventID   string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// Preferences are the notification kinds an account muted.
+type Preferences struct {
+	AccountID  string
+	MutedKinds []Kind
+	UpdatedAt  time.Time
+}
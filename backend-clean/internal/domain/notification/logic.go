@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"slices"
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// New creates a notification of an event for the recipient. It returns false when the recipient
+// caused the event, since nobody needs to be told about their own actions.
+func New(recipientID string, kind Kind, e event.Event, subjectID, subjectTitle string) (Notification, bool) {
+	if recipientID == "" || recipientID == e.ActorID {
+		return Notification{}, false
+	}
+	return Notification{
+		RecipientID:  recipientID,
+		Kind:         kind,
+		ActorID:      e.ActorID,
+		SubjectID:    subjectID,
+		SubjectTitle: subjectTitle,
+		EventID:      e.ID,
+	}, true
+}
+
+// ValidatePreferences checks the account and that every muted kind exists.
+func ValidatePreferences(p Preferences) error {
+	if strings.TrimSpace(p.AccountID) == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	for _, k := range p.MutedKinds {
+		if !slices.Contains(Kinds, k) {
+			return domainerr.ErrInvalidNotificationKind
+		}
+	}
+	return nil
+}
+
+// Wants reports whether the account receives notifications of the kind.
+func (p Preferences) Wants(kind Kind) bool {
+	return !slices.Contains(p.MutedKinds, kind)
+}
+
+// ValidateRecipient checks the notification was sent to the account.
+func ValidateRecipient(n Notification, accountID string) error {
+	if n.RecipientID != accountID {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
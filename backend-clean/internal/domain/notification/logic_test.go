@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestNew(t *testing.T) {
+	e := event.Event{ID: "ev-1", Name: event.NotePublished, ActorID: "author"}
+	tests := []struct {
+		name        string
+		recipientID string
+		wantOK      bool
+	}{
+		{name: "[Success] event caused by another account", recipientID: "owner", wantOK: true},
+		{name: "[Fail] own action", recipientID: "author"},
+		{name: "[Fail] no recipient", recipientID: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, ok := New(tt.recipientID, KindTemplateNotePublished, e, "note-1", "ADR")
+			if ok != tt.wantOK {
+				t.Fatalf("want ok=%v, got %v", tt.wantOK, ok)
+			}
+			if ok && (n.RecipientID != tt.recipientID || n.ActorID != "author" || n.EventID != "ev-1" || n.SubjectID != "note-1" || n.SubjectTitle != "ADR") {
+				t.Fatalf("unexpected notification: %+v", n)
+			}
+		})
+	}
+}
+
+func TestValidatePreferences(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefs     Preferences
+		wantError error
+	}{
+		{name: "[Success] nothing muted", prefs: Preferences{AccountID: "a"}},
+		{name: "[Success] known kinds muted", prefs: Preferences{AccountID: "a", MutedKinds: []Kind{KindNoteTransferred, KindTemplateDeprecated}}},
+		{name: "[Fail] unknown kind", prefs: Preferences{AccountID: "a", MutedKinds: []Kind{"comment.created"}}, wantError: domainerr.ErrInvalidNotificationKind},
+		{name: "[Fail] account missing", prefs: Preferences{AccountID: " "}, wantError: domainerr.ErrOwnerRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePreferences(tt.prefs)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestPreferences_Wants(t *testing.T) {
+	p := Preferences{AccountID: "a", MutedKinds: []Kind{KindTemplateDeprecated}}
+	if !p.Wants(KindNoteTransferred) || p.Wants(KindTemplateDeprecated) {
+		t.Fatalf("unexpected wants for %+v", p)
+	}
+}
+
+func TestValidateRecipient(t *testing.T) {
+	n := Notification{RecipientID: "a"}
+	if err := ValidateRecipient(n, "a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ValidateRecipient(n, "b"); !errors.Is(err, domainerr.ErrUnauthorized) {
+		t.Fatalf("want ErrUnauthorized, got %v", err)
+	}
+}
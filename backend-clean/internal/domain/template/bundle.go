@@ -0,0 +1,288 @@
+// Package template holds template domain models.
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// BundleVersion is the bundle format version written on export and accepted on import.
+const BundleVersion = 1
+
+// Bundle limits.
+const (
+	// MaxBundleSize limits the size of an imported bundle document in bytes.
+	MaxBundleSize = 1 << 20
+	// MaxBundleTemplates limits the number of templates in one bundle.
+	MaxBundleTemplates = 100
+)
+
+// BundleFormat is the encoding of a bundle document.
+type BundleFormat string
+
+// BundleFormat constants.
+const (
+	BundleFormatJSON BundleFormat = "json"
+	BundleFormatYAML BundleFormat = "yaml"
+)
+
+// Validate checks if the bundle format is known.
+func (f BundleFormat) Validate() error {
+	if f != BundleFormatJSON && f != BundleFormatYAML {
+		return domainerr.ErrInvalidBundleFormat
+	}
+	return nil
+}
+
+// ConflictMode decides what a bundle import does with a template whose name
+// matches one the importing account already owns.
+type ConflictMode string
+
+// ConflictMode constants.
+const (
+	// ConflictSkip leaves the existing template untouched.
+	ConflictSkip ConflictMode = "skip"
+	// ConflictRename creates the template under the first free "name (n)".
+	ConflictRename ConflictMode = "rename"
+	// ConflictUpdate stores the bundle fields as a new version of the existing template.
+	ConflictUpdate ConflictMode = "update"
+)
+
+// Validate checks if the conflict mode is known.
+func (m ConflictMode) Validate() error {
+	if m != ConflictSkip && m != ConflictRename && m != ConflictUpdate {
+		return domainerr.ErrInvalidConflictMode
+	}
+	return nil
+}
+
+// Bundle is the portable document templates are exported to and imported from.
+// It carries no IDs, owners or versions so it can move between accounts and environments:
+//
+//	version: 1
+//	templates:
+//	  - name: Daily report
+//	    visibility: public
+//	    fields:
+//	      - label: Done
+//	        type: markdown
+//	        order: 1
+//	        required: true
+//	      - label: Mood
+//	        type: select
+//	        order: 2
+//	        required: false
+//	        choices: [good, bad]
+//
+// The JSON encoding uses the same keys.
+type Bundle struct {
+	Version   int              `json:"version" yaml:"version"`
+	Templates []BundleTemplate `json:"templates" yaml:"templates"`
+}
+
+// BundleTemplate is one template of a bundle. An empty visibility means the default on create
+// and the current visibility on update.
+type BundleTemplate struct {
+	Name       string        `json:"name" yaml:"name"`
+	Visibility Visibility    `json:"visibility,omitempty" yaml:"visibility,omitempty"`
+	Fields     []BundleField `json:"fields" yaml:"fields"`
+}
+
+// BundleField is one field of a bundle template. Type defaults to text; the remaining
+// keys are only written when set and follow the rules of the field type.
+type BundleField struct {
+	Label       string    `json:"label" yaml:"label"`
+	Type        FieldType `json:"type,omitempty" yaml:"type,omitempty"`
+	Order       int       `json:"order" yaml:"order"`
+	Required    bool      `json:"required" yaml:"required"`
+	Choices     []string  `json:"choices,omitempty" yaml:"choices,omitempty"`
+	Min         *float64  `json:"min,omitempty" yaml:"min,omitempty"`
+	Max         *float64  `json:"max,omitempty" yaml:"max,omitempty"`
+	MinLength   *int      `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLength   *int      `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	Pattern     string    `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Placeholder string    `json:"placeholder,omitempty" yaml:"placeholder,omitempty"`
+	HelpText    string    `json:"helpText,omitempty" yaml:"helpText,omitempty"`
+}
+
+// BundleAction is what an import did with one bundle template.
+type BundleAction string
+
+// BundleAction constants.
+const (
+	BundleActionCreated BundleAction = "created"
+	BundleActionRenamed BundleAction = "renamed"
+	BundleActionUpdated BundleAction = "updated"
+	BundleActionSkipped BundleAction = "skipped"
+)
+
+// BundleImportResult reports the outcome of importing one bundle template.
+// Name is the name the template was stored under; Err is set when it was rejected.
+type BundleImportResult struct {
+	Index      int
+	Name       string
+	TemplateID string
+	Action     BundleAction
+	Err        error
+}
+
+// NewBundle builds a bundle of the current fields of templates, in field order.
+func NewBundle(templates []Template) Bundle {
+	b := Bundle{Version: BundleVersion, Templates: make([]BundleTemplate, 0, len(templates))}
+	for _, t := range templates {
+		fields := slices.Clone(t.Fields)
+		slices.SortStableFunc(fields, func(a, b Field) int { return a.Order - b.Order })
+		bt := BundleTemplate{Name: t.Name, Visibility: t.Visibility, Fields: make([]BundleField, 0, len(fields))}
+		for _, f := range fields {
+			bt.Fields = append(bt.Fields, BundleField{
+				Label:       f.Label,
+				Type:        f.Type,
+				Order:       f.Order,
+				Required:    f.IsRequired,
+				Choices:     slices.Clone(f.Options.Choices),
+				Min:         f.Options.Min,
+				Max:         f.Options.Max,
+				MinLength:   f.MinLength,
+				MaxLength:   f.MaxLength,
+				Pattern:     f.Pattern,
+				Placeholder: f.Placeholder,
+				HelpText:    f.HelpText,
+			})
+		}
+		b.Templates = append(b.Templates, bt)
+	}
+	return b
+}
+
+// Template returns the unsaved template described by the bundle entry, owned by ownerID.
+func (b BundleTemplate) Template(ownerID string) Template {
+	fields := make([]Field, 0, len(b.Fields))
+	for _, f := range b.Fields {
+		fields = append(fields, Field{
+			Label:       strings.TrimSpace(f.Label),
+			Order:       f.Order,
+			IsRequired:  f.Required,
+			Type:        f.Type,
+			Options:     FieldOptions{Min: f.Min, Max: f.Max, Choices: slices.Clone(f.Choices)},
+			MinLength:   f.MinLength,
+			MaxLength:   f.MaxLength,
+			Pattern:     f.Pattern,
+			Placeholder: f.Placeholder,
+			HelpText:    f.HelpText,
+		})
+	}
+	return Template{
+		Name:       strings.TrimSpace(b.Name),
+		OwnerID:    ownerID,
+		Visibility: b.Visibility,
+		Fields:     fields,
+	}
+}
+
+// EncodeBundle renders a bundle document in the given format.
+func EncodeBundle(b Bundle, format BundleFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if format == BundleFormatJSON {
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(b); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(b); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseBundle reads a JSON or YAML bundle document; documents starting with "{" are read as JSON.
+// Unknown keys, other format versions, bundles without templates and repeated template names are rejected.
+func ParseBundle(content []byte) (Bundle, error) {
+	if len(content) > MaxBundleSize {
+		return Bundle{}, domainerr.ErrBundleTooLarge
+	}
+	var b Bundle
+	trimmed := bytes.TrimSpace(content)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&b); err != nil {
+			return Bundle{}, fmt.Errorf("%w: %v", domainerr.ErrBundleInvalid, err)
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(trimmed))
+		dec.KnownFields(true)
+		if err := dec.Decode(&b); err != nil {
+			return Bundle{}, fmt.Errorf("%w: %v", domainerr.ErrBundleInvalid, err)
+		}
+	}
+	if b.Version != BundleVersion {
+		return Bundle{}, fmt.Errorf("%w: unsupported version %d", domainerr.ErrBundleInvalid, b.Version)
+	}
+	if len(b.Templates) == 0 {
+		return Bundle{}, domainerr.ErrBundleEmpty
+	}
+	if len(b.Templates) > MaxBundleTemplates {
+		return Bundle{}, domainerr.ErrBundleTooLarge
+	}
+	names := make(map[string]bool, len(b.Templates))
+	for _, t := range b.Templates {
+		name := strings.TrimSpace(t.Name)
+		if names[name] {
+			return Bundle{}, fmt.Errorf("%w: duplicate template name %q", domainerr.ErrBundleInvalid, name)
+		}
+		names[name] = true
+	}
+	return b, nil
+}
+
+// UniqueName returns name, or the first "name (n)" from n = 2 that is not taken.
+func UniqueName(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	for n := 2; ; n++ {
+		candidate := name + " (" + strconv.Itoa(n) + ")"
+		if !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
+// MatchFieldIDs gives fields the IDs of current fields with the same label so an update keeps them;
+// fields whose label is new, or not unique among the current fields, stay without an ID and are added.
+func MatchFieldIDs(fields, current []Field) []Field {
+	byLabel := make(map[string]string, len(current))
+	count := make(map[string]int, len(current))
+	for _, f := range current {
+		byLabel[f.Label] = f.ID
+		count[f.Label]++
+	}
+	matched := make([]Field, 0, len(fields))
+	used := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if id := byLabel[f.Label]; count[f.Label] == 1 && !used[id] {
+			f.ID = id
+			used[id] = true
+		}
+		matched = append(matched, f)
+	}
+	return matched
+}
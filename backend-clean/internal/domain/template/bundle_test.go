@@ -0,0 +1,159 @@
+package template
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	maxLen := 200
+	templates := []Template{{
+		ID:         "tpl-1",
+		Name:       "Daily",
+		OwnerID:    "owner-1",
+		Version:    3,
+		Visibility: VisibilityUnlisted,
+		Fields: []Field{
+			{ID: "f2", Label: "Body", Order: 2, Type: FieldTypeMarkdown, MaxLength: &maxLen, HelpText: "What happened"},
+			{ID: "f1", Label: "Mood", Order: 1, IsRequired: true, Type: FieldTypeSelect, Options: FieldOptions{Choices: []string{"good", "bad"}}},
+		},
+	}}
+
+	for _, format := range []BundleFormat{BundleFormatJSON, BundleFormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			doc, err := EncodeBundle(NewBundle(templates), format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Contains(string(doc), "tpl-1") || strings.Contains(string(doc), "owner-1") {
+				t.Fatalf("bundle leaks ids: %s", doc)
+			}
+			b, err := ParseBundle(doc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := b.Templates[0].Template("owner-2")
+			if got.Name != "Daily" || got.OwnerID != "owner-2" || got.Visibility != VisibilityUnlisted {
+				t.Fatalf("unexpected template: %+v", got)
+			}
+			want := []Field{
+				{Label: "Mood", Order: 1, IsRequired: true, Type: FieldTypeSelect, Options: FieldOptions{Choices: []string{"good", "bad"}}},
+				{Label: "Body", Order: 2, Type: FieldTypeMarkdown, MaxLength: &maxLen, HelpText: "What happened"},
+			}
+			if !reflect.DeepEqual(got.Fields, want) {
+				t.Fatalf("fields = %+v, want %+v", got.Fields, want)
+			}
+		})
+	}
+}
+
+func TestParseBundle(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantCount int
+		wantError error
+	}{
+		{
+			name:      "[Success] yaml",
+			content:   "version: 1\ntemplates:\n  - name: Daily\n    fields:\n      - label: Body\n        order: 1\n        required: true\n",
+			wantCount: 1,
+		},
+		{
+			name:      "[Success] json",
+			content:   `{"version": 1, "templates": [{"name": "Daily", "fields": [{"label": "Body", "order": 1, "required": true}]}]}`,
+			wantCount: 1,
+		},
+		{
+			name:      "[Fail] unknown key",
+			content:   "version: 1\ntemplates:\n  - name: Daily\n    owner: me\n",
+			wantError: domainerr.ErrBundleInvalid,
+		},
+		{
+			name:      "[Fail] unsupported version",
+			content:   `{"version": 2, "templates": [{"name": "Daily", "fields": []}]}`,
+			wantError: domainerr.ErrBundleInvalid,
+		},
+		{
+			name:      "[Fail] malformed",
+			content:   "{not json",
+			wantError: domainerr.ErrBundleInvalid,
+		},
+		{
+			name:      "[Fail] duplicate names",
+			content:   "version: 1\ntemplates:\n  - name: Daily\n  - name: ' Daily'\n",
+			wantError: domainerr.ErrBundleInvalid,
+		},
+		{
+			name:      "[Fail] no templates",
+			content:   "version: 1\ntemplates: []\n",
+			wantError: domainerr.ErrBundleEmpty,
+		},
+		{
+			name:      "[Fail] too many templates",
+			content:   "version: 1\ntemplates:\n" + strings.Repeat("  - name: T\n", MaxBundleTemplates+1),
+			wantError: domainerr.ErrBundleTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := ParseBundle([]byte(tt.content))
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(b.Templates) != tt.wantCount {
+				t.Fatalf("templates = %d, want %d", len(b.Templates), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	taken := map[string]bool{"Daily": true, "Daily (2)": true}
+	if got := UniqueName("Daily", taken); got != "Daily (3)" {
+		t.Fatalf("UniqueName = %q, want %q", got, "Daily (3)")
+	}
+	if got := UniqueName("Weekly", taken); got != "Weekly" {
+		t.Fatalf("UniqueName = %q, want %q", got, "Weekly")
+	}
+}
+
+func TestMatchFieldIDs(t *testing.T) {
+	current := []Field{
+		{ID: "f1", Label: "Body"},
+		{ID: "f2", Label: "Note"},
+		{ID: "f3", Label: "Note"},
+	}
+	got := MatchFieldIDs([]Field{{Label: "Body"}, {Label: "Note"}, {Label: "Mood"}, {Label: "Body"}}, current)
+	want := []string{"f1", "", "", ""}
+	for i, f := range got {
+		if f.ID != want[i] {
+			t.Fatalf("field %d id = %q, want %q", i, f.ID, want[i])
+		}
+	}
+}
+
+func TestConflictMode_Validate(t *testing.T) {
+	for _, m := range []ConflictMode{ConflictSkip, ConflictRename, ConflictUpdate} {
+		if err := m.Validate(); err != nil {
+			t.Fatalf("%s: unexpected error: %v", m, err)
+		}
+	}
+	if err := ConflictMode("merge").Validate(); !errors.Is(err, domainerr.ErrInvalidConflictMode) {
+		t.Fatalf("want ErrInvalidConflictMode, got %v", err)
+	}
+	if err := BundleFormat("xml").Validate(); !errors.Is(err, domainerr.ErrInvalidBundleFormat) {
+		t.Fatalf("want ErrInvalidBundleFormat, got %v", err)
+	}
+}
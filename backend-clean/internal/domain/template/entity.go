@@ -5,17 +5,75 @@ import "time"
 
 // Template represents a note template aggregate.
 type Template struct {
-	ID        string
-	Name      string
-	OwnerID   string
-	Fields    []Field
-	UpdatedAt time.Time
+	ID      string
+	Name    string
+	OwnerID string
+	// Version is the current version; each update stores the fields again under the next one.
+	Version int
+	// ForkedFromID is the template this one was copied from; empty when it was not forked.
+	ForkedFromID string
+	Visibility   Visibility
+	// DeprecatedAt is when the template was deprecated; nil while new notes may use it.
+	DeprecatedAt *time.Time
+	// SuccessorID is the template replacing a deprecated one; empty when none was named.
+	SuccessorID string
+	Fields      []Field
+	UpdatedAt   time.Time
 }
 
+// Visibility controls who can find and use a template.
+type Visibility string
+
+// Visibility constants.
+// Private templates are only visible to their owner, unlisted ones are reachable by ID
+// but left out of other accounts' lists, and public ones are visible everywhere.
+const (
+	VisibilityPrivate  Visibility = "private"
+	VisibilityUnlisted Visibility = "unlisted"
+	VisibilityPublic   Visibility = "public"
+)
+
+// DefaultVisibility applies when a template is created without a visibility.
+const DefaultVisibility = VisibilityPublic
+
+// FieldType represents the input type of a field.
+type FieldType string
+
+// FieldType constants.
+const (
+	FieldTypeText      FieldType = "text"
+	FieldTypeMarkdown  FieldType = "markdown"
+	FieldTypeURL       FieldType = "url"
+	FieldTypeNumber    FieldType = "number"
+	FieldTypeDate      FieldType = "date"
+	FieldTypeSelect    FieldType = "select"
+	FieldTypeChecklist FieldType = "checklist"
+)
+
+// DateLayout is the content format of date fields.
+const DateLayout = "2006-01-02"
+
 // Field represents a template field definition.
 type Field struct {
 	ID         string
 	Label      string
 	Order      int
 	IsRequired bool
+	Type       FieldType
+	Options    FieldOptions
+	// MinLength/MaxLength limit content length in characters; nil means unlimited.
+	MinLength *int
+	MaxLength *int
+	// Pattern is a regular expression the whole content must match; empty means none.
+	Pattern     string
+	Placeholder string
+	HelpText    string
+}
+
+// FieldOptions holds type-specific settings of a field.
+// Min/Max apply to number fields, Choices to select and checklist fields.
+type FieldOptions struct {
+	Min     *float64
+	Max     *float64
+	Choices []string
 }
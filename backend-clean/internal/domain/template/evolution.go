@@ -0,0 +1,156 @@
+package template
+
+import (
+	"slices"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// FieldRemovalPolicy controls what happens to note content of fields that are left out when a note
+// moves to another template version.
+type FieldRemovalPolicy string
+
+// FieldRemovalPolicy constants.
+const (
+	// FieldRemovalReject refuses to leave out fields that still hold note content.
+	FieldRemovalReject FieldRemovalPolicy = "reject"
+	// FieldRemovalDeleteContent drops the content of fields that are left out.
+	FieldRemovalDeleteContent FieldRemovalPolicy = "delete_content"
+)
+
+// Validate checks if the removal policy is known.
+func (p FieldRemovalPolicy) Validate() error {
+	if p != FieldRemovalReject && p != FieldRemovalDeleteContent {
+		return domainerr.ErrInvalidFieldRemovalPolicy
+	}
+	return nil
+}
+
+// FieldChangeKind describes one aspect of a field change.
+type FieldChangeKind string
+
+// FieldChangeKind constants.
+const (
+	FieldAdded     FieldChangeKind = "added"
+	FieldRemoved   FieldChangeKind = "removed"
+	FieldRenamed   FieldChangeKind = "renamed"
+	FieldReordered FieldChangeKind = "reordered"
+	// FieldModified covers type, requirement, options and constraint changes.
+	FieldModified FieldChangeKind = "modified"
+)
+
+// FieldChange reports how a single field changed between two versions.
+type FieldChange struct {
+	// FieldID is the ID in the new version; empty for removed fields.
+	FieldID string
+	// PreviousFieldID is the ID in the previous version; empty for added fields.
+	PreviousFieldID string
+	Label           string
+	PreviousLabel   string
+	Kinds           []FieldChangeKind
+}
+
+// FieldDiff pairs the requested fields of a new version with the fields of the previous one.
+type FieldDiff struct {
+	// Fields has one change per requested field in request order; unchanged fields have no kinds.
+	Fields []FieldChange
+	// Removed lists previous fields missing from the request.
+	Removed []FieldChange
+}
+
+// ChangeReport summarizes a template update.
+type ChangeReport struct {
+	PreviousVersion int
+	Version         int
+	Fields          []FieldChange
+}
+
+// DiffFields matches requested fields to the previous version's fields by ID. Requested fields
+// without an ID are added and previous fields missing from the request are removed.
+// Existing notes stay pinned to the previous version and only meet removed and added fields when
+// they are upgraded: the removal policy then guards content of removed fields, and added fields
+// get empty sections.
+func DiffFields(previous, next []Field) (FieldDiff, error) {
+	byID := make(map[string]Field, len(previous))
+	for _, f := range previous {
+		byID[f.ID] = f
+	}
+	kept := make(map[string]bool, len(next))
+	diff := FieldDiff{Fields: make([]FieldChange, 0, len(next))}
+	for _, f := range next {
+		if f.ID == "" {
+			diff.Fields = append(diff.Fields, FieldChange{Label: f.Label, Kinds: []FieldChangeKind{FieldAdded}})
+			continue
+		}
+		old, ok := byID[f.ID]
+		if !ok {
+			return FieldDiff{}, domainerr.ErrUnknownField
+		}
+		if kept[f.ID] {
+			return FieldDiff{}, domainerr.ErrInvalidTemplateField
+		}
+		kept[f.ID] = true
+		change := FieldChange{PreviousFieldID: f.ID, Label: f.Label, Kinds: compareFields(old, f)}
+		if slices.Contains(change.Kinds, FieldRenamed) {
+			change.PreviousLabel = old.Label
+		}
+		diff.Fields = append(diff.Fields, change)
+	}
+	for _, f := range previous {
+		if kept[f.ID] {
+			continue
+		}
+		diff.Removed = append(diff.Removed, FieldChange{PreviousFieldID: f.ID, Label: f.Label, Kinds: []FieldChangeKind{FieldRemoved}})
+	}
+	return diff, nil
+}
+
+// Report builds the change report once the requested fields are stored under the new version.
+// created holds the stored fields in request order; only changed fields are reported.
+func (d FieldDiff) Report(previousVersion, version int, created []Field) ChangeReport {
+	report := ChangeReport{PreviousVersion: previousVersion, Version: version}
+	for i, c := range d.Fields {
+		if len(c.Kinds) == 0 {
+			continue
+		}
+		if i < len(created) {
+			c.FieldID = created[i].ID
+		}
+		report.Fields = append(report.Fields, c)
+	}
+	report.Fields = append(report.Fields, d.Removed...)
+	return report
+}
+
+func compareFields(old, next Field) []FieldChangeKind {
+	var kinds []FieldChangeKind
+	if old.Label != next.Label {
+		kinds = append(kinds, FieldRenamed)
+	}
+	if old.Order != next.Order {
+		kinds = append(kinds, FieldReordered)
+	}
+	if old.IsRequired != next.IsRequired ||
+		old.Type != next.Type ||
+		!equalOptions(old.Options, next.Options) ||
+		!equalIntPtr(old.MinLength, next.MinLength) ||
+		!equalIntPtr(old.MaxLength, next.MaxLength) ||
+		old.Pattern != next.Pattern ||
+		old.Placeholder != next.Placeholder ||
+		old.HelpText != next.HelpText {
+		kinds = append(kinds, FieldModified)
+	}
+	return kinds
+}
+
+func equalOptions(a, b FieldOptions) bool {
+	return equalFloatPtr(a.Min, b.Min) && equalFloatPtr(a.Max, b.Max) && slices.Equal(a.Choices, b.Choices)
+}
+
+func equalIntPtr(a, b *int) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func equalFloatPtr(a, b *float64) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
@@ -0,0 +1,81 @@
+package template
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestDiffFields_Report(t *testing.T) {
+	previous := []Field{
+		{ID: "f1", Label: "Context", Order: 1, Type: FieldTypeText},
+		{ID: "f2", Label: "Decision", Order: 2, Type: FieldTypeText},
+		{ID: "f3", Label: "Risks", Order: 3, Type: FieldTypeText},
+	}
+	tests := []struct {
+		name        string
+		next        []Field
+		wantChanges []FieldChange
+		wantErr     error
+	}{
+		{
+			name: "[Success] rename, reorder, modify, add and remove",
+			next: []Field{
+				{ID: "f2", Label: "Decision", Order: 1, Type: FieldTypeText},
+				{ID: "f1", Label: "Background", Order: 2, Type: FieldTypeText, MaxLength: intPtr(10)},
+				{Label: "Status", Order: 3, Type: FieldTypeSelect},
+			},
+			wantChanges: []FieldChange{
+				{FieldID: "n1", PreviousFieldID: "f2", Label: "Decision", Kinds: []FieldChangeKind{FieldReordered}},
+				{FieldID: "n2", PreviousFieldID: "f1", Label: "Background", PreviousLabel: "Context", Kinds: []FieldChangeKind{FieldRenamed, FieldReordered, FieldModified}},
+				{FieldID: "n3", Label: "Status", Kinds: []FieldChangeKind{FieldAdded}},
+				{PreviousFieldID: "f3", Label: "Risks", Kinds: []FieldChangeKind{FieldRemoved}},
+			},
+		},
+		{
+			name: "[Success] unchanged fields are not reported",
+			next: previous,
+		},
+		{
+			name:    "[Fail] unknown field id",
+			next:    []Field{{ID: "other", Label: "X", Order: 1}},
+			wantErr: domainerr.ErrUnknownField,
+		},
+		{
+			name:    "[Fail] duplicate field id",
+			next:    []Field{{ID: "f1", Label: "A", Order: 1}, {ID: "f1", Label: "B", Order: 2}},
+			wantErr: domainerr.ErrInvalidTemplateField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := DiffFields(previous, tt.next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(diff.Fields) != len(tt.next) {
+				t.Fatalf("want one change per requested field, got %+v", diff.Fields)
+			}
+			created := []Field{{ID: "n1"}, {ID: "n2"}, {ID: "n3"}}
+			report := diff.Report(1, 2, created)
+			if report.PreviousVersion != 1 || report.Version != 2 {
+				t.Fatalf("unexpected versions: %+v", report)
+			}
+			if len(report.Fields) != len(tt.wantChanges) {
+				t.Fatalf("changes = %+v, want %+v", report.Fields, tt.wantChanges)
+			}
+			for i, want := range tt.wantChanges {
+				got := report.Fields[i]
+				if got.FieldID != want.FieldID || got.PreviousFieldID != want.PreviousFieldID || got.Label != want.Label || got.PreviousLabel != want.PreviousLabel || !slices.Equal(got.Kinds, want.Kinds) {
+					t.Fatalf("change %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Package template holds template domain models.
+package template
+
+import "strings"
+
+// Fork builds an unsaved copy of source owned by ownerID.
+// The copy takes name, or the source name when name is blank, and the fields of the source
+// without their IDs so they are stored as the first version of the new template.
+// Forks start with the default visibility whatever the visibility of the source.
+func Fork(source Template, ownerID, name string) (Template, error) {
+	if strings.TrimSpace(name) == "" {
+		name = source.Name
+	}
+	fields := make([]Field, 0, len(source.Fields))
+	for _, f := range source.Fields {
+		f.ID = ""
+		if f.Options.Choices != nil {
+			f.Options.Choices = append([]string(nil), f.Options.Choices...)
+		}
+		fields = append(fields, f)
+	}
+	fork := Template{
+		Name:         name,
+		OwnerID:      ownerID,
+		ForkedFromID: source.ID,
+		Visibility:   DefaultVisibility,
+		Fields:       fields,
+	}
+	if err := ValidateTemplate(fork); err != nil {
+		return Template{}, err
+	}
+	return fork, nil
+}
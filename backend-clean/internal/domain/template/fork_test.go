@@ -0,0 +1,75 @@
+package template
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestFork(t *testing.T) {
+	source := Template{
+		ID:      "tpl-1",
+		Name:    "Daily",
+		OwnerID: "owner-1",
+		Version: 3,
+		Fields: []Field{
+			{ID: "f1", Label: "Mood", Order: 1, IsRequired: true, Type: FieldTypeSelect, Options: FieldOptions{Choices: []string{"good", "bad"}}},
+			{ID: "f2", Label: "Body", Order: 2, Type: FieldTypeMarkdown},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		ownerID   string
+		forkName  string
+		wantName  string
+		wantError error
+	}{
+		{
+			name:     "[Success] keeps source name",
+			ownerID:  "owner-2",
+			wantName: "Daily",
+		},
+		{
+			name:     "[Success] renames fork",
+			ownerID:  "owner-2",
+			forkName: "My daily",
+			wantName: "My daily",
+		},
+		{
+			name:      "[Fail] missing owner",
+			wantError: domainerr.ErrTemplateOwnerRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fork, err := Fork(source, tt.ownerID, tt.forkName)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fork.Name != tt.wantName || fork.OwnerID != tt.ownerID || fork.ForkedFromID != source.ID {
+				t.Fatalf("unexpected fork: %+v", fork)
+			}
+			if fork.ID != "" || fork.Version != 0 || len(fork.Fields) != len(source.Fields) {
+				t.Fatalf("unexpected fork: %+v", fork)
+			}
+			for i, f := range fork.Fields {
+				if f.ID != "" || f.Label != source.Fields[i].Label || f.Type != source.Fields[i].Type {
+					t.Fatalf("unexpected field %d: %+v", i, f)
+				}
+			}
+			fork.Fields[0].Options.Choices[0] = "changed"
+			if source.Fields[0].Options.Choices[0] != "good" {
+				t.Fatal("fork shares choices with source")
+			}
+		})
+	}
+}
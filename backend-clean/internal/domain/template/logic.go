@@ -1,17 +1,21 @@
 package template
 
 import (
+	"regexp"
 	"strings"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
 
-// NormalizeAndValidate sets missing order and validates fields.
+// NormalizeAndValidate sets missing order and type, and validates fields.
 func NormalizeAndValidate(fields []Field) ([]Field, error) {
 	for i := range fields {
 		if fields[i].Order == 0 {
 			fields[i].Order = i + 1
 		}
+		if fields[i].Type == "" {
+			fields[i].Type = FieldTypeText
+		}
 	}
 	if err := validateFields(fields); err != nil {
 		return nil, err
@@ -36,6 +40,86 @@ func validateFields(fields []Field) error {
 			return domainerr.ErrFieldOrderInvalid
 		}
 		seen[order] = true
+		if err := f.Type.Validate(); err != nil {
+			return err
+		}
+		if err := validateOptions(f.Type, f.Options); err != nil {
+			return err
+		}
+		if err := validateConstraints(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks if field type is known.
+func (t FieldType) Validate() error {
+	switch t {
+	case FieldTypeText, FieldTypeMarkdown, FieldTypeURL, FieldTypeNumber, FieldTypeDate, FieldTypeSelect, FieldTypeChecklist:
+		return nil
+	default:
+		return domainerr.ErrInvalidFieldType
+	}
+}
+
+// HasChoices reports whether the field type takes its values from declared choices.
+func (t FieldType) HasChoices() bool {
+	return t == FieldTypeSelect || t == FieldTypeChecklist
+}
+
+// IsTextual reports whether the field type holds free text that length and pattern constraints apply to.
+func (t FieldType) IsTextual() bool {
+	return t == FieldTypeText || t == FieldTypeMarkdown || t == FieldTypeURL
+}
+
+// CompilePattern compiles the field pattern anchored to match the whole content.
+// It returns nil when the field has no pattern.
+func (f Field) CompilePattern() (*regexp.Regexp, error) {
+	if f.Pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile(`^(?:` + f.Pattern + `)$`)
+}
+
+func validateConstraints(f Field) error {
+	if !f.Type.IsTextual() && (f.MinLength != nil || f.MaxLength != nil || f.Pattern != "") {
+		return domainerr.ErrInvalidFieldConstraints
+	}
+	if (f.MinLength != nil && *f.MinLength < 0) || (f.MaxLength != nil && *f.MaxLength < 0) {
+		return domainerr.ErrInvalidFieldConstraints
+	}
+	if f.MinLength != nil && f.MaxLength != nil && *f.MinLength > *f.MaxLength {
+		return domainerr.ErrInvalidFieldConstraints
+	}
+	if _, err := f.CompilePattern(); err != nil {
+		return domainerr.ErrInvalidFieldConstraints
+	}
+	return nil
+}
+
+func validateOptions(t FieldType, opts FieldOptions) error {
+	if t != FieldTypeNumber && (opts.Min != nil || opts.Max != nil) {
+		return domainerr.ErrInvalidFieldOptions
+	}
+	if opts.Min != nil && opts.Max != nil && *opts.Min > *opts.Max {
+		return domainerr.ErrInvalidFieldOptions
+	}
+	if !t.HasChoices() {
+		if len(opts.Choices) > 0 {
+			return domainerr.ErrInvalidFieldOptions
+		}
+		return nil
+	}
+	if len(opts.Choices) == 0 {
+		return domainerr.ErrInvalidFieldOptions
+	}
+	seen := make(map[string]bool, len(opts.Choices))
+	for _, c := range opts.Choices {
+		if strings.TrimSpace(c) == "" || seen[c] {
+			return domainerr.ErrInvalidFieldOptions
+		}
+		seen[c] = true
 	}
 	return nil
 }
@@ -72,3 +156,64 @@ func ValidateTemplateOwnership(templateOwnerID, actorID string) error {
 	}
 	return nil
 }
+
+// Validate ensures the visibility is a known value.
+func (v Visibility) Validate() error {
+	switch v {
+	case VisibilityPrivate, VisibilityUnlisted, VisibilityPublic:
+		return nil
+	default:
+		return domainerr.ErrInvalidVisibility
+	}
+}
+
+// ValidateTemplateAccess hides private templates from everyone but their owner.
+// It reports ErrNotFound so a private template is indistinguishable from a missing one.
+func ValidateTemplateAccess(t Template, viewerID string) error {
+	if t.Visibility != VisibilityPrivate {
+		return nil
+	}
+	if strings.TrimSpace(viewerID) == "" || t.OwnerID != viewerID {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// IsListedFor reports whether the template appears in the viewer's template list.
+// Only public templates are listed to other accounts.
+func IsListedFor(t Template, viewerID string) bool {
+	return t.Visibility == VisibilityPublic || (strings.TrimSpace(viewerID) != "" && t.OwnerID == viewerID)
+}
+
+// CanChangeVisibility rejects making a template private while other accounts' notes use it.
+func CanChangeVisibility(current, next Visibility, usedByOthers bool) error {
+	if err := next.Validate(); err != nil {
+		return err
+	}
+	if next == VisibilityPrivate && current != VisibilityPrivate && usedByOthers {
+		return domainerr.ErrTemplateUsedByOthers
+	}
+	return nil
+}
+
+// IsDeprecated reports whether the template is deprecated.
+func (t Template) IsDeprecated() bool {
+	return t.DeprecatedAt != nil
+}
+
+// ValidateTemplateUsable rejects writing new notes with a deprecated template.
+// The error names the successor so callers can point users to it; existing notes are not affected.
+func ValidateTemplateUsable(t Template) error {
+	if !t.IsDeprecated() {
+		return nil
+	}
+	return &domainerr.DeprecatedTemplateError{TemplateID: t.ID, SuccessorID: t.SuccessorID}
+}
+
+// ValidateSuccessor ensures successor can replace t: another template that is not deprecated itself.
+func ValidateSuccessor(t, successor Template) error {
+	if successor.ID == t.ID || successor.IsDeprecated() {
+		return domainerr.ErrInvalidSuccessor
+	}
+	return nil
+}
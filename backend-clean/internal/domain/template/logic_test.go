@@ -3,6 +3,7 @@ package template
 import (
 	"errors"
 	"testing"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
@@ -42,6 +43,84 @@ func TestNormalizeAndValidate(t *testing.T) {
 			},
 			wantError: domainerr.ErrFieldOrderInvalid,
 		},
+		{
+			name: "[Success] typed fields with options",
+			fields: []Field{
+				{ID: "f1", Label: "Score", Order: 1, Type: FieldTypeNumber, Options: FieldOptions{Min: floatPtr(0), Max: floatPtr(10)}},
+				{ID: "f2", Label: "Priority", Order: 2, Type: FieldTypeSelect, Options: FieldOptions{Choices: []string{"High", "Low"}}},
+			},
+			wantOrder: []int{1, 2},
+		},
+		{
+			name: "[Fail] unknown type",
+			fields: []Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: FieldType("image")},
+			},
+			wantError: domainerr.ErrInvalidFieldType,
+		},
+		{
+			name: "[Fail] select without choices",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldTypeSelect},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
+		{
+			name: "[Fail] duplicate choices",
+			fields: []Field{
+				{ID: "f1", Label: "Steps", Order: 1, Type: FieldTypeChecklist, Options: FieldOptions{Choices: []string{"a", "a"}}},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
+		{
+			name: "[Fail] min greater than max",
+			fields: []Field{
+				{ID: "f1", Label: "Score", Order: 1, Type: FieldTypeNumber, Options: FieldOptions{Min: floatPtr(5), Max: floatPtr(1)}},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
+		{
+			name: "[Fail] range on text field",
+			fields: []Field{
+				{ID: "f1", Label: "Title", Order: 1, Options: FieldOptions{Max: floatPtr(1)}},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
+		{
+			name: "[Success] text constraints",
+			fields: []Field{
+				{ID: "f1", Label: "Ticket", Order: 1, MinLength: intPtr(5), MaxLength: intPtr(20), Pattern: `PROJ-\d+`, Placeholder: "PROJ-123", HelpText: "Jira ticket"},
+			},
+			wantOrder: []int{1},
+		},
+		{
+			name: "[Fail] invalid pattern",
+			fields: []Field{
+				{ID: "f1", Label: "Ticket", Order: 1, Pattern: `PROJ-(`},
+			},
+			wantError: domainerr.ErrInvalidFieldConstraints,
+		},
+		{
+			name: "[Fail] min length greater than max length",
+			fields: []Field{
+				{ID: "f1", Label: "Cause", Order: 1, MinLength: intPtr(50), MaxLength: intPtr(10)},
+			},
+			wantError: domainerr.ErrInvalidFieldConstraints,
+		},
+		{
+			name: "[Fail] negative length",
+			fields: []Field{
+				{ID: "f1", Label: "Cause", Order: 1, MinLength: intPtr(-1)},
+			},
+			wantError: domainerr.ErrInvalidFieldConstraints,
+		},
+		{
+			name: "[Fail] length on number field",
+			fields: []Field{
+				{ID: "f1", Label: "Score", Order: 1, Type: FieldTypeNumber, MaxLength: intPtr(3)},
+			},
+			wantError: domainerr.ErrInvalidFieldConstraints,
+		},
 	}
 
 	for _, tt := range tests {
@@ -61,6 +140,9 @@ func TestNormalizeAndValidate(t *testing.T) {
 					if f.Order != tt.wantOrder[i] {
 						t.Fatalf("order mismatch at %d: want %d, got %d", i, tt.wantOrder[i], f.Order)
 					}
+					if f.Type == "" {
+						t.Fatalf("type not normalized at %d", i)
+					}
 				}
 			}
 		})
@@ -194,3 +276,122 @@ func TestValidateTemplateOwnership(t *testing.T) {
 		})
 	}
 }
+
+func floatPtr(v float64) *float64 { return &v }
+
+func intPtr(v int) *int { return &v }
+
+func TestValidateTemplateAccess(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility Visibility
+		viewerID   string
+		wantError  error
+		wantListed bool
+	}{
+		{name: "[Success] public to anyone", visibility: VisibilityPublic, wantListed: true},
+		{name: "[Success] unlisted by ID", visibility: VisibilityUnlisted, viewerID: "other"},
+		{name: "[Success] unlisted listed to owner", visibility: VisibilityUnlisted, viewerID: "owner-1", wantListed: true},
+		{name: "[Success] private to owner", visibility: VisibilityPrivate, viewerID: "owner-1", wantListed: true},
+		{name: "[Fail] private to other", visibility: VisibilityPrivate, viewerID: "other", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] private to anonymous", visibility: VisibilityPrivate, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tpl := Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: tt.visibility}
+			err := ValidateTemplateAccess(tpl, tt.viewerID)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if got := IsListedFor(tpl, tt.viewerID); got != tt.wantListed {
+				t.Fatalf("listed = %v, want %v", got, tt.wantListed)
+			}
+		})
+	}
+}
+
+func TestCanChangeVisibility(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      Visibility
+		next         Visibility
+		usedByOthers bool
+		wantError    error
+	}{
+		{name: "[Success] public to unlisted while used", current: VisibilityPublic, next: VisibilityUnlisted, usedByOthers: true},
+		{name: "[Success] public to private when unused by others", current: VisibilityPublic, next: VisibilityPrivate},
+		{name: "[Success] private stays private", current: VisibilityPrivate, next: VisibilityPrivate, usedByOthers: true},
+		{name: "[Fail] public to private while used by others", current: VisibilityPublic, next: VisibilityPrivate, usedByOthers: true, wantError: domainerr.ErrTemplateUsedByOthers},
+		{name: "[Fail] unknown visibility", current: VisibilityPublic, next: "secret", wantError: domainerr.ErrInvalidVisibility},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CanChangeVisibility(tt.current, tt.next, tt.usedByOthers); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestValidateTemplateUsable(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name        string
+		tpl         Template
+		wantError   error
+		wantMessage string
+	}{
+		{name: "[Success] active template", tpl: Template{ID: "tpl-1"}},
+		{
+			name:        "[Fail] deprecated with successor",
+			tpl:         Template{ID: "tpl-1", DeprecatedAt: &at, SuccessorID: "tpl-2"},
+			wantError:   domainerr.ErrTemplateDeprecated,
+			wantMessage: "template is deprecated; use template tpl-2 instead",
+		},
+		{
+			name:        "[Fail] deprecated without successor",
+			tpl:         Template{ID: "tpl-1", DeprecatedAt: &at},
+			wantError:   domainerr.ErrTemplateDeprecated,
+			wantMessage: "template is deprecated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplateUsable(tt.tpl)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if err == nil {
+				return
+			}
+			var derr *domainerr.DeprecatedTemplateError
+			if !errors.As(err, &derr) || derr.SuccessorID != tt.tpl.SuccessorID || err.Error() != tt.wantMessage {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateSuccessor(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name      string
+		successor Template
+		wantError error
+	}{
+		{name: "[Success] active template", successor: Template{ID: "tpl-2"}},
+		{name: "[Fail] itself", successor: Template{ID: "tpl-1"}, wantError: domainerr.ErrInvalidSuccessor},
+		{name: "[Fail] deprecated successor", successor: Template{ID: "tpl-2", DeprecatedAt: &at}, wantError: domainerr.ErrInvalidSuccessor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSuccessor(Template{ID: "tpl-1"}, tt.successor); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// Package template holds template domain models.
+package template
+
+import "strings"
+
+// MatchFields returns the IDs of the fields that satisfy the search filters, in field order.
+// A field matches when its label contains Query case-insensitively or equals FieldLabel.
+// Nil is returned when neither filter is set.
+func (f Filters) MatchFields(fields []Field) []string {
+	query := ""
+	if f.Query != nil {
+		query = strings.ToLower(strings.TrimSpace(*f.Query))
+	}
+	label := ""
+	if f.FieldLabel != nil {
+		label = *f.FieldLabel
+	}
+	if query == "" && label == "" {
+		return nil
+	}
+	matched := make([]string, 0)
+	for _, field := range fields {
+		if (query != "" && strings.Contains(strings.ToLower(field.Label), query)) || (label != "" && field.Label == label) {
+			matched = append(matched, field.ID)
+		}
+	}
+	return matched
+}
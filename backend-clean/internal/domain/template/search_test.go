@@ -0,0 +1,36 @@
+package template
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFilters_MatchFields(t *testing.T) {
+	fields := []Field{
+		{ID: "f1", Label: "Summary"},
+		{ID: "f2", Label: "Action items"},
+		{ID: "f3", Label: "summary notes"},
+	}
+	query := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		filters Filters
+		want    []string
+	}{
+		{name: "[Success] no search", filters: Filters{OwnerID: query("owner-1")}, want: nil},
+		{name: "[Success] query is case-insensitive", filters: Filters{Query: query(" SUMMARY ")}, want: []string{"f1", "f3"}},
+		{name: "[Success] field label is exact", filters: Filters{FieldLabel: query("Summary")}, want: []string{"f1"}},
+		{name: "[Success] both filters", filters: Filters{Query: query("action"), FieldLabel: query("Summary")}, want: []string{"f1", "f2"}},
+		{name: "[Success] query matching only the name", filters: Filters{Query: query("weekly")}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filters.MatchFields(fields)
+			if (got == nil) != (tt.want == nil) || !slices.Equal(got, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,10 +1,18 @@
 // Package template holds template domain models.
 package template
 
+import "time"
+
 // Filters for listing templates.
+// Query matches the template name or a field label; FieldLabel matches a field label exactly.
+// Only fields of the current version are searched. ViewerID decides which non-public templates are listed;
+// deprecated templates are only listed with IncludeDeprecated.
 type Filters struct {
-	Query   *string
-	OwnerID *string
+	Query             *string
+	OwnerID           *string
+	FieldLabel        *string
+	ViewerID          *string
+	IncludeDeprecated bool
 }
 
 // Owner holds minimal owner info for embedding.
@@ -15,9 +23,23 @@ type Owner struct {
 	Thumbnail *string
 }
 
+// Usage summarizes the notes written with a template across all of its versions.
+type Usage struct {
+	NoteCount      int
+	PublishedCount int
+	AuthorCount    int
+	// LastUsedAt is when the latest note was created; nil when no note uses the template.
+	LastUsedAt *time.Time
+}
+
 // WithUsage is a template with usage metadata.
 type WithUsage struct {
 	Template Template
 	IsUsed   bool
+	Usage    Usage
 	Owner    Owner
+	// ForkCount is the number of public templates forked from this one.
+	ForkCount int
+	// MatchedFieldIDs lists the fields that matched a search; nil outside of searches.
+	MatchedFieldIDs []string
 }
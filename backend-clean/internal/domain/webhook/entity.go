@@ -0,0 +1,92 @@
+// Package webhook holds outgoing webhook subscriptions and their deliveries.
+package webhook
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// MinSecretLength is the shortest secret accepted for signing deliveries.
+const MinSecretLength = 16
+
+// Request headers sent with every delivery.
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	// SignatureHeader carries "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>".
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// SubscribableEvents lists the events a subscription may filter on.
+var SubscribableEvents = []event.Name{
+	event.NoteCreated,
+	event.NoteUpdated,
+	event.NotePublished,
+	event.NoteUnpublished,
+	event.NoteDeleted,
+	event.TemplateChanged,
+}
+
+// RetryPolicy retries failed deliveries with exponential backoff for about a day.
+var RetryPolicy = event.RetryPolicy{
+	MaxAttempts: 8,
+	BaseDelay:   30 * time.Second,
+	MaxDelay:    6 * time.Hour,
+}
+
+// Subscription sends the events on the notes and templates of its owner to a URL.
+// An empty Events filter subscribes to every subscribable event.
+type Subscription struct {
+	ID        string
+	OwnerID   string
+	URL       string
+	Secret    string
+	Events    []event.Name
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliveryStatus is the state of a delivery.
+type DeliveryStatus string
+
+// Delivery status constants.
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is one event sent to one subscription. ResponseStatus and Latency describe the last attempt.
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventName      event.Name
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	ResponseStatus int
+	Latency        time.Duration
+	LastError      string
+	// RedeliveryOf is the delivery this one repeats; empty for the first delivery of an event.
+	RedeliveryOf string
+	CreatedAt    time.Time
+	CompletedAt  *time.Time
+}
+
+// Response is what the receiver answered to a delivery.
+type Response struct {
+	StatusCode int
+	Latency    time.Duration
+}
+
+// Attempt is the outcome of sending a delivery once.
+type Attempt struct {
+	Status         DeliveryStatus
+	ResponseStatus int
+	Latency        time.Duration
+	Error          string
+}
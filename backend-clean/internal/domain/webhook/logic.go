@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// ValidateSubscription checks owner, target URL, secret and event filter of a subscription.
+func ValidateSubscription(s Subscription) error {
+	if strings.TrimSpace(s.OwnerID) == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domainerr.ErrInvalidWebhookURL
+	}
+	// Names are checked again for every address they resolve to when delivering.
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return domainerr.ErrWebhookTargetNotAllowed
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return domainerr.ErrWebhookTargetNotAllowed
+	}
+	if len(s.Secret) < MinSecretLength {
+		return domainerr.ErrWebhookSecretTooShort
+	}
+	for _, name := range s.Events {
+		if !slices.Contains(SubscribableEvents, name) {
+			return domainerr.ErrInvalidWebhookEvent
+		}
+	}
+	return nil
+}
+
+// nonPublicPrefixes are special-purpose ranges not covered by the netip.Addr predicates.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, which can reach any IPv4 address
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2002::/16"),      // 6to4, which embeds IPv4 addresses
+	netip.MustParsePrefix("2001::/32"),      // Teredo, which embeds IPv4 addresses
+}
+
+// IsPublicAddr reports whether deliveries may connect to addr. Loopback, private, link-local
+// (including cloud metadata at 169.254.169.254), multicast and other special-purpose addresses
+// are refused so a subscription cannot reach services inside our network.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateOwnership checks the subscription belongs to the account.
+func ValidateOwnership(ownerID, actorID string) error {
+	if ownerID != actorID {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// Matches reports whether the event concerns a note or template of the owner and passes the filter.
+func (s Subscription) Matches(e event.Event) bool {
+	if !s.Active || e.Data["ownerId"] != s.OwnerID || !slices.Contains(SubscribableEvents, e.Name) {
+		return false
+	}
+	return len(s.Events) == 0 || slices.Contains(s.Events, e.Name)
+}
+
+// payload is the JSON body of a delivery.
+type payload struct {
+	ID          string            `json:"id"`
+	Event       event.Name        `json:"event"`
+	AggregateID string            `json:"aggregateId"`
+	ActorID     string            `json:"actorId"`
+	OccurredAt  time.Time         `json:"occurredAt"`
+	Data        map[string]string `json:"data"`
+}
+
+// NewDelivery builds the pending delivery of an event to a subscription.
+func NewDelivery(s Subscription, e event.Event) (Delivery, error) {
+	body, err := json.Marshal(payload{
+		ID:          e.ID,
+		Event:       e.Name,
+		AggregateID: e.AggregateID,
+		ActorID:     e.ActorID,
+		OccurredAt:  e.OccurredAt,
+		Data:        e.Data,
+	})
+	if err != nil {
+		return Delivery{}, err
+	}
+	return Delivery{
+		SubscriptionID: s.ID,
+		EventID:        e.ID,
+		EventName:      e.Name,
+		Payload:        body,
+		Status:         DeliveryPending,
+	}, nil
+}
+
+// NewRedelivery repeats a delivery with the same payload as a new pending delivery.
+func NewRedelivery(d Delivery) Delivery {
+	return Delivery{
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventName:      d.EventName,
+		Payload:        d.Payload,
+		Status:         DeliveryPending,
+		RedeliveryOf:   d.ID,
+	}
+}
+
+// Sign returns the signature header value of a body sent at the given Unix time.
+// Receivers recompute it with their copy of the secret and should reject stale timestamps.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Headers returns the request headers of a delivery sent at the given time.
+func Headers(s Subscription, d Delivery, at time.Time) map[string]string {
+	ts := at.Unix()
+	return map[string]string{
+		EventHeader:     string(d.EventName),
+		DeliveryHeader:  d.ID,
+		TimestampHeader: strconv.FormatInt(ts, 10),
+		SignatureHeader: Sign(s.Secret, ts, d.Payload),
+	}
+}
+
+// Succeeded reports whether the receiver accepted the delivery.
+func (r Response) Succeeded() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// NextAttempt decides the outcome of an attempt and, when it failed and may be retried, the delay
+// before the next one. attempts counts the attempts made so far including this one.
+func NextAttempt(res Response, sendErr error, attempts int, policy event.RetryPolicy) (Attempt, time.Duration) {
+	a := Attempt{ResponseStatus: res.StatusCode, Latency: res.Latency}
+	switch {
+	case sendErr != nil:
+		a.Error = sendErr.Error()
+	case !res.Succeeded():
+		a.Error = "unexpected response status " + strconv.Itoa(res.StatusCode)
+	default:
+		a.Status = DeliverySucceeded
+		return a, 0
+	}
+	delay, dead := policy.Next(attempts)
+	if dead {
+		a.Status = DeliveryFailed
+		return a, 0
+	}
+	a.Status = DeliveryPending
+	return a, delay
+}
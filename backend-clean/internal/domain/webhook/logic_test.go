@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestValidateSubscription(t *testing.T) {
+	valid := Subscription{OwnerID: "owner-1", URL: "https://hooks.example.com/notes", Secret: strings.Repeat("s", MinSecretLength)}
+	tests := []struct {
+		name      string
+		edit      func(s *Subscription)
+		wantError error
+	}{
+		{name: "[Success] all events", edit: func(*Subscription) {}},
+		{name: "[Success] filtered events", edit: func(s *Subscription) { s.Events = []event.Name{event.NotePublished, event.TemplateChanged} }},
+		{name: "[Fail] owner missing", edit: func(s *Subscription) { s.OwnerID = " " }, wantError: domainerr.ErrOwnerRequired},
+		{name: "[Fail] relative url", edit: func(s *Subscription) { s.URL = "/hooks" }, wantError: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] unsupported scheme", edit: func(s *Subscription) { s.URL = "ftp://example.com" }, wantError: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] loopback ip", edit: func(s *Subscription) { s.URL = "http://127.0.0.1:8080/hooks" }, wantError: domainerr.ErrWebhookTargetNotAllowed},
+		{name: "[Fail] localhost", edit: func(s *Subscription) { s.URL = "http://LocalHost./hooks" }, wantError: domainerr.ErrWebhookTargetNotAllowed},
+		{name: "[Fail] cloud metadata", edit: func(s *Subscription) { s.URL = "http://169.254.169.254/latest/meta-data" }, wantError: domainerr.ErrWebhookTargetNotAllowed},
+		{name: "[Fail] private ipv6", edit: func(s *Subscription) { s.URL = "https://[fd00::1]/hooks" }, wantError: domainerr.ErrWebhookTargetNotAllowed},
+		{name: "[Fail] short secret", edit: func(s *Subscription) { s.Secret = "short" }, wantError: domainerr.ErrWebhookSecretTooShort},
+		{name: "[Fail] account events are not subscribable", edit: func(s *Subscription) { s.Events = []event.Name{event.AccountRegistered} }, wantError: domainerr.ErrInvalidWebhookEvent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			tt.edit(&s)
+			err := ValidateSubscription(s)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	published := event.Event{Name: event.NotePublished, Data: map[string]string{"ownerId": "owner-1"}}
+	tests := []struct {
+		name string
+		sub  Subscription
+		e    event.Event
+		want bool
+	}{
+		{name: "[Success] all events", sub: Subscription{OwnerID: "owner-1", Active: true}, e: published, want: true},
+		{name: "[Success] filtered event", sub: Subscription{OwnerID: "owner-1", Active: true, Events: []event.Name{event.NotePublished}}, e: published, want: true},
+		{name: "[Fail] filtered out", sub: Subscription{OwnerID: "owner-1", Active: true, Events: []event.Name{event.NoteDeleted}}, e: published},
+		{name: "[Fail] inactive", sub: Subscription{OwnerID: "owner-1"}, e: published},
+		{name: "[Fail] another owner's note", sub: Subscription{OwnerID: "owner-2", Active: true}, e: published},
+		{name: "[Fail] account event", sub: Subscription{OwnerID: "owner-1", Active: true}, e: event.Event{Name: event.AccountRegistered, Data: map[string]string{"ownerId": "owner-1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sub.Matches(tt.e); got != tt.want {
+				t.Fatalf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDelivery(t *testing.T) {
+	occurred := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := event.Event{ID: "evt-1", Name: event.NotePublished, AggregateID: "note-1", ActorID: "owner-1", OccurredAt: occurred, Data: map[string]string{"title": "ADR"}}
+	d, err := NewDelivery(Subscription{ID: "wh-1"}, e)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.SubscriptionID != "wh-1" || d.EventID != "evt-1" || d.EventName != event.NotePublished || d.Status != DeliveryPending {
+		t.Fatalf("unexpected delivery: %+v", d)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(d.Payload, &body); err != nil {
+		t.Fatalf("payload is not json: %v", err)
+	}
+	if body["id"] != "evt-1" || body["event"] != "note.published" || body["aggregateId"] != "note-1" || body["occurredAt"] != "2026-01-01T00:00:00Z" {
+		t.Fatalf("unexpected payload: %s", d.Payload)
+	}
+
+	again := NewRedelivery(Delivery{ID: "del-1", SubscriptionID: "wh-1", EventID: "evt-1", Payload: d.Payload, Status: DeliveryFailed, Attempts: 8})
+	if again.RedeliveryOf != "del-1" || again.Status != DeliveryPending || again.Attempts != 0 || string(again.Payload) != string(d.Payload) {
+		t.Fatalf("unexpected redelivery: %+v", again)
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"a":1}' | openssl dgst -sha256 -hmac 'secret'
+	got := Sign("secret", 1700000000, []byte(`{"a":1}`))
+	want := "sha256=49f24e537407743fa4a0242bb63b94b9a47ee99cbbe071ccd8a22550ae411686"
+	if got != want {
+		t.Fatalf("Sign = %s, want %s", got, want)
+	}
+	if Sign("secret", 1700000000, []byte(`{"a":2}`)) == got || Sign("other", 1700000000, []byte(`{"a":1}`)) == got || Sign("secret", 1700000001, []byte(`{"a":1}`)) == got {
+		t.Fatal("signature does not cover secret, timestamp and body")
+	}
+	headers := Headers(Subscription{Secret: "secret"}, Delivery{ID: "del-1", EventName: event.NoteCreated, Payload: []byte(`{"a":1}`)}, time.Unix(1700000000, 0))
+	if headers[SignatureHeader] != got || headers[TimestampHeader] != "1700000000" || headers[DeliveryHeader] != "del-1" || headers[EventHeader] != "note.created" {
+		t.Fatalf("unexpected headers: %+v", headers)
+	}
+}
+
+func TestNextAttempt(t *testing.T) {
+	policy := event.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	tests := []struct {
+		name       string
+		res        Response
+		sendErr    error
+		attempts   int
+		wantStatus DeliveryStatus
+		wantDelay  time.Duration
+		wantError  string
+	}{
+		{name: "[Success] 2xx succeeds", res: Response{StatusCode: 204, Latency: time.Millisecond}, attempts: 1, wantStatus: DeliverySucceeded},
+		{name: "[Fail] 5xx is retried with backoff", res: Response{StatusCode: 503}, attempts: 2, wantStatus: DeliveryPending, wantDelay: 2 * time.Second, wantError: "unexpected response status 503"},
+		{name: "[Fail] transport error is retried", sendErr: errors.New("connection refused"), attempts: 1, wantStatus: DeliveryPending, wantDelay: time.Second, wantError: "connection refused"},
+		{name: "[Fail] gives up after max attempts", res: Response{StatusCode: 500}, attempts: 3, wantStatus: DeliveryFailed, wantError: "unexpected response status 500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, delay := NextAttempt(tt.res, tt.sendErr, tt.attempts, policy)
+			if a.Status != tt.wantStatus || delay != tt.wantDelay || a.Error != tt.wantError {
+				t.Fatalf("got %+v after %v", a, delay)
+			}
+			if a.ResponseStatus != tt.res.StatusCode || a.Latency != tt.res.Latency {
+				t.Fatalf("response not recorded: %+v", a)
+			}
+		})
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "93.184.216.34", want: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{addr: "127.0.0.1"},
+		{addr: "::1"},
+		{addr: "10.1.2.3"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.1"},
+		{addr: "169.254.169.254"},
+		{addr: "fe80::1"},
+		{addr: "fd12::1"},
+		{addr: "0.0.0.0"},
+		{addr: "100.64.0.1"},
+		{addr: "255.255.255.255"},
+		{addr: "224.0.0.1"},
+		{addr: "::ffff:127.0.0.1"},
+		{addr: "64:ff9b::a9fe:a9fe"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := IsPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Fatalf("IsPublicAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds all application configuration.
@@ -18,6 +19,28 @@ type Config struct {
 
 	// CORS configuration
 	AllowedOrigins []string
+
+	// Attachment storage directory
+	BlobDir string
+
+	// How often the outbox is polled for events to dispatch
+	OutboxPollInterval time.Duration
+
+	// SMTP server (host:port) digests are sent through; digests are off when empty
+	SMTPAddr     string
+	SMTPUsername string
+	SMTPPassword string
+	// Sender address of emails
+	MailFrom string
+
+	// Web app base URL emails link to
+	AppBaseURL string
+	// Public API base URL unsubscribe links point to
+	APIBaseURL string
+	// Key signing digest unsubscribe links
+	DigestSecret string
+	// How often due digests are looked for
+	DigestPollInterval time.Duration
 }
 
 // Load reads configuration from environment variables.
@@ -38,10 +61,64 @@ func Load() (*Config, error) {
 
 	origins := parseAllowedOrigins(os.Getenv("CLIENT_ORIGIN"))
 
+	blobDir := strings.TrimSpace(os.Getenv("BLOB_DIR"))
+	if blobDir == "" {
+		blobDir = "data/blobs"
+	}
+
+	pollInterval := 2 * time.Second
+	if s := strings.TrimSpace(os.Getenv("OUTBOX_POLL_INTERVAL")); s != "" {
+		parsed, err := time.ParseDuration(s)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("OUTBOX_POLL_INTERVAL must be a positive duration")
+		}
+		pollInterval = parsed
+	}
+
+	digestPoll := 10 * time.Minute
+	if s := strings.TrimSpace(os.Getenv("DIGEST_POLL_INTERVAL")); s != "" {
+		parsed, err := time.ParseDuration(s)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("DIGEST_POLL_INTERVAL must be a positive duration")
+		}
+		digestPoll = parsed
+	}
+
+	smtpAddr := strings.TrimSpace(os.Getenv("SMTP_ADDR"))
+	mailFrom := strings.TrimSpace(os.Getenv("MAIL_FROM"))
+	digestSecret := os.Getenv("DIGEST_SECRET")
+	if smtpAddr != "" {
+		if mailFrom == "" {
+			return nil, errors.New("MAIL_FROM is required when SMTP_ADDR is set")
+		}
+		if len(digestSecret) < 16 {
+			return nil, errors.New("DIGEST_SECRET must be at least 16 characters when SMTP_ADDR is set")
+		}
+	}
+
+	appBaseURL := strings.TrimSpace(os.Getenv("APP_BASE_URL"))
+	if appBaseURL == "" {
+		appBaseURL = origins[0]
+	}
+	apiBaseURL := strings.TrimSpace(os.Getenv("API_BASE_URL"))
+	if apiBaseURL == "" {
+		apiBaseURL = "http://localhost:" + strconv.Itoa(port)
+	}
+
 	return &Config{
-		DatabaseURL:    dbURL,
-		ServerPort:     port,
-		AllowedOrigins: origins,
+		DatabaseURL:        dbURL,
+		ServerPort:         port,
+		AllowedOrigins:     origins,
+		BlobDir:            blobDir,
+		OutboxPollInterval: pollInterval,
+		SMTPAddr:           smtpAddr,
+		SMTPUsername:       os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:       os.Getenv("SMTP_PASSWORD"),
+		MailFrom:           mailFrom,
+		AppBaseURL:         strings.TrimRight(appBaseURL, "/"),
+		APIBaseURL:         strings.TrimRight(apiBaseURL, "/"),
+		DigestSecret:       digestSecret,
+		DigestPollInterval: digestPoll,
 	}, nil
 }
 
@@ -0,0 +1,11 @@
+// Package cli provides factory functions for command line adapters.
+package cli
+
+import clipresenter "immortal-architecture-clean/backend/internal/adapter/cli/presenter"
+
+// NewTemplateBundleOutputFactory returns a factory for the command line TemplateBundlePresenter.
+func NewTemplateBundleOutputFactory() func() *clipresenter.TemplateBundlePresenter {
+	return func() *clipresenter.TemplateBundlePresenter {
+		return clipresenter.NewTemplateBundlePresenter()
+	}
+}
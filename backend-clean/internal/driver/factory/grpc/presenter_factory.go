@@ -1,7 +1,10 @@
 // Package grpc provides factory functions for gRPC adapters.
 package grpc
 
-import grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+import (
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+)
 
 // NewAccountOutputFactory returns a factory for gRPC AccountPresenter.
 func NewAccountOutputFactory() func() *grpcpresenter.AccountPresenter {
@@ -9,3 +12,24 @@ func NewAccountOutputFactory() func() *grpcpresenter.AccountPresenter {
 		return grpcpresenter.NewAccountPresenter()
 	}
 }
+
+// NewNoteOutputFactory returns a factory for gRPC NotePresenter.
+func NewNoteOutputFactory() func() *grpcpresenter.NotePresenter {
+	return func() *grpcpresenter.NotePresenter {
+		return grpcpresenter.NewNotePresenter()
+	}
+}
+
+// NewNoteWatchOutputFactory returns a factory for gRPC NoteWatchPresenter.
+func NewNoteWatchOutputFactory() func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteWatchPresenter {
+	return func(stream notepb.NoteService_WatchNotesServer) *grpcpresenter.NoteWatchPresenter {
+		return grpcpresenter.NewNoteWatchPresenter(stream)
+	}
+}
+
+// NewTemplateOutputFactory returns a factory for gRPC TemplatePresenter.
+func NewTemplateOutputFactory() func() *grpcpresenter.TemplatePresenter {
+	return func() *grpcpresenter.TemplatePresenter {
+		return grpcpresenter.NewTemplatePresenter()
+	}
+}
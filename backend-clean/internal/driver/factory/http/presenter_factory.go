@@ -1,7 +1,12 @@
 // Package http provides factory functions for HTTP adapters.
 package http
 
-import httppresenter "immortal-architecture-clean/backend/internal/adapter/http/presenter"
+import (
+	"io"
+	"net/http"
+
+	httppresenter "immortal-architecture-clean/backend/internal/adapter/http/presenter"
+)
 
 // NewAccountOutputFactory returns a factory for HTTP AccountPresenter.
 func NewAccountOutputFactory() func() *httppresenter.AccountPresenter {
@@ -23,3 +28,95 @@ func NewNoteOutputFactory() func() *httppresenter.NotePresenter {
 		return httppresenter.NewNotePresenter()
 	}
 }
+
+// NewAttachmentOutputFactory returns a factory for HTTP AttachmentPresenter.
+func NewAttachmentOutputFactory() func() *httppresenter.AttachmentPresenter {
+	return func() *httppresenter.AttachmentPresenter {
+		return httppresenter.NewAttachmentPresenter()
+	}
+}
+
+// NewNoteExportOutputFactory returns a factory for note export presenters keyed by format.
+// It returns nil for unsupported formats.
+func NewNoteExportOutputFactory() func(format string) httppresenter.NoteExportPresenter {
+	return func(format string) httppresenter.NoteExportPresenter {
+		switch format {
+		case "markdown":
+			return httppresenter.NewMarkdownNotePresenter()
+		case "html":
+			return httppresenter.NewHTMLNotePresenter()
+		default:
+			return nil
+		}
+	}
+}
+
+// NewNoteImportOutputFactory returns a factory for HTTP NoteImportPresenter.
+func NewNoteImportOutputFactory() func() *httppresenter.NoteImportPresenter {
+	return func() *httppresenter.NoteImportPresenter {
+		return httppresenter.NewNoteImportPresenter()
+	}
+}
+
+// NewNoteCSVOutputFactory returns a factory for HTTP NoteCSVPresenter.
+func NewNoteCSVOutputFactory() func() *httppresenter.NoteCSVPresenter {
+	return func() *httppresenter.NoteCSVPresenter {
+		return httppresenter.NewNoteCSVPresenter()
+	}
+}
+
+// NewTemplateBundleOutputFactory returns a factory for HTTP TemplateBundlePresenter.
+func NewTemplateBundleOutputFactory() func() *httppresenter.TemplateBundlePresenter {
+	return func() *httppresenter.TemplateBundlePresenter {
+		return httppresenter.NewTemplateBundlePresenter()
+	}
+}
+
+// NewNoteBatchOutputFactory returns a factory for HTTP NoteBatchPresenter.
+func NewNoteBatchOutputFactory() func() *httppresenter.NoteBatchPresenter {
+	return func() *httppresenter.NoteBatchPresenter {
+		return httppresenter.NewNoteBatchPresenter()
+	}
+}
+
+// NewWebhookOutputFactory returns a factory for HTTP WebhookPresenter.
+func NewWebhookOutputFactory() func() *httppresenter.WebhookPresenter {
+	return func() *httppresenter.WebhookPresenter {
+		return httppresenter.NewWebhookPresenter()
+	}
+}
+
+// NewNoteStreamOutputFactory returns a factory for HTTP NoteStreamPresenter writing to a response.
+func NewNoteStreamOutputFactory() func(w http.ResponseWriter) *httppresenter.NoteStreamPresenter {
+	return func(w http.ResponseWriter) *httppresenter.NoteStreamPresenter {
+		return httppresenter.NewNoteStreamPresenter(w)
+	}
+}
+
+// NewCollabOutputFactory returns a factory for HTTP CollabPresenter writing to a WebSocket connection.
+func NewCollabOutputFactory() func(w io.Writer) *httppresenter.CollabPresenter {
+	return func(w io.Writer) *httppresenter.CollabPresenter {
+		return httppresenter.NewCollabPresenter(w)
+	}
+}
+
+// NewNotificationOutputFactory returns a factory for NotificationPresenter.
+func NewNotificationOutputFactory() func() *httppresenter.NotificationPresenter {
+	return func() *httppresenter.NotificationPresenter {
+		return httppresenter.NewNotificationPresenter()
+	}
+}
+
+// NewFollowOutputFactory returns a factory for FollowPresenter.
+func NewFollowOutputFactory() func() *httppresenter.FollowPresenter {
+	return func() *httppresenter.FollowPresenter {
+		return httppresenter.NewFollowPresenter()
+	}
+}
+
+// NewDigestOutputFactory returns a factory for DigestPresenter.
+func NewDigestOutputFactory() func() *httppresenter.DigestPresenter {
+	return func() *httppresenter.DigestPresenter {
+		return httppresenter.NewDigestPresenter()
+	}
+}
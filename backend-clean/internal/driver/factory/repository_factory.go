@@ -41,3 +41,45 @@ func NewNoteRepoFactory(pool *pgxpool.Pool) func() port.NoteRepository {
 		return sqlc.NewNoteRepository(pool)
 	}
 }
+
+// NewAttachmentRepoFactory returns a factory that creates AttachmentRepository.
+func NewAttachmentRepoFactory(pool *pgxpool.Pool) func() port.AttachmentRepository {
+	return func() port.AttachmentRepository {
+		return sqlc.NewAttachmentRepository(pool)
+	}
+}
+
+// NewOutboxRepoFactory returns a factory that creates OutboxRepository.
+func NewOutboxRepoFactory(pool *pgxpool.Pool) func() port.OutboxRepository {
+	return func() port.OutboxRepository {
+		return sqlc.NewOutboxRepository(pool)
+	}
+}
+
+// NewWebhookRepoFactory returns a factory that creates WebhookRepository.
+func NewWebhookRepoFactory(pool *pgxpool.Pool) func() port.WebhookRepository {
+	return func() port.WebhookRepository {
+		return sqlc.NewWebhookRepository(pool)
+	}
+}
+
+// NewNotificationRepoFactory returns a factory that creates NotificationRepository.
+func NewNotificationRepoFactory(pool *pgxpool.Pool) func() port.NotificationRepository {
+	return func() port.NotificationRepository {
+		return sqlc.NewNotificationRepository(pool)
+	}
+}
+
+// NewFollowRepoFactory returns a factory that creates FollowRepository.
+func NewFollowRepoFactory(pool *pgxpool.Pool) func() port.FollowRepository {
+	return func() port.FollowRepository {
+		return sqlc.NewFollowRepository(pool)
+	}
+}
+
+// NewDigestRepoFactory returns a factory that creates DigestRepository.
+func NewDigestRepoFactory(pool *pgxpool.Pool) func() port.DigestRepository {
+	return func() port.DigestRepository {
+		return sqlc.NewDigestRepository(pool)
+	}
+}
@@ -9,3 +9,24 @@ func NewTxFactory(tx port.TxManager) func() port.TxManager {
 		return tx
 	}
 }
+
+// NewBlobStoreFactory returns a factory that provides BlobStore.
+func NewBlobStoreFactory(store port.BlobStore) func() port.BlobStore {
+	return func() port.BlobStore {
+		return store
+	}
+}
+
+// NewEventBusFactory returns a factory that always returns the provided EventBus.
+func NewEventBusFactory(bus port.EventBus) func() port.EventBus {
+	return func() port.EventBus {
+		return bus
+	}
+}
+
+// NewCollabHubFactory returns a factory that always returns the provided CollabHub.
+func NewCollabHubFactory(hub port.CollabHub) func() port.CollabHub {
+	return func() port.CollabHub {
+		return hub
+	}
+}
@@ -2,27 +2,119 @@
 package factory
 
 import (
+	"immortal-architecture-clean/backend/internal/domain/digest"
 	"immortal-architecture-clean/backend/internal/port"
 	"immortal-architecture-clean/backend/internal/usecase"
 )
 
 // NewAccountInputFactory returns a factory for AccountInteractor.
-func NewAccountInputFactory() func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
+// The outbox and its transaction are bound here since only a first sign-in records an event.
+func NewAccountInputFactory(outboxFactory func() port.OutboxRepository, txFactory func() port.TxManager) func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
 	return func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
-		return usecase.NewAccountInteractor(repo, output)
+		return usecase.NewAccountInteractor(repo, outboxFactory(), txFactory(), output)
 	}
 }
 
 // NewTemplateInputFactory returns a factory for TemplateInteractor.
-func NewTemplateInputFactory() func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+func NewTemplateInputFactory(outboxFactory func() port.OutboxRepository) func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
 	return func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
-		return usecase.NewTemplateInteractor(repo, tx, output)
+		return usecase.NewTemplateInteractor(repo, outboxFactory(), tx, output)
+	}
+}
+
+// NewTemplateBundleInputFactory returns a factory for TemplateBundleInteractor.
+func NewTemplateBundleInputFactory(outboxFactory func() port.OutboxRepository) func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort {
+	return func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateBundleOutputPort) port.TemplateBundleInputPort {
+		return usecase.NewTemplateBundleInteractor(repo, outboxFactory(), tx, output)
 	}
 }
 
 // NewNoteInputFactory returns a factory for NoteInteractor.
-func NewNoteInputFactory() func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+// Attachment storage is bound here since note deletion only needs it for cleanup.
+func NewNoteInputFactory(attachmentRepoFactory func() port.AttachmentRepository, blobs port.BlobStore, outboxFactory func() port.OutboxRepository) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
 	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
-		return usecase.NewNoteInteractor(noteRepo, tplRepo, tx, output)
+		return usecase.NewNoteInteractor(noteRepo, tplRepo, attachmentRepoFactory(), blobs, outboxFactory(), tx, output)
+	}
+}
+
+// NewAttachmentInputFactory returns a factory for AttachmentInteractor.
+func NewAttachmentInputFactory() func(attachmentRepo port.AttachmentRepository, noteRepo port.NoteRepository, blobs port.BlobStore, output port.AttachmentOutputPort) port.AttachmentInputPort {
+	return func(attachmentRepo port.AttachmentRepository, noteRepo port.NoteRepository, blobs port.BlobStore, output port.AttachmentOutputPort) port.AttachmentInputPort {
+		return usecase.NewAttachmentInteractor(attachmentRepo, noteRepo, blobs, output)
+	}
+}
+
+// NewNoteImportInputFactory returns a factory that creates NoteImportInputPort.
+func NewNoteImportInputFactory(decoder port.ImportDecoder, outboxFactory func() port.OutboxRepository) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteImportOutputPort) port.NoteImportInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteImportOutputPort) port.NoteImportInputPort {
+		return usecase.NewNoteImportInteractor(noteRepo, tplRepo, decoder, outboxFactory(), tx, output)
+	}
+}
+
+// NewNoteCSVInputFactory returns a factory that creates NoteCSVInputPort.
+func NewNoteCSVInputFactory(outboxFactory func() port.OutboxRepository) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteCSVOutputPort) port.NoteCSVInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteCSVOutputPort) port.NoteCSVInputPort {
+		return usecase.NewNoteCSVInteractor(noteRepo, tplRepo, outboxFactory(), tx, output)
+	}
+}
+
+// NewNoteBatchInputFactory returns a factory for NoteBatchInteractor.
+// Attachment cleanup dependencies are bound here so controllers stay unaware of blob storage.
+func NewNoteBatchInputFactory(attachmentRepoFactory func() port.AttachmentRepository, blobs port.BlobStore, outboxFactory func() port.OutboxRepository) func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteBatchOutputPort) port.NoteBatchInputPort {
+	return func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.NoteBatchOutputPort) port.NoteBatchInputPort {
+		return usecase.NewNoteBatchInteractor(noteRepo, accountRepo, attachmentRepoFactory(), blobs, outboxFactory(), tx, output)
+	}
+}
+
+// NewWebhookInputFactory returns a factory for WebhookInteractor.
+func NewWebhookInputFactory() func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+	return func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+		return usecase.NewWebhookInteractor(repo, output)
+	}
+}
+
+// NewNoteStreamInputFactory returns a factory for NoteStreamInteractor.
+func NewNoteStreamInputFactory() func(bus port.EventBus, output port.NoteStreamOutputPort) port.NoteStreamInputPort {
+	return func(bus port.EventBus, output port.NoteStreamOutputPort) port.NoteStreamInputPort {
+		return usecase.NewNoteStreamInteractor(bus, output)
+	}
+}
+
+// NewNoteWatchInputFactory returns a factory for NoteWatchInteractor.
+func NewNoteWatchInputFactory() func(noteRepo port.NoteRepository, bus port.EventBus, output port.NoteWatchOutputPort) port.NoteWatchInputPort {
+	return func(noteRepo port.NoteRepository, bus port.EventBus, output port.NoteWatchOutputPort) port.NoteWatchInputPort {
+		return usecase.NewNoteWatchInteractor(noteRepo, bus, output)
+	}
+}
+
+// NewCollabInputFactory returns a factory for CollabInteractor.
+// Saves go through the note use case built by noteInputFactory.
+func NewCollabInputFactory(noteInputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, hub port.CollabHub, output port.CollabOutputPort) port.CollabInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, hub port.CollabHub, output port.CollabOutputPort) port.CollabInputPort {
+		saver := func(noteOutput port.NoteOutputPort) port.NoteInputPort {
+			return noteInputFactory(noteRepo, tplRepo, tx, noteOutput)
+		}
+		return usecase.NewCollabInteractor(noteRepo, hub, saver, output)
+	}
+}
+
+// NewNotificationInputFactory returns a factory for NotificationInteractor.
+func NewNotificationInputFactory() func(repo port.NotificationRepository, output port.NotificationOutputPort) port.NotificationInputPort {
+	return func(repo port.NotificationRepository, output port.NotificationOutputPort) port.NotificationInputPort {
+		return usecase.NewNotificationInteractor(repo, output)
+	}
+}
+
+// NewFollowInputFactory returns a factory for FollowInteractor.
+func NewFollowInputFactory() func(follows port.FollowRepository, accounts port.AccountRepository, output port.FollowOutputPort) port.FollowInputPort {
+	return func(follows port.FollowRepository, accounts port.AccountRepository, output port.FollowOutputPort) port.FollowInputPort {
+		return usecase.NewFollowInteractor(follows, accounts, output)
+	}
+}
+
+// NewDigestInputFactory returns a factory for DigestInteractor verifying unsubscribe links with the given signer.
+func NewDigestInputFactory(unsubscribe digest.Unsubscribe) func(repo port.DigestRepository, output port.DigestOutputPort) port.DigestInputPort {
+	return func(repo port.DigestRepository, output port.DigestOutputPort) port.DigestInputPort {
+		return usecase.NewDigestInteractor(repo, unsubscribe, output)
 	}
 }
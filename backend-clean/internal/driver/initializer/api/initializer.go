@@ -3,16 +3,44 @@ package initializer
 
 import (
 	"context"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"immortal-architecture-clean/backend/internal/adapter/gateway/blob"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/collabhub"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/eventbus"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/importfile"
+	mailgw "immortal-architecture-clean/backend/internal/adapter/gateway/mail"
+	webhookgw "immortal-architecture-clean/backend/internal/adapter/gateway/webhook"
 	httpcontroller "immortal-architecture-clean/backend/internal/adapter/http/controller"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
 	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	httpfactory "immortal-architecture-clean/backend/internal/driver/factory/http"
+	"immortal-architecture-clean/backend/internal/driver/worker"
+	"immortal-architecture-clean/backend/internal/usecase"
+)
+
+const (
+	// webhookTimeout bounds a single webhook request so slow receivers cannot stall delivery.
+	webhookTimeout = 10 * time.Second
+	// streamHistory is how many note events a reconnecting stream client can catch up on.
+	streamHistory = 1000
+	// streamBuffer is how many events a slow stream client may lag behind before it is dropped.
+	streamBuffer = 64
+	// collabBuffer is how many events a slow collaborator may lag behind before it is dropped.
+	collabBuffer = 256
+	// mailTimeout bounds sending one email so a slow SMTP server cannot stall the digest job.
+	mailTimeout = 30 * time.Second
+	// listenRetry is how long to wait before reconnecting a lost outbox listener.
+	listenRetry = 5 * time.Second
 )
 
 // BuildServer composes all dependencies and returns an Echo server, config, and cleanup function.
@@ -32,18 +60,71 @@ func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error
 
 	txMgr := driverdb.NewTxManager(pool)
 
+	blobStore, err := blob.NewLocalStore(cfg.BlobDir)
+	if err != nil {
+		cleanup()
+		return nil, nil, func() {}, err
+	}
+
+	// Digests are only sent when an SMTP server is configured.
+	var mailer *mailgw.SMTPMailer
+	if cfg.SMTPAddr != "" {
+		mailer, err = mailgw.NewSMTPMailer(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom, mailTimeout)
+		if err != nil {
+			cleanup()
+			return nil, nil, func() {}, err
+		}
+	}
+
 	accountRepoFactory := factory.NewAccountRepoFactory(pool)
 	templateRepoFactory := factory.NewTemplateRepoFactory(pool)
 	noteRepoFactory := factory.NewNoteRepoFactory(pool)
+	attachmentRepoFactory := factory.NewAttachmentRepoFactory(pool)
+	outboxRepoFactory := factory.NewOutboxRepoFactory(pool)
+	webhookRepoFactory := factory.NewWebhookRepoFactory(pool)
+	notificationRepoFactory := factory.NewNotificationRepoFactory(pool)
+	followRepoFactory := factory.NewFollowRepoFactory(pool)
+	digestRepoFactory := factory.NewDigestRepoFactory(pool)
 	txFactory := factory.NewTxFactory(txMgr)
+	blobFactory := factory.NewBlobStoreFactory(blobStore)
+	noteBus := eventbus.NewMemoryBus(streamHistory, streamBuffer)
+	busFactory := factory.NewEventBusFactory(noteBus)
+	// Collaborators on the same note must be connected to the same process.
+	collabHub := collabhub.NewMemoryHub(collabBuffer)
+	hubFactory := factory.NewCollabHubFactory(collabHub)
 
 	accountOutputFactory := httpfactory.NewAccountOutputFactory()
 	templateOutputFactory := httpfactory.NewTemplateOutputFactory()
 	noteOutputFactory := httpfactory.NewNoteOutputFactory()
+	noteExportOutputFactory := httpfactory.NewNoteExportOutputFactory()
+	noteImportOutputFactory := httpfactory.NewNoteImportOutputFactory()
+	noteCSVOutputFactory := httpfactory.NewNoteCSVOutputFactory()
+	noteBatchOutputFactory := httpfactory.NewNoteBatchOutputFactory()
+	templateBundleOutputFactory := httpfactory.NewTemplateBundleOutputFactory()
+	attachmentOutputFactory := httpfactory.NewAttachmentOutputFactory()
+	webhookOutputFactory := httpfactory.NewWebhookOutputFactory()
+	noteStreamOutputFactory := httpfactory.NewNoteStreamOutputFactory()
+	collabOutputFactory := httpfactory.NewCollabOutputFactory()
+	notificationOutputFactory := httpfactory.NewNotificationOutputFactory()
+	followOutputFactory := httpfactory.NewFollowOutputFactory()
+	digestOutputFactory := httpfactory.NewDigestOutputFactory()
 
-	accountInputFactory := factory.NewAccountInputFactory()
-	templateInputFactory := factory.NewTemplateInputFactory()
-	noteInputFactory := factory.NewNoteInputFactory()
+	accountInputFactory := factory.NewAccountInputFactory(outboxRepoFactory, txFactory)
+	templateInputFactory := factory.NewTemplateInputFactory(outboxRepoFactory)
+	templateBundleInputFactory := factory.NewTemplateBundleInputFactory(outboxRepoFactory)
+	noteInputFactory := factory.NewNoteInputFactory(attachmentRepoFactory, blobStore, outboxRepoFactory)
+	noteImportInputFactory := factory.NewNoteImportInputFactory(importfile.NewDecoder(), outboxRepoFactory)
+	noteCSVInputFactory := factory.NewNoteCSVInputFactory(outboxRepoFactory)
+	noteBatchInputFactory := factory.NewNoteBatchInputFactory(attachmentRepoFactory, blobStore, outboxRepoFactory)
+	attachmentInputFactory := factory.NewAttachmentInputFactory()
+	webhookInputFactory := factory.NewWebhookInputFactory()
+	noteStreamInputFactory := factory.NewNoteStreamInputFactory()
+	collabInputFactory := factory.NewCollabInputFactory(noteInputFactory)
+	notificationInputFactory := factory.NewNotificationInputFactory()
+	followInputFactory := factory.NewFollowInputFactory()
+	// Unsubscribe links in digests are verified here, so they keep working while sending is off.
+	unsubscribe := digest.Unsubscribe{Secret: cfg.DigestSecret, BaseURL: cfg.APIBaseURL + "/api/digests/unsubscribe"}
+	digestInputFactory := factory.NewDigestInputFactory(unsubscribe)
 
 	e := echo.New()
 
@@ -60,10 +141,49 @@ func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error
 	}))
 
 	ac := httpcontroller.NewAccountController(accountInputFactory, accountOutputFactory, accountRepoFactory)
-	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
+	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteExportOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
+	nic := httpcontroller.NewNoteImportController(noteImportInputFactory, noteImportOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
+	nbc := httpcontroller.NewNoteBatchController(noteBatchInputFactory, noteBatchOutputFactory, noteRepoFactory, accountRepoFactory, txFactory)
+	ncc := httpcontroller.NewNoteCSVController(noteCSVInputFactory, noteCSVOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
 	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateRepoFactory, txFactory)
-	server := httpcontroller.NewServer(ac, nc, tc)
+	tbc := httpcontroller.NewTemplateBundleController(templateBundleInputFactory, templateBundleOutputFactory, templateRepoFactory, txFactory)
+	atc := httpcontroller.NewAttachmentController(attachmentInputFactory, attachmentOutputFactory, attachmentRepoFactory, noteRepoFactory, blobFactory)
+	wc := httpcontroller.NewWebhookController(webhookInputFactory, webhookOutputFactory, webhookRepoFactory)
+	nsc := httpcontroller.NewNoteStreamController(noteStreamInputFactory, noteStreamOutputFactory, busFactory)
+	cc := httpcontroller.NewCollabController(collabInputFactory, collabOutputFactory, noteRepoFactory, templateRepoFactory, txFactory, hubFactory)
+	ntc := httpcontroller.NewNotificationController(notificationInputFactory, notificationOutputFactory, notificationRepoFactory)
+	fc := httpcontroller.NewFollowController(followInputFactory, followOutputFactory, followRepoFactory, accountRepoFactory)
+	dc := httpcontroller.NewDigestController(digestInputFactory, digestOutputFactory, digestRepoFactory)
+	server := httpcontroller.NewServer(ac, nc, nic, nbc, ncc, tc, tbc, atc, wc, nsc, cc, ntc, fc, dc)
 	openapi.RegisterHandlers(e, server)
+	// Open streams and sessions never finish on their own, so end them when a graceful shutdown starts.
+	e.Server.RegisterOnShutdown(noteBus.Close)
+	e.Server.RegisterOnShutdown(collabHub.Close)
+
+	// Deliver outbox events to in-process handlers and send the resulting webhooks until the server shuts down.
+	dispatcher := usecase.NewEventDispatcher(outboxRepoFactory(), event.DefaultRetryPolicy)
+	dispatcher.Subscribe(usecase.NewWebhookEventHandler(webhookRepoFactory()), webhook.SubscribableEvents...)
+	// Note streams of every API and gRPC process follow delivered note events through the database.
+	dispatcher.Subscribe(sqlc.NewOutboxNotifier(pool), event.NoteEvents...)
+	dispatcher.Subscribe(usecase.NewNotificationEventHandler(notificationRepoFactory(), noteRepoFactory(), templateRepoFactory()), event.NotePublished, event.NoteUpdated, event.TemplateChanged)
+	dispatcher.Subscribe(usecase.NewFeedEventHandler(followRepoFactory()), event.NotePublished, event.NoteUpdated, event.NoteUnpublished, event.NoteDeleted, event.TemplateChanged)
+	deliverer := usecase.NewWebhookDeliverer(webhookRepoFactory(), webhookgw.NewHTTPSender(webhookTimeout), webhook.RetryPolicy)
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	go worker.RunOutbox(workerCtx, dispatcher, cfg.OutboxPollInterval)
+	go worker.RunWebhooks(workerCtx, deliverer, cfg.OutboxPollInterval)
+	// Streams hear about events whichever process dispatched them. The bus forgets its history
+	// whenever the listener reconnects, so clients resuming across the gap are told to reload.
+	listener := sqlc.NewOutboxListener(pool, event.NoteEvents...)
+	go worker.RunEventListener(workerCtx, listener, noteBus.Reset, usecase.NewEventPublisher(noteBus), listenRetry)
+	if mailer != nil {
+		renderer := mailgw.NewDigestRenderer(cfg.AppBaseURL, time.Local)
+		sender := usecase.NewDigestSender(digestRepoFactory(), accountRepoFactory(), followRepoFactory(), notificationRepoFactory(), renderer, mailer, unsubscribe)
+		go worker.RunDigests(workerCtx, sender, cfg.DigestPollInterval)
+	}
+	cleanup = func() {
+		stopWorker()
+		pool.Close()
+	}
 
 	return e, cfg, cleanup, nil
 }
@@ -6,8 +6,10 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 
+	"immortal-architecture-clean/backend/internal/adapter/gateway/importfile"
 	httpcontroller "immortal-architecture-clean/backend/internal/adapter/http/controller"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/digest"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	httpfactory "immortal-architecture-clean/backend/internal/driver/factory/http"
 )
@@ -17,25 +19,104 @@ func TestNewServer_Wiring(t *testing.T) {
 	// use nil pool since factories are functional closures; server wiring should not panic
 	var pool *pgxpool.Pool
 	ac := httpcontroller.NewAccountController(
-		factory.NewAccountInputFactory(),
+		factory.NewAccountInputFactory(factory.NewOutboxRepoFactory(pool), factory.NewTxFactory(nil)),
 		httpfactory.NewAccountOutputFactory(),
 		factory.NewAccountRepoFactory(pool),
 	)
 	tc := httpcontroller.NewTemplateController(
-		factory.NewTemplateInputFactory(),
+		factory.NewTemplateInputFactory(factory.NewOutboxRepoFactory(pool)),
 		httpfactory.NewTemplateOutputFactory(),
 		factory.NewTemplateRepoFactory(pool),
 		factory.NewTxFactory(nil),
 	)
 	nc := httpcontroller.NewNoteController(
-		factory.NewNoteInputFactory(),
+		factory.NewNoteInputFactory(factory.NewAttachmentRepoFactory(pool), nil, factory.NewOutboxRepoFactory(pool)),
 		httpfactory.NewNoteOutputFactory(),
+		httpfactory.NewNoteExportOutputFactory(),
 		factory.NewNoteRepoFactory(pool),
 		factory.NewTemplateRepoFactory(pool),
 		factory.NewTxFactory(nil),
 	)
 
-	srv := httpcontroller.NewServer(ac, nc, tc)
+	nic := httpcontroller.NewNoteImportController(
+		factory.NewNoteImportInputFactory(importfile.NewDecoder(), factory.NewOutboxRepoFactory(pool)),
+		httpfactory.NewNoteImportOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	nbc := httpcontroller.NewNoteBatchController(
+		factory.NewNoteBatchInputFactory(factory.NewAttachmentRepoFactory(pool), nil, factory.NewOutboxRepoFactory(pool)),
+		httpfactory.NewNoteBatchOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	ncc := httpcontroller.NewNoteCSVController(
+		factory.NewNoteCSVInputFactory(factory.NewOutboxRepoFactory(pool)),
+		httpfactory.NewNoteCSVOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	tbc := httpcontroller.NewTemplateBundleController(
+		factory.NewTemplateBundleInputFactory(factory.NewOutboxRepoFactory(pool)),
+		httpfactory.NewTemplateBundleOutputFactory(),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewTxFactory(nil),
+	)
+
+	atc := httpcontroller.NewAttachmentController(
+		factory.NewAttachmentInputFactory(),
+		httpfactory.NewAttachmentOutputFactory(),
+		factory.NewAttachmentRepoFactory(pool),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewBlobStoreFactory(nil),
+	)
+
+	wc := httpcontroller.NewWebhookController(
+		factory.NewWebhookInputFactory(),
+		httpfactory.NewWebhookOutputFactory(),
+		factory.NewWebhookRepoFactory(pool),
+	)
+
+	nsc := httpcontroller.NewNoteStreamController(
+		factory.NewNoteStreamInputFactory(),
+		httpfactory.NewNoteStreamOutputFactory(),
+		factory.NewEventBusFactory(nil),
+	)
+
+	cc := httpcontroller.NewCollabController(
+		factory.NewCollabInputFactory(factory.NewNoteInputFactory(factory.NewAttachmentRepoFactory(pool), nil, factory.NewOutboxRepoFactory(pool))),
+		httpfactory.NewCollabOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewTxFactory(nil),
+		factory.NewCollabHubFactory(nil),
+	)
+	ntc := httpcontroller.NewNotificationController(
+		factory.NewNotificationInputFactory(),
+		httpfactory.NewNotificationOutputFactory(),
+		factory.NewNotificationRepoFactory(pool),
+	)
+
+	fc := httpcontroller.NewFollowController(
+		factory.NewFollowInputFactory(),
+		httpfactory.NewFollowOutputFactory(),
+		factory.NewFollowRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+	)
+
+	dc := httpcontroller.NewDigestController(
+		factory.NewDigestInputFactory(digest.Unsubscribe{}),
+		httpfactory.NewDigestOutputFactory(),
+		factory.NewDigestRepoFactory(pool),
+	)
+
+	srv := httpcontroller.NewServer(ac, nc, nic, nbc, ncc, tc, tbc, atc, wc, nsc, cc, ntc, fc, dc)
 	if srv == nil {
 		t.Fatalf("server is nil")
 	}
@@ -0,0 +1,37 @@
+// Package initializer wires dependencies for the command line tool.
+package initializer
+
+import (
+	"context"
+
+	clicontroller "immortal-architecture-clean/backend/internal/adapter/cli/controller"
+	"immortal-architecture-clean/backend/internal/driver/config"
+	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	clifactory "immortal-architecture-clean/backend/internal/driver/factory/cli"
+)
+
+// BuildTemplateBundleCommand composes all dependencies and returns the templates command and cleanup function.
+func BuildTemplateBundleCommand(ctx context.Context) (*clicontroller.TemplateBundleCommand, func(), error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	cleanup := func() {
+		pool.Close()
+	}
+
+	txMgr := driverdb.NewTxManager(pool)
+	cmd := clicontroller.NewTemplateBundleCommand(
+		factory.NewTemplateBundleInputFactory(factory.NewOutboxRepoFactory(pool)),
+		clifactory.NewTemplateBundleOutputFactory(),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewTxFactory(txMgr),
+	)
+	return cmd, cleanup, nil
+}
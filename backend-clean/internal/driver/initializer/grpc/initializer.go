@@ -5,18 +5,37 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 
+	"immortal-architecture-clean/backend/internal/adapter/gateway/blob"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/eventbus"
 	grpccontroller "immortal-architecture-clean/backend/internal/adapter/grpc/controller"
 	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/accountpb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	grpcfactory "immortal-architecture-clean/backend/internal/driver/factory/grpc"
+	"immortal-architecture-clean/backend/internal/driver/worker"
+	"immortal-architecture-clean/backend/internal/usecase"
+)
+
+const (
+	// watchHistory is how many note changes a reconnecting watcher can resume from.
+	watchHistory = 1000
+	// watchBuffer is how many changes a slow watcher may lag behind before its stream is ended.
+	watchBuffer = 256
+	// listenRetry is how long to wait before reconnecting a lost outbox listener.
+	listenRetry = 5 * time.Second
 )
 
 // BuildServer composes all dependencies and returns a gRPC server, config, and cleanup function.
+// Watch streams end when ctx is canceled, so a graceful stop does not wait for them.
 func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), error) {
 	cfg, err := config.Load()
 	if err != nil {
@@ -31,10 +50,49 @@ func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), err
 		pool.Close()
 	}
 
+	// Attachments of deleted notes live in the same blob directory the API server uses.
+	blobStore, err := blob.NewLocalStore(cfg.BlobDir)
+	if err != nil {
+		cleanup()
+		return nil, nil, func() {}, err
+	}
+
+	// Events are written to the outbox and dispatched by the API server's worker.
+	outboxRepoFactory := factory.NewOutboxRepoFactory(pool)
+	txFactory := factory.NewTxFactory(driverdb.NewTxManager(pool))
+
 	accountRepoFactory := factory.NewAccountRepoFactory(pool)
-	accountInputFactory := factory.NewAccountInputFactory()
+	accountInputFactory := factory.NewAccountInputFactory(outboxRepoFactory, txFactory)
 	accountOutputFactory := grpcfactory.NewAccountOutputFactory()
 
+	templateRepoFactory := factory.NewTemplateRepoFactory(pool)
+	templateInputFactory := factory.NewTemplateInputFactory(outboxRepoFactory)
+	templateOutputFactory := grpcfactory.NewTemplateOutputFactory()
+
+	noteRepoFactory := factory.NewNoteRepoFactory(pool)
+	noteInputFactory := factory.NewNoteInputFactory(factory.NewAttachmentRepoFactory(pool), blobStore, outboxRepoFactory)
+	noteOutputFactory := grpcfactory.NewNoteOutputFactory()
+
+	// Watchers follow the note events the API server's dispatcher delivers and announces through
+	// the database, the same way the API server's own streams do. The bus forgets its history whenever the listener reconnects, so
+	// watchers resuming across the gap take a new snapshot instead.
+	watchBus := eventbus.NewMemoryBus(watchHistory, watchBuffer)
+	busFactory := factory.NewEventBusFactory(watchBus)
+	noteWatchInputFactory := factory.NewNoteWatchInputFactory()
+	noteWatchOutputFactory := grpcfactory.NewNoteWatchOutputFactory()
+	listener := sqlc.NewOutboxListener(pool, event.NoteEvents...)
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	go worker.RunEventListener(workerCtx, listener, watchBus.Reset, usecase.NewEventPublisher(watchBus), listenRetry)
+	go func() {
+		<-ctx.Done()
+		watchBus.Close()
+	}()
+	cleanup = func() {
+		stopWorker()
+		watchBus.Close()
+		pool.Close()
+	}
+
 	// Create gRPC server
 	s := grpc.NewServer()
 
@@ -46,6 +104,28 @@ func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), err
 	)
 	accountpb.RegisterAccountServiceServer(s, accountController)
 
+	// Register template service
+	templateController := grpccontroller.NewTemplateController(
+		templateInputFactory,
+		templateOutputFactory,
+		templateRepoFactory,
+		txFactory,
+	)
+	templatepb.RegisterTemplateServiceServer(s, templateController)
+
+	// Register note service
+	noteController := grpccontroller.NewNoteController(
+		noteInputFactory,
+		noteOutputFactory,
+		noteRepoFactory,
+		templateRepoFactory,
+		txFactory,
+		noteWatchInputFactory,
+		noteWatchOutputFactory,
+		busFactory,
+	)
+	notepb.RegisterNoteServiceServer(s, noteController)
+
 	return s, cfg, cleanup, nil
 }
 
@@ -0,0 +1,61 @@
+// Package worker runs background loops next to the servers.
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// RunOutbox dispatches pending outbox events every interval until ctx is canceled.
+func RunOutbox(ctx context.Context, dispatcher port.EventDispatcherInputPort, interval time.Duration) {
+	poll(ctx, "outbox dispatch", dispatcher.DispatchPending, interval)
+}
+
+// RunWebhooks sends pending webhook deliveries every interval until ctx is canceled.
+func RunWebhooks(ctx context.Context, deliverer port.WebhookDeliveryInputPort, interval time.Duration) {
+	poll(ctx, "webhook delivery", deliverer.DeliverPending, interval)
+}
+
+// RunDigests sends due digest emails every interval until ctx is canceled.
+func RunDigests(ctx context.Context, sender port.DigestJobInputPort, interval time.Duration) {
+	poll(ctx, "digest email", sender.SendDue, interval)
+}
+
+// RunEventListener keeps listener connected until ctx is canceled, waiting retry after it drops.
+// onListening runs on every (re)connect, so consumers can account for events missed in between.
+func RunEventListener(ctx context.Context, listener port.EventListener, onListening func(), handler port.EventHandler, retry time.Duration) {
+	for {
+		if err := listener.Listen(ctx, onListening, handler); err != nil && ctx.Err() == nil {
+			log.Printf("event listener failed: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
+
+// poll runs a batch every interval. It keeps going without waiting while the batch claimed
+// work so a backlog drains quickly.
+func poll(ctx context.Context, name string, batch func(context.Context) (int, error), interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := batch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("%s failed: %v\n", name, err)
+		}
+		if err == nil && n > 0 && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package port
+
+import (
+	"context"
+	"io"
+
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+)
+
+// AttachmentInputPort defines attachment use case inputs.
+type AttachmentInputPort interface {
+	Upload(ctx context.Context, input AttachmentUploadInput) error
+	List(ctx context.Context, noteID, viewerID string) error
+	Download(ctx context.Context, noteID, id, viewerID string) error
+	Delete(ctx context.Context, noteID, id, ownerID string) error
+}
+
+// AttachmentOutputPort defines attachment presenters.
+type AttachmentOutputPort interface {
+	PresentAttachment(ctx context.Context, a *attachment.Attachment) error
+	PresentAttachmentList(ctx context.Context, attachments []attachment.Attachment) error
+	PresentAttachmentContent(ctx context.Context, a *attachment.Attachment, content io.ReadCloser) error
+	PresentAttachmentDeleted(ctx context.Context) error
+}
+
+// AttachmentRepository abstracts attachment metadata persistence.
+type AttachmentRepository interface {
+	Create(ctx context.Context, a attachment.Attachment) (*attachment.Attachment, error)
+	Get(ctx context.Context, id string) (*attachment.Attachment, error)
+	ListByNote(ctx context.Context, noteID string) ([]attachment.Attachment, error)
+	Delete(ctx context.Context, id string) error
+	// UpdateSection links an attachment to another section of its note.
+	UpdateSection(ctx context.Context, id, sectionID string) error
+}
+
+// BlobStore abstracts binary object storage.
+type BlobStore interface {
+	// Put stores content under a new key and returns the key and the number of bytes written.
+	Put(ctx context.Context, content io.Reader) (key string, size int64, err error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// AttachmentUploadInput is input for uploading attachments.
+type AttachmentUploadInput struct {
+	NoteID      string
+	SectionID   *string
+	OwnerID     string
+	FileName    string
+	ContentType string
+	Size        int64
+	Content     io.Reader
+}
@@ -0,0 +1,85 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+)
+
+// CollabInputPort runs a live editing session on a note.
+type CollabInputPort interface {
+	// Collaborate joins the note's session and handles commands until ctx is canceled,
+	// commands is closed or the hub drops the connection.
+	Collaborate(ctx context.Context, input CollabInput) error
+}
+
+// CollabOutputPort writes session messages to one participant.
+type CollabOutputPort interface {
+	PresentCollabJoined(ctx context.Context, joined CollabJoined) error
+	PresentCollabEvent(ctx context.Context, e collab.Event) error
+	// PresentCollabError reports a rejected command; the session continues.
+	PresentCollabError(ctx context.Context, err error) error
+}
+
+// CollabInput is input for joining a session as AccountID.
+type CollabInput struct {
+	NoteID    string
+	AccountID string
+	Commands  <-chan CollabCommand
+}
+
+// CollabCommandType identifies a participant's request.
+type CollabCommandType string
+
+// CollabCommandType constants.
+const (
+	CollabLock   CollabCommandType = "lock"
+	CollabUnlock CollabCommandType = "unlock"
+	CollabChange CollabCommandType = "change"
+	CollabSave   CollabCommandType = "save"
+)
+
+// CollabCommand is a participant's request. Lock, unlock and change name one section;
+// save writes the sections the participant holds locks for and keeps the title when it is empty.
+type CollabCommand struct {
+	Type      CollabCommandType
+	SectionID string
+	Content   string
+	Title     string
+	Sections  []SectionUpdateInput
+}
+
+// CollabJoined is what a participant sees on joining: the note and the session as it stands.
+type CollabJoined struct {
+	ConnID       string
+	Note         *NoteWithMeta
+	Participants []collab.Participant
+	Locks        []collab.Lock
+}
+
+// CollabHub keeps the sessions of this process. Each operation changes a note's room and broadcasts
+// the resulting event under one lock, so every participant sees changes in the same order.
+type CollabHub interface {
+	// Join adds a connection for the account and broadcasts the new presence.
+	Join(noteID, accountID string) CollabMembership
+	// Leave removes a connection, releasing its locks.
+	Leave(noteID, connID string)
+	Lock(noteID, sectionID, connID string) error
+	Unlock(noteID, sectionID, connID string) error
+	// Change renews the connection's lock and sends the content to the other participants.
+	Change(noteID, sectionID, connID, content string) error
+	Holds(noteID, sectionID, connID string) bool
+	// Expire releases the note's locks that were not renewed in time.
+	Expire(noteID string)
+	// Broadcast sends an event to every participant of the note.
+	Broadcast(noteID string, e collab.Event)
+}
+
+// CollabMembership is one connection's place in a session.
+type CollabMembership struct {
+	ConnID       string
+	Participants []collab.Participant
+	Locks        []collab.Lock
+	// Events is closed when the hub shuts down or the connection falls too far behind.
+	Events <-chan collab.Event
+}
@@ -0,0 +1,55 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// DigestInputPort defines digest settings use case inputs.
+type DigestInputPort interface {
+	GetSettings(ctx context.Context, accountID string) error
+	UpdateSettings(ctx context.Context, input DigestSettingsInput) error
+	// Unsubscribe turns the digest off for the account a signed unsubscribe token was issued to.
+	Unsubscribe(ctx context.Context, token string) error
+}
+
+// DigestOutputPort defines digest presenters.
+type DigestOutputPort interface {
+	PresentDigestSettings(ctx context.Context, s *digest.Settings) error
+}
+
+// DigestJobInputPort sends the digests that are due.
+type DigestJobInputPort interface {
+	// SendDue sends one batch of due digests and returns how many accounts were due.
+	SendDue(ctx context.Context) (int, error)
+}
+
+// DigestRepository abstracts persistence of digest settings and the content only digests need.
+type DigestRepository interface {
+	// GetSettings returns the account's settings; an account that never saved any gets the default frequency.
+	GetSettings(ctx context.Context, accountID string) (*digest.Settings, error)
+	SaveFrequency(ctx context.Context, accountID string, f digest.Frequency) (*digest.Settings, error)
+	// ListDue returns settings of active accounts whose digest is due at now.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]digest.Settings, error)
+	// Claim records the digest as sent at the given time. It returns false when another worker
+	// claimed it since s was read.
+	Claim(ctx context.Context, s digest.Settings, at time.Time) (bool, error)
+	// Release undoes a claim made at the given time so the digest is tried again.
+	Release(ctx context.Context, s digest.Settings, at time.Time) error
+	// ListStaleDrafts returns the owner's drafts last updated before the given time, oldest first.
+	ListStaleDrafts(ctx context.Context, ownerID string, before time.Time, limit int) ([]note.Note, error)
+}
+
+// DigestRenderer renders a digest into an email.
+type DigestRenderer interface {
+	Render(d digest.Digest) (MailMessage, error)
+}
+
+// DigestSettingsInput is input for changing how often an account receives its digest.
+type DigestSettingsInput struct {
+	AccountID string
+	Frequency digest.Frequency
+}
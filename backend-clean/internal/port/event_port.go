@@ -0,0 +1,38 @@
+// Package port defines application ports (interfaces).
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// EventDispatcherInputPort delivers recorded domain events to their handlers.
+type EventDispatcherInputPort interface {
+	DispatchPending(ctx context.Context) (int, error)
+}
+
+// EventHandler reacts to a domain event in process. Events are delivered at least once,
+// so handlers must tolerate seeing the same event again.
+type EventHandler interface {
+	Handle(ctx context.Context, e event.Event) error
+}
+
+// EventListener follows events as the dispatcher, possibly in another process, delivers them,
+// without claiming them from the outbox itself.
+type EventListener interface {
+	// Listen calls onListening once no later event can be missed, then passes each event to handler
+	// until ctx is canceled or the connection is lost.
+	Listen(ctx context.Context, onListening func(), handler EventHandler) error
+}
+
+// OutboxRepository abstracts the outbox. Append joins the transaction in ctx so an event is
+// stored together with the change that raised it.
+type OutboxRepository interface {
+	Append(ctx context.Context, e event.Event) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]event.Delivery, error)
+	MarkDelivered(ctx context.Context, id string) error
+	Reschedule(ctx context.Context, id string, delay time.Duration, lastErr string) error
+	MarkDead(ctx context.Context, id, lastErr string) error
+}
@@ -0,0 +1,42 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+)
+
+// FollowInputPort defines follow and activity feed use case inputs.
+type FollowInputPort interface {
+	Follow(ctx context.Context, followerID, followeeID string) error
+	Unfollow(ctx context.Context, followerID, followeeID string) error
+	Feed(ctx context.Context, input FeedInput) error
+}
+
+// FollowOutputPort defines presenters for follows and the activity feed.
+type FollowOutputPort interface {
+	// PresentFollowee presents the followed or unfollowed account with its updated counts.
+	PresentFollowee(ctx context.Context, followee *account.Account) error
+	PresentFeed(ctx context.Context, page feed.Page) error
+}
+
+// FollowRepository abstracts persistence of follows and feed items.
+type FollowRepository interface {
+	// Follow records that the follower follows the followee; following again is a no-op.
+	Follow(ctx context.Context, followerID, followeeID string) error
+	// Unfollow removes the follow; unfollowing an account that is not followed is a no-op.
+	Unfollow(ctx context.Context, followerID, followeeID string) error
+	// RecordFeedItem stores the item as the latest activity on its subject unless a newer one is stored.
+	RecordFeedItem(ctx context.Context, item feed.Item) error
+	RemoveFeedItem(ctx context.Context, subjectID string) error
+	// ListFeed returns up to limit items of the accounts the follower follows, newest first, after the cursor.
+	ListFeed(ctx context.Context, followerID string, after *feed.Cursor, limit int) ([]feed.Item, error)
+}
+
+// FeedInput is input for reading a page of the activity feed.
+type FeedInput struct {
+	AccountID string
+	Cursor    string
+	Limit     int
+}
@@ -0,0 +1,18 @@
+package port
+
+import "context"
+
+// MailMessage is an email with a plain text and an HTML body of the same content.
+type MailMessage struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+	// Headers are extra headers such as List-Unsubscribe.
+	Headers map[string]string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(ctx context.Context, msg MailMessage) error
+}
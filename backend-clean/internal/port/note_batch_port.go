@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteBatchInputPort defines batch note use case inputs.
+type NoteBatchInputPort interface {
+	Publish(ctx context.Context, input NoteBatchInput) error
+	Unpublish(ctx context.Context, input NoteBatchInput) error
+	Delete(ctx context.Context, input NoteBatchInput) error
+	TransferOwnership(ctx context.Context, input NoteBatchTransferInput) error
+}
+
+// NoteBatchOutputPort defines batch note presenters.
+type NoteBatchOutputPort interface {
+	PresentNoteBatch(ctx context.Context, mode note.BatchMode, results []note.BatchItemResult) error
+}
+
+// NoteBatchInput is input for batch operations on notes owned by OwnerID.
+type NoteBatchInput struct {
+	IDs     []string
+	OwnerID string
+	Mode    note.BatchMode
+}
+
+// NoteBatchTransferInput is input for transferring notes to another owner.
+type NoteBatchTransferInput struct {
+	NoteBatchInput
+	NewOwnerID string
+}
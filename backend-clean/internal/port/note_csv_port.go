@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// NoteCSVInputPort defines CSV export and import use case inputs for the notes of a template.
+type NoteCSVInputPort interface {
+	Export(ctx context.Context, templateID, viewerID string) error
+	Import(ctx context.Context, input NoteCSVImportInput) error
+}
+
+// NoteCSVOutputPort defines CSV presenters.
+type NoteCSVOutputPort interface {
+	PresentNoteCSV(ctx context.Context, fields []template.Field, notes []note.WithMeta) error
+	PresentNoteCSVImport(ctx context.Context, results []note.CSVRowResult) error
+}
+
+// NoteCSVImportInput is input for creating or updating notes of a template from CSV.
+type NoteCSVImportInput struct {
+	TemplateID string
+	OwnerID    string
+	Content    []byte
+}
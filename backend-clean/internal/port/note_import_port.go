@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteImportInputPort defines note import use case inputs.
+type NoteImportInputPort interface {
+	Import(ctx context.Context, input NoteImportInput) error
+}
+
+// NoteImportOutputPort defines note import presenters.
+type NoteImportOutputPort interface {
+	PresentNoteImport(ctx context.Context, dryRun bool, results []note.ImportResult) error
+}
+
+// NoteImportInput is input for importing notes from a Markdown file or a zip of them.
+type NoteImportInput struct {
+	TemplateID string
+	OwnerID    string
+	FileName   string
+	Content    []byte
+	// Aliases maps a field label or ID to extra headings that match it.
+	Aliases map[string][]string
+	DryRun  bool
+}
+
+// ImportDecoder reads the Markdown documents out of an uploaded file or archive.
+type ImportDecoder interface {
+	// Decode returns the documents sorted by name, or an import error when the upload is unusable.
+	Decode(fileName string, content []byte) ([]note.ImportFile, error)
+}
@@ -11,11 +11,15 @@ import (
 // NoteInputPort defines note use case inputs.
 type NoteInputPort interface {
 	List(ctx context.Context, filters note.Filters) error
+	ListByTemplate(ctx context.Context, templateID, viewerID string) error
 	Get(ctx context.Context, id string) error
 	Create(ctx context.Context, input NoteCreateInput) error
 	Update(ctx context.Context, input NoteUpdateInput) error
 	ChangeStatus(ctx context.Context, input NoteStatusChangeInput) error
 	Delete(ctx context.Context, id, ownerID string) error
+	Export(ctx context.Context, id, viewerID string) error
+	Upgrade(ctx context.Context, input NoteUpgradeInput) error
+	Retemplate(ctx context.Context, input NoteRetemplateInput) error
 }
 
 // NoteOutputPort defines note presenters.
@@ -32,8 +36,13 @@ type NoteRepository interface {
 	Create(ctx context.Context, n note.Note) (*note.Note, error)
 	Update(ctx context.Context, n note.Note) (*note.Note, error)
 	UpdateStatus(ctx context.Context, id string, status note.NoteStatus) (*note.Note, error)
+	UpdateOwner(ctx context.Context, id, ownerID string) (*note.Note, error)
+	UpdateTemplateVersion(ctx context.Context, id string, version int) (*note.Note, error)
+	UpdateTemplate(ctx context.Context, id, templateID string, version int) (*note.Note, error)
 	Delete(ctx context.Context, id string) error
 	ReplaceSections(ctx context.Context, noteID string, sections []note.Section) error
+	DeleteSections(ctx context.Context, noteID string) error
+	CreateRevision(ctx context.Context, rev note.Revision) (*note.Revision, error)
 }
 
 // NoteCreateInput is input for creating notes.
@@ -71,6 +80,25 @@ type NoteStatusChangeInput struct {
 	Status  note.NoteStatus
 }
 
+// NoteUpgradeInput is input for moving a note to the latest template version.
+type NoteUpgradeInput struct {
+	ID      string
+	OwnerID string
+	Mapping note.FieldMapping
+	// FieldRemoval decides what happens to content of unmapped fields; empty means reject.
+	FieldRemoval template.FieldRemovalPolicy
+}
+
+// NoteRetemplateInput is input for moving a note to the latest version of another template.
+type NoteRetemplateInput struct {
+	ID         string
+	OwnerID    string
+	TemplateID string
+	Mapping    note.FieldMapping
+	// FieldRemoval decides what happens to content of unmapped fields; empty means reject.
+	FieldRemoval template.FieldRemovalPolicy
+}
+
 // NoteFilters aliases domain note.Filters
 // NoteWithMeta aliases domain note.WithMeta
 // TemplateFields aliases template.Field slice
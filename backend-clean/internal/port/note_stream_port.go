@@ -0,0 +1,51 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// NoteStreamInputPort streams live note changes.
+type NoteStreamInputPort interface {
+	// Stream presents matching events until ctx is canceled or the stream is closed.
+	Stream(ctx context.Context, input NoteStreamInput) error
+}
+
+// NoteStreamOutputPort writes stream messages to the client as they happen.
+type NoteStreamOutputPort interface {
+	PresentNoteEvent(ctx context.Context, e event.Envelope) error
+	// PresentStreamReset tells a resuming client that events were missed and it should reload.
+	PresentStreamReset(ctx context.Context) error
+	PresentHeartbeat(ctx context.Context) error
+}
+
+// NoteStreamInput is input for streaming note changes. LastEventID resumes after an earlier event.
+type NoteStreamInput struct {
+	Filter      event.StreamFilter
+	LastEventID string
+}
+
+// EventBus fans published events out to live subscribers and keeps a bounded history for resuming.
+type EventBus interface {
+	// Publish records an event; publishing an event that is still in the history again is a no-op.
+	Publish(e event.Event)
+	// Subscribe returns the history after lastID and a channel of later events.
+	Subscribe(lastID string) EventSubscription
+}
+
+// EventSubscription is a live subscription to an EventBus.
+type EventSubscription struct {
+	// Replay holds the events published after the requested ID.
+	Replay []event.Envelope
+	// Resumed is false when a requested ID is no longer in the history, so events may have been missed.
+	Resumed bool
+	// LastID is the ID of the newest event when subscribing; resuming from it misses nothing before Events.
+	LastID string
+	// Events is closed when the bus shuts down or the subscriber falls too far behind.
+	Events <-chan event.Envelope
+	// Lagged reports whether Events was closed because the subscriber fell too far behind.
+	Lagged func() bool
+	// Cancel ends the subscription.
+	Cancel func()
+}
@@ -0,0 +1,32 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteWatchInputPort follows note changes for internal consumers such as indexers.
+type NoteWatchInputPort interface {
+	// Watch presents a snapshot, or the changes after a resume token, followed by live changes
+	// until ctx is canceled or the stream ends with an error.
+	Watch(ctx context.Context, input NoteWatchInput) error
+}
+
+// NoteWatchOutputPort writes watch messages to the consumer as they happen.
+type NoteWatchOutputPort interface {
+	PresentSnapshotNote(ctx context.Context, n note.WithMeta) error
+	// PresentSnapshotComplete ends a snapshot; resumeToken continues right after it.
+	PresentSnapshotComplete(ctx context.Context, resumeToken string) error
+	// PresentNoteChange writes a change with the current note, which is nil once the note is deleted.
+	PresentNoteChange(ctx context.Context, env event.Envelope, current *note.WithMeta) error
+	// PresentNoteRemoved writes a tombstone for a note the change moved out of the filter.
+	PresentNoteRemoved(ctx context.Context, env event.Envelope) error
+}
+
+// NoteWatchInput is input for watching notes. An empty or unknown ResumeToken starts with a snapshot.
+type NoteWatchInput struct {
+	Filter      event.WatchFilter
+	ResumeToken string
+}
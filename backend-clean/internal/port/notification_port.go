@@ -0,0 +1,49 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/notification"
+)
+
+// NotificationInputPort defines notification center use case inputs.
+type NotificationInputPort interface {
+	List(ctx context.Context, recipientID string, unreadOnly bool) error
+	CountUnread(ctx context.Context, recipientID string) error
+	MarkRead(ctx context.Context, id, recipientID string) error
+	MarkAllRead(ctx context.Context, recipientID string) error
+	GetPreferences(ctx context.Context, accountID string) error
+	UpdatePreferences(ctx context.Context, input NotificationPreferencesInput) error
+}
+
+// NotificationOutputPort defines notification presenters.
+type NotificationOutputPort interface {
+	PresentNotificationList(ctx context.Context, notifications []notification.Notification) error
+	PresentNotification(ctx context.Context, n *notification.Notification) error
+	PresentUnreadCount(ctx context.Context, count int) error
+	PresentMarkedAllRead(ctx context.Context, count int) error
+	PresentNotificationPreferences(ctx context.Context, p *notification.Preferences) error
+}
+
+// NotificationRepository abstracts persistence of notifications and preferences.
+type NotificationRepository interface {
+	// Create stores a notification; storing one for the same recipient, event and kind again is a no-op.
+	Create(ctx context.Context, n notification.Notification) error
+	// List returns the latest notifications of a recipient, newest first.
+	List(ctx context.Context, recipientID string, unreadOnly bool, limit int) ([]notification.Notification, error)
+	CountUnread(ctx context.Context, recipientID string) (int, error)
+	Get(ctx context.Context, id string) (*notification.Notification, error)
+	// MarkRead marks a notification read; one that was already read keeps its first read time.
+	MarkRead(ctx context.Context, id string) (*notification.Notification, error)
+	// MarkAllRead marks every unread notification of a recipient read and returns how many there were.
+	MarkAllRead(ctx context.Context, recipientID string) (int, error)
+	// GetPreferences returns the account's preferences; an account that never saved any mutes nothing.
+	GetPreferences(ctx context.Context, accountID string) (*notification.Preferences, error)
+	SavePreferences(ctx context.Context, p notification.Preferences) (*notification.Preferences, error)
+}
+
+// NotificationPreferencesInput is input for replacing the kinds an account muted.
+type NotificationPreferencesInput struct {
+	AccountID  string
+	MutedKinds []notification.Kind
+}
@@ -0,0 +1,35 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// TemplateBundleInputPort defines bundle export and import use case inputs for templates.
+type TemplateBundleInputPort interface {
+	Export(ctx context.Context, input TemplateBundleExportInput) error
+	Import(ctx context.Context, input TemplateBundleImportInput) error
+}
+
+// TemplateBundleOutputPort defines bundle presenters.
+type TemplateBundleOutputPort interface {
+	PresentTemplateBundle(ctx context.Context, bundle template.Bundle) error
+	PresentTemplateBundleImport(ctx context.Context, results []template.BundleImportResult) error
+}
+
+// TemplateBundleExportInput selects the templates to export: the given IDs in order,
+// or every template of OwnerID listed for the viewer when no IDs are given.
+type TemplateBundleExportInput struct {
+	IDs      []string
+	OwnerID  string
+	ViewerID string
+}
+
+// TemplateBundleImportInput is input for creating the templates of a bundle for OwnerID.
+// An empty OnConflict skips templates whose name the owner already uses.
+type TemplateBundleImportInput struct {
+	OwnerID    string
+	Content    []byte
+	OnConflict template.ConflictMode
+}
@@ -10,9 +10,13 @@ import (
 // TemplateInputPort defines template use case inputs.
 type TemplateInputPort interface {
 	List(ctx context.Context, filters template.Filters) error
-	Get(ctx context.Context, id string) error
+	Get(ctx context.Context, id, viewerID string) error
+	GetVersion(ctx context.Context, id string, version int, viewerID string) error
 	Create(ctx context.Context, input TemplateCreateInput) error
 	Update(ctx context.Context, input TemplateUpdateInput) error
+	Fork(ctx context.Context, input TemplateForkInput) error
+	Deprecate(ctx context.Context, input TemplateDeprecateInput) error
+	Undeprecate(ctx context.Context, id, ownerID string) error
 	Delete(ctx context.Context, id, ownerID string) error
 }
 
@@ -21,29 +25,54 @@ type TemplateOutputPort interface {
 	PresentTemplateList(ctx context.Context, templates []template.WithUsage) error
 	PresentTemplate(ctx context.Context, template *template.WithUsage) error
 	PresentTemplateDeleted(ctx context.Context) error
+	PresentTemplateChanges(ctx context.Context, report template.ChangeReport) error
 }
 
 // TemplateRepository abstracts template persistence.
 type TemplateRepository interface {
 	List(ctx context.Context, filters template.Filters) ([]template.WithUsage, error)
 	Get(ctx context.Context, id string) (*template.WithUsage, error)
+	GetVersion(ctx context.Context, id string, version int) (*template.WithUsage, error)
 	Create(ctx context.Context, tpl template.Template) (*template.Template, error)
 	Update(ctx context.Context, tpl template.Template) (*template.Template, error)
+	Deprecate(ctx context.Context, id, successorID string) (*template.Template, error)
+	Undeprecate(ctx context.Context, id string) (*template.Template, error)
 	Delete(ctx context.Context, id string) error
-	ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error
+	IsUsedByOthers(ctx context.Context, id, ownerID string) (bool, error)
+	CreateFields(ctx context.Context, templateID string, version int, fields []template.Field) ([]template.Field, error)
 }
 
 // TemplateCreateInput is input for creating templates.
+// An empty Visibility creates a template with the default visibility.
 type TemplateCreateInput struct {
-	Name    string
-	OwnerID string
-	Fields  []template.Field
+	Name       string
+	OwnerID    string
+	Visibility template.Visibility
+	Fields     []template.Field
 }
 
 // TemplateUpdateInput is input for updating templates.
+// An empty Visibility keeps the current visibility.
 type TemplateUpdateInput struct {
+	ID         string
+	Name       string
+	Visibility template.Visibility
+	Fields     []template.Field
+	OwnerID    string
+}
+
+// TemplateForkInput is input for forking a template into a copy owned by OwnerID.
+// An empty Name keeps the name of the source template.
+type TemplateForkInput struct {
 	ID      string
-	Name    string
-	Fields  []template.Field
 	OwnerID string
+	Name    string
+}
+
+// TemplateDeprecateInput is input for deprecating a template.
+// An empty SuccessorID deprecates the template without naming a replacement.
+type TemplateDeprecateInput struct {
+	ID          string
+	OwnerID     string
+	SuccessorID string
 }
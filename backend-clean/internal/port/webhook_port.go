@@ -0,0 +1,77 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+// WebhookInputPort defines webhook subscription use case inputs.
+type WebhookInputPort interface {
+	List(ctx context.Context, ownerID string) error
+	Get(ctx context.Context, id, ownerID string) error
+	Create(ctx context.Context, input WebhookCreateInput) error
+	Update(ctx context.Context, input WebhookUpdateInput) error
+	Delete(ctx context.Context, id, ownerID string) error
+	ListDeliveries(ctx context.Context, id, ownerID string) error
+	Redeliver(ctx context.Context, id, deliveryID, ownerID string) error
+}
+
+// WebhookOutputPort defines webhook presenters.
+type WebhookOutputPort interface {
+	PresentWebhookList(ctx context.Context, subs []webhook.Subscription) error
+	PresentWebhook(ctx context.Context, sub *webhook.Subscription) error
+	PresentWebhookDeleted(ctx context.Context) error
+	PresentWebhookDeliveries(ctx context.Context, deliveries []webhook.Delivery) error
+	PresentWebhookDelivery(ctx context.Context, delivery *webhook.Delivery) error
+}
+
+// WebhookDeliveryInputPort sends pending webhook deliveries.
+type WebhookDeliveryInputPort interface {
+	DeliverPending(ctx context.Context) (int, error)
+}
+
+// WebhookRepository abstracts persistence of subscriptions and their deliveries.
+type WebhookRepository interface {
+	ListByOwner(ctx context.Context, ownerID string) ([]webhook.Subscription, error)
+	Get(ctx context.Context, id string) (*webhook.Subscription, error)
+	Create(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error)
+	Update(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error)
+	Delete(ctx context.Context, id string) error
+	// Enqueue stores the first delivery of an event to a subscription; enqueueing it again is a no-op.
+	Enqueue(ctx context.Context, d webhook.Delivery) error
+	// CreateDelivery stores a redelivery.
+	CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error)
+	GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error)
+	// ListDeliveries returns the latest deliveries of a subscription, newest first.
+	ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhook.Delivery, error)
+	// ClaimDeliveries takes up to limit due pending deliveries and hides them for the lease.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error)
+	// RecordAttempt stores the outcome of an attempt; a pending delivery is due again after retryIn.
+	RecordAttempt(ctx context.Context, id string, attempt webhook.Attempt, retryIn time.Duration) error
+}
+
+// WebhookSender posts a delivery body to a receiver.
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (webhook.Response, error)
+}
+
+// WebhookCreateInput is input for subscribing a URL to events.
+type WebhookCreateInput struct {
+	OwnerID string
+	URL     string
+	Secret  string
+	Events  []event.Name
+}
+
+// WebhookUpdateInput is input for replacing a subscription. An empty Secret keeps the current one.
+type WebhookUpdateInput struct {
+	ID      string
+	OwnerID string
+	URL     string
+	Secret  string
+	Events  []event.Name
+	Active  bool
+}
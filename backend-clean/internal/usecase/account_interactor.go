@@ -5,23 +5,26 @@ import (
 	"context"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // AccountInteractor handles account use cases.
 type AccountInteractor struct {
 	repo   port.AccountRepository
+	events port.OutboxRepository
+	tx     port.TxManager
 	output port.AccountOutputPort
 }
 
 var _ port.AccountInputPort = (*AccountInteractor)(nil)
 
 // NewAccountInteractor creates AccountInteractor.
-func NewAccountInteractor(repo port.AccountRepository, output port.AccountOutputPort) *AccountInteractor {
-	return &AccountInteractor{repo: repo, output: output}
+func NewAccountInteractor(repo port.AccountRepository, events port.OutboxRepository, tx port.TxManager, output port.AccountOutputPort) *AccountInteractor {
+	return &AccountInteractor{repo: repo, events: events, tx: tx, output: output}
 }
 
-// CreateOrGet handles upsert/get of OAuth account.
+// CreateOrGet handles upsert/get of OAuth account. A first sign-in records an account.registered event.
 func (u *AccountInteractor) CreateOrGet(ctx context.Context, input account.OAuthAccountInput) error {
 	email, err := account.ParseEmail(input.Email)
 	if err != nil {
@@ -38,7 +41,17 @@ func (u *AccountInteractor) CreateOrGet(ctx context.Context, input account.OAuth
 	if err := account.Validate(acc); err != nil {
 		return err
 	}
-	a, err := u.repo.UpsertOAuthAccount(ctx, input)
+	var a *account.Account
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		a, err = u.repo.UpsertOAuthAccount(txCtx, input)
+		if err != nil {
+			return err
+		}
+		if !account.IsNewlyRegistered(*a) {
+			return nil
+		}
+		return u.events.Append(txCtx, event.NewAccountRegistered(*a))
+	})
 	if err != nil {
 		return err
 	}
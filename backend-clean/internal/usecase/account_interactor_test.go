@@ -4,21 +4,25 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
 )
 
 func TestAccountInteractor_CreateOrGet(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name      string
 		input     account.OAuthAccountInput
 		repoAcc   *account.Account
 		repoErr   error
+		wantEvent bool
 		wantError error
 	}{
 		{
@@ -30,7 +34,8 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 				Provider:          "google",
 				ProviderAccountID: "pid",
 			},
-			repoAcc: &account.Account{ID: "acc-1"},
+			repoAcc:   &account.Account{ID: "acc-1", CreatedAt: created, UpdatedAt: created},
+			wantEvent: true,
 		},
 		{
 			name: "[Success] return existing",
@@ -41,7 +46,7 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 				Provider:          "google",
 				ProviderAccountID: "pid",
 			},
-			repoAcc: &account.Account{ID: "acc-1", FirstName: "Hanako"},
+			repoAcc: &account.Account{ID: "acc-1", FirstName: "Hanako", CreatedAt: created, UpdatedAt: created.Add(time.Hour)},
 		},
 		{
 			name: "[Fail] invalid email",
@@ -81,17 +86,32 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockAccountRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockAccountOutputPort(ctrl)
 
 			shouldCallRepo := tt.wantError == nil || (tt.repoErr != nil && tt.wantError.Error() == tt.repoErr.Error())
 			if shouldCallRepo {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
 				repo.EXPECT().UpsertOAuthAccount(gomock.Any(), tt.input).Return(tt.repoAcc, tt.repoErr)
 			}
+			if tt.wantEvent {
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.AccountRegistered || e.AggregateID != tt.repoAcc.ID {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
+			}
 			if tt.wantError == nil && tt.repoErr == nil {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, events, tx, out)
 			err := interactor.CreateOrGet(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -138,7 +158,7 @@ func TestAccountInteractor_GetByID(t *testing.T) {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, nil, nil, out)
 			err := interactor.GetByID(context.Background(), tt.id)
 
 			if tt.wantError == nil && err != nil {
@@ -197,7 +217,7 @@ func TestAccountInteractor_GetByEmail(t *testing.T) {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, nil, nil, out)
 			err := interactor.GetByEmail(context.Background(), tt.email)
 
 			if tt.wantError == nil && err != nil {
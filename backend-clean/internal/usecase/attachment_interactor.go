@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AttachmentInteractor handles note attachment use cases.
+type AttachmentInteractor struct {
+	attachments port.AttachmentRepository
+	notes       port.NoteRepository
+	blobs       port.BlobStore
+	output      port.AttachmentOutputPort
+}
+
+var _ port.AttachmentInputPort = (*AttachmentInteractor)(nil)
+
+// NewAttachmentInteractor creates AttachmentInteractor.
+func NewAttachmentInteractor(attachments port.AttachmentRepository, notes port.NoteRepository, blobs port.BlobStore, output port.AttachmentOutputPort) *AttachmentInteractor {
+	return &AttachmentInteractor{
+		attachments: attachments,
+		notes:       notes,
+		blobs:       blobs,
+		output:      output,
+	}
+}
+
+// Upload stores a file and attaches it to the note.
+func (u *AttachmentInteractor) Upload(ctx context.Context, input port.AttachmentUploadInput) error {
+	n, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(n.Note.OwnerID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := attachment.ValidateUpload(input.FileName, input.ContentType, input.Size); err != nil {
+		return err
+	}
+	if input.SectionID != nil && !hasSection(n.Sections, *input.SectionID) {
+		return domainerr.ErrNotFound
+	}
+
+	// read one byte past the limit so an understated size is still rejected
+	key, size, err := u.blobs.Put(ctx, io.LimitReader(input.Content, attachment.MaxSize+1))
+	if err != nil {
+		return err
+	}
+	if size > attachment.MaxSize {
+		return errors.Join(domainerr.ErrAttachmentTooLarge, u.blobs.Delete(ctx, key))
+	}
+	created, err := u.attachments.Create(ctx, attachment.Attachment{
+		NoteID:      n.Note.ID,
+		SectionID:   input.SectionID,
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		Size:        size,
+		StorageKey:  key,
+	})
+	if err != nil {
+		return errors.Join(err, u.blobs.Delete(ctx, key))
+	}
+	return u.output.PresentAttachment(ctx, created)
+}
+
+// List returns attachments of a note visible to the viewer.
+func (u *AttachmentInteractor) List(ctx context.Context, noteID, viewerID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteVisibility(n.Note, viewerID); err != nil {
+		return err
+	}
+	attachments, err := u.attachments.ListByNote(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentAttachmentList(ctx, attachments)
+}
+
+// Download opens attachment content visible to the viewer.
+func (u *AttachmentInteractor) Download(ctx context.Context, noteID, id, viewerID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteVisibility(n.Note, viewerID); err != nil {
+		return err
+	}
+	a, err := u.getForNote(ctx, noteID, id)
+	if err != nil {
+		return err
+	}
+	content, err := u.blobs.Open(ctx, a.StorageKey)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentAttachmentContent(ctx, a, content)
+}
+
+// Delete removes an attachment and its content.
+func (u *AttachmentInteractor) Delete(ctx context.Context, noteID, id, ownerID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(n.Note.OwnerID, ownerID); err != nil {
+		return err
+	}
+	a, err := u.getForNote(ctx, noteID, id)
+	if err != nil {
+		return err
+	}
+	if err := u.attachments.Delete(ctx, a.ID); err != nil {
+		return err
+	}
+	// The row is gone, so the attachment is deleted for the caller; a failed blob delete only leaves an orphan behind.
+	if err := u.blobs.Delete(ctx, a.StorageKey); err != nil {
+		log.Printf("delete orphaned attachment blob %s failed: %v\n", a.StorageKey, err)
+	}
+	return u.output.PresentAttachmentDeleted(ctx)
+}
+
+func (u *AttachmentInteractor) getForNote(ctx context.Context, noteID, id string) (*attachment.Attachment, error) {
+	a, err := u.attachments.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.NoteID != noteID {
+		return nil, domainerr.ErrNotFound
+	}
+	return a, nil
+}
+
+func hasSection(sections []note.SectionWithField, sectionID string) bool {
+	for _, s := range sections {
+		if s.Section.ID == sectionID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestAttachmentInteractor_Upload(t *testing.T) {
+	current := &note.WithMeta{
+		Note:     note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusDraft},
+		Sections: []note.SectionWithField{{Section: note.Section{ID: "sec-1", FieldID: "f1"}}},
+	}
+	tests := []struct {
+		name       string
+		input      port.AttachmentUploadInput
+		expectPut  bool
+		putSize    int64
+		expectSave bool
+		saveErr    error
+		expectDrop bool
+		wantError  error
+	}{
+		{
+			name:       "[Success] upload to section",
+			input:      port.AttachmentUploadInput{NoteID: "note-1", OwnerID: "owner-1", SectionID: strPtr("sec-1"), FileName: "a.png", ContentType: "image/png", Size: 3},
+			expectPut:  true,
+			putSize:    3,
+			expectSave: true,
+		},
+		{
+			name:      "[Fail] owner mismatch",
+			input:     port.AttachmentUploadInput{NoteID: "note-1", OwnerID: "other", FileName: "a.png", ContentType: "image/png", Size: 3},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] unsupported content type",
+			input:     port.AttachmentUploadInput{NoteID: "note-1", OwnerID: "owner-1", FileName: "a.exe", ContentType: "application/x-msdownload", Size: 3},
+			wantError: domainerr.ErrUnsupportedContentType,
+		},
+		{
+			name:      "[Fail] unknown section",
+			input:     port.AttachmentUploadInput{NoteID: "note-1", OwnerID: "owner-1", SectionID: strPtr("sec-x"), FileName: "a.png", ContentType: "image/png", Size: 3},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:       "[Fail] content exceeds limit",
+			input:      port.AttachmentUploadInput{NoteID: "note-1", OwnerID: "owner-1", FileName: "a.png", ContentType: "image/png", Size: 3},
+			expectPut:  true,
+			putSize:    attachment.MaxSize + 1,
+			expectDrop: true,
+			wantError:  domainerr.ErrAttachmentTooLarge,
+		},
+		{
+			name:       "[Fail] save error removes blob",
+			input:      port.AttachmentUploadInput{NoteID: "note-1", OwnerID: "owner-1", FileName: "a.png", ContentType: "image/png", Size: 3},
+			expectPut:  true,
+			putSize:    3,
+			expectSave: true,
+			saveErr:    errors.New("save err"),
+			expectDrop: true,
+			wantError:  errors.New("save err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			blobs := mockusecase.NewMockBlobStore(ctrl)
+			out := mockusecase.NewMockAttachmentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "note-1").Return(current, nil)
+			if tt.expectPut {
+				blobs.EXPECT().Put(gomock.Any(), gomock.Any()).Return("key-1", tt.putSize, nil)
+			}
+			if tt.expectSave {
+				attachments.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a attachment.Attachment) (*attachment.Attachment, error) {
+					if a.StorageKey != "key-1" || a.Size != tt.putSize {
+						t.Fatalf("unexpected attachment: %+v", a)
+					}
+					a.ID = "att-1"
+					return &a, tt.saveErr
+				})
+			}
+			if tt.expectDrop {
+				blobs.EXPECT().Delete(gomock.Any(), "key-1").Return(nil)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentAttachment(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			tt.input.Content = strings.NewReader("abc")
+			interactor := uc.NewAttachmentInteractor(attachments, notes, blobs, out)
+			err := interactor.Upload(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || !strings.Contains(err.Error(), tt.wantError.Error())) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    note.NoteStatus
+		viewerID  string
+		wantError error
+	}{
+		{name: "[Success] published note", status: note.StatusPublish},
+		{name: "[Success] draft for owner", status: note.StatusDraft, viewerID: "owner-1"},
+		{name: "[Fail] draft for other", status: note.StatusDraft, viewerID: "other", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockAttachmentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: tt.status}}, nil)
+			if tt.wantError == nil {
+				attachments.EXPECT().ListByNote(gomock.Any(), "note-1").Return([]attachment.Attachment{{ID: "att-1"}}, nil)
+				out.EXPECT().PresentAttachmentList(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewAttachmentInteractor(attachments, notes, nil, out)
+			err := interactor.List(context.Background(), "note-1", tt.viewerID)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_Download(t *testing.T) {
+	tests := []struct {
+		name      string
+		found     *attachment.Attachment
+		wantError error
+	}{
+		{name: "[Success] download", found: &attachment.Attachment{ID: "att-1", NoteID: "note-1", StorageKey: "key-1"}},
+		{name: "[Fail] attachment of other note", found: &attachment.Attachment{ID: "att-1", NoteID: "note-2", StorageKey: "key-1"}, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			blobs := mockusecase.NewMockBlobStore(ctrl)
+			out := mockusecase.NewMockAttachmentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Status: note.StatusPublish}}, nil)
+			attachments.EXPECT().Get(gomock.Any(), "att-1").Return(tt.found, nil)
+			if tt.wantError == nil {
+				blobs.EXPECT().Open(gomock.Any(), "key-1").Return(io.NopCloser(bytes.NewBufferString("abc")), nil)
+				out.EXPECT().PresentAttachmentContent(gomock.Any(), tt.found, gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewAttachmentInteractor(attachments, notes, blobs, out)
+			err := interactor.Download(context.Background(), "note-1", "att-1", "")
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownerID   string
+		blobErr   error
+		wantError error
+	}{
+		{name: "[Success] delete", ownerID: "owner-1"},
+		{name: "[Success] blob delete error does not fail the delete", ownerID: "owner-1", blobErr: errors.New("disk error")},
+		{name: "[Fail] owner mismatch", ownerID: "other", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			blobs := mockusecase.NewMockBlobStore(ctrl)
+			out := mockusecase.NewMockAttachmentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}}, nil)
+			if tt.wantError == nil {
+				attachments.EXPECT().Get(gomock.Any(), "att-1").Return(&attachment.Attachment{ID: "att-1", NoteID: "note-1", StorageKey: "key-1"}, nil)
+				attachments.EXPECT().Delete(gomock.Any(), "att-1").Return(nil)
+				blobs.EXPECT().Delete(gomock.Any(), "key-1").Return(tt.blobErr)
+				out.EXPECT().PresentAttachmentDeleted(gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewAttachmentInteractor(attachments, notes, blobs, out)
+			err := interactor.Delete(context.Background(), "note-1", "att-1", tt.ownerID)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// collabSweepInterval is how often a session checks for locks that were not renewed.
+const collabSweepInterval = 5 * time.Second
+
+// CollabInteractor runs one connection's live editing session on a note.
+// Saves go through the note use case, so they are validated and recorded like any other update.
+type CollabInteractor struct {
+	notes  port.NoteRepository
+	hub    port.CollabHub
+	saver  func(output port.NoteOutputPort) port.NoteInputPort
+	output port.CollabOutputPort
+}
+
+var _ port.CollabInputPort = (*CollabInteractor)(nil)
+
+// NewCollabInteractor creates CollabInteractor. saver builds the note use case that saves sections.
+func NewCollabInteractor(notes port.NoteRepository, hub port.CollabHub, saver func(output port.NoteOutputPort) port.NoteInputPort, output port.CollabOutputPort) *CollabInteractor {
+	return &CollabInteractor{notes: notes, hub: hub, saver: saver, output: output}
+}
+
+// Collaborate joins the session if the account may see the note and handles its commands.
+// Only the owner may lock, change and save sections; other viewers are present without editing.
+// Rejected commands are reported to the participant; only a failed write ends the session.
+func (u *CollabInteractor) Collaborate(ctx context.Context, input port.CollabInput) error {
+	n, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteVisibility(n.Note, input.AccountID); err != nil {
+		return err
+	}
+	canEdit := note.ValidateNoteOwnership(n.Note.OwnerID, input.AccountID) == nil
+
+	m := u.hub.Join(input.NoteID, input.AccountID)
+	defer u.hub.Leave(input.NoteID, m.ConnID)
+	if err := u.output.PresentCollabJoined(ctx, port.CollabJoined{
+		ConnID:       m.ConnID,
+		Note:         n,
+		Participants: m.Participants,
+		Locks:        m.Locks,
+	}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(collabSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case cmd, ok := <-input.Commands:
+			if !ok {
+				return nil
+			}
+			if err := u.handle(ctx, input, m.ConnID, canEdit, cmd); err != nil {
+				if err := u.output.PresentCollabError(ctx, err); err != nil {
+					return err
+				}
+			}
+		case e, ok := <-m.Events:
+			if !ok {
+				return nil
+			}
+			if err := u.output.PresentCollabEvent(ctx, e); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			u.hub.Expire(input.NoteID)
+		}
+	}
+}
+
+// handle runs one command. Without edit permission a viewer could hold every lock and keep the
+// owner from saving, or show others content that is never saved.
+func (u *CollabInteractor) handle(ctx context.Context, input port.CollabInput, connID string, canEdit bool, cmd port.CollabCommand) error {
+	if !canEdit && (cmd.Type == port.CollabLock || cmd.Type == port.CollabChange || cmd.Type == port.CollabSave) {
+		return domainerr.ErrUnauthorized
+	}
+	switch cmd.Type {
+	case port.CollabLock:
+		return u.hub.Lock(input.NoteID, cmd.SectionID, connID)
+	case port.CollabUnlock:
+		return u.hub.Unlock(input.NoteID, cmd.SectionID, connID)
+	case port.CollabChange:
+		return u.hub.Change(input.NoteID, cmd.SectionID, connID, cmd.Content)
+	case port.CollabSave:
+		return u.save(ctx, input, connID, cmd)
+	default:
+		return domainerr.ErrInvalidCollabCommand
+	}
+}
+
+// save writes the given sections, which the connection must hold locks for, over the note's current
+// content so sections other participants saved meanwhile are kept.
+func (u *CollabInteractor) save(ctx context.Context, input port.CollabInput, connID string, cmd port.CollabCommand) error {
+	if len(cmd.Sections) == 0 && strings.TrimSpace(cmd.Title) == "" {
+		return domainerr.ErrInvalidCollabCommand
+	}
+	edited := make(map[string]string, len(cmd.Sections))
+	for _, s := range cmd.Sections {
+		if !u.hub.Holds(input.NoteID, s.SectionID, connID) {
+			return domainerr.ErrSectionNotLocked
+		}
+		edited[s.SectionID] = s.Content
+	}
+
+	current, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	sections := make([]port.SectionUpdateInput, 0, len(current.Sections))
+	for _, s := range current.Sections {
+		content := s.Section.Content
+		if c, ok := edited[s.Section.ID]; ok {
+			content = c
+			delete(edited, s.Section.ID)
+		}
+		sections = append(sections, port.SectionUpdateInput{SectionID: s.Section.ID, Content: content})
+	}
+	if len(edited) > 0 {
+		return domainerr.ErrSectionsMissing
+	}
+	title := cmd.Title
+	if strings.TrimSpace(title) == "" {
+		title = current.Note.Title
+	}
+
+	saved := &savedNote{}
+	if err := u.saver(saved).Update(ctx, port.NoteUpdateInput{
+		ID:       input.NoteID,
+		Title:    title,
+		OwnerID:  input.AccountID,
+		Sections: sections,
+	}); err != nil {
+		return err
+	}
+	u.hub.Broadcast(input.NoteID, collab.Event{
+		Type:      collab.EventSaved,
+		NoteID:    input.NoteID,
+		ConnID:    connID,
+		AccountID: input.AccountID,
+		Note:      saved.note,
+	})
+	return nil
+}
+
+// savedNote captures the note the note use case presents after a save.
+type savedNote struct {
+	note *note.WithMeta
+}
+
+var _ port.NoteOutputPort = (*savedNote)(nil)
+
+func (s *savedNote) PresentNoteList(context.Context, []note.WithMeta) error { return nil }
+
+func (s *savedNote) PresentNote(_ context.Context, n *note.WithMeta) error {
+	s.note = n
+	return nil
+}
+
+func (s *savedNote) PresentNoteDeleted(context.Context) error { return nil }
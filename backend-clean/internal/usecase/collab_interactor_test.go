@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func collabNote(status note.NoteStatus) *note.WithMeta {
+	return &note.WithMeta{
+		Note: note.Note{ID: "note-1", Title: "Minutes", OwnerID: "owner-1", Status: status},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "s1", Content: "old one"}},
+			{Section: note.Section{ID: "s2", Content: "old two"}},
+		},
+	}
+}
+
+func TestCollabInteractor_Collaborate(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		status     note.NoteStatus
+		commands   []port.CollabCommand
+		setup      func(hub *mockusecase.MockCollabHub, notes *mockusecase.MockNoteRepository, saver *mockusecase.MockNoteInputPort)
+		wantErrors []error
+		wantErr    error
+	}{
+		{
+			name:      "[Success] lock change unlock",
+			accountID: "owner-1",
+			status:    note.StatusDraft,
+			commands: []port.CollabCommand{
+				{Type: port.CollabLock, SectionID: "s1"},
+				{Type: port.CollabChange, SectionID: "s1", Content: "typing"},
+				{Type: port.CollabUnlock, SectionID: "s1"},
+			},
+			setup: func(hub *mockusecase.MockCollabHub, _ *mockusecase.MockNoteRepository, _ *mockusecase.MockNoteInputPort) {
+				hub.EXPECT().Lock("note-1", "s1", "conn-1").Return(nil)
+				hub.EXPECT().Change("note-1", "s1", "conn-1", "typing").Return(nil)
+				hub.EXPECT().Unlock("note-1", "s1", "conn-1").Return(nil)
+			},
+		},
+		{
+			name:      "[Success] save merges held sections into current content",
+			accountID: "owner-1",
+			status:    note.StatusDraft,
+			commands: []port.CollabCommand{
+				{Type: port.CollabSave, Sections: []port.SectionUpdateInput{{SectionID: "s2", Content: "new two"}}},
+			},
+			setup: func(hub *mockusecase.MockCollabHub, notes *mockusecase.MockNoteRepository, saver *mockusecase.MockNoteInputPort) {
+				hub.EXPECT().Holds("note-1", "s2", "conn-1").Return(true)
+				notes.EXPECT().Get(gomock.Any(), "note-1").Return(collabNote(note.StatusDraft), nil)
+				saver.EXPECT().Update(gomock.Any(), port.NoteUpdateInput{
+					ID:      "note-1",
+					Title:   "Minutes",
+					OwnerID: "owner-1",
+					Sections: []port.SectionUpdateInput{
+						{SectionID: "s1", Content: "old one"},
+						{SectionID: "s2", Content: "new two"},
+					},
+				}).Return(nil)
+				hub.EXPECT().Broadcast("note-1", gomock.Any()).Do(func(_ string, e collab.Event) {
+					if e.Type != collab.EventSaved || e.ConnID != "conn-1" || e.AccountID != "owner-1" {
+						t.Errorf("unexpected saved event: %+v", e)
+					}
+				})
+			},
+		},
+		{
+			name:      "[Fail] rejected commands are reported and the session continues",
+			accountID: "owner-1",
+			status:    note.StatusPublish,
+			commands: []port.CollabCommand{
+				{Type: port.CollabLock, SectionID: "s1"},
+				{Type: port.CollabSave, Sections: []port.SectionUpdateInput{{SectionID: "s1", Content: "x"}}},
+				{Type: "shout"},
+				{Type: port.CollabSave},
+			},
+			setup: func(hub *mockusecase.MockCollabHub, _ *mockusecase.MockNoteRepository, _ *mockusecase.MockNoteInputPort) {
+				hub.EXPECT().Lock("note-1", "s1", "conn-1").Return(domainerr.ErrSectionLocked)
+				hub.EXPECT().Holds("note-1", "s1", "conn-1").Return(false)
+			},
+			wantErrors: []error{domainerr.ErrSectionLocked, domainerr.ErrSectionNotLocked, domainerr.ErrInvalidCollabCommand, domainerr.ErrInvalidCollabCommand},
+		},
+		{
+			name:      "[Fail] viewer of a published note may not lock, change or save",
+			accountID: "viewer-1",
+			status:    note.StatusPublish,
+			commands: []port.CollabCommand{
+				{Type: port.CollabLock, SectionID: "s1"},
+				{Type: port.CollabChange, SectionID: "s1", Content: "fake"},
+				{Type: port.CollabSave, Title: "Renamed"},
+			},
+			wantErrors: []error{domainerr.ErrUnauthorized, domainerr.ErrUnauthorized, domainerr.ErrUnauthorized},
+		},
+		{
+			name:      "[Fail] draft of another account",
+			accountID: "viewer-1",
+			status:    note.StatusDraft,
+			wantErr:   domainerr.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			hub := mockusecase.NewMockCollabHub(ctrl)
+			saver := mockusecase.NewMockNoteInputPort(ctrl)
+			out := mockusecase.NewMockCollabOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "note-1").Return(collabNote(tt.status), nil)
+			joined := tt.wantErr == nil
+			events := make(chan collab.Event)
+			hub.EXPECT().Join("note-1", tt.accountID).Return(port.CollabMembership{ConnID: "conn-1", Events: events}).Times(b2i(joined))
+			hub.EXPECT().Leave("note-1", "conn-1").Times(b2i(joined))
+			hub.EXPECT().Expire(gomock.Any()).AnyTimes()
+			out.EXPECT().PresentCollabJoined(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, j port.CollabJoined) error {
+				if j.ConnID != "conn-1" || j.Note.Note.ID != "note-1" {
+					t.Errorf("unexpected joined: %+v", j)
+				}
+				return nil
+			}).Times(b2i(joined))
+			var gotErrors []error
+			out.EXPECT().PresentCollabError(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, err error) error {
+				gotErrors = append(gotErrors, err)
+				return nil
+			}).AnyTimes()
+			if tt.setup != nil {
+				tt.setup(hub, notes, saver)
+			}
+
+			commands := make(chan port.CollabCommand, len(tt.commands))
+			for _, cmd := range tt.commands {
+				commands <- cmd
+			}
+			close(commands)
+
+			saverFactory := func(port.NoteOutputPort) port.NoteInputPort { return saver }
+			err := uc.NewCollabInteractor(notes, hub, saverFactory, out).Collaborate(context.Background(), port.CollabInput{
+				NoteID:    "note-1",
+				AccountID: tt.accountID,
+				Commands:  commands,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if len(gotErrors) != len(tt.wantErrors) {
+				t.Fatalf("want errors %v, got %v", tt.wantErrors, gotErrors)
+			}
+			for i := range gotErrors {
+				if !errors.Is(gotErrors[i], tt.wantErrors[i]) {
+					t.Fatalf("want errors %v, got %v", tt.wantErrors, gotErrors)
+				}
+			}
+		})
+	}
+}
+
+func TestCollabInteractor_WriteErrorEndsSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notes := mockusecase.NewMockNoteRepository(ctrl)
+	hub := mockusecase.NewMockCollabHub(ctrl)
+	out := mockusecase.NewMockCollabOutputPort(ctrl)
+	writeErr := errors.New("broken pipe")
+
+	events := make(chan collab.Event, 1)
+	events <- collab.Event{Type: collab.EventPresence}
+	notes.EXPECT().Get(gomock.Any(), "note-1").Return(collabNote(note.StatusPublish), nil)
+	hub.EXPECT().Join("note-1", "viewer-1").Return(port.CollabMembership{ConnID: "conn-1", Events: events})
+	hub.EXPECT().Leave("note-1", "conn-1")
+	out.EXPECT().PresentCollabJoined(gomock.Any(), gomock.Any()).Return(nil)
+	out.EXPECT().PresentCollabEvent(gomock.Any(), gomock.Any()).Return(writeErr)
+
+	err := uc.NewCollabInteractor(notes, hub, nil, out).Collaborate(context.Background(), port.CollabInput{
+		NoteID:    "note-1",
+		AccountID: "viewer-1",
+		Commands:  make(chan port.CollabCommand),
+	})
+	if !errors.Is(err, writeErr) {
+		t.Fatalf("want %v, got %v", writeErr, err)
+	}
+}
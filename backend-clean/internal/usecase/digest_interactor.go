@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// DigestInteractor handles digest settings use cases.
+type DigestInteractor struct {
+	repo        port.DigestRepository
+	unsubscribe digest.Unsubscribe
+	output      port.DigestOutputPort
+}
+
+var _ port.DigestInputPort = (*DigestInteractor)(nil)
+
+// NewDigestInteractor creates DigestInteractor; unsubscribe verifies the links sent in digests.
+func NewDigestInteractor(repo port.DigestRepository, unsubscribe digest.Unsubscribe, output port.DigestOutputPort) *DigestInteractor {
+	return &DigestInteractor{repo: repo, unsubscribe: unsubscribe, output: output}
+}
+
+// GetSettings returns how often the account receives its digest.
+func (u *DigestInteractor) GetSettings(ctx context.Context, accountID string) error {
+	settings, err := u.repo.GetSettings(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentDigestSettings(ctx, settings)
+}
+
+// UpdateSettings changes how often the account receives its digest.
+func (u *DigestInteractor) UpdateSettings(ctx context.Context, input port.DigestSettingsInput) error {
+	if err := digest.ValidateSettings(digest.Settings{AccountID: input.AccountID, Frequency: input.Frequency}); err != nil {
+		return err
+	}
+	return u.save(ctx, input.AccountID, input.Frequency)
+}
+
+// Unsubscribe turns the digest off for the account the token was issued to.
+func (u *DigestInteractor) Unsubscribe(ctx context.Context, token string) error {
+	accountID, err := u.unsubscribe.AccountID(token)
+	if err != nil {
+		return err
+	}
+	return u.save(ctx, accountID, digest.FrequencyOff)
+}
+
+func (u *DigestInteractor) save(ctx context.Context, accountID string, f digest.Frequency) error {
+	saved, err := u.repo.SaveFrequency(ctx, accountID, f)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentDigestSettings(ctx, saved)
+}
@@ -0,0 +1,97 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+var testUnsubscribe = digest.Unsubscribe{Secret: "digest-secret", BaseURL: "https://api.example.com/api/digests/unsubscribe"}
+
+func TestDigestInteractor_UpdateSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.DigestSettingsInput
+		saveErr   error
+		wantSave  bool
+		wantError error
+	}{
+		{name: "[Success] daily", input: port.DigestSettingsInput{AccountID: "acc-1", Frequency: digest.FrequencyDaily}, wantSave: true},
+		{name: "[Fail] unknown frequency", input: port.DigestSettingsInput{AccountID: "acc-1", Frequency: "hourly"}, wantError: domainerr.ErrInvalidDigestFrequency},
+		{name: "[Fail] repo error", input: port.DigestSettingsInput{AccountID: "acc-1", Frequency: digest.FrequencyOff}, saveErr: errors.New("db error"), wantSave: true, wantError: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockDigestRepository(ctrl)
+			out := mockusecase.NewMockDigestOutputPort(ctrl)
+			saved := &digest.Settings{AccountID: "acc-1", Frequency: tt.input.Frequency}
+			repo.EXPECT().SaveFrequency(gomock.Any(), "acc-1", tt.input.Frequency).Return(saved, tt.saveErr).Times(b2i(tt.wantSave))
+			out.EXPECT().PresentDigestSettings(gomock.Any(), saved).Return(nil).Times(b2i(tt.wantSave && tt.saveErr == nil))
+
+			err := uc.NewDigestInteractor(repo, testUnsubscribe, out).UpdateSettings(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || err.Error() != tt.wantError.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestDigestInteractor_Unsubscribe(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     string
+		wantSave  bool
+		wantError error
+	}{
+		{name: "[Success] signed token turns digest off", token: testUnsubscribe.Token("acc-1"), wantSave: true},
+		{name: "[Fail] forged token", token: digest.Unsubscribe{Secret: "guess"}.Token("acc-1"), wantError: domainerr.ErrInvalidUnsubscribeToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockDigestRepository(ctrl)
+			out := mockusecase.NewMockDigestOutputPort(ctrl)
+			saved := &digest.Settings{AccountID: "acc-1", Frequency: digest.FrequencyOff}
+			repo.EXPECT().SaveFrequency(gomock.Any(), "acc-1", digest.FrequencyOff).Return(saved, nil).Times(b2i(tt.wantSave))
+			out.EXPECT().PresentDigestSettings(gomock.Any(), saved).Return(nil).Times(b2i(tt.wantSave))
+
+			err := uc.NewDigestInteractor(repo, testUnsubscribe, out).Unsubscribe(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestDigestInteractor_GetSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockusecase.NewMockDigestRepository(ctrl)
+	out := mockusecase.NewMockDigestOutputPort(ctrl)
+	settings := &digest.Settings{AccountID: "acc-1", Frequency: digest.DefaultFrequency}
+	repo.EXPECT().GetSettings(gomock.Any(), "acc-1").Return(settings, nil)
+	out.EXPECT().PresentDigestSettings(gomock.Any(), settings).Return(nil)
+
+	if err := uc.NewDigestInteractor(repo, testUnsubscribe, out).GetSettings(context.Background(), "acc-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// digestBatchSize caps the digests sent per run.
+const digestBatchSize = 20
+
+// DigestSender emails the digests that are due. Each digest is claimed before it is sent so
+// several servers never send the same one, and given back when sending fails.
+type DigestSender struct {
+	digests       port.DigestRepository
+	accounts      port.AccountRepository
+	follows       port.FollowRepository
+	notifications port.NotificationRepository
+	renderer      port.DigestRenderer
+	mailer        port.Mailer
+	unsubscribe   digest.Unsubscribe
+}
+
+var _ port.DigestJobInputPort = (*DigestSender)(nil)
+
+// NewDigestSender creates DigestSender.
+func NewDigestSender(
+	digests port.DigestRepository,
+	accounts port.AccountRepository,
+	follows port.FollowRepository,
+	notifications port.NotificationRepository,
+	renderer port.DigestRenderer,
+	mailer port.Mailer,
+	unsubscribe digest.Unsubscribe,
+) *DigestSender {
+	return &DigestSender{
+		digests:       digests,
+		accounts:      accounts,
+		follows:       follows,
+		notifications: notifications,
+		renderer:      renderer,
+		mailer:        mailer,
+		unsubscribe:   unsubscribe,
+	}
+}
+
+// SendDue sends one batch of due digests and returns how many were due. Digests with nothing
+// to tell are marked sent without an email. Failed digests are given back and their errors
+// returned together after the batch; repository errors stop the batch.
+func (s *DigestSender) SendDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := s.digests.ListDue(ctx, now, digestBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	var failed []error
+	for _, settings := range due {
+		claimed, err := s.digests.Claim(ctx, settings, now)
+		if err != nil {
+			return len(due), err
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.send(ctx, settings, now); err != nil {
+			if relErr := s.digests.Release(ctx, settings, now); relErr != nil {
+				return len(due), relErr
+			}
+			failed = append(failed, fmt.Errorf("digest for account %s: %w", settings.AccountID, err))
+		}
+	}
+	return len(due), errors.Join(failed...)
+}
+
+func (s *DigestSender) send(ctx context.Context, settings digest.Settings, now time.Time) error {
+	recipient, err := s.accounts.GetByID(ctx, settings.AccountID)
+	if err != nil {
+		return err
+	}
+	items, err := s.follows.ListFeed(ctx, settings.AccountID, nil, feed.MaxPageSize)
+	if err != nil {
+		return err
+	}
+	pending, err := s.notifications.List(ctx, settings.AccountID, true, digest.SectionLimit)
+	if err != nil {
+		return err
+	}
+	drafts, err := s.digests.ListStaleDrafts(ctx, settings.AccountID, now.Add(-digest.StaleDraftAge), digest.SectionLimit)
+	if err != nil {
+		return err
+	}
+	d := digest.Digest{
+		Recipient:      *recipient,
+		Frequency:      settings.Frequency,
+		Since:          settings.Since(now),
+		Notes:          digest.NewNotes(items, settings.Since(now)),
+		Pending:        pending,
+		StaleDrafts:    drafts,
+		UnsubscribeURL: s.unsubscribe.URL(settings.AccountID),
+	}
+	if d.Empty() {
+		return nil
+	}
+	msg, err := s.renderer.Render(d)
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, msg)
+}
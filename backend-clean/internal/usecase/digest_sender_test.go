@@ -0,0 +1,93 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestDigestSender_SendDue(t *testing.T) {
+	due := digest.Settings{AccountID: "acc-1", Frequency: digest.FrequencyWeekly}
+	recent := feed.Item{SubjectID: "note-1", Kind: feed.KindNotePublished, Title: "ADR", OccurredAt: time.Now().Add(-time.Hour)}
+	tests := []struct {
+		name        string
+		claimed     bool
+		items       []feed.Item
+		drafts      []note.Note
+		sendErr     error
+		wantBuild   bool
+		wantSend    bool
+		wantRelease bool
+		wantErr     bool
+	}{
+		{name: "[Success] digest sent", claimed: true, items: []feed.Item{recent}, wantBuild: true, wantSend: true},
+		{name: "[Success] stale drafts only", claimed: true, drafts: []note.Note{{ID: "note-2"}}, wantBuild: true, wantSend: true},
+		{name: "[Success] nothing to tell is not mailed", claimed: true, wantBuild: true},
+		{name: "[Success] claimed by another worker", claimed: false},
+		{name: "[Fail] mail error gives the digest back", claimed: true, items: []feed.Item{recent}, sendErr: errors.New("smtp down"), wantBuild: true, wantSend: true, wantRelease: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			digests := mockusecase.NewMockDigestRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			follows := mockusecase.NewMockFollowRepository(ctrl)
+			notifications := mockusecase.NewMockNotificationRepository(ctrl)
+			renderer := mockusecase.NewMockDigestRenderer(ctrl)
+			mailer := mockusecase.NewMockMailer(ctrl)
+
+			digests.EXPECT().ListDue(gomock.Any(), gomock.Any(), 20).Return([]digest.Settings{due}, nil)
+			digests.EXPECT().Claim(gomock.Any(), due, gomock.Any()).Return(tt.claimed, nil)
+			accounts.EXPECT().GetByID(gomock.Any(), "acc-1").Return(&account.Account{ID: "acc-1", Email: "reader@example.com"}, nil).Times(b2i(tt.wantBuild))
+			follows.EXPECT().ListFeed(gomock.Any(), "acc-1", nil, feed.MaxPageSize).Return(tt.items, nil).Times(b2i(tt.wantBuild))
+			notifications.EXPECT().List(gomock.Any(), "acc-1", true, digest.SectionLimit).Return([]notification.Notification{}, nil).Times(b2i(tt.wantBuild))
+			digests.EXPECT().ListStaleDrafts(gomock.Any(), "acc-1", gomock.Any(), digest.SectionLimit).Return(tt.drafts, nil).Times(b2i(tt.wantBuild))
+			renderer.EXPECT().Render(gomock.Any()).DoAndReturn(func(d digest.Digest) (port.MailMessage, error) {
+				if d.Recipient.ID != "acc-1" || d.UnsubscribeURL != testUnsubscribe.URL("acc-1") || len(d.Notes) != len(tt.items) {
+					t.Errorf("unexpected digest: %+v", d)
+				}
+				return port.MailMessage{To: "reader@example.com"}, nil
+			}).Times(b2i(tt.wantSend))
+			mailer.EXPECT().Send(gomock.Any(), port.MailMessage{To: "reader@example.com"}).Return(tt.sendErr).Times(b2i(tt.wantSend))
+			digests.EXPECT().Release(gomock.Any(), due, gomock.Any()).Return(nil).Times(b2i(tt.wantRelease))
+
+			sender := uc.NewDigestSender(digests, accounts, follows, notifications, renderer, mailer, testUnsubscribe)
+			n, err := sender.SendDue(context.Background())
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if n != 1 {
+				t.Fatalf("due = %d, want 1", n)
+			}
+		})
+	}
+}
+
+func TestDigestSender_SendDueRepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	digests := mockusecase.NewMockDigestRepository(ctrl)
+	digests.EXPECT().ListDue(gomock.Any(), gomock.Any(), 20).Return([]digest.Settings{{AccountID: "acc-1"}, {AccountID: "acc-2"}}, nil)
+	digests.EXPECT().Claim(gomock.Any(), digest.Settings{AccountID: "acc-1"}, gomock.Any()).Return(false, errors.New("db error"))
+
+	sender := uc.NewDigestSender(digests, nil, nil, nil, nil, nil, testUnsubscribe)
+	if _, err := sender.SendDue(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Dispatch defaults.
+const (
+	dispatchBatchSize = 50
+	// dispatchLease hides claimed events from other dispatchers while their handlers run.
+	dispatchLease = time.Minute
+)
+
+// EventDispatcher delivers outbox events to in-process handlers at least once.
+// An event is delivered when every handler subscribed to it succeeds; otherwise it is retried
+// for all of them according to the retry policy and dead-lettered once attempts run out.
+type EventDispatcher struct {
+	outbox   port.OutboxRepository
+	policy   event.RetryPolicy
+	handlers []subscription
+}
+
+type subscription struct {
+	handler port.EventHandler
+	names   map[event.Name]bool
+}
+
+var _ port.EventDispatcherInputPort = (*EventDispatcher)(nil)
+
+// NewEventDispatcher creates EventDispatcher.
+func NewEventDispatcher(outbox port.OutboxRepository, policy event.RetryPolicy) *EventDispatcher {
+	return &EventDispatcher{outbox: outbox, policy: policy}
+}
+
+// Subscribe registers a handler for the named events, or for every event when no name is given.
+// Handlers are registered while wiring, before dispatching starts.
+func (d *EventDispatcher) Subscribe(h port.EventHandler, names ...event.Name) {
+	var filter map[event.Name]bool
+	if len(names) > 0 {
+		filter = make(map[event.Name]bool, len(names))
+		for _, n := range names {
+			filter[n] = true
+		}
+	}
+	d.handlers = append(d.handlers, subscription{handler: h, names: filter})
+}
+
+// DispatchPending delivers one batch of due events and returns how many were claimed.
+// Handler failures are recorded on the event; only outbox errors are returned.
+func (d *EventDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	deliveries, err := d.outbox.Claim(ctx, dispatchBatchSize, dispatchLease)
+	if err != nil {
+		return 0, err
+	}
+	for _, delivery := range deliveries {
+		if err := d.deliver(ctx, delivery); err != nil {
+			return len(deliveries), err
+		}
+	}
+	return len(deliveries), nil
+}
+
+func (d *EventDispatcher) deliver(ctx context.Context, delivery event.Delivery) error {
+	var errs []error
+	for _, s := range d.handlers {
+		if s.names != nil && !s.names[delivery.Event.Name] {
+			continue
+		}
+		errs = append(errs, s.handler.Handle(ctx, delivery.Event))
+	}
+	handleErr := errors.Join(errs...)
+	if handleErr == nil {
+		return d.outbox.MarkDelivered(ctx, delivery.Event.ID)
+	}
+	delay, dead := d.policy.Next(delivery.Attempts)
+	if dead {
+		return d.outbox.MarkDead(ctx, delivery.Event.ID, handleErr.Error())
+	}
+	return d.outbox.Reschedule(ctx, delivery.Event.ID, delay, handleErr.Error())
+}
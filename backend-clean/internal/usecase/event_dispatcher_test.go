@@ -0,0 +1,87 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestEventDispatcher_DispatchPending(t *testing.T) {
+	policy := event.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	created := event.Delivery{Event: event.Event{ID: "ev-1", Name: event.NoteCreated}, Attempts: 1}
+	tests := []struct {
+		name        string
+		deliveries  []event.Delivery
+		claimErr    error
+		allErr      error
+		notesErr    error
+		wantOutcome string
+		wantDelay   time.Duration
+		wantCount   int
+		wantErr     bool
+	}{
+		{name: "[Success] delivered to every subscribed handler", deliveries: []event.Delivery{created}, wantOutcome: "delivered", wantCount: 1},
+		{name: "[Success] nothing due", wantCount: 0},
+		{
+			name:        "[Success] failed handler reschedules with backoff",
+			deliveries:  []event.Delivery{{Event: created.Event, Attempts: 2}},
+			notesErr:    errors.New("index down"),
+			wantOutcome: "rescheduled",
+			wantDelay:   2 * time.Second,
+			wantCount:   1,
+		},
+		{
+			name:        "[Success] dead-lettered after max attempts",
+			deliveries:  []event.Delivery{{Event: created.Event, Attempts: 3}},
+			allErr:      errors.New("webhook down"),
+			wantOutcome: "dead",
+			wantCount:   1,
+		},
+		{name: "[Fail] claim error", claimErr: errors.New("db down"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			outbox := mockusecase.NewMockOutboxRepository(ctrl)
+			all := mockusecase.NewMockEventHandler(ctrl)
+			notes := mockusecase.NewMockEventHandler(ctrl)
+			templates := mockusecase.NewMockEventHandler(ctrl)
+
+			outbox.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.deliveries, tt.claimErr)
+			for _, d := range tt.deliveries {
+				all.EXPECT().Handle(gomock.Any(), d.Event).Return(tt.allErr)
+				notes.EXPECT().Handle(gomock.Any(), d.Event).Return(tt.notesErr)
+			}
+			switch tt.wantOutcome {
+			case "delivered":
+				outbox.EXPECT().MarkDelivered(gomock.Any(), "ev-1").Return(nil)
+			case "rescheduled":
+				outbox.EXPECT().Reschedule(gomock.Any(), "ev-1", tt.wantDelay, "index down").Return(nil)
+			case "dead":
+				outbox.EXPECT().MarkDead(gomock.Any(), "ev-1", "webhook down").Return(nil)
+			}
+
+			d := uc.NewEventDispatcher(outbox, policy)
+			d.Subscribe(all)
+			d.Subscribe(notes, event.NoteCreated, event.NoteUpdated)
+			d.Subscribe(templates, event.TemplateChanged)
+			got, err := d.DispatchPending(context.Background())
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.wantCount {
+				t.Fatalf("count = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
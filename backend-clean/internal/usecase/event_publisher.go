@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// EventPublisher forwards dispatched events to live subscribers of an EventBus.
+type EventPublisher struct {
+	bus port.EventBus
+}
+
+var _ port.EventHandler = (*EventPublisher)(nil)
+
+// NewEventPublisher creates EventPublisher.
+func NewEventPublisher(bus port.EventBus) *EventPublisher {
+	return &EventPublisher{bus: bus}
+}
+
+// Handle publishes the event; the bus ignores events it has already seen.
+func (p *EventPublisher) Handle(_ context.Context, e event.Event) error {
+	p.bus.Publish(e)
+	return nil
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// FeedEventHandler keeps the activity feed in step with note and template events.
+// Recording keeps the newest activity per subject, so redispatched events do not move items back.
+type FeedEventHandler struct {
+	follows port.FollowRepository
+}
+
+var _ port.EventHandler = (*FeedEventHandler)(nil)
+
+// NewFeedEventHandler creates FeedEventHandler.
+func NewFeedEventHandler(follows port.FollowRepository) *FeedEventHandler {
+	return &FeedEventHandler{follows: follows}
+}
+
+// Handle records public activity as the latest on its subject and removes subjects others can no longer see.
+func (h *FeedEventHandler) Handle(ctx context.Context, e event.Event) error {
+	item, change := feed.FromEvent(e)
+	switch change {
+	case feed.ChangeRecord:
+		return h.follows.RecordFeedItem(ctx, item)
+	case feed.ChangeRemove:
+		return h.follows.RemoveFeedItem(ctx, item.SubjectID)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestFeedEventHandler_Handle(t *testing.T) {
+	published := note.Note{ID: "note-1", Title: "ADR", OwnerID: "author", Status: note.StatusPublish}
+	draft := published
+	draft.Status = note.StatusDraft
+	tests := []struct {
+		name       string
+		event      event.Event
+		recordErr  error
+		wantRecord int
+		wantRemove int
+		wantErr    bool
+	}{
+		{name: "[Success] record published note", event: event.NewNoteStatusChanged(published, "author"), wantRecord: 1},
+		{name: "[Success] remove unpublished note", event: event.NewNoteStatusChanged(draft, "author"), wantRemove: 1},
+		{name: "[Success] ignore draft update", event: event.NewNoteUpdated(draft, "author")},
+		{name: "[Success] ignore account events", event: event.Event{Name: event.AccountRegistered}},
+		{name: "[Success] record public template", event: event.NewTemplateChanged(template.Template{ID: "tpl-1", OwnerID: "author", Visibility: template.VisibilityPublic}, "author", event.TemplateCreated), wantRecord: 1},
+		{name: "[Fail] record error", event: event.NewNoteUpdated(published, "author"), recordErr: errors.New("db error"), wantRecord: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			follows := mockusecase.NewMockFollowRepository(ctrl)
+			follows.EXPECT().RecordFeedItem(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item feed.Item) error {
+				if item.SubjectID != tt.event.AggregateID || item.AuthorID != "author" {
+					t.Fatalf("unexpected item: %+v", item)
+				}
+				return tt.recordErr
+			}).Times(tt.wantRecord)
+			follows.EXPECT().RemoveFeedItem(gomock.Any(), tt.event.AggregateID).Return(nil).Times(tt.wantRemove)
+
+			err := uc.NewFeedEventHandler(follows).Handle(context.Background(), tt.event)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// FollowInteractor handles follow and activity feed use cases.
+type FollowInteractor struct {
+	follows  port.FollowRepository
+	accounts port.AccountRepository
+	output   port.FollowOutputPort
+}
+
+var _ port.FollowInputPort = (*FollowInteractor)(nil)
+
+// NewFollowInteractor creates FollowInteractor.
+func NewFollowInteractor(follows port.FollowRepository, accounts port.AccountRepository, output port.FollowOutputPort) *FollowInteractor {
+	return &FollowInteractor{follows: follows, accounts: accounts, output: output}
+}
+
+// Follow makes the follower follow an existing account and presents it with its updated counts.
+func (u *FollowInteractor) Follow(ctx context.Context, followerID, followeeID string) error {
+	if err := account.ValidateFollow(followerID, followeeID); err != nil {
+		return err
+	}
+	if _, err := u.accounts.GetByID(ctx, followeeID); err != nil {
+		return err
+	}
+	if err := u.follows.Follow(ctx, followerID, followeeID); err != nil {
+		return err
+	}
+	return u.presentFollowee(ctx, followeeID)
+}
+
+// Unfollow stops the follower following an account and presents it with its updated counts.
+func (u *FollowInteractor) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	if err := account.ValidateFollow(followerID, followeeID); err != nil {
+		return err
+	}
+	if err := u.follows.Unfollow(ctx, followerID, followeeID); err != nil {
+		return err
+	}
+	return u.presentFollowee(ctx, followeeID)
+}
+
+// Feed returns a page of the activity of the accounts the account follows, newest first.
+func (u *FollowInteractor) Feed(ctx context.Context, input port.FeedInput) error {
+	size, err := feed.PageSize(input.Limit)
+	if err != nil {
+		return err
+	}
+	after, err := feed.ParseCursor(input.Cursor)
+	if err != nil {
+		return err
+	}
+	// One extra item tells whether another page follows.
+	items, err := u.follows.ListFeed(ctx, input.AccountID, after, size+1)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentFeed(ctx, feed.NewPage(items, size))
+}
+
+func (u *FollowInteractor) presentFollowee(ctx context.Context, followeeID string) error {
+	followee, err := u.accounts.GetByID(ctx, followeeID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentFollowee(ctx, followee)
+}
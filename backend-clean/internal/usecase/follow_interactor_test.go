@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestFollowInteractor_Follow(t *testing.T) {
+	followee := &account.Account{ID: "author", FollowerCount: 1}
+	tests := []struct {
+		name       string
+		followerID string
+		getErr     error
+		followErr  error
+		wantFollow bool
+		wantErr    error
+	}{
+		{name: "[Success] follow", followerID: "reader", wantFollow: true},
+		{name: "[Fail] follow self", followerID: "author", wantErr: domainerr.ErrCannotFollowSelf},
+		{name: "[Fail] followee not found", followerID: "reader", getErr: domainerr.ErrNotFound, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] repo error", followerID: "reader", followErr: errors.New("db error"), wantFollow: true, wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			follows := mockusecase.NewMockFollowRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			out := mockusecase.NewMockFollowOutputPort(ctrl)
+			valid := tt.wantErr != domainerr.ErrCannotFollowSelf
+			presented := tt.wantErr == nil
+			accounts.EXPECT().GetByID(gomock.Any(), "author").Return(followee, tt.getErr).Times(b2i(valid) + b2i(presented))
+			follows.EXPECT().Follow(gomock.Any(), tt.followerID, "author").Return(tt.followErr).Times(b2i(tt.wantFollow))
+			out.EXPECT().PresentFollowee(gomock.Any(), followee).Return(nil).Times(b2i(presented))
+
+			err := uc.NewFollowInteractor(follows, accounts, out).Follow(context.Background(), tt.followerID, "author")
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFollowInteractor_Unfollow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	follows := mockusecase.NewMockFollowRepository(ctrl)
+	accounts := mockusecase.NewMockAccountRepository(ctrl)
+	out := mockusecase.NewMockFollowOutputPort(ctrl)
+	followee := &account.Account{ID: "author"}
+	follows.EXPECT().Unfollow(gomock.Any(), "reader", "author").Return(nil)
+	accounts.EXPECT().GetByID(gomock.Any(), "author").Return(followee, nil)
+	out.EXPECT().PresentFollowee(gomock.Any(), followee).Return(nil)
+
+	if err := uc.NewFollowInteractor(follows, accounts, out).Unfollow(context.Background(), "reader", "author"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestFollowInteractor_Feed(t *testing.T) {
+	now := time.Now()
+	items := []feed.Item{
+		{SubjectID: "note-1", OccurredAt: now},
+		{SubjectID: "note-2", OccurredAt: now.Add(-time.Minute)},
+		{SubjectID: "tpl-1", OccurredAt: now.Add(-2 * time.Minute)},
+	}
+	cursor := feed.Cursor{OccurredAt: now, SubjectID: "note-0"}.Encode()
+	tests := []struct {
+		name      string
+		input     port.FeedInput
+		listed    []feed.Item
+		wantLimit int
+		wantItems int
+		wantNext  bool
+		wantErr   error
+	}{
+		{name: "[Success] default page size", input: port.FeedInput{AccountID: "reader"}, listed: items, wantLimit: feed.DefaultPageSize + 1, wantItems: 3},
+		{name: "[Success] more pages follow", input: port.FeedInput{AccountID: "reader", Cursor: cursor, Limit: 2}, listed: items, wantLimit: 3, wantItems: 2, wantNext: true},
+		{name: "[Fail] invalid cursor", input: port.FeedInput{AccountID: "reader", Cursor: "%%%"}, wantErr: domainerr.ErrInvalidCursor},
+		{name: "[Fail] page too large", input: port.FeedInput{AccountID: "reader", Limit: feed.MaxPageSize + 1}, wantErr: domainerr.ErrInvalidPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			follows := mockusecase.NewMockFollowRepository(ctrl)
+			out := mockusecase.NewMockFollowOutputPort(ctrl)
+			ok := tt.wantErr == nil
+			follows.EXPECT().ListFeed(gomock.Any(), "reader", gomock.Any(), tt.wantLimit).DoAndReturn(func(_ context.Context, _ string, after *feed.Cursor, _ int) ([]feed.Item, error) {
+				if (after != nil) != (tt.input.Cursor != "") {
+					t.Fatalf("unexpected cursor: %+v", after)
+				}
+				return tt.listed, nil
+			}).Times(b2i(ok))
+			out.EXPECT().PresentFeed(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, page feed.Page) error {
+				if len(page.Items) != tt.wantItems || (page.NextCursor != "") != tt.wantNext {
+					t.Fatalf("unexpected page: %+v", page)
+				}
+				return nil
+			}).Times(b2i(ok))
+
+			err := uc.NewFollowInteractor(follows, nil, out).Feed(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
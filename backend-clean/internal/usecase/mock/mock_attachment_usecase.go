@@ -0,0 +1,230 @@
+package mockusecase
+
+import (
+	"context"
+	"io"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+)
+
+// MockAttachmentRepository is a mock of port.AttachmentRepository.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+}
+
+// MockAttachmentRepositoryMockRecorder records invocations.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockAttachmentRepository) Create(ctx context.Context, a attachment.Attachment) (*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, a)
+	res0, _ := ret[0].(*attachment.Attachment)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockAttachmentRepositoryMockRecorder) Create(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachmentRepository)(nil).Create), ctx, a)
+}
+
+func (m *MockAttachmentRepository) Get(ctx context.Context, id string) (*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*attachment.Attachment)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockAttachmentRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAttachmentRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockAttachmentRepository) ListByNote(ctx context.Context, noteID string) ([]attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByNote", ctx, noteID)
+	res0, _ := ret[0].([]attachment.Attachment)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockAttachmentRepositoryMockRecorder) ListByNote(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByNote", reflect.TypeOf((*MockAttachmentRepository)(nil).ListByNote), ctx, noteID)
+}
+
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAttachmentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentRepository)(nil).Delete), ctx, id)
+}
+
+func (m *MockAttachmentRepository) UpdateSection(ctx context.Context, id, sectionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSection", ctx, id, sectionID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAttachmentRepositoryMockRecorder) UpdateSection(ctx, id, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSection", reflect.TypeOf((*MockAttachmentRepository)(nil).UpdateSection), ctx, id, sectionID)
+}
+
+// MockBlobStore is a mock of port.BlobStore.
+type MockBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStoreMockRecorder
+}
+
+// MockBlobStoreMockRecorder records invocations.
+type MockBlobStoreMockRecorder struct {
+	mock *MockBlobStore
+}
+
+// NewMockBlobStore creates a new mock.
+func NewMockBlobStore(ctrl *gomock.Controller) *MockBlobStore {
+	mock := &MockBlobStore{ctrl: ctrl}
+	mock.recorder = &MockBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockBlobStore) EXPECT() *MockBlobStoreMockRecorder {
+	return m.recorder
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, content io.Reader) (string, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, content)
+	res0, _ := ret[0].(string)
+	res1, _ := ret[1].(int64)
+	res2, _ := ret[2].(error)
+	return res0, res1, res2
+}
+
+func (mr *MockBlobStoreMockRecorder) Put(ctx, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStore)(nil).Put), ctx, content)
+}
+
+func (m *MockBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, key)
+	res0, _ := ret[0].(io.ReadCloser)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockBlobStoreMockRecorder) Open(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockBlobStore)(nil).Open), ctx, key)
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockBlobStoreMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStore)(nil).Delete), ctx, key)
+}
+
+// MockAttachmentOutputPort is a mock of port.AttachmentOutputPort.
+type MockAttachmentOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentOutputPortMockRecorder
+}
+
+// MockAttachmentOutputPortMockRecorder records invocations.
+type MockAttachmentOutputPortMockRecorder struct {
+	mock *MockAttachmentOutputPort
+}
+
+// NewMockAttachmentOutputPort creates a new mock.
+func NewMockAttachmentOutputPort(ctrl *gomock.Controller) *MockAttachmentOutputPort {
+	mock := &MockAttachmentOutputPort{ctrl: ctrl}
+	mock.recorder = &MockAttachmentOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockAttachmentOutputPort) EXPECT() *MockAttachmentOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockAttachmentOutputPort) PresentAttachment(ctx context.Context, a *attachment.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentAttachment", ctx, a)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAttachmentOutputPortMockRecorder) PresentAttachment(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentAttachment", reflect.TypeOf((*MockAttachmentOutputPort)(nil).PresentAttachment), ctx, a)
+}
+
+func (m *MockAttachmentOutputPort) PresentAttachmentList(ctx context.Context, attachments []attachment.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentAttachmentList", ctx, attachments)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAttachmentOutputPortMockRecorder) PresentAttachmentList(ctx, attachments any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentAttachmentList", reflect.TypeOf((*MockAttachmentOutputPort)(nil).PresentAttachmentList), ctx, attachments)
+}
+
+func (m *MockAttachmentOutputPort) PresentAttachmentContent(ctx context.Context, a *attachment.Attachment, content io.ReadCloser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentAttachmentContent", ctx, a, content)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAttachmentOutputPortMockRecorder) PresentAttachmentContent(ctx, a, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentAttachmentContent", reflect.TypeOf((*MockAttachmentOutputPort)(nil).PresentAttachmentContent), ctx, a, content)
+}
+
+func (m *MockAttachmentOutputPort) PresentAttachmentDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentAttachmentDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAttachmentOutputPortMockRecorder) PresentAttachmentDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentAttachmentDeleted", reflect.TypeOf((*MockAttachmentOutputPort)(nil).PresentAttachmentDeleted), ctx)
+}
@@ -0,0 +1,327 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/collab"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockCollabHub is a mock of port.CollabHub.
+type MockCollabHub struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollabHubMockRecorder
+}
+
+// MockCollabHubMockRecorder records invocations.
+type MockCollabHubMockRecorder struct {
+	mock *MockCollabHub
+}
+
+// NewMockCollabHub creates a new mock.
+func NewMockCollabHub(ctrl *gomock.Controller) *MockCollabHub {
+	mock := &MockCollabHub{ctrl: ctrl}
+	mock.recorder = &MockCollabHubMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockCollabHub) EXPECT() *MockCollabHubMockRecorder {
+	return m.recorder
+}
+
+func (m *MockCollabHub) Join(noteID string, accountID string) port.CollabMembership {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", noteID, accountID)
+	res0, _ := ret[0].(port.CollabMembership)
+	return res0
+}
+
+func (mr *MockCollabHubMockRecorder) Join(noteID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockCollabHub)(nil).Join), noteID, accountID)
+}
+
+func (m *MockCollabHub) Leave(noteID string, connID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Leave", noteID, connID)
+}
+
+func (mr *MockCollabHubMockRecorder) Leave(noteID, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockCollabHub)(nil).Leave), noteID, connID)
+}
+
+func (m *MockCollabHub) Lock(noteID string, sectionID string, connID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", noteID, sectionID, connID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCollabHubMockRecorder) Lock(noteID, sectionID, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockCollabHub)(nil).Lock), noteID, sectionID, connID)
+}
+
+func (m *MockCollabHub) Unlock(noteID string, sectionID string, connID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", noteID, sectionID, connID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCollabHubMockRecorder) Unlock(noteID, sectionID, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockCollabHub)(nil).Unlock), noteID, sectionID, connID)
+}
+
+func (m *MockCollabHub) Change(noteID string, sectionID string, connID string, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Change", noteID, sectionID, connID, content)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCollabHubMockRecorder) Change(noteID, sectionID, connID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockCollabHub)(nil).Change), noteID, sectionID, connID, content)
+}
+
+func (m *MockCollabHub) Holds(noteID string, sectionID string, connID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Holds", noteID, sectionID, connID)
+	res0, _ := ret[0].(bool)
+	return res0
+}
+
+func (mr *MockCollabHubMockRecorder) Holds(noteID, sectionID, connID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holds", reflect.TypeOf((*MockCollabHub)(nil).Holds), noteID, sectionID, connID)
+}
+
+func (m *MockCollabHub) Expire(noteID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Expire", noteID)
+}
+
+func (mr *MockCollabHubMockRecorder) Expire(noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockCollabHub)(nil).Expire), noteID)
+}
+
+func (m *MockCollabHub) Broadcast(noteID string, e collab.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Broadcast", noteID, e)
+}
+
+func (mr *MockCollabHubMockRecorder) Broadcast(noteID, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockCollabHub)(nil).Broadcast), noteID, e)
+}
+
+// MockCollabOutputPort is a mock of port.CollabOutputPort.
+type MockCollabOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollabOutputPortMockRecorder
+}
+
+// MockCollabOutputPortMockRecorder records invocations.
+type MockCollabOutputPortMockRecorder struct {
+	mock *MockCollabOutputPort
+}
+
+// NewMockCollabOutputPort creates a new mock.
+func NewMockCollabOutputPort(ctrl *gomock.Controller) *MockCollabOutputPort {
+	mock := &MockCollabOutputPort{ctrl: ctrl}
+	mock.recorder = &MockCollabOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockCollabOutputPort) EXPECT() *MockCollabOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockCollabOutputPort) PresentCollabJoined(ctx context.Context, joined port.CollabJoined) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCollabJoined", ctx, joined)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCollabOutputPortMockRecorder) PresentCollabJoined(ctx, joined any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCollabJoined", reflect.TypeOf((*MockCollabOutputPort)(nil).PresentCollabJoined), ctx, joined)
+}
+
+func (m *MockCollabOutputPort) PresentCollabEvent(ctx context.Context, e collab.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCollabEvent", ctx, e)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCollabOutputPortMockRecorder) PresentCollabEvent(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCollabEvent", reflect.TypeOf((*MockCollabOutputPort)(nil).PresentCollabEvent), ctx, e)
+}
+
+func (m *MockCollabOutputPort) PresentCollabError(ctx context.Context, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCollabError", ctx, err)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCollabOutputPortMockRecorder) PresentCollabError(ctx, err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCollabError", reflect.TypeOf((*MockCollabOutputPort)(nil).PresentCollabError), ctx, err)
+}
+
+// MockNoteInputPort is a mock of port.NoteInputPort.
+type MockNoteInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteInputPortMockRecorder
+}
+
+// MockNoteInputPortMockRecorder records invocations.
+type MockNoteInputPortMockRecorder struct {
+	mock *MockNoteInputPort
+}
+
+// NewMockNoteInputPort creates a new mock.
+func NewMockNoteInputPort(ctrl *gomock.Controller) *MockNoteInputPort {
+	mock := &MockNoteInputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteInputPort) EXPECT() *MockNoteInputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteInputPort) List(ctx context.Context, filters note.Filters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) List(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNoteInputPort)(nil).List), ctx, filters)
+}
+
+func (m *MockNoteInputPort) ListByTemplate(ctx context.Context, templateID string, viewerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTemplate", ctx, templateID, viewerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) ListByTemplate(ctx, templateID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTemplate", reflect.TypeOf((*MockNoteInputPort)(nil).ListByTemplate), ctx, templateID, viewerID)
+}
+
+func (m *MockNoteInputPort) Get(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNoteInputPort)(nil).Get), ctx, id)
+}
+
+func (m *MockNoteInputPort) Create(ctx context.Context, input port.NoteCreateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNoteInputPort)(nil).Create), ctx, input)
+}
+
+func (m *MockNoteInputPort) Update(ctx context.Context, input port.NoteUpdateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNoteInputPort)(nil).Update), ctx, input)
+}
+
+func (m *MockNoteInputPort) ChangeStatus(ctx context.Context, input port.NoteStatusChangeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) ChangeStatus(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockNoteInputPort)(nil).ChangeStatus), ctx, input)
+}
+
+func (m *MockNoteInputPort) Delete(ctx context.Context, id string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, ownerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Delete(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNoteInputPort)(nil).Delete), ctx, id, ownerID)
+}
+
+func (m *MockNoteInputPort) Export(ctx context.Context, id string, viewerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id, viewerID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Export(ctx, id, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockNoteInputPort)(nil).Export), ctx, id, viewerID)
+}
+
+func (m *MockNoteInputPort) Upgrade(ctx context.Context, input port.NoteUpgradeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Upgrade(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockNoteInputPort)(nil).Upgrade), ctx, input)
+}
+
+func (m *MockNoteInputPort) Retemplate(ctx context.Context, input port.NoteRetemplateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retemplate", ctx, input)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteInputPortMockRecorder) Retemplate(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retemplate", reflect.TypeOf((*MockNoteInputPort)(nil).Retemplate), ctx, input)
+}
@@ -0,0 +1,219 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/digest"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockDigestRepository is a mock of port.DigestRepository.
+type MockDigestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestRepositoryMockRecorder
+}
+
+// MockDigestRepositoryMockRecorder records invocations.
+type MockDigestRepositoryMockRecorder struct {
+	mock *MockDigestRepository
+}
+
+// NewMockDigestRepository creates a new mock.
+func NewMockDigestRepository(ctrl *gomock.Controller) *MockDigestRepository {
+	mock := &MockDigestRepository{ctrl: ctrl}
+	mock.recorder = &MockDigestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockDigestRepository) EXPECT() *MockDigestRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockDigestRepository) GetSettings(ctx context.Context, accountID string) (*digest.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, accountID)
+	res0, _ := ret[0].(*digest.Settings)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockDigestRepositoryMockRecorder) GetSettings(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MockDigestRepository)(nil).GetSettings), ctx, accountID)
+}
+
+func (m *MockDigestRepository) SaveFrequency(ctx context.Context, accountID string, f digest.Frequency) (*digest.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFrequency", ctx, accountID, f)
+	res0, _ := ret[0].(*digest.Settings)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockDigestRepositoryMockRecorder) SaveFrequency(ctx, accountID, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFrequency", reflect.TypeOf((*MockDigestRepository)(nil).SaveFrequency), ctx, accountID, f)
+}
+
+func (m *MockDigestRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]digest.Settings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, now, limit)
+	res0, _ := ret[0].([]digest.Settings)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockDigestRepositoryMockRecorder) ListDue(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockDigestRepository)(nil).ListDue), ctx, now, limit)
+}
+
+func (m *MockDigestRepository) Claim(ctx context.Context, s digest.Settings, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, s, at)
+	res0, _ := ret[0].(bool)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockDigestRepositoryMockRecorder) Claim(ctx, s, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockDigestRepository)(nil).Claim), ctx, s, at)
+}
+
+func (m *MockDigestRepository) Release(ctx context.Context, s digest.Settings, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, s, at)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockDigestRepositoryMockRecorder) Release(ctx, s, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockDigestRepository)(nil).Release), ctx, s, at)
+}
+
+func (m *MockDigestRepository) ListStaleDrafts(ctx context.Context, ownerID string, before time.Time, limit int) ([]note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleDrafts", ctx, ownerID, before, limit)
+	res0, _ := ret[0].([]note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockDigestRepositoryMockRecorder) ListStaleDrafts(ctx, ownerID, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleDrafts", reflect.TypeOf((*MockDigestRepository)(nil).ListStaleDrafts), ctx, ownerID, before, limit)
+}
+
+// MockDigestOutputPort is a mock of port.DigestOutputPort.
+type MockDigestOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestOutputPortMockRecorder
+}
+
+// MockDigestOutputPortMockRecorder records invocations.
+type MockDigestOutputPortMockRecorder struct {
+	mock *MockDigestOutputPort
+}
+
+// NewMockDigestOutputPort creates a new mock.
+func NewMockDigestOutputPort(ctrl *gomock.Controller) *MockDigestOutputPort {
+	mock := &MockDigestOutputPort{ctrl: ctrl}
+	mock.recorder = &MockDigestOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockDigestOutputPort) EXPECT() *MockDigestOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockDigestOutputPort) PresentDigestSettings(ctx context.Context, s *digest.Settings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentDigestSettings", ctx, s)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockDigestOutputPortMockRecorder) PresentDigestSettings(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDigestSettings", reflect.TypeOf((*MockDigestOutputPort)(nil).PresentDigestSettings), ctx, s)
+}
+
+// MockDigestRenderer is a mock of port.DigestRenderer.
+type MockDigestRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestRendererMockRecorder
+}
+
+// MockDigestRendererMockRecorder records invocations.
+type MockDigestRendererMockRecorder struct {
+	mock *MockDigestRenderer
+}
+
+// NewMockDigestRenderer creates a new mock.
+func NewMockDigestRenderer(ctrl *gomock.Controller) *MockDigestRenderer {
+	mock := &MockDigestRenderer{ctrl: ctrl}
+	mock.recorder = &MockDigestRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockDigestRenderer) EXPECT() *MockDigestRendererMockRecorder {
+	return m.recorder
+}
+
+func (m *MockDigestRenderer) Render(d digest.Digest) (port.MailMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", d)
+	res0, _ := ret[0].(port.MailMessage)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockDigestRendererMockRecorder) Render(d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockDigestRenderer)(nil).Render), d)
+}
+
+// MockMailer is a mock of port.Mailer.
+type MockMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailerMockRecorder
+}
+
+// MockMailerMockRecorder records invocations.
+type MockMailerMockRecorder struct {
+	mock *MockMailer
+}
+
+// NewMockMailer creates a new mock.
+func NewMockMailer(ctrl *gomock.Controller) *MockMailer {
+	mock := &MockMailer{ctrl: ctrl}
+	mock.recorder = &MockMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockMailer) EXPECT() *MockMailerMockRecorder {
+	return m.recorder
+}
+
+func (m *MockMailer) Send(ctx context.Context, msg port.MailMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockMailerMockRecorder) Send(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), ctx, msg)
+}
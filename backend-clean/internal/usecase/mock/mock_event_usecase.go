@@ -0,0 +1,130 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// MockOutboxRepository is a mock of port.OutboxRepository.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+}
+
+// MockOutboxRepositoryMockRecorder records invocations.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockOutboxRepository) Append(ctx context.Context, e event.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, e)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) Append(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockOutboxRepository)(nil).Append), ctx, e)
+}
+
+func (m *MockOutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]event.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, limit, lease)
+	res0, _ := ret[0].([]event.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) Claim(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockOutboxRepository)(nil).Claim), ctx, limit, lease)
+}
+
+func (m *MockOutboxRepository) MarkDelivered(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) MarkDelivered(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockOutboxRepository)(nil).MarkDelivered), ctx, id)
+}
+
+func (m *MockOutboxRepository) Reschedule(ctx context.Context, id string, delay time.Duration, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, id, delay, lastErr)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) Reschedule(ctx, id, delay, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockOutboxRepository)(nil).Reschedule), ctx, id, delay, lastErr)
+}
+
+func (m *MockOutboxRepository) MarkDead(ctx context.Context, id, lastErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDead", ctx, id, lastErr)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockOutboxRepositoryMockRecorder) MarkDead(ctx, id, lastErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDead", reflect.TypeOf((*MockOutboxRepository)(nil).MarkDead), ctx, id, lastErr)
+}
+
+// MockEventHandler is a mock of port.EventHandler.
+type MockEventHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHandlerMockRecorder
+}
+
+// MockEventHandlerMockRecorder records invocations.
+type MockEventHandlerMockRecorder struct {
+	mock *MockEventHandler
+}
+
+// NewMockEventHandler creates a new mock.
+func NewMockEventHandler(ctrl *gomock.Controller) *MockEventHandler {
+	mock := &MockEventHandler{ctrl: ctrl}
+	mock.recorder = &MockEventHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockEventHandler) EXPECT() *MockEventHandlerMockRecorder {
+	return m.recorder
+}
+
+func (m *MockEventHandler) Handle(ctx context.Context, e event.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, e)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockEventHandlerMockRecorder) Handle(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockEventHandler)(nil).Handle), ctx, e)
+}
@@ -0,0 +1,142 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/feed"
+)
+
+// MockFollowRepository is a mock of port.FollowRepository.
+type MockFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRepositoryMockRecorder
+}
+
+// MockFollowRepositoryMockRecorder records invocations.
+type MockFollowRepositoryMockRecorder struct {
+	mock *MockFollowRepository
+}
+
+// NewMockFollowRepository creates a new mock.
+func NewMockFollowRepository(ctrl *gomock.Controller) *MockFollowRepository {
+	mock := &MockFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockFollowRepository) EXPECT() *MockFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockFollowRepository) Follow(ctx context.Context, followerID string, followeeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, followerID, followeeID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockFollowRepositoryMockRecorder) Follow(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockFollowRepository)(nil).Follow), ctx, followerID, followeeID)
+}
+
+func (m *MockFollowRepository) Unfollow(ctx context.Context, followerID string, followeeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, followerID, followeeID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockFollowRepositoryMockRecorder) Unfollow(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockFollowRepository)(nil).Unfollow), ctx, followerID, followeeID)
+}
+
+func (m *MockFollowRepository) RecordFeedItem(ctx context.Context, item feed.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFeedItem", ctx, item)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockFollowRepositoryMockRecorder) RecordFeedItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFeedItem", reflect.TypeOf((*MockFollowRepository)(nil).RecordFeedItem), ctx, item)
+}
+
+func (m *MockFollowRepository) RemoveFeedItem(ctx context.Context, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFeedItem", ctx, subjectID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockFollowRepositoryMockRecorder) RemoveFeedItem(ctx, subjectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFeedItem", reflect.TypeOf((*MockFollowRepository)(nil).RemoveFeedItem), ctx, subjectID)
+}
+
+func (m *MockFollowRepository) ListFeed(ctx context.Context, followerID string, after *feed.Cursor, limit int) ([]feed.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeed", ctx, followerID, after, limit)
+	res0, _ := ret[0].([]feed.Item)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockFollowRepositoryMockRecorder) ListFeed(ctx, followerID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeed", reflect.TypeOf((*MockFollowRepository)(nil).ListFeed), ctx, followerID, after, limit)
+}
+
+// MockFollowOutputPort is a mock of port.FollowOutputPort.
+type MockFollowOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowOutputPortMockRecorder
+}
+
+// MockFollowOutputPortMockRecorder records invocations.
+type MockFollowOutputPortMockRecorder struct {
+	mock *MockFollowOutputPort
+}
+
+// NewMockFollowOutputPort creates a new mock.
+func NewMockFollowOutputPort(ctrl *gomock.Controller) *MockFollowOutputPort {
+	mock := &MockFollowOutputPort{ctrl: ctrl}
+	mock.recorder = &MockFollowOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockFollowOutputPort) EXPECT() *MockFollowOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockFollowOutputPort) PresentFollowee(ctx context.Context, followee *account.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentFollowee", ctx, followee)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockFollowOutputPortMockRecorder) PresentFollowee(ctx, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentFollowee", reflect.TypeOf((*MockFollowOutputPort)(nil).PresentFollowee), ctx, followee)
+}
+
+func (m *MockFollowOutputPort) PresentFeed(ctx context.Context, page feed.Page) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentFeed", ctx, page)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockFollowOutputPortMockRecorder) PresentFeed(ctx, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentFeed", reflect.TypeOf((*MockFollowOutputPort)(nil).PresentFeed), ctx, page)
+}
@@ -0,0 +1,45 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteBatchOutputPort is a mock of port.NoteBatchOutputPort.
+type MockNoteBatchOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteBatchOutputPortMockRecorder
+}
+
+// MockNoteBatchOutputPortMockRecorder records invocations.
+type MockNoteBatchOutputPortMockRecorder struct {
+	mock *MockNoteBatchOutputPort
+}
+
+// NewMockNoteBatchOutputPort creates a new mock.
+func NewMockNoteBatchOutputPort(ctrl *gomock.Controller) *MockNoteBatchOutputPort {
+	mock := &MockNoteBatchOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteBatchOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteBatchOutputPort) EXPECT() *MockNoteBatchOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteBatchOutputPort) PresentNoteBatch(ctx context.Context, mode note.BatchMode, results []note.BatchItemResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteBatch", ctx, mode, results)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteBatchOutputPortMockRecorder) PresentNoteBatch(ctx, mode, results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteBatch", reflect.TypeOf((*MockNoteBatchOutputPort)(nil).PresentNoteBatch), ctx, mode, results)
+}
@@ -0,0 +1,58 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// MockNoteCSVOutputPort is a mock of port.NoteCSVOutputPort.
+type MockNoteCSVOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteCSVOutputPortMockRecorder
+}
+
+// MockNoteCSVOutputPortMockRecorder records invocations.
+type MockNoteCSVOutputPortMockRecorder struct {
+	mock *MockNoteCSVOutputPort
+}
+
+// NewMockNoteCSVOutputPort creates a new mock.
+func NewMockNoteCSVOutputPort(ctrl *gomock.Controller) *MockNoteCSVOutputPort {
+	mock := &MockNoteCSVOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteCSVOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteCSVOutputPort) EXPECT() *MockNoteCSVOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteCSVOutputPort) PresentNoteCSV(ctx context.Context, fields []template.Field, notes []note.WithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteCSV", ctx, fields, notes)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteCSVOutputPortMockRecorder) PresentNoteCSV(ctx, fields, notes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteCSV", reflect.TypeOf((*MockNoteCSVOutputPort)(nil).PresentNoteCSV), ctx, fields, notes)
+}
+
+func (m *MockNoteCSVOutputPort) PresentNoteCSVImport(ctx context.Context, results []note.CSVRowResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteCSVImport", ctx, results)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteCSVOutputPortMockRecorder) PresentNoteCSVImport(ctx, results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteCSVImport", reflect.TypeOf((*MockNoteCSVOutputPort)(nil).PresentNoteCSVImport), ctx, results)
+}
@@ -0,0 +1,81 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteImportOutputPort is a mock of port.NoteImportOutputPort.
+type MockNoteImportOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteImportOutputPortMockRecorder
+}
+
+// MockNoteImportOutputPortMockRecorder records invocations.
+type MockNoteImportOutputPortMockRecorder struct {
+	mock *MockNoteImportOutputPort
+}
+
+// NewMockNoteImportOutputPort creates a new mock.
+func NewMockNoteImportOutputPort(ctrl *gomock.Controller) *MockNoteImportOutputPort {
+	mock := &MockNoteImportOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteImportOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteImportOutputPort) EXPECT() *MockNoteImportOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteImportOutputPort) PresentNoteImport(ctx context.Context, dryRun bool, results []note.ImportResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteImport", ctx, dryRun, results)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteImportOutputPortMockRecorder) PresentNoteImport(ctx, dryRun, results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteImport", reflect.TypeOf((*MockNoteImportOutputPort)(nil).PresentNoteImport), ctx, dryRun, results)
+}
+
+// MockImportDecoder is a mock of port.ImportDecoder.
+type MockImportDecoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportDecoderMockRecorder
+}
+
+// MockImportDecoderMockRecorder records invocations.
+type MockImportDecoderMockRecorder struct {
+	mock *MockImportDecoder
+}
+
+// NewMockImportDecoder creates a new mock.
+func NewMockImportDecoder(ctrl *gomock.Controller) *MockImportDecoder {
+	mock := &MockImportDecoder{ctrl: ctrl}
+	mock.recorder = &MockImportDecoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockImportDecoder) EXPECT() *MockImportDecoderMockRecorder {
+	return m.recorder
+}
+
+func (m *MockImportDecoder) Decode(fileName string, content []byte) ([]note.ImportFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decode", fileName, content)
+	res0, _ := ret[0].([]note.ImportFile)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockImportDecoderMockRecorder) Decode(fileName, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decode", reflect.TypeOf((*MockImportDecoder)(nil).Decode), fileName, content)
+}
@@ -0,0 +1,115 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// MockEventBus is a mock of port.EventBus.
+type MockEventBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBusMockRecorder
+}
+
+// MockEventBusMockRecorder records invocations.
+type MockEventBusMockRecorder struct {
+	mock *MockEventBus
+}
+
+// NewMockEventBus creates a new mock.
+func NewMockEventBus(ctrl *gomock.Controller) *MockEventBus {
+	mock := &MockEventBus{ctrl: ctrl}
+	mock.recorder = &MockEventBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockEventBus) EXPECT() *MockEventBusMockRecorder {
+	return m.recorder
+}
+
+func (m *MockEventBus) Publish(e event.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", e)
+}
+
+func (mr *MockEventBusMockRecorder) Publish(e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventBus)(nil).Publish), e)
+}
+
+func (m *MockEventBus) Subscribe(lastID string) port.EventSubscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", lastID)
+	res0, _ := ret[0].(port.EventSubscription)
+	return res0
+}
+
+func (mr *MockEventBusMockRecorder) Subscribe(lastID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventBus)(nil).Subscribe), lastID)
+}
+
+// MockNoteStreamOutputPort is a mock of port.NoteStreamOutputPort.
+type MockNoteStreamOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteStreamOutputPortMockRecorder
+}
+
+// MockNoteStreamOutputPortMockRecorder records invocations.
+type MockNoteStreamOutputPortMockRecorder struct {
+	mock *MockNoteStreamOutputPort
+}
+
+// NewMockNoteStreamOutputPort creates a new mock.
+func NewMockNoteStreamOutputPort(ctrl *gomock.Controller) *MockNoteStreamOutputPort {
+	mock := &MockNoteStreamOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteStreamOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteStreamOutputPort) EXPECT() *MockNoteStreamOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteStreamOutputPort) PresentNoteEvent(ctx context.Context, e event.Envelope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteEvent", ctx, e)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteStreamOutputPortMockRecorder) PresentNoteEvent(ctx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteEvent", reflect.TypeOf((*MockNoteStreamOutputPort)(nil).PresentNoteEvent), ctx, e)
+}
+
+func (m *MockNoteStreamOutputPort) PresentStreamReset(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentStreamReset", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteStreamOutputPortMockRecorder) PresentStreamReset(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentStreamReset", reflect.TypeOf((*MockNoteStreamOutputPort)(nil).PresentStreamReset), ctx)
+}
+
+func (m *MockNoteStreamOutputPort) PresentHeartbeat(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentHeartbeat", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteStreamOutputPortMockRecorder) PresentHeartbeat(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentHeartbeat", reflect.TypeOf((*MockNoteStreamOutputPort)(nil).PresentHeartbeat), ctx)
+}
@@ -97,6 +97,19 @@ func (mr *MockNoteRepositoryMockRecorder) UpdateStatus(ctx, id, status any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockNoteRepository)(nil).UpdateStatus), ctx, id, status)
 }
 
+func (m *MockNoteRepository) UpdateOwner(ctx context.Context, id, ownerID string) (*note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOwner", ctx, id, ownerID)
+	res0, _ := ret[0].(*note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) UpdateOwner(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwner", reflect.TypeOf((*MockNoteRepository)(nil).UpdateOwner), ctx, id, ownerID)
+}
+
 func (m *MockNoteRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, id)
@@ -121,6 +134,57 @@ func (mr *MockNoteRepositoryMockRecorder) ReplaceSections(ctx, noteID, sections
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSections", reflect.TypeOf((*MockNoteRepository)(nil).ReplaceSections), ctx, noteID, sections)
 }
 
+func (m *MockNoteRepository) UpdateTemplateVersion(ctx context.Context, id string, version int) (*note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateVersion", ctx, id, version)
+	res0, _ := ret[0].(*note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) UpdateTemplateVersion(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersion", reflect.TypeOf((*MockNoteRepository)(nil).UpdateTemplateVersion), ctx, id, version)
+}
+
+func (m *MockNoteRepository) DeleteSections(ctx context.Context, noteID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSections", ctx, noteID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRepositoryMockRecorder) DeleteSections(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSections", reflect.TypeOf((*MockNoteRepository)(nil).DeleteSections), ctx, noteID)
+}
+
+func (m *MockNoteRepository) UpdateTemplate(ctx context.Context, id, templateID string, version int) (*note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, id, templateID, version)
+	res0, _ := ret[0].(*note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) UpdateTemplate(ctx, id, templateID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockNoteRepository)(nil).UpdateTemplate), ctx, id, templateID, version)
+}
+
+func (m *MockNoteRepository) CreateRevision(ctx context.Context, rev note.Revision) (*note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevision", ctx, rev)
+	res0, _ := ret[0].(*note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) CreateRevision(ctx, rev any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevision", reflect.TypeOf((*MockNoteRepository)(nil).CreateRevision), ctx, rev)
+}
+
 // MockNoteOutputPort is a mock of port.NoteOutputPort.
 type MockNoteOutputPort struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,82 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteWatchOutputPort is a mock of port.NoteWatchOutputPort.
+type MockNoteWatchOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteWatchOutputPortMockRecorder
+}
+
+// MockNoteWatchOutputPortMockRecorder records invocations.
+type MockNoteWatchOutputPortMockRecorder struct {
+	mock *MockNoteWatchOutputPort
+}
+
+// NewMockNoteWatchOutputPort creates a new mock.
+func NewMockNoteWatchOutputPort(ctrl *gomock.Controller) *MockNoteWatchOutputPort {
+	mock := &MockNoteWatchOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteWatchOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteWatchOutputPort) EXPECT() *MockNoteWatchOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteWatchOutputPort) PresentSnapshotNote(ctx context.Context, n note.WithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentSnapshotNote", ctx, n)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteWatchOutputPortMockRecorder) PresentSnapshotNote(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentSnapshotNote", reflect.TypeOf((*MockNoteWatchOutputPort)(nil).PresentSnapshotNote), ctx, n)
+}
+
+func (m *MockNoteWatchOutputPort) PresentSnapshotComplete(ctx context.Context, resumeToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentSnapshotComplete", ctx, resumeToken)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteWatchOutputPortMockRecorder) PresentSnapshotComplete(ctx, resumeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentSnapshotComplete", reflect.TypeOf((*MockNoteWatchOutputPort)(nil).PresentSnapshotComplete), ctx, resumeToken)
+}
+
+func (m *MockNoteWatchOutputPort) PresentNoteChange(ctx context.Context, env event.Envelope, current *note.WithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteChange", ctx, env, current)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteWatchOutputPortMockRecorder) PresentNoteChange(ctx, env, current any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteChange", reflect.TypeOf((*MockNoteWatchOutputPort)(nil).PresentNoteChange), ctx, env, current)
+}
+
+func (m *MockNoteWatchOutputPort) PresentNoteRemoved(ctx context.Context, env event.Envelope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteRemoved", ctx, env)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteWatchOutputPortMockRecorder) PresentNoteRemoved(ctx, env any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteRemoved", reflect.TypeOf((*MockNoteWatchOutputPort)(nil).PresentNoteRemoved), ctx, env)
+}
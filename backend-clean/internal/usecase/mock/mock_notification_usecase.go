@@ -0,0 +1,219 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/notification"
+)
+
+// MockNotificationRepository is a mock of port.NotificationRepository.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+}
+
+// MockNotificationRepositoryMockRecorder records invocations.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNotificationRepository) Create(ctx context.Context, n notification.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, n)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) Create(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationRepository)(nil).Create), ctx, n)
+}
+
+func (m *MockNotificationRepository) List(ctx context.Context, recipientID string, unreadOnly bool, limit int) ([]notification.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, recipientID, unreadOnly, limit)
+	res0, _ := ret[0].([]notification.Notification)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) List(ctx, recipientID, unreadOnly, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNotificationRepository)(nil).List), ctx, recipientID, unreadOnly, limit)
+}
+
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, recipientID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, recipientID)
+	res0, _ := ret[0].(int)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) CountUnread(ctx, recipientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockNotificationRepository)(nil).CountUnread), ctx, recipientID)
+}
+
+func (m *MockNotificationRepository) Get(ctx context.Context, id string) (*notification.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*notification.Notification)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNotificationRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, id string) (*notification.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, id)
+	res0, _ := ret[0].(*notification.Notification)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) MarkRead(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkRead), ctx, id)
+}
+
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, recipientID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, recipientID)
+	res0, _ := ret[0].(int)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllRead(ctx, recipientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllRead), ctx, recipientID)
+}
+
+func (m *MockNotificationRepository) GetPreferences(ctx context.Context, accountID string) (*notification.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx, accountID)
+	res0, _ := ret[0].(*notification.Preferences)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) GetPreferences(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockNotificationRepository)(nil).GetPreferences), ctx, accountID)
+}
+
+func (m *MockNotificationRepository) SavePreferences(ctx context.Context, p notification.Preferences) (*notification.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePreferences", ctx, p)
+	res0, _ := ret[0].(*notification.Preferences)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) SavePreferences(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePreferences", reflect.TypeOf((*MockNotificationRepository)(nil).SavePreferences), ctx, p)
+}
+
+// MockNotificationOutputPort is a mock of port.NotificationOutputPort.
+type MockNotificationOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationOutputPortMockRecorder
+}
+
+// MockNotificationOutputPortMockRecorder records invocations.
+type MockNotificationOutputPortMockRecorder struct {
+	mock *MockNotificationOutputPort
+}
+
+// NewMockNotificationOutputPort creates a new mock.
+func NewMockNotificationOutputPort(ctrl *gomock.Controller) *MockNotificationOutputPort {
+	mock := &MockNotificationOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNotificationOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNotificationOutputPort) EXPECT() *MockNotificationOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNotificationOutputPort) PresentNotificationList(ctx context.Context, notifications []notification.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNotificationList", ctx, notifications)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNotificationOutputPortMockRecorder) PresentNotificationList(ctx, notifications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNotificationList", reflect.TypeOf((*MockNotificationOutputPort)(nil).PresentNotificationList), ctx, notifications)
+}
+
+func (m *MockNotificationOutputPort) PresentNotification(ctx context.Context, n *notification.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNotification", ctx, n)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNotificationOutputPortMockRecorder) PresentNotification(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNotification", reflect.TypeOf((*MockNotificationOutputPort)(nil).PresentNotification), ctx, n)
+}
+
+func (m *MockNotificationOutputPort) PresentUnreadCount(ctx context.Context, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentUnreadCount", ctx, count)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNotificationOutputPortMockRecorder) PresentUnreadCount(ctx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentUnreadCount", reflect.TypeOf((*MockNotificationOutputPort)(nil).PresentUnreadCount), ctx, count)
+}
+
+func (m *MockNotificationOutputPort) PresentMarkedAllRead(ctx context.Context, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMarkedAllRead", ctx, count)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNotificationOutputPortMockRecorder) PresentMarkedAllRead(ctx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMarkedAllRead", reflect.TypeOf((*MockNotificationOutputPort)(nil).PresentMarkedAllRead), ctx, count)
+}
+
+func (m *MockNotificationOutputPort) PresentNotificationPreferences(ctx context.Context, p *notification.Preferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNotificationPreferences", ctx, p)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNotificationOutputPortMockRecorder) PresentNotificationPreferences(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNotificationPreferences", reflect.TypeOf((*MockNotificationOutputPort)(nil).PresentNotificationPreferences), ctx, p)
+}
@@ -0,0 +1,57 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// MockTemplateBundleOutputPort is a mock of port.TemplateBundleOutputPort.
+type MockTemplateBundleOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateBundleOutputPortMockRecorder
+}
+
+// MockTemplateBundleOutputPortMockRecorder records invocations.
+type MockTemplateBundleOutputPortMockRecorder struct {
+	mock *MockTemplateBundleOutputPort
+}
+
+// NewMockTemplateBundleOutputPort creates a new mock.
+func NewMockTemplateBundleOutputPort(ctrl *gomock.Controller) *MockTemplateBundleOutputPort {
+	mock := &MockTemplateBundleOutputPort{ctrl: ctrl}
+	mock.recorder = &MockTemplateBundleOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockTemplateBundleOutputPort) EXPECT() *MockTemplateBundleOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockTemplateBundleOutputPort) PresentTemplateBundle(ctx context.Context, bundle template.Bundle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentTemplateBundle", ctx, bundle)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTemplateBundleOutputPortMockRecorder) PresentTemplateBundle(ctx, bundle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTemplateBundle", reflect.TypeOf((*MockTemplateBundleOutputPort)(nil).PresentTemplateBundle), ctx, bundle)
+}
+
+func (m *MockTemplateBundleOutputPort) PresentTemplateBundleImport(ctx context.Context, results []template.BundleImportResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentTemplateBundleImport", ctx, results)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTemplateBundleOutputPortMockRecorder) PresentTemplateBundleImport(ctx, results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTemplateBundleImport", reflect.TypeOf((*MockTemplateBundleOutputPort)(nil).PresentTemplateBundleImport), ctx, results)
+}
@@ -97,16 +97,69 @@ func (mr *MockTemplateRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateRepository)(nil).Delete), ctx, id)
 }
 
-func (m *MockTemplateRepository) ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error {
+func (m *MockTemplateRepository) IsUsedByOthers(ctx context.Context, id string, ownerID string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceFields", ctx, templateID, fields)
-	res0, _ := ret[0].(error)
-	return res0
+	ret := m.ctrl.Call(m, "IsUsedByOthers", ctx, id, ownerID)
+	res0, _ := ret[0].(bool)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) IsUsedByOthers(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsedByOthers", reflect.TypeOf((*MockTemplateRepository)(nil).IsUsedByOthers), ctx, id, ownerID)
+}
+
+func (m *MockTemplateRepository) Deprecate(ctx context.Context, id string, successorID string) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deprecate", ctx, id, successorID)
+	res0, _ := ret[0].(*template.Template)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) Deprecate(ctx, id, successorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deprecate", reflect.TypeOf((*MockTemplateRepository)(nil).Deprecate), ctx, id, successorID)
+}
+
+func (m *MockTemplateRepository) Undeprecate(ctx context.Context, id string) (*template.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undeprecate", ctx, id)
+	res0, _ := ret[0].(*template.Template)
+	res1, _ := ret[1].(error)
+	return res0, res1
 }
 
-func (mr *MockTemplateRepositoryMockRecorder) ReplaceFields(ctx, templateID, fields any) *gomock.Call {
+func (mr *MockTemplateRepositoryMockRecorder) Undeprecate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFields", reflect.TypeOf((*MockTemplateRepository)(nil).ReplaceFields), ctx, templateID, fields)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undeprecate", reflect.TypeOf((*MockTemplateRepository)(nil).Undeprecate), ctx, id)
+}
+
+func (m *MockTemplateRepository) GetVersion(ctx context.Context, id string, version int) (*template.WithUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, id, version)
+	res0, _ := ret[0].(*template.WithUsage)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) GetVersion(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockTemplateRepository)(nil).GetVersion), ctx, id, version)
+}
+
+func (m *MockTemplateRepository) CreateFields(ctx context.Context, templateID string, version int, fields []template.Field) ([]template.Field, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFields", ctx, templateID, version, fields)
+	res0, _ := ret[0].([]template.Field)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) CreateFields(ctx, templateID, version, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFields", reflect.TypeOf((*MockTemplateRepository)(nil).CreateFields), ctx, templateID, version, fields)
 }
 
 // MockTxManager is a mock of port.TxManager.
@@ -202,3 +255,15 @@ func (mr *MockTemplateOutputPortMockRecorder) PresentTemplateDeleted(ctx any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTemplateDeleted", reflect.TypeOf((*MockTemplateOutputPort)(nil).PresentTemplateDeleted), ctx)
 }
+
+func (m *MockTemplateOutputPort) PresentTemplateChanges(ctx context.Context, report template.ChangeReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentTemplateChanges", ctx, report)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTemplateOutputPortMockRecorder) PresentTemplateChanges(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTemplateChanges", reflect.TypeOf((*MockTemplateOutputPort)(nil).PresentTemplateChanges), ctx, report)
+}
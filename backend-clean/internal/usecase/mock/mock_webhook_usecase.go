@@ -0,0 +1,293 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+// MockWebhookRepository is a mock of port.WebhookRepository.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder records invocations.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookRepository) ListByOwner(ctx context.Context, ownerID string) ([]webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwner", ctx, ownerID)
+	res0, _ := ret[0].([]webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ListByOwner(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockWebhookRepository)(nil).ListByOwner), ctx, ownerID)
+}
+
+func (m *MockWebhookRepository) Get(ctx context.Context, id string) (*webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, sub)
+	res0, _ := ret[0].(*webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Create(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), ctx, sub)
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, sub)
+	res0, _ := ret[0].(*webhook.Subscription)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Update(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), ctx, sub)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+func (m *MockWebhookRepository) Enqueue(ctx context.Context, d webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, d)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Enqueue(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockWebhookRepository)(nil).Enqueue), ctx, d)
+}
+
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, d)
+	res0, _ := ret[0].(*webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) CreateDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDelivery), ctx, d)
+}
+
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	res0, _ := ret[0].(*webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) GetDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetDelivery), ctx, id)
+}
+
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, subscriptionID, limit)
+	res0, _ := ret[0].([]webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ListDeliveries(ctx, subscriptionID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListDeliveries), ctx, subscriptionID, limit)
+}
+
+func (m *MockWebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDeliveries", ctx, limit, lease)
+	res0, _ := ret[0].([]webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ClaimDeliveries(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimDeliveries), ctx, limit, lease)
+}
+
+func (m *MockWebhookRepository) RecordAttempt(ctx context.Context, id string, attempt webhook.Attempt, retryIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAttempt", ctx, id, attempt, retryIn)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) RecordAttempt(ctx, id, attempt, retryIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAttempt", reflect.TypeOf((*MockWebhookRepository)(nil).RecordAttempt), ctx, id, attempt, retryIn)
+}
+
+// MockWebhookSender is a mock of port.WebhookSender.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder records invocations.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (webhook.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, url, headers, body)
+	res0, _ := ret[0].(webhook.Response)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, url, headers, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, url, headers, body)
+}
+
+// MockWebhookOutputPort is a mock of port.WebhookOutputPort.
+type MockWebhookOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookOutputPortMockRecorder
+}
+
+// MockWebhookOutputPortMockRecorder records invocations.
+type MockWebhookOutputPortMockRecorder struct {
+	mock *MockWebhookOutputPort
+}
+
+// NewMockWebhookOutputPort creates a new mock.
+func NewMockWebhookOutputPort(ctrl *gomock.Controller) *MockWebhookOutputPort {
+	mock := &MockWebhookOutputPort{ctrl: ctrl}
+	mock.recorder = &MockWebhookOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookOutputPort) EXPECT() *MockWebhookOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookList(ctx context.Context, subs []webhook.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookList", ctx, subs)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookList(ctx, subs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookList", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookList), ctx, subs)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhook(ctx context.Context, sub *webhook.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhook", ctx, sub)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhook(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhook", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhook), ctx, sub)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookDeleted", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookDeleted), ctx)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookDeliveries(ctx context.Context, deliveries []webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookDeliveries", ctx, deliveries)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookDeliveries(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookDeliveries", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookDeliveries), ctx, deliveries)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookDelivery", ctx, delivery)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookDelivery", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookDelivery), ctx, delivery)
+}
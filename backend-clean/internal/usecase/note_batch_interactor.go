@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/service"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteBatchInteractor handles batch note use cases.
+type NoteBatchInteractor struct {
+	notes       port.NoteRepository
+	accounts    port.AccountRepository
+	attachments port.AttachmentRepository
+	blobs       port.BlobStore
+	events      port.OutboxRepository
+	tx          port.TxManager
+	output      port.NoteBatchOutputPort
+}
+
+var _ port.NoteBatchInputPort = (*NoteBatchInteractor)(nil)
+
+// NewNoteBatchInteractor creates NoteBatchInteractor.
+func NewNoteBatchInteractor(notes port.NoteRepository, accounts port.AccountRepository, attachments port.AttachmentRepository, blobs port.BlobStore, events port.OutboxRepository, tx port.TxManager, output port.NoteBatchOutputPort) *NoteBatchInteractor {
+	return &NoteBatchInteractor{
+		notes:       notes,
+		accounts:    accounts,
+		attachments: attachments,
+		blobs:       blobs,
+		events:      events,
+		tx:          tx,
+		output:      output,
+	}
+}
+
+// batchStep applies the operation to a single note inside a transaction.
+type batchStep func(ctx context.Context, id string) error
+
+// Publish publishes every note of the batch.
+func (u *NoteBatchInteractor) Publish(ctx context.Context, input port.NoteBatchInput) error {
+	return u.run(ctx, input, func(ctx context.Context, id string) error {
+		n, err := u.notes.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := service.CanPublish(n.Note, input.OwnerID); err != nil {
+			return err
+		}
+		updated, err := u.notes.UpdateStatus(ctx, id, note.StatusPublish)
+		if err != nil {
+			return err
+		}
+		return u.events.Append(ctx, event.NewNoteStatusChanged(*updated, input.OwnerID))
+	}, nil)
+}
+
+// Unpublish reverts every note of the batch to draft.
+func (u *NoteBatchInteractor) Unpublish(ctx context.Context, input port.NoteBatchInput) error {
+	return u.run(ctx, input, func(ctx context.Context, id string) error {
+		n, err := u.notes.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := service.CanUnpublish(n.Note, input.OwnerID); err != nil {
+			return err
+		}
+		updated, err := u.notes.UpdateStatus(ctx, id, note.StatusDraft)
+		if err != nil {
+			return err
+		}
+		return u.events.Append(ctx, event.NewNoteStatusChanged(*updated, input.OwnerID))
+	}, nil)
+}
+
+// Delete deletes every note of the batch; attachment blobs are removed once deletions are committed.
+func (u *NoteBatchInteractor) Delete(ctx context.Context, input port.NoteBatchInput) error {
+	keys := make(map[string][]string)
+	step := func(ctx context.Context, id string) error {
+		n, err := u.notes.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := note.ValidateNoteOwnership(n.Note.OwnerID, input.OwnerID); err != nil {
+			return err
+		}
+		attachments, err := u.attachments.ListByNote(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.notes.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := u.events.Append(ctx, event.NewNoteDeleted(n.Note, input.OwnerID)); err != nil {
+			return err
+		}
+		keys[id] = keys[id][:0]
+		for _, a := range attachments {
+			keys[id] = append(keys[id], a.StorageKey)
+		}
+		return nil
+	}
+	// Deleted notes are gone either way; a blob left behind only wastes space, so it must not fail the batch.
+	cleanup := func(ctx context.Context, results []note.BatchItemResult) {
+		for _, r := range results {
+			if r.Err != nil {
+				continue
+			}
+			for _, key := range keys[r.ID] {
+				if err := u.blobs.Delete(ctx, key); err != nil {
+					log.Printf("delete attachment blob %s of note %s failed: %v\n", key, r.ID, err)
+				}
+			}
+		}
+	}
+	return u.run(ctx, input, step, cleanup)
+}
+
+// TransferOwnership hands every note of the batch over to another account.
+func (u *NoteBatchInteractor) TransferOwnership(ctx context.Context, input port.NoteBatchTransferInput) error {
+	if input.NewOwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if _, err := u.accounts.GetByID(ctx, input.NewOwnerID); err != nil {
+		return err
+	}
+	return u.run(ctx, input.NoteBatchInput, func(ctx context.Context, id string) error {
+		n, err := u.notes.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := note.CanTransferOwnership(n.Note, input.OwnerID, input.NewOwnerID); err != nil {
+			return err
+		}
+		updated, err := u.notes.UpdateOwner(ctx, id, input.NewOwnerID)
+		if err != nil {
+			return err
+		}
+		return u.events.Append(ctx, event.NewNoteTransferred(*updated, n.Note.OwnerID, input.OwnerID))
+	}, nil)
+}
+
+// run executes step for every note ID according to the batch mode and presents per-item results.
+// All-or-nothing runs the whole batch in one transaction and rolls it back if any item fails;
+// best effort commits each item in its own transaction. Errors outside the per-note domain rules
+// abort the batch.
+func (u *NoteBatchInteractor) run(ctx context.Context, input port.NoteBatchInput, step batchStep, afterCommit func(ctx context.Context, results []note.BatchItemResult)) error {
+	if input.OwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	mode := input.Mode
+	if mode == "" {
+		mode = note.BatchAllOrNothing
+	}
+	if err := mode.Validate(); err != nil {
+		return err
+	}
+	ids, err := note.NormalizeBatchIDs(input.IDs)
+	if err != nil {
+		return err
+	}
+
+	var results []note.BatchItemResult
+	if mode == note.BatchAllOrNothing {
+		results, err = u.runAllOrNothing(ctx, ids, step)
+	} else {
+		results, err = u.runBestEffort(ctx, ids, step)
+	}
+	if err != nil {
+		return err
+	}
+	if afterCommit != nil {
+		afterCommit(ctx, results)
+	}
+	return u.output.PresentNoteBatch(ctx, mode, results)
+}
+
+func (u *NoteBatchInteractor) runAllOrNothing(ctx context.Context, ids []string, step batchStep) ([]note.BatchItemResult, error) {
+	var results []note.BatchItemResult
+	failed := false
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		results = make([]note.BatchItemResult, 0, len(ids))
+		failed = false
+		for _, id := range ids {
+			err := step(txCtx, id)
+			if err != nil && !isBatchItemError(err) {
+				return err
+			}
+			failed = failed || err != nil
+			results = append(results, note.BatchItemResult{ID: id, Err: err})
+		}
+		if failed {
+			// keep evaluating every item above so the report lists all failures, then roll back
+			return domainerr.ErrBatchAborted
+		}
+		return nil
+	})
+	if err != nil && !(failed && errors.Is(err, domainerr.ErrBatchAborted)) {
+		return nil, err
+	}
+	if failed {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = domainerr.ErrBatchAborted
+			}
+		}
+	}
+	return results, nil
+}
+
+func (u *NoteBatchInteractor) runBestEffort(ctx context.Context, ids []string, step batchStep) ([]note.BatchItemResult, error) {
+	results := make([]note.BatchItemResult, 0, len(ids))
+	for _, id := range ids {
+		err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+			return step(txCtx, id)
+		})
+		if err != nil && !isBatchItemError(err) {
+			return nil, err
+		}
+		results = append(results, note.BatchItemResult{ID: id, Err: err})
+	}
+	return results, nil
+}
+
+// isBatchItemError reports whether err comes from per-note domain rules and belongs in the item result.
+func isBatchItemError(err error) bool {
+	return errors.Is(err, domainerr.ErrNotFound) ||
+		errors.Is(err, domainerr.ErrUnauthorized) ||
+		errors.Is(err, domainerr.ErrOwnerRequired) ||
+		errors.Is(err, domainerr.ErrInvalidStatus) ||
+		errors.Is(err, domainerr.ErrInvalidStatusChange)
+}
@@ -0,0 +1,279 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/attachment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+type batchMocks struct {
+	notes       *mockusecase.MockNoteRepository
+	accounts    *mockusecase.MockAccountRepository
+	attachments *mockusecase.MockAttachmentRepository
+	blobs       *mockusecase.MockBlobStore
+	outbox      *mockusecase.MockOutboxRepository
+	tx          *mockusecase.MockTxManager
+	out         *mockusecase.MockNoteBatchOutputPort
+	results     []note.BatchItemResult
+	events      []event.Event
+}
+
+func newBatchMocks(ctrl *gomock.Controller) *batchMocks {
+	m := &batchMocks{
+		notes:       mockusecase.NewMockNoteRepository(ctrl),
+		accounts:    mockusecase.NewMockAccountRepository(ctrl),
+		attachments: mockusecase.NewMockAttachmentRepository(ctrl),
+		blobs:       mockusecase.NewMockBlobStore(ctrl),
+		outbox:      mockusecase.NewMockOutboxRepository(ctrl),
+		tx:          mockusecase.NewMockTxManager(ctrl),
+		out:         mockusecase.NewMockNoteBatchOutputPort(ctrl),
+	}
+	m.tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+	m.outbox.EXPECT().Append(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, e event.Event) error {
+			m.events = append(m.events, e)
+			return nil
+		},
+	)
+	m.out.EXPECT().PresentNoteBatch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, _ note.BatchMode, results []note.BatchItemResult) error {
+			m.results = results
+			return nil
+		},
+	)
+	return m
+}
+
+func (m *batchMocks) interactor() *uc.NoteBatchInteractor {
+	return uc.NewNoteBatchInteractor(m.notes, m.accounts, m.attachments, m.blobs, m.outbox, m.tx, m.out)
+}
+
+func assertBatchResults(t *testing.T, got []note.BatchItemResult, want map[string]error) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("results = %d, want %d", len(got), len(want))
+	}
+	for _, r := range got {
+		w, ok := want[r.ID]
+		if !ok {
+			t.Fatalf("unexpected result for %s", r.ID)
+		}
+		if (w == nil) != (r.Err == nil) || (w != nil && !errors.Is(r.Err, w)) {
+			t.Fatalf("%s: err = %v, want %v", r.ID, r.Err, w)
+		}
+	}
+}
+
+func TestNoteBatchInteractor_Publish(t *testing.T) {
+	notes := map[string]*note.WithMeta{
+		"n1": {Note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft}},
+		"n2": {Note: note.Note{ID: "n2", OwnerID: "other", Status: note.StatusDraft}},
+	}
+	tests := []struct {
+		name        string
+		input       port.NoteBatchInput
+		wantUpdates int
+		wantResults map[string]error
+		wantError   error
+	}{
+		{
+			name:        "[Success] best effort applies valid items",
+			input:       port.NoteBatchInput{IDs: []string{"n1", "n2", "missing", "n1"}, OwnerID: "owner-1", Mode: note.BatchBestEffort},
+			wantUpdates: 1,
+			wantResults: map[string]error{"n1": nil, "n2": domainerr.ErrUnauthorized, "missing": domainerr.ErrNotFound},
+		},
+		{
+			name:        "[Success] all or nothing rolls back",
+			input:       port.NoteBatchInput{IDs: []string{"n1", "n2"}, OwnerID: "owner-1", Mode: note.BatchAllOrNothing},
+			wantUpdates: 1,
+			wantResults: map[string]error{"n1": domainerr.ErrBatchAborted, "n2": domainerr.ErrUnauthorized},
+		},
+		{
+			name:        "[Success] default mode applies all",
+			input:       port.NoteBatchInput{IDs: []string{"n1"}, OwnerID: "owner-1"},
+			wantUpdates: 1,
+			wantResults: map[string]error{"n1": nil},
+		},
+		{
+			name:      "[Fail] empty batch",
+			input:     port.NoteBatchInput{IDs: []string{" "}, OwnerID: "owner-1"},
+			wantError: domainerr.ErrBatchEmpty,
+		},
+		{
+			name:      "[Fail] unknown mode",
+			input:     port.NoteBatchInput{IDs: []string{"n1"}, OwnerID: "owner-1", Mode: "sometimes"},
+			wantError: domainerr.ErrInvalidBatchMode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newBatchMocks(ctrl)
+
+			m.notes.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(_ context.Context, id string) (*note.WithMeta, error) {
+					if n, ok := notes[id]; ok {
+						return n, nil
+					}
+					return nil, domainerr.ErrNotFound
+				},
+			)
+			m.notes.EXPECT().UpdateStatus(gomock.Any(), "n1", note.StatusPublish).Times(tt.wantUpdates).Return(&note.Note{ID: "n1", Status: note.StatusPublish}, nil)
+
+			err := m.interactor().Publish(context.Background(), tt.input)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertBatchResults(t, m.results, tt.wantResults)
+			if len(m.events) != tt.wantUpdates || m.events[0].Name != event.NotePublished {
+				t.Fatalf("unexpected events: %+v", m.events)
+			}
+		})
+	}
+}
+
+func TestNoteBatchInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        note.BatchMode
+		repoErr     error
+		wantResults map[string]error
+		blobErr     error
+		wantBlobDel bool
+		wantError   bool
+	}{
+		{
+			name:        "[Success] deletes notes and blobs",
+			mode:        note.BatchBestEffort,
+			wantResults: map[string]error{"n1": nil, "n2": domainerr.ErrUnauthorized},
+			wantBlobDel: true,
+		},
+		{
+			name:        "[Success] blob delete error does not fail the batch",
+			mode:        note.BatchBestEffort,
+			blobErr:     errors.New("disk error"),
+			wantResults: map[string]error{"n1": nil, "n2": domainerr.ErrUnauthorized},
+			wantBlobDel: true,
+		},
+		{
+			name:        "[Success] rolled back batch keeps blobs",
+			mode:        note.BatchAllOrNothing,
+			wantResults: map[string]error{"n1": domainerr.ErrBatchAborted, "n2": domainerr.ErrUnauthorized},
+		},
+		{
+			name:      "[Fail] storage error aborts",
+			mode:      note.BatchBestEffort,
+			repoErr:   errors.New("db down"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newBatchMocks(ctrl)
+
+			m.notes.EXPECT().Get(gomock.Any(), "n1").Return(&note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner-1"}}, nil)
+			m.notes.EXPECT().Get(gomock.Any(), "n2").AnyTimes().Return(&note.WithMeta{Note: note.Note{ID: "n2", OwnerID: "other"}}, nil)
+			m.attachments.EXPECT().ListByNote(gomock.Any(), "n1").Return([]attachment.Attachment{{ID: "a1", StorageKey: "k1"}}, nil)
+			m.notes.EXPECT().Delete(gomock.Any(), "n1").Return(tt.repoErr)
+			m.blobs.EXPECT().Delete(gomock.Any(), "k1").Times(b2i(tt.wantBlobDel)).Return(tt.blobErr)
+
+			err := m.interactor().Delete(context.Background(), port.NoteBatchInput{IDs: []string{"n1", "n2"}, OwnerID: "owner-1", Mode: tt.mode})
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertBatchResults(t, m.results, tt.wantResults)
+		})
+	}
+}
+
+func TestNoteBatchInteractor_TransferOwnership(t *testing.T) {
+	tests := []struct {
+		name        string
+		newOwnerID  string
+		accountErr  error
+		wantResults map[string]error
+		wantError   error
+	}{
+		{
+			name:        "[Success] transfer notes",
+			newOwnerID:  "owner-2",
+			wantResults: map[string]error{"n1": nil},
+		},
+		{
+			name:       "[Fail] unknown new owner",
+			newOwnerID: "ghost",
+			accountErr: domainerr.ErrNotFound,
+			wantError:  domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] new owner missing",
+			wantError: domainerr.ErrOwnerRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newBatchMocks(ctrl)
+
+			if tt.newOwnerID != "" {
+				m.accounts.EXPECT().GetByID(gomock.Any(), tt.newOwnerID).Return(&account.Account{ID: tt.newOwnerID}, tt.accountErr)
+			}
+			if tt.wantError == nil {
+				m.notes.EXPECT().Get(gomock.Any(), "n1").Return(&note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner-1"}}, nil)
+				m.notes.EXPECT().UpdateOwner(gomock.Any(), "n1", tt.newOwnerID).Return(&note.Note{ID: "n1", OwnerID: tt.newOwnerID}, nil)
+			}
+
+			err := m.interactor().TransferOwnership(context.Background(), port.NoteBatchTransferInput{
+				NoteBatchInput: port.NoteBatchInput{IDs: []string{"n1"}, OwnerID: "owner-1"},
+				NewOwnerID:     tt.newOwnerID,
+			})
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Fatalf("want %v, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertBatchResults(t, m.results, tt.wantResults)
+			if len(m.events) != 1 || m.events[0].Data["previousOwnerId"] != "owner-1" || m.events[0].Data["ownerId"] != tt.newOwnerID {
+				t.Fatalf("unexpected events: %+v", m.events)
+			}
+		})
+	}
+}
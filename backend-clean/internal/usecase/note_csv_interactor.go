@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCSVInteractor handles CSV export and import of the notes of a template.
+type NoteCSVInteractor struct {
+	notes     port.NoteRepository
+	templates port.TemplateRepository
+	events    port.OutboxRepository
+	tx        port.TxManager
+	output    port.NoteCSVOutputPort
+}
+
+var _ port.NoteCSVInputPort = (*NoteCSVInteractor)(nil)
+
+// NewNoteCSVInteractor creates NoteCSVInteractor.
+func NewNoteCSVInteractor(notes port.NoteRepository, templates port.TemplateRepository, events port.OutboxRepository, tx port.TxManager, output port.NoteCSVOutputPort) *NoteCSVInteractor {
+	return &NoteCSVInteractor{
+		notes:     notes,
+		templates: templates,
+		events:    events,
+		tx:        tx,
+		output:    output,
+	}
+}
+
+// Export presents every note of the template the viewer may see: published notes and their own drafts.
+// Columns follow the latest version; notes pinned to earlier versions are mapped onto it by field label.
+func (u *NoteCSVInteractor) Export(ctx context.Context, templateID, viewerID string) error {
+	tpl, err := getVisibleTemplate(ctx, u.templates, templateID, viewerID)
+	if err != nil {
+		return err
+	}
+	notes, err := u.notes.List(ctx, note.Filters{TemplateID: &tpl.Template.ID})
+	if err != nil {
+		return err
+	}
+	visible := make([]note.WithMeta, 0, len(notes))
+	pinned := map[int][]template.Field{tpl.Template.Version: tpl.Template.Fields}
+	for _, n := range notes {
+		if note.ValidateNoteVisibility(n.Note, viewerID) != nil {
+			continue
+		}
+		if n.Note.TemplateVersion != tpl.Template.Version {
+			fields, ok := pinned[n.Note.TemplateVersion]
+			if !ok {
+				version, err := u.templates.GetVersion(ctx, tpl.Template.ID, n.Note.TemplateVersion)
+				if err != nil {
+					return err
+				}
+				fields = version.Template.Fields
+				pinned[n.Note.TemplateVersion] = fields
+			}
+			n = note.AlignCSVSections(n, fields, tpl.Template.Fields)
+		}
+		visible = append(visible, n)
+	}
+	return u.output.PresentNoteCSV(ctx, tpl.Template.Fields, visible)
+}
+
+// Import creates a draft note for each row without an ID and updates the title and sections
+// of each row with one. Rows go through the normal section validation and failures are reported
+// per row without stopping the others. Rows already applied stay when a later row hits a storage error.
+func (u *NoteCSVInteractor) Import(ctx context.Context, input port.NoteCSVImportInput) error {
+	if input.OwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	if len(input.Content) > note.MaxImportUploadSize {
+		return domainerr.ErrImportTooLarge
+	}
+	tpl, err := getVisibleTemplate(ctx, u.templates, input.TemplateID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	rows, err := note.ParseCSV(tpl.Template.Fields, input.Content)
+	if err != nil {
+		return err
+	}
+
+	results := make([]note.CSVRowResult, 0, len(rows))
+	for _, row := range rows {
+		result := note.CSVRowResult{Line: row.Line, NoteID: row.ID, Err: row.Err}
+		switch {
+		case result.Err != nil:
+		case row.ID == "":
+			create := port.NoteCreateInput{
+				Title:      row.Title,
+				TemplateID: tpl.Template.ID,
+				OwnerID:    input.OwnerID,
+				Sections:   csvCreateSections(tpl.Template.Fields, row.Sections),
+			}
+			if result.Err = validateNoteForCreate(tpl.Template, create); result.Err != nil {
+				break
+			}
+			noteID, err := createNote(ctx, u.notes, u.events, u.tx, tpl.Template, create)
+			if err != nil {
+				return err
+			}
+			result.NoteID = noteID
+			result.Created = true
+		default:
+			current, err := u.notes.Get(ctx, row.ID)
+			if errors.Is(err, domainerr.ErrNotFound) {
+				result.Err = err
+				break
+			}
+			if err != nil {
+				return err
+			}
+			sections, err := csvUpdateSections(tpl.Template, current, input.OwnerID, row)
+			if err != nil {
+				result.Err = err
+				break
+			}
+			if err := u.updateNote(ctx, row.ID, row.Title, input.OwnerID, sections); err != nil {
+				return err
+			}
+		}
+		results = append(results, result)
+	}
+	return u.output.PresentNoteCSVImport(ctx, results)
+}
+
+func (u *NoteCSVInteractor) updateNote(ctx context.Context, id, title, ownerID string, sections []note.Section) error {
+	return u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.Update(txCtx, note.Note{ID: id, Title: title})
+		if err != nil {
+			return err
+		}
+		if err := u.notes.ReplaceSections(txCtx, id, sections); err != nil {
+			return err
+		}
+		return u.events.Append(txCtx, event.NewNoteUpdated(*updated, ownerID))
+	})
+}
+
+// csvCreateSections returns one section input per template field; fields without a column stay empty.
+func csvCreateSections(fields []template.Field, sections []note.Section) []port.SectionInput {
+	contents := make(map[string]string, len(sections))
+	for _, s := range sections {
+		contents[s.FieldID] = s.Content
+	}
+	inputs := make([]port.SectionInput, 0, len(fields))
+	for _, f := range fields {
+		inputs = append(inputs, port.SectionInput{FieldID: f.ID, Content: contents[f.ID]})
+	}
+	return inputs
+}
+
+// csvUpdateSections checks that the row may update the note and merges its columns into the
+// existing sections; fields without a column keep their current content.
+func csvUpdateSections(tpl template.Template, current *note.WithMeta, ownerID string, row note.CSVRow) ([]note.Section, error) {
+	if current.Note.TemplateID != tpl.ID {
+		return nil, domainerr.ErrTemplateMismatch
+	}
+	// columns follow the latest fields; notes on earlier versions must be upgraded first
+	if current.Note.TemplateVersion != tpl.Version {
+		return nil, domainerr.ErrTemplateVersionMismatch
+	}
+	if err := note.ValidateNoteOwnership(current.Note.OwnerID, ownerID); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(row.Title) == "" {
+		return nil, domainerr.ErrTitleRequired
+	}
+	existing := make(map[string]note.Section, len(current.Sections))
+	for _, s := range current.Sections {
+		existing[s.Section.FieldID] = s.Section
+	}
+	updates := make(map[string]string, len(row.Sections))
+	for _, s := range row.Sections {
+		updates[s.FieldID] = s.Content
+	}
+	sections := make([]note.Section, 0, len(tpl.Fields))
+	for _, f := range tpl.Fields {
+		s, ok := existing[f.ID]
+		if !ok {
+			s = note.Section{FieldID: f.ID, NoteID: current.Note.ID}
+		}
+		if content, ok := updates[f.ID]; ok {
+			s.Content = content
+		}
+		sections = append(sections, s)
+	}
+	if err := note.ValidateSections(tpl.Fields, sections); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
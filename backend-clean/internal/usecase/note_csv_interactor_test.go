@@ -0,0 +1,263 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func csvTemplate() *template.WithUsage {
+	return &template.WithUsage{Template: template.Template{
+		ID:      "tpl-1",
+		Name:    "ADR",
+		OwnerID: "owner-1",
+		Fields: []template.Field{
+			{ID: "f1", Label: "Context", Order: 1, IsRequired: true},
+			{ID: "f2", Label: "Decision", Order: 2},
+		},
+	}}
+}
+
+func TestNoteCSVInteractor_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+	tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+	out := mockusecase.NewMockNoteCSVOutputPort(ctrl)
+
+	tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(csvTemplate(), nil)
+	notesRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filters note.Filters) ([]note.WithMeta, error) {
+			if filters.TemplateID == nil || *filters.TemplateID != "tpl-1" {
+				t.Fatalf("unexpected filters: %+v", filters)
+			}
+			return []note.WithMeta{
+				{Note: note.Note{ID: "published", OwnerID: "other", Status: note.StatusPublish}},
+				{Note: note.Note{ID: "own-draft", OwnerID: "viewer", Status: note.StatusDraft}},
+				{Note: note.Note{ID: "other-draft", OwnerID: "other", Status: note.StatusDraft}},
+			}, nil
+		},
+	)
+	out.EXPECT().PresentNoteCSV(gomock.Any(), gomock.Len(2), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ []template.Field, notes []note.WithMeta) error {
+			if len(notes) != 2 || notes[0].Note.ID != "published" || notes[1].Note.ID != "own-draft" {
+				t.Fatalf("unexpected notes: %+v", notes)
+			}
+			return nil
+		},
+	)
+
+	interactor := uc.NewNoteCSVInteractor(notesRepo, tplRepo, nil, nil, out)
+	if err := interactor.Export(context.Background(), "tpl-1", "viewer"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNoteCSVInteractor_ExportPinnedVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+	tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+	out := mockusecase.NewMockNoteCSVOutputPort(ctrl)
+
+	latest := csvTemplate()
+	latest.Template.Version = 2
+	tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(latest, nil)
+	// fetched once for both notes pinned to version 1
+	tplRepo.EXPECT().GetVersion(gomock.Any(), "tpl-1", 1).Return(&template.WithUsage{Template: template.Template{
+		ID:      "tpl-1",
+		Version: 1,
+		Fields:  []template.Field{{ID: "old-f1", Label: "Context", Order: 1}, {ID: "old-f3", Label: "Background", Order: 2}},
+	}}, nil)
+	notesRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]note.WithMeta{
+		{
+			Note:     note.Note{ID: "old-1", OwnerID: "viewer", Status: note.StatusPublish, TemplateVersion: 1},
+			Sections: []note.SectionWithField{{Section: note.Section{FieldID: "old-f1", Content: "why"}}, {Section: note.Section{FieldID: "old-f3", Content: "dropped"}}},
+		},
+		{Note: note.Note{ID: "old-2", OwnerID: "viewer", Status: note.StatusPublish, TemplateVersion: 1}},
+		{
+			Note:     note.Note{ID: "latest", OwnerID: "viewer", Status: note.StatusPublish, TemplateVersion: 2},
+			Sections: []note.SectionWithField{{Section: note.Section{FieldID: "f2", Content: "go"}}},
+		},
+	}, nil)
+	out.EXPECT().PresentNoteCSV(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fields []template.Field, notes []note.WithMeta) error {
+			want := []string{"why", "", "", "", "", "go"}
+			var got []string
+			for _, n := range notes {
+				record := note.CSVRecord(fields, n)
+				got = append(got, record[len(record)-2:]...)
+			}
+			if !slices.Equal(got, want) {
+				t.Fatalf("field columns = %q, want %q", got, want)
+			}
+			return nil
+		},
+	)
+
+	interactor := uc.NewNoteCSVInteractor(notesRepo, tplRepo, nil, nil, out)
+	if err := interactor.Export(context.Background(), "tpl-1", "viewer"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNoteCSVInteractor_Import(t *testing.T) {
+	existing := func(id, templateID, ownerID string) *note.WithMeta {
+		return &note.WithMeta{
+			Note: note.Note{ID: id, TemplateID: templateID, OwnerID: ownerID},
+			Sections: []note.SectionWithField{
+				{Section: note.Section{ID: "s1", NoteID: id, FieldID: "f1", Content: "old context"}},
+				{Section: note.Section{ID: "s2", NoteID: id, FieldID: "f2", Content: "old decision"}},
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		input       port.NoteCSVImportInput
+		notes       map[string]*note.WithMeta
+		wantCreates int
+		wantUpdates int
+		wantContext string
+		wantResults []note.CSVRowResult
+		wantError   error
+	}{
+		{
+			name:        "[Success] create row",
+			input:       port.NoteCSVImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", Content: []byte("title,Context\nNew,why\n")},
+			wantCreates: 1,
+			wantResults: []note.CSVRowResult{{Line: 2, NoteID: "note-new", Created: true}},
+		},
+		{
+			name:        "[Success] update keeps columns not in file",
+			input:       port.NoteCSVImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", Content: []byte("id,title,Decision\nn1,Renamed,new decision\n")},
+			notes:       map[string]*note.WithMeta{"n1": existing("n1", "tpl-1", "owner-1")},
+			wantUpdates: 1,
+			wantContext: "old context",
+			wantResults: []note.CSVRowResult{{Line: 2, NoteID: "n1"}},
+		},
+		{
+			name: "[Success] row errors reported per row",
+			input: port.NoteCSVImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", Content: []byte(
+				"id,title,Context\n" +
+					",Missing context,\n" +
+					"n2,Other owner,x\n" +
+					"n3,Other template,x\n" +
+					"n4,Gone,x\n" +
+					"n1,,x\n" +
+					"n1\n" +
+					"n1,Fine,x\n")},
+			notes: map[string]*note.WithMeta{
+				"n1": existing("n1", "tpl-1", "owner-1"),
+				"n2": existing("n2", "tpl-1", "owner-2"),
+				"n3": existing("n3", "tpl-2", "owner-1"),
+			},
+			wantUpdates: 1,
+			wantResults: []note.CSVRowResult{
+				{Line: 2, Err: domainerr.ErrRequiredFieldEmpty},
+				{Line: 3, NoteID: "n2", Err: domainerr.ErrUnauthorized},
+				{Line: 4, NoteID: "n3", Err: domainerr.ErrTemplateMismatch},
+				{Line: 5, NoteID: "n4", Err: domainerr.ErrNotFound},
+				{Line: 6, NoteID: "n1", Err: domainerr.ErrTitleRequired},
+				{Line: 7, Err: domainerr.ErrCSVColumnCount},
+				{Line: 8, NoteID: "n1"},
+			},
+		},
+		{
+			name:      "[Fail] owner missing",
+			input:     port.NoteCSVImportInput{TemplateID: "tpl-1", Content: []byte("title\nA\n")},
+			wantError: domainerr.ErrOwnerRequired,
+		},
+		{
+			name:      "[Fail] unknown column",
+			input:     port.NoteCSVImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", Content: []byte("title,Risks\nA,b\n")},
+			wantError: domainerr.ErrCSVHeaderInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteCSVOutputPort(ctrl)
+
+			if tt.input.OwnerID != "" {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(csvTemplate(), nil)
+			}
+			notesRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(_ context.Context, id string) (*note.WithMeta, error) {
+					if n, ok := tt.notes[id]; ok {
+						return n, nil
+					}
+					return nil, domainerr.ErrNotFound
+				},
+			)
+			tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).Times(tt.wantCreates + tt.wantUpdates).DoAndReturn(
+				func(_ context.Context, fn func(context.Context) error) error {
+					return fn(context.Background())
+				},
+			)
+			notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(tt.wantCreates).Return(&note.Note{ID: "note-new"}, nil)
+			notesRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(tt.wantUpdates).Return(&note.Note{}, nil)
+			notesRepo.EXPECT().ReplaceSections(gomock.Any(), gomock.Any(), gomock.Any()).Times(tt.wantCreates + tt.wantUpdates).DoAndReturn(
+				func(_ context.Context, noteID string, sections []note.Section) error {
+					if noteID == "n1" && (sections[0].ID != "s1" || sections[1].ID != "s2") {
+						t.Fatalf("existing sections not kept: %+v", sections)
+					}
+					if tt.wantContext != "" && sections[0].Content != tt.wantContext {
+						t.Fatalf("context = %q, want %q", sections[0].Content, tt.wantContext)
+					}
+					return nil
+				},
+			)
+			events.EXPECT().Append(gomock.Any(), gomock.Any()).Times(tt.wantCreates + tt.wantUpdates).Return(nil)
+			var got []note.CSVRowResult
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteCSVImport(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, results []note.CSVRowResult) error {
+						got = results
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewNoteCSVInteractor(notesRepo, tplRepo, events, tx, out)
+			err := interactor.Import(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if len(got) != len(tt.wantResults) {
+				t.Fatalf("results = %d, want %d", len(got), len(tt.wantResults))
+			}
+			for i, want := range tt.wantResults {
+				r := got[i]
+				if r.Line != want.Line || r.NoteID != want.NoteID || r.Created != want.Created {
+					t.Fatalf("result %d = %+v, want %+v", i, r, want)
+				}
+				if (want.Err == nil) != (r.Err == nil) || (want.Err != nil && !errors.Is(r.Err, want.Err)) {
+					t.Fatalf("result %d err = %v, want %v", i, r.Err, want.Err)
+				}
+			}
+		})
+	}
+}
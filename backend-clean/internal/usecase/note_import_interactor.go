@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteImportInteractor handles importing notes from Markdown documents.
+type NoteImportInteractor struct {
+	notes     port.NoteRepository
+	templates port.TemplateRepository
+	decoder   port.ImportDecoder
+	events    port.OutboxRepository
+	tx        port.TxManager
+	output    port.NoteImportOutputPort
+}
+
+var _ port.NoteImportInputPort = (*NoteImportInteractor)(nil)
+
+// NewNoteImportInteractor creates NoteImportInteractor.
+func NewNoteImportInteractor(notes port.NoteRepository, templates port.TemplateRepository, decoder port.ImportDecoder, events port.OutboxRepository, tx port.TxManager, output port.NoteImportOutputPort) *NoteImportInteractor {
+	return &NoteImportInteractor{
+		notes:     notes,
+		templates: templates,
+		decoder:   decoder,
+		events:    events,
+		tx:        tx,
+		output:    output,
+	}
+}
+
+// Import creates a draft note per Markdown document, mapping headings to template fields.
+// Validation failures are reported per file and do not stop other files; in dry-run mode
+// nothing is persisted. Notes already created stay when a later file hits a storage error.
+func (u *NoteImportInteractor) Import(ctx context.Context, input port.NoteImportInput) error {
+	if input.OwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	tpl, err := getVisibleTemplate(ctx, u.templates, input.TemplateID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	files, err := u.decoder.Decode(input.FileName, input.Content)
+	if err != nil {
+		return err
+	}
+
+	results := make([]note.ImportResult, 0, len(files))
+	for _, f := range files {
+		doc := note.ParseMarkdown(f.Name, f.Content)
+		mapping := note.MapHeadings(tpl.Template.Fields, doc, input.Aliases)
+		result := note.ImportResult{
+			FileName:          f.Name,
+			Title:             doc.Title,
+			UnmatchedHeadings: mapping.UnmatchedHeadings,
+			MissingRequired:   mapping.MissingRequired,
+		}
+		create := port.NoteCreateInput{
+			Title:      doc.Title,
+			TemplateID: tpl.Template.ID,
+			OwnerID:    input.OwnerID,
+			Sections:   toSectionInputs(mapping.Sections),
+		}
+		if err := validateNoteForCreate(tpl.Template, create); err != nil {
+			result.Err = err
+		} else if !input.DryRun {
+			noteID, err := createNote(ctx, u.notes, u.events, u.tx, tpl.Template, create)
+			if err != nil {
+				return err
+			}
+			result.NoteID = noteID
+		}
+		results = append(results, result)
+	}
+	return u.output.PresentNoteImport(ctx, input.DryRun, results)
+}
+
+func toSectionInputs(sections []note.Section) []port.SectionInput {
+	inputs := make([]port.SectionInput, 0, len(sections))
+	for _, s := range sections {
+		inputs = append(inputs, port.SectionInput{FieldID: s.FieldID, Content: s.Content})
+	}
+	return inputs
+}
@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteImportInteractor_Import(t *testing.T) {
+	tpl := &template.WithUsage{Template: template.Template{
+		ID:      "tpl-1",
+		Name:    "ADR",
+		OwnerID: "owner-1",
+		Fields: []template.Field{
+			{ID: "f1", Label: "Context", Order: 1, IsRequired: true},
+			{ID: "f2", Label: "Decision", Order: 2, IsRequired: true},
+		},
+	}}
+	complete := "# Use cache\n## Context\nslow\n## Decision\ncache it\n"
+	incomplete := "# Drop cache\n## Context\nfast now\n## Risks\nnone\n"
+
+	tests := []struct {
+		name        string
+		input       port.NoteImportInput
+		files       []note.ImportFile
+		decodeErr   error
+		wantCreates int
+		wantResults []note.ImportResult
+		wantError   error
+	}{
+		{
+			name:        "[Success] single markdown file",
+			input:       port.NoteImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", FileName: "adr.md"},
+			files:       []note.ImportFile{{Name: "adr.md", Content: complete}},
+			wantCreates: 1,
+			wantResults: []note.ImportResult{{FileName: "adr.md", Title: "Use cache", NoteID: "note-1"}},
+		},
+		{
+			name:        "[Success] archive keeps going past invalid documents",
+			input:       port.NoteImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", FileName: "adrs.zip"},
+			files:       []note.ImportFile{{Name: "adrs/1.md", Content: complete}, {Name: "adrs/2.md", Content: incomplete}},
+			wantCreates: 1,
+			wantResults: []note.ImportResult{
+				{FileName: "adrs/1.md", Title: "Use cache", NoteID: "note-1"},
+				{FileName: "adrs/2.md", Title: "Drop cache", UnmatchedHeadings: []string{"Risks"}, MissingRequired: []string{"Decision"}, Err: domainerr.ErrRequiredFieldEmpty},
+			},
+		},
+		{
+			name:        "[Success] dry run with aliases persists nothing",
+			input:       port.NoteImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", FileName: "adr.md", Aliases: map[string][]string{"Decision": {"Risks"}}, DryRun: true},
+			files:       []note.ImportFile{{Name: "adr.md", Content: incomplete}},
+			wantResults: []note.ImportResult{{FileName: "adr.md", Title: "Drop cache"}},
+		},
+		{
+			name:      "[Fail] owner missing",
+			input:     port.NoteImportInput{TemplateID: "tpl-1", FileName: "adr.md"},
+			wantError: domainerr.ErrOwnerRequired,
+		},
+		{
+			name:      "[Fail] unreadable upload",
+			input:     port.NoteImportInput{TemplateID: "tpl-1", OwnerID: "owner-1", FileName: "adrs.zip", Content: []byte("not a zip")},
+			decodeErr: domainerr.ErrImportInvalidArchive,
+			wantError: domainerr.ErrImportInvalidArchive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteImportOutputPort(ctrl)
+			decoder := mockusecase.NewMockImportDecoder(ctrl)
+
+			if tt.input.OwnerID != "" {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tpl, nil)
+				decoder.EXPECT().Decode(tt.input.FileName, tt.input.Content).Return(tt.files, tt.decodeErr)
+			}
+			tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).Times(tt.wantCreates).DoAndReturn(
+				func(_ context.Context, fn func(context.Context) error) error {
+					return fn(context.Background())
+				},
+			)
+			notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(tt.wantCreates).Return(&note.Note{ID: "note-1"}, nil)
+			notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", gomock.Any()).Times(tt.wantCreates).Return(nil)
+			events.EXPECT().Append(gomock.Any(), gomock.Any()).Times(tt.wantCreates).Return(nil)
+			var got []note.ImportResult
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteImport(gomock.Any(), tt.input.DryRun, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ bool, results []note.ImportResult) error {
+						got = results
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewNoteImportInteractor(notesRepo, tplRepo, decoder, events, tx, out)
+			err := interactor.Import(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if len(got) != len(tt.wantResults) {
+				t.Fatalf("results = %d, want %d", len(got), len(tt.wantResults))
+			}
+			for i, want := range tt.wantResults {
+				r := got[i]
+				if r.FileName != want.FileName || r.Title != want.Title || r.NoteID != want.NoteID {
+					t.Fatalf("result %d = %+v, want %+v", i, r, want)
+				}
+				if len(r.UnmatchedHeadings) != len(want.UnmatchedHeadings) || len(r.MissingRequired) != len(want.MissingRequired) {
+					t.Fatalf("result %d report = %+v, want %+v", i, r, want)
+				}
+				if (want.Err == nil) != (r.Err == nil) || (want.Err != nil && !errors.Is(r.Err, want.Err)) {
+					t.Fatalf("result %d err = %v, want %v", i, r.Err, want.Err)
+				}
+			}
+		})
+	}
+}
@@ -3,9 +3,13 @@ package usecase
 
 import (
 	"context"
+	"log"
+	"slices"
 	"strings"
 
+	"immortal-architecture-clean/backend/internal/domain/attachment"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/domain/note"
 	"immortal-architecture-clean/backend/internal/domain/service"
 	"immortal-architecture-clean/backend/internal/domain/template"
@@ -14,21 +18,27 @@ import (
 
 // NoteInteractor handles note use cases.
 type NoteInteractor struct {
-	notes     port.NoteRepository
-	templates port.TemplateRepository
-	tx        port.TxManager
-	output    port.NoteOutputPort
+	notes       port.NoteRepository
+	templates   port.TemplateRepository
+	attachments port.AttachmentRepository
+	blobs       port.BlobStore
+	events      port.OutboxRepository
+	tx          port.TxManager
+	output      port.NoteOutputPort
 }
 
 var _ port.NoteInputPort = (*NoteInteractor)(nil)
 
 // NewNoteInteractor creates NoteInteractor.
-func NewNoteInteractor(notes port.NoteRepository, templates port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) *NoteInteractor {
+func NewNoteInteractor(notes port.NoteRepository, templates port.TemplateRepository, attachments port.AttachmentRepository, blobs port.BlobStore, events port.OutboxRepository, tx port.TxManager, output port.NoteOutputPort) *NoteInteractor {
 	return &NoteInteractor{
-		notes:     notes,
-		templates: templates,
-		tx:        tx,
-		output:    output,
+		notes:       notes,
+		templates:   templates,
+		attachments: attachments,
+		blobs:       blobs,
+		events:      events,
+		tx:          tx,
+		output:      output,
 	}
 }
 
@@ -41,6 +51,26 @@ func (u *NoteInteractor) List(ctx context.Context, filters note.Filters) error {
 	return u.output.PresentNoteList(ctx, notes)
 }
 
+// ListByTemplate returns the notes written with a template that the viewer may see:
+// published notes and their own drafts, across every version of the template.
+func (u *NoteInteractor) ListByTemplate(ctx context.Context, templateID, viewerID string) error {
+	tpl, err := getVisibleTemplate(ctx, u.templates, templateID, viewerID)
+	if err != nil {
+		return err
+	}
+	notes, err := u.notes.List(ctx, note.Filters{TemplateID: &tpl.Template.ID})
+	if err != nil {
+		return err
+	}
+	visible := make([]note.WithMeta, 0, len(notes))
+	for _, n := range notes {
+		if note.ValidateNoteVisibility(n.Note, viewerID) == nil {
+			visible = append(visible, n)
+		}
+	}
+	return u.output.PresentNoteList(ctx, visible)
+}
+
 // Get returns note by ID.
 func (u *NoteInteractor) Get(ctx context.Context, id string) error {
 	n, err := u.notes.Get(ctx, id)
@@ -56,42 +86,15 @@ func (u *NoteInteractor) Create(ctx context.Context, input port.NoteCreateInput)
 		return domainerr.ErrOwnerRequired
 	}
 
-	tpl, err := u.templates.Get(ctx, input.TemplateID)
+	tpl, err := getVisibleTemplate(ctx, u.templates, input.TemplateID, input.OwnerID)
 	if err != nil {
 		return err
 	}
 
-	sections, err := buildSections("", input.Sections)
-	if err != nil {
-		return err
-	}
-	if err := note.ValidateNoteForCreate(input.Title, tpl.Template, sections); err != nil {
+	if err := validateNoteForCreate(tpl.Template, input); err != nil {
 		return err
 	}
-
-	var noteID string
-	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		newNote := note.Note{
-			Title:      input.Title,
-			TemplateID: tpl.Template.ID,
-			OwnerID:    input.OwnerID,
-			Status:     note.StatusDraft,
-			Sections:   sections,
-		}
-		nn, err := u.notes.Create(txCtx, newNote)
-		if err != nil {
-			return err
-		}
-		noteID = nn.ID
-		sectionsWithID, err := buildSections(noteID, input.Sections)
-		if err != nil {
-			return err
-		}
-		if err := note.ValidateSections(tpl.Template.Fields, sectionsWithID); err != nil {
-			return err
-		}
-		return u.notes.ReplaceSections(txCtx, noteID, sectionsWithID)
-	})
+	noteID, err := createNote(ctx, u.notes, u.events, u.tx, tpl.Template, input)
 	if err != nil {
 		return err
 	}
@@ -116,7 +119,7 @@ func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput)
 	}
 
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		_, err := u.notes.Update(txCtx, note.Note{
+		updated, err := u.notes.Update(txCtx, note.Note{
 			ID:    input.ID,
 			Title: input.Title,
 		})
@@ -124,7 +127,7 @@ func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput)
 			return err
 		}
 		if input.Sections != nil {
-			tpl, err := u.templates.Get(ctx, current.Note.TemplateID)
+			tpl, err := u.templates.GetVersion(txCtx, current.Note.TemplateID, current.Note.TemplateVersion)
 			if err != nil {
 				return err
 			}
@@ -139,7 +142,7 @@ func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput)
 				return err
 			}
 		}
-		return nil
+		return u.events.Append(txCtx, event.NewNoteUpdated(*updated, input.OwnerID))
 	})
 	if err != nil {
 		return err
@@ -177,7 +180,14 @@ func (u *NoteInteractor) ChangeStatus(ctx context.Context, input port.NoteStatus
 		return err
 	}
 
-	if _, err := u.notes.UpdateStatus(ctx, input.ID, input.Status); err != nil {
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := u.notes.UpdateStatus(txCtx, input.ID, input.Status)
+		if err != nil {
+			return err
+		}
+		return u.events.Append(txCtx, event.NewNoteStatusChanged(*updated, input.OwnerID))
+	})
+	if err != nil {
 		return err
 	}
 	n, err := u.notes.Get(ctx, input.ID)
@@ -187,7 +197,7 @@ func (u *NoteInteractor) ChangeStatus(ctx context.Context, input port.NoteStatus
 	return u.output.PresentNote(ctx, n)
 }
 
-// Delete deletes a note.
+// Delete deletes a note together with its attachments.
 func (u *NoteInteractor) Delete(ctx context.Context, id, ownerID string) error {
 	current, err := u.notes.Get(ctx, id)
 	if err != nil {
@@ -196,12 +206,270 @@ func (u *NoteInteractor) Delete(ctx context.Context, id, ownerID string) error {
 	if err := note.ValidateNoteOwnership(current.Note.OwnerID, ownerID); err != nil {
 		return err
 	}
-	if err := u.notes.Delete(ctx, id); err != nil {
+	attachments, err := u.attachments.ListByNote(ctx, id)
+	if err != nil {
 		return err
 	}
+	// attachment rows are removed by cascade; blobs are removed once the note is gone
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.notes.Delete(txCtx, id); err != nil {
+			return err
+		}
+		return u.events.Append(txCtx, event.NewNoteDeleted(current.Note, ownerID))
+	})
+	if err != nil {
+		return err
+	}
+	// The note is gone either way; a blob left behind only wastes space, so it must not fail the request.
+	for _, a := range attachments {
+		if err := u.blobs.Delete(ctx, a.StorageKey); err != nil {
+			log.Printf("delete attachment blob %s of note %s failed: %v\n", a.StorageKey, id, err)
+		}
+	}
 	return u.output.PresentNoteDeleted(ctx)
 }
 
+// Export presents a note for rendering into a document.
+// The output port decides the format; drafts are only exported for their owner.
+func (u *NoteInteractor) Export(ctx context.Context, id, viewerID string) error {
+	n, err := u.notes.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteVisibility(n.Note, viewerID); err != nil {
+		return err
+	}
+	return u.output.PresentNote(ctx, n)
+}
+
+// Upgrade moves a note to the latest version of its template. Section content follows the field
+// mapping; latest fields without a mapped source start empty and must still pass validation, and
+// content of unmapped fields is only dropped under the delete_content removal policy. Attachments
+// follow their section's content to the new layout.
+func (u *NoteInteractor) Upgrade(ctx context.Context, input port.NoteUpgradeInput) error {
+	current, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+		return err
+	}
+	tpl, err := u.templates.Get(ctx, current.Note.TemplateID)
+	if err != nil {
+		return err
+	}
+	if err := note.CanUpgrade(current.Note, tpl.Template.Version); err != nil {
+		return err
+	}
+	policy, err := fieldRemovalPolicy(input.FieldRemoval)
+	if err != nil {
+		return err
+	}
+	sections, err := note.UpgradeSections(current.Note.ID, current.Sections, tpl.Template.Fields, input.Mapping, policy)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateSections(tpl.Template.Fields, sections); err != nil {
+		return err
+	}
+
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		attachments, err := u.attachments.ListByNote(txCtx, input.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.notes.DeleteSections(txCtx, input.ID); err != nil {
+			return err
+		}
+		updated, err := u.notes.UpdateTemplateVersion(txCtx, input.ID, tpl.Template.Version)
+		if err != nil {
+			return err
+		}
+		if err := u.notes.ReplaceSections(txCtx, input.ID, sections); err != nil {
+			return err
+		}
+		if err := u.relinkAttachments(txCtx, current, input.Mapping, attachments); err != nil {
+			return err
+		}
+		return u.events.Append(txCtx, event.NewNoteUpdated(*updated, input.OwnerID))
+	})
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNote(ctx, n)
+}
+
+// Retemplate moves a note to the latest version of another template the owner may use.
+// Section content follows the field mapping as in Upgrade, and the previous layout is kept
+// as a revision in the same transaction.
+func (u *NoteInteractor) Retemplate(ctx context.Context, input port.NoteRetemplateInput) error {
+	current, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := note.CanRetemplate(current.Note, input.TemplateID); err != nil {
+		return err
+	}
+	tpl, err := getVisibleTemplate(ctx, u.templates, input.TemplateID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	if err := template.ValidateTemplateUsable(tpl.Template); err != nil {
+		return err
+	}
+	policy, err := fieldRemovalPolicy(input.FieldRemoval)
+	if err != nil {
+		return err
+	}
+	sections, err := note.UpgradeSections(current.Note.ID, current.Sections, tpl.Template.Fields, input.Mapping, policy)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateSections(tpl.Template.Fields, sections); err != nil {
+		return err
+	}
+
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := u.notes.CreateRevision(txCtx, note.NewRevision(*current)); err != nil {
+			return err
+		}
+		attachments, err := u.attachments.ListByNote(txCtx, input.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.notes.DeleteSections(txCtx, input.ID); err != nil {
+			return err
+		}
+		updated, err := u.notes.UpdateTemplate(txCtx, input.ID, tpl.Template.ID, tpl.Template.Version)
+		if err != nil {
+			return err
+		}
+		if err := u.notes.ReplaceSections(txCtx, input.ID, sections); err != nil {
+			return err
+		}
+		if err := u.relinkAttachments(txCtx, current, input.Mapping, attachments); err != nil {
+			return err
+		}
+		return u.events.Append(txCtx, event.NewNoteRetemplated(*updated, current.Note.TemplateID, input.OwnerID))
+	})
+	if err != nil {
+		return err
+	}
+	n, err := u.notes.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNote(ctx, n)
+}
+
+// getVisibleTemplate loads a template the viewer may see and write notes with;
+// private templates of other accounts are reported as not found.
+func getVisibleTemplate(ctx context.Context, templates port.TemplateRepository, id, viewerID string) (*template.WithUsage, error) {
+	tpl, err := templates.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := template.ValidateTemplateAccess(tpl.Template, viewerID); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+// relinkAttachments moves attachments linked to sections of the previous layout onto the sections
+// that received their content. Replacing the sections unlinked them, so attachments of unmapped
+// fields stay on the note without a section.
+func (u *NoteInteractor) relinkAttachments(ctx context.Context, previous *note.WithMeta, mapping note.FieldMapping, attachments []attachment.Attachment) error {
+	linked := slices.ContainsFunc(attachments, func(a attachment.Attachment) bool { return a.SectionID != nil })
+	if !linked {
+		return nil
+	}
+	moved, err := u.notes.Get(ctx, previous.Note.ID)
+	if err != nil {
+		return err
+	}
+	sectionIDs := note.MovedSectionIDs(previous.Sections, mapping, moved.Sections)
+	for _, a := range attachments {
+		if a.SectionID == nil {
+			continue
+		}
+		if id, ok := sectionIDs[*a.SectionID]; ok {
+			if err := u.attachments.UpdateSection(ctx, a.ID, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fieldRemovalPolicy defaults an empty policy to reject and checks that it is known.
+func fieldRemovalPolicy(policy template.FieldRemovalPolicy) (template.FieldRemovalPolicy, error) {
+	if policy == "" {
+		policy = template.FieldRemovalReject
+	}
+	if err := policy.Validate(); err != nil {
+		return "", err
+	}
+	return policy, nil
+}
+
+// validateNoteForCreate checks a create input against the template without persisting anything.
+// Deprecated templates are rejected here so every way of creating notes refuses them.
+func validateNoteForCreate(tpl template.Template, input port.NoteCreateInput) error {
+	if err := template.ValidateTemplateUsable(tpl); err != nil {
+		return err
+	}
+	sections, err := buildSections("", input.Sections)
+	if err != nil {
+		return err
+	}
+	return note.ValidateNoteForCreate(input.Title, tpl, sections)
+}
+
+// createNote persists a validated draft note, its sections and its NoteCreated event in one transaction.
+func createNote(ctx context.Context, notes port.NoteRepository, events port.OutboxRepository, tx port.TxManager, tpl template.Template, input port.NoteCreateInput) (string, error) {
+	var noteID string
+	err := tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		sections, err := buildSections("", input.Sections)
+		if err != nil {
+			return err
+		}
+		nn, err := notes.Create(txCtx, note.Note{
+			Title:           input.Title,
+			TemplateID:      tpl.ID,
+			TemplateVersion: tpl.Version,
+			OwnerID:         input.OwnerID,
+			Status:          note.StatusDraft,
+			Sections:        sections,
+		})
+		if err != nil {
+			return err
+		}
+		noteID = nn.ID
+		sectionsWithID, err := buildSections(noteID, input.Sections)
+		if err != nil {
+			return err
+		}
+		if err := note.ValidateSections(tpl.Fields, sectionsWithID); err != nil {
+			return err
+		}
+		if err := notes.ReplaceSections(txCtx, noteID, sectionsWithID); err != nil {
+			return err
+		}
+		return events.Append(txCtx, event.NewNoteCreated(*nn))
+	})
+	if err != nil {
+		return "", err
+	}
+	return noteID, nil
+}
+
 func buildSections(noteID string, inputs []port.SectionInput) ([]note.Section, error) {
 	if len(inputs) == 0 {
 		return nil, domainerr.ErrSectionsMissing
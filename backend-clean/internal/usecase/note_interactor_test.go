@@ -3,11 +3,15 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/attachment"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/domain/note"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
@@ -43,6 +47,7 @@ func TestNoteInteractor_List(t *testing.T) {
 
 			notes := mockusecase.NewMockNoteRepository(ctrl)
 			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
@@ -51,7 +56,7 @@ func TestNoteInteractor_List(t *testing.T) {
 				out.EXPECT().PresentNoteList(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notes, templates, tx, out)
+			interactor := uc.NewNoteInteractor(notes, templates, nil, nil, events, tx, out)
 			err := interactor.List(context.Background(), tt.filters)
 
 			if tt.wantError == nil && err != nil {
@@ -64,6 +69,99 @@ func TestNoteInteractor_List(t *testing.T) {
 	}
 }
 
+func TestNoteInteractor_ListByTemplate(t *testing.T) {
+	tpl := &template.WithUsage{Template: template.Template{ID: "tpl-1"}}
+	notes := []note.WithMeta{
+		{Note: note.Note{ID: "n1", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusPublish}},
+		{Note: note.Note{ID: "n2", OwnerID: "owner-1", TemplateID: "tpl-1", Status: note.StatusDraft}},
+		{Note: note.Note{ID: "n3", OwnerID: "owner-2", TemplateID: "tpl-1", Status: note.StatusDraft}},
+	}
+
+	tests := []struct {
+		name      string
+		viewerID  string
+		getErr    error
+		listErr   error
+		wantIDs   []string
+		wantError error
+	}{
+		{
+			name:    "[Success] anonymous viewer sees published notes",
+			wantIDs: []string{"n1"},
+		},
+		{
+			name:     "[Success] owner also sees own drafts",
+			viewerID: "owner-1",
+			wantIDs:  []string{"n1", "n2"},
+		},
+		{
+			name:      "[Fail] template not found",
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] list error",
+			listErr:   errors.New("list err"),
+			wantError: errors.New("list err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			if tt.getErr != nil {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(nil, tt.getErr)
+			} else {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tpl, nil)
+				notesRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, filters note.Filters) ([]note.WithMeta, error) {
+						if filters.TemplateID == nil || *filters.TemplateID != "tpl-1" {
+							t.Fatalf("unexpected filters: %+v", filters)
+						}
+						if tt.listErr != nil {
+							return nil, tt.listErr
+						}
+						return notes, nil
+					},
+				)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteList(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, got []note.WithMeta) error {
+						if len(got) != len(tt.wantIDs) {
+							t.Fatalf("want %v, got %+v", tt.wantIDs, got)
+						}
+						for i, n := range got {
+							if n.Note.ID != tt.wantIDs[i] {
+								t.Fatalf("want %v, got %+v", tt.wantIDs, got)
+							}
+						}
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, nil, nil, events, tx, out)
+			err := interactor.ListByTemplate(context.Background(), "tpl-1", tt.viewerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestNoteInteractor_Get(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -92,6 +190,7 @@ func TestNoteInteractor_Get(t *testing.T) {
 
 			notes := mockusecase.NewMockNoteRepository(ctrl)
 			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
@@ -100,7 +199,7 @@ func TestNoteInteractor_Get(t *testing.T) {
 				out.EXPECT().PresentNote(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notes, templates, tx, out)
+			interactor := uc.NewNoteInteractor(notes, templates, nil, nil, events, tx, out)
 			err := interactor.Get(context.Background(), tt.id)
 
 			if tt.wantError == nil && err != nil {
@@ -116,6 +215,7 @@ func TestNoteInteractor_Get(t *testing.T) {
 func TestNoteInteractor_Create(t *testing.T) {
 	templateFields := []template.Field{{ID: "f1", Label: "Title", Order: 1, IsRequired: false}}
 	validSections := []port.SectionInput{{FieldID: "f1", Content: "content"}}
+	deprecatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name        string
 		input       port.NoteCreateInput
@@ -163,6 +263,28 @@ func TestNoteInteractor_Create(t *testing.T) {
 			getTplErr: errors.New("get tpl err"),
 			wantError: errors.New("get tpl err"),
 		},
+		{
+			name: "[Fail] private template of another owner",
+			input: port.NoteCreateInput{
+				Title:      "Hello",
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-2",
+				Sections:   validSections,
+			},
+			tpl:       &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Visibility: template.VisibilityPrivate, Fields: templateFields}},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name: "[Fail] deprecated template names successor",
+			input: port.NoteCreateInput{
+				Title:      "Hello",
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-1",
+				Sections:   validSections,
+			},
+			tpl:       &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", DeprecatedAt: &deprecatedAt, SuccessorID: "tpl-2", Fields: templateFields}},
+			wantError: &domainerr.DeprecatedTemplateError{TemplateID: "tpl-1", SuccessorID: "tpl-2"},
+		},
 		{
 			name: "[Fail] validation error",
 			input: port.NoteCreateInput{
@@ -209,6 +331,7 @@ func TestNoteInteractor_Create(t *testing.T) {
 
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
@@ -225,13 +348,21 @@ func TestNoteInteractor_Create(t *testing.T) {
 				if tt.createErr == nil {
 					notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", gomock.Any()).Return(tt.replaceErr)
 				}
+				if tt.createErr == nil && tt.replaceErr == nil {
+					events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+						if e.Name != event.NoteCreated || e.AggregateID != "note-1" || e.ActorID != tt.input.OwnerID {
+							t.Fatalf("unexpected event: %+v", e)
+						}
+						return nil
+					})
+				}
 			}
 			if tt.getTplErr == nil && tt.createErr == nil && tt.replaceErr == nil && tt.wantError == nil {
 				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: tt.input.OwnerID, TemplateID: tt.input.TemplateID}}, nil)
 				out.EXPECT().PresentNote(gomock.Any(), gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, nil, nil, events, tx, out)
 			err := interactor.Create(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -255,6 +386,7 @@ func TestNoteInteractor_Update(t *testing.T) {
 		},
 	}
 
+	deprecatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name         string
 		input        port.NoteUpdateInput
@@ -291,13 +423,31 @@ func TestNoteInteractor_Update(t *testing.T) {
 				},
 			},
 			current: &note.WithMeta{
-				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"},
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 2},
 				Sections: existingSections,
 			},
 			tpl:          &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Fields: templateFields}},
 			expectTxRun:  true,
 			withSections: true,
 		},
+		{
+			name: "[Success] update sections of deprecated template",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				Sections: []port.SectionUpdateInput{
+					{SectionID: "sec1", Content: "updated"},
+				},
+			},
+			current: &note.WithMeta{
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 2},
+				Sections: existingSections,
+			},
+			tpl:          &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", DeprecatedAt: &deprecatedAt, SuccessorID: "tpl-2", Fields: templateFields}},
+			expectTxRun:  true,
+			withSections: true,
+		},
 		{
 			name: "[Fail] owner mismatch",
 			input: port.NoteUpdateInput{
@@ -369,6 +519,7 @@ func TestNoteInteractor_Update(t *testing.T) {
 
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
@@ -381,16 +532,24 @@ func TestNoteInteractor_Update(t *testing.T) {
 				)
 				notesRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&tt.current.Note, tt.updateErr)
 				if tt.updateErr == nil && tt.withSections {
-					tplRepo.EXPECT().Get(gomock.Any(), tt.current.Note.TemplateID).Return(tt.tpl, nil)
+					tplRepo.EXPECT().GetVersion(gomock.Any(), tt.current.Note.TemplateID, tt.current.Note.TemplateVersion).Return(tt.tpl, nil)
 					notesRepo.EXPECT().ReplaceSections(gomock.Any(), tt.input.ID, gomock.Any()).Return(tt.replaceErr)
 				}
+				if tt.updateErr == nil && (!tt.withSections || tt.replaceErr == nil) {
+					events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+						if e.Name != event.NoteUpdated || e.ActorID != tt.input.OwnerID {
+							t.Fatalf("unexpected event: %+v", e)
+						}
+						return nil
+					})
+				}
 			}
 			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && (!tt.withSections || tt.replaceErr == nil) {
 				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
 				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, nil, nil, events, tx, out)
 			err := interactor.Update(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -403,6 +562,387 @@ func TestNoteInteractor_Update(t *testing.T) {
 	}
 }
 
+func TestNoteInteractor_Upgrade(t *testing.T) {
+	oldFields := []template.Field{
+		{ID: "f1", Label: "Body", Order: 1, IsRequired: true},
+		{ID: "f2", Label: "Memo", Order: 2},
+	}
+	newFields := []template.Field{
+		{ID: "g1", Label: "Content", Order: 1, IsRequired: true},
+		{ID: "g2", Label: "Summary", Order: 2},
+	}
+	current := &note.WithMeta{
+		Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 1},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "s1", NoteID: "note-1", FieldID: "f1", Content: "body"}, FieldLabel: oldFields[0].Label, FieldOrder: 1, IsRequired: true},
+			{Section: note.Section{ID: "s2", NoteID: "note-1", FieldID: "f2", Content: "memo"}, FieldLabel: oldFields[1].Label, FieldOrder: 2},
+		},
+	}
+	latest := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Version: 2, Fields: newFields}}
+	upgraded := &note.WithMeta{
+		Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 2},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "t1", NoteID: "note-1", FieldID: "g1", Content: "body"}},
+			{Section: note.Section{ID: "t2", NoteID: "note-1", FieldID: "g2"}},
+		},
+	}
+	s1, s2 := "s1", "s2"
+
+	tests := []struct {
+		name        string
+		input       port.NoteUpgradeInput
+		tpl         *template.WithUsage
+		attachments []attachment.Attachment
+		replaceErr  error
+		wantError   error
+		expectTxRun bool
+		// wantRelinked maps attachment IDs to the sections they are moved to.
+		wantRelinked map[string]string
+	}{
+		{
+			name: "[Success] upgrade with mapping",
+			input: port.NoteUpgradeInput{
+				ID:           "note-1",
+				OwnerID:      "owner-1",
+				Mapping:      note.FieldMapping{"f1": "g1"},
+				FieldRemoval: template.FieldRemovalDeleteContent,
+			},
+			tpl:         latest,
+			expectTxRun: true,
+		},
+		{
+			name: "[Success] attachments follow their section's content",
+			input: port.NoteUpgradeInput{
+				ID:           "note-1",
+				OwnerID:      "owner-1",
+				Mapping:      note.FieldMapping{"f1": "g1"},
+				FieldRemoval: template.FieldRemovalDeleteContent,
+			},
+			tpl: latest,
+			attachments: []attachment.Attachment{
+				{ID: "a1", NoteID: "note-1", SectionID: &s1},
+				{ID: "a2", NoteID: "note-1", SectionID: &s2},
+				{ID: "a3", NoteID: "note-1"},
+			},
+			expectTxRun:  true,
+			wantRelinked: map[string]string{"a1": "t1"},
+		},
+		{
+			name:      "[Fail] owner mismatch",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "other"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:  "[Fail] already up to date",
+			input: port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1"},
+			tpl: &template.WithUsage{Template: template.Template{
+				ID: "tpl-1", OwnerID: "owner-1", Version: 1, Fields: oldFields,
+			}},
+			wantError: domainerr.ErrNoteUpToDate,
+		},
+		{
+			name: "[Fail] unknown mapping target",
+			input: port.NoteUpgradeInput{
+				ID:           "note-1",
+				OwnerID:      "owner-1",
+				Mapping:      note.FieldMapping{"f1": "missing"},
+				FieldRemoval: template.FieldRemovalDeleteContent,
+			},
+			tpl:       latest,
+			wantError: domainerr.ErrInvalidFieldMapping,
+		},
+		{
+			name:      "[Fail] required field left empty",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1", FieldRemoval: template.FieldRemovalDeleteContent},
+			tpl:       latest,
+			wantError: fmt.Errorf("Content: %w", domainerr.ErrRequiredFieldEmpty),
+		},
+		{
+			name: "[Fail] unmapped field with content under the default reject policy",
+			input: port.NoteUpgradeInput{
+				ID:      "note-1",
+				OwnerID: "owner-1",
+				Mapping: note.FieldMapping{"f1": "g1"},
+			},
+			tpl:       latest,
+			wantError: domainerr.ErrFieldHasContent,
+		},
+		{
+			name:      "[Fail] unknown removal policy",
+			input:     port.NoteUpgradeInput{ID: "note-1", OwnerID: "owner-1", FieldRemoval: "keep"},
+			tpl:       latest,
+			wantError: domainerr.ErrInvalidFieldRemovalPolicy,
+		},
+		{
+			name: "[Fail] replace sections error",
+			input: port.NoteUpgradeInput{
+				ID:           "note-1",
+				OwnerID:      "owner-1",
+				Mapping:      note.FieldMapping{"f1": "g1"},
+				FieldRemoval: template.FieldRemovalDeleteContent,
+			},
+			tpl:         latest,
+			replaceErr:  errors.New("replace err"),
+			wantError:   errors.New("replace err"),
+			expectTxRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(current, nil)
+			if tt.tpl != nil {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tt.tpl, nil)
+			}
+			if tt.expectTxRun {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
+				attachments.EXPECT().ListByNote(gomock.Any(), tt.input.ID).Return(tt.attachments, nil)
+				notesRepo.EXPECT().DeleteSections(gomock.Any(), tt.input.ID).Return(nil)
+				notesRepo.EXPECT().UpdateTemplateVersion(gomock.Any(), tt.input.ID, tt.tpl.Template.Version).Return(&current.Note, nil)
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), tt.input.ID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, sections []note.Section) error {
+						if len(sections) != 2 || sections[0].FieldID != "g1" || sections[0].Content != "body" || sections[1].Content != "" {
+							t.Fatalf("unexpected sections: %+v", sections)
+						}
+						return tt.replaceErr
+					},
+				)
+			}
+			if tt.wantRelinked != nil {
+				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(upgraded, nil)
+				for id, sectionID := range tt.wantRelinked {
+					attachments.EXPECT().UpdateSection(gomock.Any(), id, sectionID).Return(nil)
+				}
+			}
+			if tt.expectTxRun && tt.replaceErr == nil {
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
+				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(current, nil)
+				out.EXPECT().PresentNote(gomock.Any(), current).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, attachments, nil, events, tx, out)
+			err := interactor.Upgrade(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_Retemplate(t *testing.T) {
+	now := time.Now()
+	current := &note.WithMeta{
+		Note: note.Note{ID: "note-1", Title: "Note", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 3},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "s1", NoteID: "note-1", FieldID: "f1", Content: "body"}, FieldLabel: "Body", FieldOrder: 1, IsRequired: true},
+			{Section: note.Section{ID: "s2", NoteID: "note-1", FieldID: "f2", Content: "memo"}, FieldLabel: "Memo", FieldOrder: 2},
+		},
+	}
+	target := &template.WithUsage{Template: template.Template{
+		ID: "tpl-2", OwnerID: "other", Visibility: template.VisibilityPublic, Version: 2,
+		Fields: []template.Field{
+			{ID: "g1", Label: "Content", Order: 1, IsRequired: true},
+			{ID: "g2", Label: "Summary", Order: 2},
+		},
+	}}
+
+	retemplated := &note.WithMeta{
+		Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2", TemplateVersion: 2},
+		Sections: []note.SectionWithField{
+			{Section: note.Section{ID: "t1", NoteID: "note-1", FieldID: "g1", Content: "body"}},
+			{Section: note.Section{ID: "t2", NoteID: "note-1", FieldID: "g2", Content: "memo"}},
+		},
+	}
+	s1, s2 := "s1", "s2"
+
+	tests := []struct {
+		name        string
+		input       port.NoteRetemplateInput
+		tpl         *template.WithUsage
+		attachments []attachment.Attachment
+		revisionErr error
+		wantError   error
+		expectTxRun bool
+		// wantRelinked maps attachment IDs to the sections they are moved to.
+		wantRelinked map[string]string
+	}{
+		{
+			name: "[Success] retemplate with mapping",
+			input: port.NoteRetemplateInput{
+				ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2",
+				Mapping: note.FieldMapping{"f1": "g1", "f2": "g2"},
+			},
+			tpl:         target,
+			expectTxRun: true,
+		},
+		{
+			name: "[Success] attachments follow their section's content",
+			input: port.NoteRetemplateInput{
+				ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2",
+				Mapping: note.FieldMapping{"f1": "g1", "f2": "g2"},
+			},
+			tpl: target,
+			attachments: []attachment.Attachment{
+				{ID: "a1", NoteID: "note-1", SectionID: &s1},
+				{ID: "a2", NoteID: "note-1", SectionID: &s2},
+			},
+			expectTxRun:  true,
+			wantRelinked: map[string]string{"a1": "t1", "a2": "t2"},
+		},
+		{
+			name:      "[Fail] owner mismatch",
+			input:     port.NoteRetemplateInput{ID: "note-1", OwnerID: "other", TemplateID: "tpl-2"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] same template",
+			input:     port.NoteRetemplateInput{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"},
+			wantError: domainerr.ErrNoteSameTemplate,
+		},
+		{
+			name:  "[Fail] private template of another account",
+			input: port.NoteRetemplateInput{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2"},
+			tpl: &template.WithUsage{Template: template.Template{
+				ID: "tpl-2", OwnerID: "other", Visibility: template.VisibilityPrivate, Fields: target.Template.Fields,
+			}},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:  "[Fail] deprecated template",
+			input: port.NoteRetemplateInput{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2"},
+			tpl: &template.WithUsage{Template: template.Template{
+				ID: "tpl-2", OwnerID: "owner-1", DeprecatedAt: &now, Fields: target.Template.Fields,
+			}},
+			wantError: &domainerr.DeprecatedTemplateError{TemplateID: "tpl-2"},
+		},
+		{
+			name: "[Fail] unknown mapping source",
+			input: port.NoteRetemplateInput{
+				ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2",
+				Mapping:      note.FieldMapping{"missing": "g1"},
+				FieldRemoval: template.FieldRemovalDeleteContent,
+			},
+			tpl:       target,
+			wantError: domainerr.ErrInvalidFieldMapping,
+		},
+		{
+			name:      "[Fail] required target field left empty",
+			input:     port.NoteRetemplateInput{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2", FieldRemoval: template.FieldRemovalDeleteContent},
+			tpl:       target,
+			wantError: fmt.Errorf("Content: %w", domainerr.ErrRequiredFieldEmpty),
+		},
+		{
+			name: "[Fail] unmapped field with content under the default reject policy",
+			input: port.NoteRetemplateInput{
+				ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2",
+				Mapping: note.FieldMapping{"f1": "g1"},
+			},
+			tpl:       target,
+			wantError: domainerr.ErrFieldHasContent,
+		},
+		{
+			name: "[Fail] revision error",
+			input: port.NoteRetemplateInput{
+				ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-2",
+				Mapping: note.FieldMapping{"f1": "g1", "f2": "g2"},
+			},
+			tpl:         target,
+			revisionErr: errors.New("revision err"),
+			wantError:   errors.New("revision err"),
+			expectTxRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(current, nil)
+			if tt.tpl != nil {
+				tplRepo.EXPECT().Get(gomock.Any(), tt.input.TemplateID).Return(tt.tpl, nil)
+			}
+			if tt.expectTxRun {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
+				notesRepo.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, rev note.Revision) (*note.Revision, error) {
+						if rev.NoteID != "note-1" || rev.TemplateID != "tpl-1" || rev.TemplateVersion != 3 || len(rev.Sections) != 2 || rev.Sections[1].Content != "memo" {
+							t.Fatalf("unexpected revision: %+v", rev)
+						}
+						return &rev, tt.revisionErr
+					},
+				)
+			}
+			if tt.expectTxRun && tt.revisionErr == nil {
+				attachments.EXPECT().ListByNote(gomock.Any(), tt.input.ID).Return(tt.attachments, nil)
+				notesRepo.EXPECT().DeleteSections(gomock.Any(), tt.input.ID).Return(nil)
+				notesRepo.EXPECT().UpdateTemplate(gomock.Any(), tt.input.ID, "tpl-2", 2).Return(&current.Note, nil)
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), tt.input.ID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, sections []note.Section) error {
+						if len(sections) != 2 || sections[0].FieldID != "g1" || sections[0].Content != "body" || sections[1].Content != "memo" {
+							t.Fatalf("unexpected sections: %+v", sections)
+						}
+						return nil
+					},
+				)
+				if tt.wantRelinked != nil {
+					notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(retemplated, nil)
+					for id, sectionID := range tt.wantRelinked {
+						attachments.EXPECT().UpdateSection(gomock.Any(), id, sectionID).Return(nil)
+					}
+				}
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Data["previousTemplateId"] != "tpl-1" {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
+				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(current, nil)
+				out.EXPECT().PresentNote(gomock.Any(), current).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, attachments, nil, events, tx, out)
+			err := interactor.Retemplate(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestNoteInteractor_ChangeStatus(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -461,20 +1001,34 @@ func TestNoteInteractor_ChangeStatus(t *testing.T) {
 
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
 			shouldUpdate := tt.getErr == nil && (tt.wantError == nil || (tt.updateErr != nil && tt.wantError.Error() == tt.updateErr.Error()))
 			if shouldUpdate {
-				notesRepo.EXPECT().UpdateStatus(gomock.Any(), tt.input.ID, tt.input.Status).Return(&tt.current.Note, tt.updateErr)
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
+				notesRepo.EXPECT().UpdateStatus(gomock.Any(), tt.input.ID, tt.input.Status).Return(&note.Note{ID: tt.input.ID, Status: tt.input.Status}, tt.updateErr)
+			}
+			if shouldUpdate && tt.updateErr == nil {
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.NotePublished || e.AggregateID != tt.input.ID {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.updateErr == nil {
 				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
 				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, nil, nil, events, tx, out)
 			err := interactor.ChangeStatus(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -497,6 +1051,8 @@ func TestNoteInteractor_Delete(t *testing.T) {
 		deleteErr error
 		wantError error
 		expectDel bool
+		blobKeys  []string
+		blobErr   error
 	}{
 		{
 			name:      "[Success] delete",
@@ -505,6 +1061,23 @@ func TestNoteInteractor_Delete(t *testing.T) {
 			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
 			expectDel: true,
 		},
+		{
+			name:      "[Success] delete removes attachment blobs",
+			id:        "note-1",
+			ownerID:   "owner-1",
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
+			expectDel: true,
+			blobKeys:  []string{"key-1", "key-2"},
+		},
+		{
+			name:      "[Success] blob delete error does not fail the delete",
+			id:        "note-1",
+			ownerID:   "owner-1",
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
+			expectDel: true,
+			blobKeys:  []string{"key-1", "key-2"},
+			blobErr:   errors.New("blob err"),
+		},
 		{
 			name:      "[Fail] get error",
 			id:        "note-1",
@@ -537,18 +1110,44 @@ func TestNoteInteractor_Delete(t *testing.T) {
 
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
+			attachments := mockusecase.NewMockAttachmentRepository(ctrl)
+			blobs := mockusecase.NewMockBlobStore(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.current, tt.getErr)
 			if tt.getErr == nil && tt.expectDel {
+				list := make([]attachment.Attachment, 0, len(tt.blobKeys))
+				for _, key := range tt.blobKeys {
+					list = append(list, attachment.Attachment{NoteID: tt.id, StorageKey: key})
+				}
+				attachments.EXPECT().ListByNote(gomock.Any(), tt.id).Return(list, nil)
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
 				notesRepo.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.deleteErr)
 			}
+			if tt.getErr == nil && tt.expectDel && tt.deleteErr == nil {
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.NoteDeleted || e.AggregateID != tt.id {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
+			}
+			if tt.deleteErr == nil {
+				for _, key := range tt.blobKeys {
+					blobs.EXPECT().Delete(gomock.Any(), key).Return(tt.blobErr)
+				}
+			}
 			if tt.getErr == nil && tt.wantError == nil && tt.deleteErr == nil {
 				out.EXPECT().PresentNoteDeleted(gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, attachments, blobs, events, tx, out)
 			err := interactor.Delete(context.Background(), tt.id, tt.ownerID)
 
 			if tt.wantError == nil && err != nil {
@@ -562,3 +1161,49 @@ func TestNoteInteractor_Delete(t *testing.T) {
 }
 
 // b2i converts bool to int for Times() convenience.
+
+func TestNoteInteractor_Export(t *testing.T) {
+	draft := &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner-1", Status: note.StatusDraft}}
+	published := &note.WithMeta{Note: note.Note{ID: "n2", OwnerID: "owner-1", Status: note.StatusPublish}}
+	tests := []struct {
+		name      string
+		id        string
+		viewerID  string
+		result    *note.WithMeta
+		repoErr   error
+		wantError error
+	}{
+		{name: "[Success] owner exports draft", id: "n1", viewerID: "owner-1", result: draft},
+		{name: "[Success] anyone exports published", id: "n2", result: published},
+		{name: "[Fail] draft of another owner", id: "n1", viewerID: "other", result: draft, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] not found", id: "missing", repoErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), tt.id).Return(tt.result, tt.repoErr)
+			if tt.wantError == nil {
+				out.EXPECT().PresentNote(gomock.Any(), tt.result).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notes, templates, nil, nil, events, tx, out)
+			err := interactor.Export(context.Background(), tt.id, tt.viewerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
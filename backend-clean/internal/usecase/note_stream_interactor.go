@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// streamHeartbeat keeps idle streams alive through proxies and detects gone clients.
+const streamHeartbeat = 20 * time.Second
+
+// NoteStreamInteractor streams live note changes to one client.
+type NoteStreamInteractor struct {
+	bus       port.EventBus
+	output    port.NoteStreamOutputPort
+	heartbeat time.Duration
+}
+
+var _ port.NoteStreamInputPort = (*NoteStreamInteractor)(nil)
+
+// NewNoteStreamInteractor creates NoteStreamInteractor.
+func NewNoteStreamInteractor(bus port.EventBus, output port.NoteStreamOutputPort) *NoteStreamInteractor {
+	return &NoteStreamInteractor{bus: bus, output: output, heartbeat: streamHeartbeat}
+}
+
+// Stream replays missed events, then presents live ones the filter matches until ctx is canceled
+// or the bus ends the subscription. A write error ends the stream with that error.
+func (u *NoteStreamInteractor) Stream(ctx context.Context, input port.NoteStreamInput) error {
+	sub := u.bus.Subscribe(input.LastEventID)
+	defer sub.Cancel()
+
+	if !sub.Resumed {
+		if err := u.output.PresentStreamReset(ctx); err != nil {
+			return err
+		}
+	}
+	for _, env := range sub.Replay {
+		if !input.Filter.Matches(env.Event) {
+			continue
+		}
+		if err := u.output.PresentNoteEvent(ctx, env); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(u.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case env, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+			if !input.Filter.Matches(env.Event) {
+				continue
+			}
+			if err := u.output.PresentNoteEvent(ctx, env); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := u.output.PresentHeartbeat(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteStreamInteractor_Stream(t *testing.T) {
+	public := event.Envelope{ID: "s-1", Event: event.Event{ID: "ev-1", Name: event.NoteUpdated, Data: map[string]string{"ownerId": "owner-1", "status": "Publish"}}}
+	draft := event.Envelope{ID: "s-2", Event: event.Event{ID: "ev-2", Name: event.NoteUpdated, Data: map[string]string{"ownerId": "owner-1", "status": "Draft"}}}
+	writeErr := errors.New("broken pipe")
+	tests := []struct {
+		name      string
+		viewerID  string
+		resumed   bool
+		replay    []event.Envelope
+		live      []event.Envelope
+		presented []string
+		presErr   error
+		wantReset bool
+		wantErr   error
+	}{
+		{name: "[Success] replay then live until bus closes", viewerID: "owner-1", resumed: true, replay: []event.Envelope{public}, live: []event.Envelope{draft}, presented: []string{"s-1", "s-2"}},
+		{name: "[Success] drafts of others are skipped", viewerID: "other", resumed: true, replay: []event.Envelope{draft}, live: []event.Envelope{public}, presented: []string{"s-1"}},
+		{name: "[Success] reset when history is gone", resumed: false, live: []event.Envelope{public}, presented: []string{"s-1"}, wantReset: true},
+		{name: "[Fail] write error ends stream", resumed: true, live: []event.Envelope{public}, presented: []string{"s-1"}, presErr: writeErr, wantErr: writeErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bus := mockusecase.NewMockEventBus(ctrl)
+			out := mockusecase.NewMockNoteStreamOutputPort(ctrl)
+			events := make(chan event.Envelope, len(tt.live))
+			for _, env := range tt.live {
+				events <- env
+			}
+			close(events)
+			canceled := false
+			bus.EXPECT().Subscribe("s-0").Return(port.EventSubscription{
+				Replay:  tt.replay,
+				Resumed: tt.resumed,
+				Events:  events,
+				Cancel:  func() { canceled = true },
+			})
+			out.EXPECT().PresentStreamReset(gomock.Any()).Return(nil).Times(b2i(tt.wantReset))
+			var got []string
+			out.EXPECT().PresentNoteEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, env event.Envelope) error {
+				got = append(got, env.ID)
+				return tt.presErr
+			}).Times(len(tt.presented))
+
+			input := port.NoteStreamInput{Filter: event.StreamFilter{ViewerID: tt.viewerID}, LastEventID: "s-0"}
+			err := uc.NewNoteStreamInteractor(bus, out).Stream(context.Background(), input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if len(got) != len(tt.presented) || (len(got) > 0 && got[0] != tt.presented[0]) {
+				t.Fatalf("presented %v, want %v", got, tt.presented)
+			}
+			if !canceled {
+				t.Fatal("subscription not canceled")
+			}
+		})
+	}
+}
+
+func TestNoteStreamInteractor_StreamStopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bus := mockusecase.NewMockEventBus(ctrl)
+	out := mockusecase.NewMockNoteStreamOutputPort(ctrl)
+	bus.EXPECT().Subscribe("").Return(port.EventSubscription{Resumed: true, Events: make(chan event.Envelope), Cancel: func() {}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := uc.NewNoteStreamInteractor(bus, out).Stream(ctx, port.NoteStreamInput{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
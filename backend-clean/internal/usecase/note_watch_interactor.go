@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteWatchInteractor follows note changes for one internal consumer.
+type NoteWatchInteractor struct {
+	notes  port.NoteRepository
+	bus    port.EventBus
+	output port.NoteWatchOutputPort
+}
+
+var _ port.NoteWatchInputPort = (*NoteWatchInteractor)(nil)
+
+// NewNoteWatchInteractor creates NoteWatchInteractor.
+func NewNoteWatchInteractor(notes port.NoteRepository, bus port.EventBus, output port.NoteWatchOutputPort) *NoteWatchInteractor {
+	return &NoteWatchInteractor{notes: notes, bus: bus, output: output}
+}
+
+// Watch subscribes before reading the snapshot, so no change is missed but a change made while the
+// snapshot is read may be presented after it although the snapshot already contains it.
+// It returns nil when ctx is canceled, ErrConsumerTooSlow when the consumer fell too far behind and
+// ErrResyncRequired when the bus ends the subscription because it shuts down or lost events.
+func (u *NoteWatchInteractor) Watch(ctx context.Context, input port.NoteWatchInput) error {
+	sub := u.bus.Subscribe(input.ResumeToken)
+	defer sub.Cancel()
+
+	if input.ResumeToken == "" || !sub.Resumed {
+		if err := u.snapshot(ctx, input.Filter, sub.LastID); err != nil {
+			return err
+		}
+	} else {
+		for _, env := range sub.Replay {
+			if err := u.change(ctx, input.Filter, env); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case env, ok := <-sub.Events:
+			if !ok {
+				if sub.Lagged != nil && sub.Lagged() {
+					return domainerr.ErrConsumerTooSlow
+				}
+				return domainerr.ErrResyncRequired
+			}
+			if err := u.change(ctx, input.Filter, env); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (u *NoteWatchInteractor) snapshot(ctx context.Context, filter event.WatchFilter, resumeToken string) error {
+	var filters note.Filters
+	if filter.Status != "" {
+		filters.Status = &filter.Status
+	}
+	if filter.TemplateID != "" {
+		filters.TemplateID = &filter.TemplateID
+	}
+	if filter.OwnerID != "" {
+		filters.OwnerID = &filter.OwnerID
+	}
+	notes, err := u.notes.List(ctx, filters)
+	if err != nil {
+		return err
+	}
+	for _, n := range notes {
+		if err := u.output.PresentSnapshotNote(ctx, n); err != nil {
+			return err
+		}
+	}
+	return u.output.PresentSnapshotComplete(ctx, resumeToken)
+}
+
+// change presents a matching event with the note as it is now, which may already be newer than the event,
+// and a removal when the event moved the note out of the filter.
+func (u *NoteWatchInteractor) change(ctx context.Context, filter event.WatchFilter, env event.Envelope) error {
+	if !filter.Matches(env.Event) {
+		if filter.Left(env.Event) {
+			return u.output.PresentNoteRemoved(ctx, env)
+		}
+		return nil
+	}
+	var current *note.WithMeta
+	if env.Event.Name != event.NoteDeleted {
+		n, err := u.notes.Get(ctx, env.Event.AggregateID)
+		if err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+			return err
+		}
+		current = n
+	}
+	return u.output.PresentNoteChange(ctx, env, current)
+}
@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteWatchInteractor_Watch(t *testing.T) {
+	data := map[string]string{"ownerId": "owner-1", "templateId": "tpl-1", "status": "Draft"}
+	updated := event.Envelope{ID: "s-1", Event: event.Event{Name: event.NoteUpdated, AggregateID: "note-1", Data: data}}
+	deleted := event.Envelope{ID: "s-2", Event: event.Event{Name: event.NoteDeleted, AggregateID: "note-2", Data: data}}
+	other := event.Envelope{ID: "s-3", Event: event.Event{Name: event.NoteUpdated, AggregateID: "note-3", Data: map[string]string{"ownerId": "owner-2"}}}
+	transferred := event.Envelope{ID: "s-4", Event: event.Event{Name: event.NoteUpdated, AggregateID: "note-4", Data: map[string]string{"ownerId": "owner-2", "previousOwnerId": "owner-1"}}}
+	current := &note.WithMeta{Note: note.Note{ID: "note-1"}}
+	writeErr := errors.New("broken pipe")
+	tests := []struct {
+		name         string
+		resumeToken  string
+		resumed      bool
+		lagged       bool
+		replay       []event.Envelope
+		live         []event.Envelope
+		snapshot     []note.WithMeta
+		listErr      error
+		getErr       error
+		presErr      error
+		wantSnapshot bool
+		wantChanges  []string
+		wantRemoved  []string
+		wantErr      error
+	}{
+		// Every case closes the subscription after the live events, as the bus does when it resets
+		// or shuts down, so watches that get that far end with ErrResyncRequired.
+		{name: "[Success] snapshot then live changes", snapshot: []note.WithMeta{*current}, live: []event.Envelope{updated, other, deleted}, wantSnapshot: true, wantChanges: []string{"s-1", "s-2"}, wantErr: domainerr.ErrResyncRequired},
+		{name: "[Success] resume replays changes", resumeToken: "s-0", resumed: true, replay: []event.Envelope{updated}, live: []event.Envelope{deleted}, wantChanges: []string{"s-1", "s-2"}, wantErr: domainerr.ErrResyncRequired},
+		{name: "[Success] unknown token takes a snapshot", resumeToken: "gone", wantSnapshot: true, wantErr: domainerr.ErrResyncRequired},
+		{name: "[Success] note gone before it is loaded", live: []event.Envelope{updated}, getErr: domainerr.ErrNotFound, wantSnapshot: true, wantChanges: []string{"s-1"}, wantErr: domainerr.ErrResyncRequired},
+		{name: "[Success] note transferred away is removed", live: []event.Envelope{transferred, other}, wantSnapshot: true, wantRemoved: []string{"s-4"}, wantErr: domainerr.ErrResyncRequired},
+		{name: "[Fail] lagging consumer", live: []event.Envelope{updated}, lagged: true, wantSnapshot: true, wantChanges: []string{"s-1"}, wantErr: domainerr.ErrConsumerTooSlow},
+		{name: "[Fail] snapshot list error", listErr: errors.New("db error"), wantErr: errors.New("db error")},
+		{name: "[Fail] get error", live: []event.Envelope{updated}, getErr: errors.New("db error"), wantSnapshot: true, wantErr: errors.New("db error")},
+		{name: "[Fail] write error ends watch", resumeToken: "s-0", resumed: true, replay: []event.Envelope{updated}, presErr: writeErr, wantChanges: []string{"s-1"}, wantErr: writeErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockNoteRepository(ctrl)
+			bus := mockusecase.NewMockEventBus(ctrl)
+			out := mockusecase.NewMockNoteWatchOutputPort(ctrl)
+			events := make(chan event.Envelope, len(tt.live))
+			for _, env := range tt.live {
+				events <- env
+			}
+			close(events)
+			canceled := false
+			bus.EXPECT().Subscribe(tt.resumeToken).Return(port.EventSubscription{
+				Replay:  tt.replay,
+				Resumed: tt.resumed,
+				LastID:  "s-0",
+				Events:  events,
+				Lagged:  func() bool { return tt.lagged },
+				Cancel:  func() { canceled = true },
+			})
+			if !tt.resumed {
+				repo.EXPECT().List(gomock.Any(), note.Filters{OwnerID: strPtr("owner-1")}).Return(tt.snapshot, tt.listErr)
+			}
+			out.EXPECT().PresentSnapshotNote(gomock.Any(), gomock.Any()).Return(nil).Times(len(tt.snapshot))
+			out.EXPECT().PresentSnapshotComplete(gomock.Any(), "s-0").Return(nil).Times(b2i(tt.wantSnapshot))
+			repo.EXPECT().Get(gomock.Any(), "note-1").Return(current, tt.getErr).AnyTimes()
+			var got []string
+			out.EXPECT().PresentNoteChange(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, env event.Envelope, n *note.WithMeta) error {
+				got = append(got, env.ID)
+				if env.Event.Name == event.NoteDeleted && n != nil {
+					t.Fatal("deleted note should have no current state")
+				}
+				return tt.presErr
+			}).Times(len(tt.wantChanges))
+			var removed []string
+			out.EXPECT().PresentNoteRemoved(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, env event.Envelope) error {
+				removed = append(removed, env.ID)
+				return nil
+			}).Times(len(tt.wantRemoved))
+
+			input := port.NoteWatchInput{Filter: event.WatchFilter{OwnerID: "owner-1"}, ResumeToken: tt.resumeToken}
+			err := uc.NewNoteWatchInteractor(repo, bus, out).Watch(context.Background(), input)
+			if (err == nil) != (tt.wantErr == nil) || (err != nil && err.Error() != tt.wantErr.Error()) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if len(got) != len(tt.wantChanges) || len(removed) != len(tt.wantRemoved) {
+				t.Fatalf("presented %v and removed %v, want %v and %v", got, removed, tt.wantChanges, tt.wantRemoved)
+			}
+			if !canceled {
+				t.Fatal("subscription not canceled")
+			}
+		})
+	}
+}
+
+func TestNoteWatchInteractor_WatchStopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockusecase.NewMockNoteRepository(ctrl)
+	bus := mockusecase.NewMockEventBus(ctrl)
+	out := mockusecase.NewMockNoteWatchOutputPort(ctrl)
+	bus.EXPECT().Subscribe("s-0").Return(port.EventSubscription{Resumed: true, Events: make(chan event.Envelope), Cancel: func() {}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := uc.NewNoteWatchInteractor(repo, bus, out).Watch(ctx, port.NoteWatchInput{ResumeToken: "s-0"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotificationEventHandler turns domain events into notifications for the accounts they concern.
+// Notifications are unique per recipient, event and kind, so redispatched events are not notified twice.
+type NotificationEventHandler struct {
+	repo      port.NotificationRepository
+	notes     port.NoteRepository
+	templates port.TemplateRepository
+}
+
+var _ port.EventHandler = (*NotificationEventHandler)(nil)
+
+// NewNotificationEventHandler creates NotificationEventHandler.
+func NewNotificationEventHandler(repo port.NotificationRepository, notes port.NoteRepository, templates port.TemplateRepository) *NotificationEventHandler {
+	return &NotificationEventHandler{repo: repo, notes: notes, templates: templates}
+}
+
+// Handle notifies template owners of notes published on their templates, new owners of
+// transferred notes and note owners of deprecated templates.
+func (h *NotificationEventHandler) Handle(ctx context.Context, e event.Event) error {
+	switch e.Name {
+	case event.NotePublished:
+		return h.notePublished(ctx, e)
+	case event.NoteUpdated:
+		// Only transfers carry the previous owner; plain edits are not notified.
+		if prev := e.Data["previousOwnerId"]; prev == "" || prev == e.Data["ownerId"] {
+			return nil
+		}
+		return h.notify(ctx, e.Data["ownerId"], notification.KindNoteTransferred, e, e.AggregateID, e.Data["title"])
+	case event.TemplateChanged:
+		if e.Data["change"] != string(event.TemplateDeprecated) {
+			return nil
+		}
+		return h.templateDeprecated(ctx, e)
+	}
+	return nil
+}
+
+func (h *NotificationEventHandler) notePublished(ctx context.Context, e event.Event) error {
+	tpl, err := h.templates.Get(ctx, e.Data["templateId"])
+	if err != nil {
+		return err
+	}
+	if tpl.Template.OwnerID == e.Data["ownerId"] {
+		return nil
+	}
+	return h.notify(ctx, tpl.Template.OwnerID, notification.KindTemplateNotePublished, e, e.AggregateID, e.Data["title"])
+}
+
+func (h *NotificationEventHandler) templateDeprecated(ctx context.Context, e event.Event) error {
+	templateID := e.AggregateID
+	notes, err := h.notes.List(ctx, note.Filters{TemplateID: &templateID})
+	if err != nil {
+		return err
+	}
+	notified := make(map[string]bool)
+	for _, n := range notes {
+		ownerID := n.Note.OwnerID
+		if notified[ownerID] {
+			continue
+		}
+		notified[ownerID] = true
+		if err := h.notify(ctx, ownerID, notification.KindTemplateDeprecated, e, templateID, e.Data["name"]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *NotificationEventHandler) notify(ctx context.Context, recipientID string, kind notification.Kind, e event.Event, subjectID, subjectTitle string) error {
+	n, ok := notification.New(recipientID, kind, e, subjectID, subjectTitle)
+	if !ok {
+		return nil
+	}
+	prefs, err := h.repo.GetPreferences(ctx, recipientID)
+	if err != nil {
+		return err
+	}
+	if !prefs.Wants(kind) {
+		return nil
+	}
+	return h.repo.Create(ctx, n)
+}
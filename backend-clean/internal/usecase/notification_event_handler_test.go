@@ -0,0 +1,89 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNotificationEventHandler_Handle(t *testing.T) {
+	n := note.Note{ID: "note-1", Title: "ADR", OwnerID: "writer", TemplateID: "tpl-1", Status: note.StatusPublish}
+	published := event.NewNoteStatusChanged(n, "writer")
+	published.ID = "ev-1"
+	transferred := event.NewNoteTransferred(note.Note{ID: "note-1", Title: "ADR", OwnerID: "receiver", TemplateID: "tpl-1"}, "writer", "writer")
+	transferred.ID = "ev-2"
+	deprecated := event.NewTemplateChanged(template.Template{ID: "tpl-1", Name: "ADR template", OwnerID: "author"}, "author", event.TemplateDeprecated)
+	deprecated.ID = "ev-3"
+	tpl := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "author"}}
+	notes := []note.WithMeta{
+		{Note: note.Note{ID: "note-1", OwnerID: "writer"}},
+		{Note: note.Note{ID: "note-2", OwnerID: "writer"}},
+		{Note: note.Note{ID: "note-3", OwnerID: "author"}},
+	}
+	tests := []struct {
+		name          string
+		event         event.Event
+		templateOwner string
+		muted         []notification.Kind
+		createErr     error
+		wantRecipient string
+		wantKind      notification.Kind
+		wantSubject   string
+		wantCreate    int
+		wantErr       bool
+	}{
+		{name: "[Success] template owner told about published note", event: published, templateOwner: "author", wantRecipient: "author", wantKind: notification.KindTemplateNotePublished, wantSubject: "note-1", wantCreate: 1},
+		{name: "[Success] publishing on own template is not notified", event: published, templateOwner: "writer"},
+		{name: "[Success] new owner told about transfer", event: transferred, wantRecipient: "receiver", wantKind: notification.KindNoteTransferred, wantSubject: "note-1", wantCreate: 1},
+		{name: "[Success] owner editing own note is not notified", event: event.NewNoteUpdated(n, "writer")},
+		{name: "[Success] edit by another actor is not a transfer", event: event.NewNoteUpdated(n, "admin")},
+		{name: "[Success] note owners told once about deprecation", event: deprecated, wantRecipient: "writer", wantKind: notification.KindTemplateDeprecated, wantSubject: "tpl-1", wantCreate: 1},
+		{name: "[Success] muted kind is skipped", event: transferred, muted: []notification.Kind{notification.KindNoteTransferred}},
+		{name: "[Success] other template changes are ignored", event: event.NewTemplateChanged(template.Template{ID: "tpl-1"}, "author", event.TemplateUpdated)},
+		{name: "[Fail] create error", event: transferred, createErr: errors.New("db error"), wantRecipient: "receiver", wantKind: notification.KindNoteTransferred, wantSubject: "note-1", wantCreate: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockNotificationRepository(ctrl)
+			noteRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			if tt.templateOwner != "" {
+				tpl.Template.OwnerID = tt.templateOwner
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tpl, nil)
+			}
+			noteRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f note.Filters) ([]note.WithMeta, error) {
+				if f.TemplateID == nil || *f.TemplateID != "tpl-1" {
+					t.Fatalf("unexpected filters: %+v", f)
+				}
+				return notes, nil
+			}).AnyTimes()
+			repo.EXPECT().GetPreferences(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, accountID string) (*notification.Preferences, error) {
+				return &notification.Preferences{AccountID: accountID, MutedKinds: tt.muted}, nil
+			}).AnyTimes()
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, got notification.Notification) error {
+				if got.RecipientID != tt.wantRecipient || got.Kind != tt.wantKind || got.SubjectID != tt.wantSubject || got.EventID != tt.event.ID {
+					t.Fatalf("unexpected notification: %+v", got)
+				}
+				return tt.createErr
+			}).Times(tt.wantCreate)
+
+			err := uc.NewNotificationEventHandler(repo, noteRepo, tplRepo).Handle(context.Background(), tt.event)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotificationInteractor handles notification center use cases.
+type NotificationInteractor struct {
+	repo   port.NotificationRepository
+	output port.NotificationOutputPort
+}
+
+var _ port.NotificationInputPort = (*NotificationInteractor)(nil)
+
+// NewNotificationInteractor creates NotificationInteractor.
+func NewNotificationInteractor(repo port.NotificationRepository, output port.NotificationOutputPort) *NotificationInteractor {
+	return &NotificationInteractor{repo: repo, output: output}
+}
+
+// List returns the latest notifications of the recipient.
+func (u *NotificationInteractor) List(ctx context.Context, recipientID string, unreadOnly bool) error {
+	list, err := u.repo.List(ctx, recipientID, unreadOnly, notification.ListLimit)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNotificationList(ctx, list)
+}
+
+// CountUnread returns how many notifications the recipient has not read yet.
+func (u *NotificationInteractor) CountUnread(ctx context.Context, recipientID string) error {
+	count, err := u.repo.CountUnread(ctx, recipientID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentUnreadCount(ctx, count)
+}
+
+// MarkRead marks a notification of the recipient read.
+func (u *NotificationInteractor) MarkRead(ctx context.Context, id, recipientID string) error {
+	n, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := notification.ValidateRecipient(*n, recipientID); err != nil {
+		return err
+	}
+	read, err := u.repo.MarkRead(ctx, id)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNotification(ctx, read)
+}
+
+// MarkAllRead marks every unread notification of the recipient read.
+func (u *NotificationInteractor) MarkAllRead(ctx context.Context, recipientID string) error {
+	count, err := u.repo.MarkAllRead(ctx, recipientID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentMarkedAllRead(ctx, count)
+}
+
+// GetPreferences returns the notification kinds the account muted.
+func (u *NotificationInteractor) GetPreferences(ctx context.Context, accountID string) error {
+	prefs, err := u.repo.GetPreferences(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNotificationPreferences(ctx, prefs)
+}
+
+// UpdatePreferences replaces the notification kinds the account muted.
+func (u *NotificationInteractor) UpdatePreferences(ctx context.Context, input port.NotificationPreferencesInput) error {
+	prefs := notification.Preferences{AccountID: input.AccountID, MutedKinds: input.MutedKinds}
+	if err := notification.ValidatePreferences(prefs); err != nil {
+		return err
+	}
+	saved, err := u.repo.SavePreferences(ctx, prefs)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNotificationPreferences(ctx, saved)
+}
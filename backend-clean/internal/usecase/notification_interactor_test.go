@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/notification"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNotificationInteractor_List(t *testing.T) {
+	list := []notification.Notification{{ID: "n-1", RecipientID: "owner-1", Kind: notification.KindNoteTransferred}}
+	tests := []struct {
+		name    string
+		listErr error
+		wantErr bool
+	}{
+		{name: "[Success] list notifications", wantErr: false},
+		{name: "[Fail] repo error", listErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockNotificationRepository(ctrl)
+			out := mockusecase.NewMockNotificationOutputPort(ctrl)
+			repo.EXPECT().List(gomock.Any(), "owner-1", true, notification.ListLimit).Return(list, tt.listErr)
+			out.EXPECT().PresentNotificationList(gomock.Any(), list).Return(nil).Times(b2i(!tt.wantErr))
+
+			err := uc.NewNotificationInteractor(repo, out).List(context.Background(), "owner-1", true)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNotificationInteractor_MarkRead(t *testing.T) {
+	current := &notification.Notification{ID: "n-1", RecipientID: "owner-1"}
+	tests := []struct {
+		name        string
+		recipientID string
+		getErr      error
+		wantMark    bool
+		wantErr     error
+	}{
+		{name: "[Success] mark read", recipientID: "owner-1", wantMark: true},
+		{name: "[Fail] not recipient", recipientID: "other", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] not found", recipientID: "owner-1", getErr: domainerr.ErrNotFound, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockNotificationRepository(ctrl)
+			out := mockusecase.NewMockNotificationOutputPort(ctrl)
+			read := &notification.Notification{ID: "n-1", RecipientID: "owner-1"}
+			repo.EXPECT().Get(gomock.Any(), "n-1").Return(current, tt.getErr)
+			repo.EXPECT().MarkRead(gomock.Any(), "n-1").Return(read, nil).Times(b2i(tt.wantMark))
+			out.EXPECT().PresentNotification(gomock.Any(), read).Return(nil).Times(b2i(tt.wantMark))
+
+			err := uc.NewNotificationInteractor(repo, out).MarkRead(context.Background(), "n-1", tt.recipientID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNotificationInteractor_Counts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockusecase.NewMockNotificationRepository(ctrl)
+	out := mockusecase.NewMockNotificationOutputPort(ctrl)
+	repo.EXPECT().CountUnread(gomock.Any(), "owner-1").Return(2, nil)
+	out.EXPECT().PresentUnreadCount(gomock.Any(), 2).Return(nil)
+	repo.EXPECT().MarkAllRead(gomock.Any(), "owner-1").Return(2, nil)
+	out.EXPECT().PresentMarkedAllRead(gomock.Any(), 2).Return(nil)
+
+	interactor := uc.NewNotificationInteractor(repo, out)
+	if err := interactor.CountUnread(context.Background(), "owner-1"); err != nil {
+		t.Fatalf("CountUnread: %v", err)
+	}
+	if err := interactor.MarkAllRead(context.Background(), "owner-1"); err != nil {
+		t.Fatalf("MarkAllRead: %v", err)
+	}
+}
+
+func TestNotificationInteractor_UpdatePreferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   port.NotificationPreferencesInput
+		saveErr error
+		wantErr error
+	}{
+		{name: "[Success] mute kind", input: port.NotificationPreferencesInput{AccountID: "owner-1", MutedKinds: []notification.Kind{notification.KindTemplateDeprecated}}},
+		{name: "[Success] unmute all", input: port.NotificationPreferencesInput{AccountID: "owner-1"}},
+		{name: "[Fail] unknown kind", input: port.NotificationPreferencesInput{AccountID: "owner-1", MutedKinds: []notification.Kind{"comment.created"}}, wantErr: domainerr.ErrInvalidNotificationKind},
+		{name: "[Fail] repo error", input: port.NotificationPreferencesInput{AccountID: "owner-1"}, saveErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockNotificationRepository(ctrl)
+			out := mockusecase.NewMockNotificationOutputPort(ctrl)
+			valid := tt.wantErr == nil || tt.saveErr != nil
+			saved := &notification.Preferences{AccountID: tt.input.AccountID, MutedKinds: tt.input.MutedKinds}
+			repo.EXPECT().SavePreferences(gomock.Any(), notification.Preferences{AccountID: tt.input.AccountID, MutedKinds: tt.input.MutedKinds}).Return(saved, tt.saveErr).Times(b2i(valid))
+			out.EXPECT().PresentNotificationPreferences(gomock.Any(), saved).Return(nil).Times(b2i(tt.wantErr == nil))
+
+			err := uc.NewNotificationInteractor(repo, out).UpdatePreferences(context.Background(), tt.input)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
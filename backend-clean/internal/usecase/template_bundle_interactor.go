@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateBundleInteractor handles exporting templates to and importing them from bundles.
+type TemplateBundleInteractor struct {
+	repo   port.TemplateRepository
+	events port.OutboxRepository
+	tx     port.TxManager
+	output port.TemplateBundleOutputPort
+}
+
+var _ port.TemplateBundleInputPort = (*TemplateBundleInteractor)(nil)
+
+// NewTemplateBundleInteractor creates TemplateBundleInteractor.
+func NewTemplateBundleInteractor(repo port.TemplateRepository, events port.OutboxRepository, tx port.TxManager, output port.TemplateBundleOutputPort) *TemplateBundleInteractor {
+	return &TemplateBundleInteractor{repo: repo, events: events, tx: tx, output: output}
+}
+
+// Export presents a bundle of the selected templates the viewer may see.
+// Selecting by ID reports a template the viewer may not see as not found;
+// selecting by owner leaves out the templates not listed for the viewer.
+func (u *TemplateBundleInteractor) Export(ctx context.Context, input port.TemplateBundleExportInput) error {
+	var templates []template.Template
+	switch {
+	case len(input.IDs) > 0:
+		if len(input.IDs) > template.MaxBundleTemplates {
+			return domainerr.ErrBundleTooLarge
+		}
+		for _, id := range input.IDs {
+			tpl, err := getVisibleTemplate(ctx, u.repo, id, input.ViewerID)
+			if err != nil {
+				return err
+			}
+			templates = append(templates, tpl.Template)
+		}
+	case input.OwnerID != "":
+		owned, err := u.repo.List(ctx, template.Filters{OwnerID: &input.OwnerID})
+		if err != nil {
+			return err
+		}
+		for _, t := range owned {
+			if template.IsListedFor(t.Template, input.ViewerID) {
+				templates = append(templates, t.Template)
+			}
+		}
+	default:
+		return domainerr.ErrBundleEmpty
+	}
+	return u.output.PresentTemplateBundle(ctx, template.NewBundle(templates))
+}
+
+// Import creates the templates of a bundle for the owner. A template named like one the owner
+// already has is skipped, created under a free name, or stored as a new version of the existing
+// template, depending on OnConflict; updates keep the IDs of fields whose label is unchanged.
+// Templates failing validation are reported without stopping the others; templates already
+// stored stay when a later one hits a storage error.
+func (u *TemplateBundleInteractor) Import(ctx context.Context, input port.TemplateBundleImportInput) error {
+	if strings.TrimSpace(input.OwnerID) == "" {
+		return domainerr.ErrTemplateOwnerRequired
+	}
+	mode := input.OnConflict
+	if mode == "" {
+		mode = template.ConflictSkip
+	}
+	if err := mode.Validate(); err != nil {
+		return err
+	}
+	bundle, err := template.ParseBundle(input.Content)
+	if err != nil {
+		return err
+	}
+	owned, err := u.repo.List(ctx, template.Filters{OwnerID: &input.OwnerID})
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]template.Template, len(owned))
+	taken := make(map[string]bool, len(owned)+len(bundle.Templates))
+	for _, t := range owned {
+		if _, ok := byName[t.Template.Name]; !ok {
+			byName[t.Template.Name] = t.Template
+		}
+		taken[t.Template.Name] = true
+	}
+	// renamed templates must not take the name of another template of the bundle
+	for _, entry := range bundle.Templates {
+		taken[strings.TrimSpace(entry.Name)] = true
+	}
+
+	results := make([]template.BundleImportResult, 0, len(bundle.Templates))
+	for i, entry := range bundle.Templates {
+		tpl := entry.Template(input.OwnerID)
+		result := template.BundleImportResult{Index: i, Name: tpl.Name}
+		current, exists := byName[tpl.Name]
+		switch {
+		case exists && mode == template.ConflictSkip:
+			result.TemplateID = current.ID
+			result.Action = template.BundleActionSkipped
+		case exists && mode == template.ConflictUpdate:
+			tpl.ID = current.ID
+			tpl.Fields = template.MatchFieldIDs(tpl.Fields, current.Fields)
+			update, err := planTemplateUpdate(ctx, u.repo, current, tpl)
+			if err != nil {
+				result.Err = err
+				break
+			}
+			if _, err := update.apply(ctx, u.repo, u.events, u.tx); err != nil {
+				return err
+			}
+			result.TemplateID = current.ID
+			result.Action = template.BundleActionUpdated
+		default:
+			action := template.BundleActionCreated
+			if exists {
+				tpl.Name = template.UniqueName(tpl.Name, taken)
+				action = template.BundleActionRenamed
+			}
+			if tpl.Visibility == "" {
+				tpl.Visibility = template.DefaultVisibility
+			}
+			if result.Err = validateBundleTemplate(tpl); result.Err != nil {
+				break
+			}
+			createdID, err := createTemplate(ctx, u.repo, u.events, u.tx, tpl)
+			if err != nil {
+				return err
+			}
+			taken[tpl.Name] = true
+			result.Name = tpl.Name
+			result.TemplateID = createdID
+			result.Action = action
+		}
+		results = append(results, result)
+	}
+	return u.output.PresentTemplateBundleImport(ctx, results)
+}
+
+func validateBundleTemplate(tpl template.Template) error {
+	if err := template.ValidateTemplate(tpl); err != nil {
+		return err
+	}
+	return tpl.Visibility.Validate()
+}
@@ -0,0 +1,274 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestTemplateBundleInteractor_Export(t *testing.T) {
+	daily := template.WithUsage{Template: template.Template{
+		ID: "tpl-1", Name: "Daily", OwnerID: "owner-1", Visibility: template.VisibilityPublic,
+		Fields: []template.Field{{ID: "f1", Label: "Body", Order: 1, IsRequired: true}},
+	}}
+	secret := template.WithUsage{Template: template.Template{ID: "tpl-2", Name: "Secret", OwnerID: "owner-1", Visibility: template.VisibilityPrivate}}
+
+	tests := []struct {
+		name      string
+		input     port.TemplateBundleExportInput
+		setup     func(repo *mockusecase.MockTemplateRepository)
+		wantNames []string
+		wantError error
+	}{
+		{
+			name:  "[Success] export by ids",
+			input: port.TemplateBundleExportInput{IDs: []string{"tpl-1"}},
+			setup: func(repo *mockusecase.MockTemplateRepository) {
+				repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(&daily, nil)
+			},
+			wantNames: []string{"Daily"},
+		},
+		{
+			name:  "[Success] export owner templates listed for viewer",
+			input: port.TemplateBundleExportInput{OwnerID: "owner-1", ViewerID: "other"},
+			setup: func(repo *mockusecase.MockTemplateRepository) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]template.WithUsage{daily, secret}, nil)
+			},
+			wantNames: []string{"Daily"},
+		},
+		{
+			name:  "[Fail] private template of another owner",
+			input: port.TemplateBundleExportInput{IDs: []string{"tpl-2"}, ViewerID: "other"},
+			setup: func(repo *mockusecase.MockTemplateRepository) {
+				repo.EXPECT().Get(gomock.Any(), "tpl-2").Return(&secret, nil)
+			},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] nothing selected",
+			input:     port.TemplateBundleExportInput{},
+			wantError: domainerr.ErrBundleEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			out := mockusecase.NewMockTemplateBundleOutputPort(ctrl)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentTemplateBundle(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, b template.Bundle) error {
+						if b.Version != template.BundleVersion || len(b.Templates) != len(tt.wantNames) {
+							t.Fatalf("unexpected bundle: %+v", b)
+						}
+						for i, name := range tt.wantNames {
+							if b.Templates[i].Name != name {
+								t.Fatalf("template %d = %q, want %q", i, b.Templates[i].Name, name)
+							}
+						}
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewTemplateBundleInteractor(repo, nil, mockusecase.NewMockTxManager(ctrl), out)
+			err := interactor.Export(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestTemplateBundleInteractor_Import(t *testing.T) {
+	const content = `version: 1
+templates:
+  - name: Daily
+    fields:
+      - label: Body
+        type: markdown
+        order: 1
+        required: true
+      - label: Mood
+        order: 2
+        required: false
+  - name: Weekly
+    fields:
+      - label: Summary
+        order: 1
+        required: true
+  - name: Broken
+    fields: []
+`
+	owned := []template.WithUsage{{Template: template.Template{
+		ID: "tpl-1", Name: "Daily", OwnerID: "owner-1", Version: 2, Visibility: template.VisibilityPublic,
+		Fields: []template.Field{{ID: "f1", Label: "Body", Order: 1, IsRequired: true, Type: template.FieldTypeMarkdown}},
+	}}}
+
+	tests := []struct {
+		name        string
+		mode        template.ConflictMode
+		expectDaily func(repo *mockusecase.MockTemplateRepository)
+		wantDaily   template.BundleImportResult
+	}{
+		{
+			name:      "[Success] skip existing",
+			mode:      template.ConflictSkip,
+			wantDaily: template.BundleImportResult{Index: 0, Name: "Daily", TemplateID: "tpl-1", Action: template.BundleActionSkipped},
+		},
+		{
+			name: "[Success] rename existing",
+			mode: template.ConflictRename,
+			expectDaily: func(repo *mockusecase.MockTemplateRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, tpl template.Template) (*template.Template, error) {
+						if tpl.Name != "Daily (2)" || tpl.OwnerID != "owner-1" || tpl.Visibility != template.DefaultVisibility {
+							t.Fatalf("unexpected template: %+v", tpl)
+						}
+						return &template.Template{ID: "tpl-new", Version: 1}, nil
+					},
+				)
+				repo.EXPECT().CreateFields(gomock.Any(), "tpl-new", 1, gomock.Any()).Return(nil, nil)
+			},
+			wantDaily: template.BundleImportResult{Index: 0, Name: "Daily (2)", TemplateID: "tpl-new", Action: template.BundleActionRenamed},
+		},
+		{
+			name: "[Success] update existing keeps matching field ids",
+			mode: template.ConflictUpdate,
+			expectDaily: func(repo *mockusecase.MockTemplateRepository) {
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, tpl template.Template) (*template.Template, error) {
+						if tpl.ID != "tpl-1" || tpl.Visibility != template.VisibilityPublic {
+							t.Fatalf("unexpected update: %+v", tpl)
+						}
+						return &template.Template{ID: "tpl-1", Version: 3}, nil
+					},
+				)
+				repo.EXPECT().CreateFields(gomock.Any(), "tpl-1", 3, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ int, fields []template.Field) ([]template.Field, error) {
+						if len(fields) != 2 || fields[0].ID != "f1" || fields[1].ID != "" {
+							t.Fatalf("unexpected fields: %+v", fields)
+						}
+						return fields, nil
+					},
+				)
+			},
+			wantDaily: template.BundleImportResult{Index: 0, Name: "Daily", TemplateID: "tpl-1", Action: template.BundleActionUpdated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateBundleOutputPort(ctrl)
+
+			tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+			).AnyTimes()
+			events.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(owned, nil)
+			if tt.expectDaily != nil {
+				tt.expectDaily(repo)
+			}
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, tpl template.Template) (*template.Template, error) {
+					if tpl.Name != "Weekly" {
+						t.Fatalf("unexpected create: %+v", tpl)
+					}
+					return &template.Template{ID: "tpl-weekly", Version: 1}, nil
+				},
+			)
+			repo.EXPECT().CreateFields(gomock.Any(), "tpl-weekly", 1, gomock.Any()).Return(nil, nil)
+			out.EXPECT().PresentTemplateBundleImport(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, results []template.BundleImportResult) error {
+					if len(results) != 3 {
+						t.Fatalf("results = %d, want 3", len(results))
+					}
+					if results[0] != tt.wantDaily {
+						t.Fatalf("daily = %+v, want %+v", results[0], tt.wantDaily)
+					}
+					if results[1].Action != template.BundleActionCreated || results[1].TemplateID != "tpl-weekly" {
+						t.Fatalf("weekly = %+v", results[1])
+					}
+					if !errors.Is(results[2].Err, domainerr.ErrFieldRequired) || results[2].Action != "" {
+						t.Fatalf("broken = %+v", results[2])
+					}
+					return nil
+				},
+			)
+
+			interactor := uc.NewTemplateBundleInteractor(repo, events, tx, out)
+			err := interactor.Import(context.Background(), port.TemplateBundleImportInput{
+				OwnerID:    "owner-1",
+				Content:    []byte(content),
+				OnConflict: tt.mode,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTemplateBundleInteractor_Import_Fail(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.TemplateBundleImportInput
+		wantError error
+	}{
+		{
+			name:      "[Fail] owner required",
+			input:     port.TemplateBundleImportInput{Content: []byte("version: 1")},
+			wantError: domainerr.ErrTemplateOwnerRequired,
+		},
+		{
+			name:      "[Fail] invalid conflict mode",
+			input:     port.TemplateBundleImportInput{OwnerID: "owner-1", OnConflict: "merge"},
+			wantError: domainerr.ErrInvalidConflictMode,
+		},
+		{
+			name:      "[Fail] invalid bundle",
+			input:     port.TemplateBundleImportInput{OwnerID: "owner-1", Content: []byte("version: [")},
+			wantError: domainerr.ErrBundleInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			interactor := uc.NewTemplateBundleInteractor(
+				mockusecase.NewMockTemplateRepository(ctrl),
+				mockusecase.NewMockOutboxRepository(ctrl),
+				mockusecase.NewMockTxManager(ctrl),
+				mockusecase.NewMockTemplateBundleOutputPort(ctrl),
+			)
+			if err := interactor.Import(context.Background(), tt.input); !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -4,7 +4,7 @@ package usecase
 import (
 	"context"
 
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
@@ -12,6 +12,7 @@ import (
 // TemplateInteractor handles template use cases.
 type TemplateInteractor struct {
 	repo   port.TemplateRepository
+	events port.OutboxRepository
 	tx     port.TxManager
 	output port.TemplateOutputPort
 }
@@ -19,25 +20,55 @@ type TemplateInteractor struct {
 var _ port.TemplateInputPort = (*TemplateInteractor)(nil)
 
 // NewTemplateInteractor creates TemplateInteractor.
-func NewTemplateInteractor(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) *TemplateInteractor {
-	return &TemplateInteractor{repo: repo, tx: tx, output: output}
+func NewTemplateInteractor(repo port.TemplateRepository, events port.OutboxRepository, tx port.TxManager, output port.TemplateOutputPort) *TemplateInteractor {
+	return &TemplateInteractor{repo: repo, events: events, tx: tx, output: output}
 }
 
-// List returns templates by filters.
+// List returns the templates listed for the viewer, marking the fields that matched a search.
 func (u *TemplateInteractor) List(ctx context.Context, filters template.Filters) error {
 	templates, err := u.repo.List(ctx, filters)
 	if err != nil {
 		return err
 	}
-	return u.output.PresentTemplateList(ctx, templates)
+	viewerID := ""
+	if filters.ViewerID != nil {
+		viewerID = *filters.ViewerID
+	}
+	listed := templates[:0]
+	for _, t := range templates {
+		if !template.IsListedFor(t.Template, viewerID) {
+			continue
+		}
+		if t.Template.IsDeprecated() && !filters.IncludeDeprecated {
+			continue
+		}
+		t.MatchedFieldIDs = filters.MatchFields(t.Template.Fields)
+		listed = append(listed, t)
+	}
+	return u.output.PresentTemplateList(ctx, listed)
 }
 
-// Get returns template by ID.
-func (u *TemplateInteractor) Get(ctx context.Context, id string) error {
+// Get returns template by ID when the viewer may see it.
+func (u *TemplateInteractor) Get(ctx context.Context, id, viewerID string) error {
 	tpl, err := u.repo.Get(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err := template.ValidateTemplateAccess(tpl.Template, viewerID); err != nil {
+		return err
+	}
+	return u.output.PresentTemplate(ctx, tpl)
+}
+
+// GetVersion returns a template with the fields of one of its versions when the viewer may see it.
+func (u *TemplateInteractor) GetVersion(ctx context.Context, id string, version int, viewerID string) error {
+	tpl, err := u.repo.GetVersion(ctx, id, version)
+	if err != nil {
+		return err
+	}
+	if err := template.ValidateTemplateAccess(tpl.Template, viewerID); err != nil {
+		return err
+	}
 	return u.output.PresentTemplate(ctx, tpl)
 }
 
@@ -50,23 +81,19 @@ func (u *TemplateInteractor) Create(ctx context.Context, input port.TemplateCrea
 	}); err != nil {
 		return err
 	}
+	visibility := input.Visibility
+	if visibility == "" {
+		visibility = template.DefaultVisibility
+	}
+	if err := visibility.Validate(); err != nil {
+		return err
+	}
 
-	var createdID string
-	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		tpl, err := u.repo.Create(txCtx, template.Template{
-			Name:    input.Name,
-			OwnerID: input.OwnerID,
-		})
-		if err != nil {
-			return err
-		}
-		createdID = tpl.ID
-		if len(input.Fields) > 0 {
-			if err := u.repo.ReplaceFields(txCtx, tpl.ID, input.Fields); err != nil {
-				return err
-			}
-		}
-		return nil
+	createdID, err := createTemplate(ctx, u.repo, u.events, u.tx, template.Template{
+		Name:       input.Name,
+		OwnerID:    input.OwnerID,
+		Visibility: visibility,
+		Fields:     input.Fields,
 	})
 	if err != nil {
 		return err
@@ -78,7 +105,11 @@ func (u *TemplateInteractor) Create(ctx context.Context, input port.TemplateCrea
 	return u.output.PresentTemplate(ctx, tpl)
 }
 
-// Update updates a template.
+// Update updates a template by moving it to a new immutable version.
+// Requested fields refer to fields of the current version by ID and the whole field set is stored
+// again under the new version, so notes keep the fields they were written against.
+// Without requested fields the current field set is carried over unchanged.
+// A template cannot become private while other accounts' notes use it.
 func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpdateInput) error {
 	current, err := u.repo.Get(ctx, input.ID)
 	if err != nil {
@@ -87,38 +118,116 @@ func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpda
 	if err := template.ValidateTemplateOwnership(current.Template.OwnerID, input.OwnerID); err != nil {
 		return err
 	}
-	if input.Fields != nil {
-		if err := template.ValidateTemplate(template.Template{
-			ID:      input.ID,
-			Name:    input.Name,
-			Fields:  input.Fields,
-			OwnerID: input.OwnerID,
-		}); err != nil {
+	fields := input.Fields
+	if fields == nil {
+		fields = current.Template.Fields
+	}
+	update, err := planTemplateUpdate(ctx, u.repo, current.Template, template.Template{
+		ID:         input.ID,
+		Name:       input.Name,
+		OwnerID:    input.OwnerID,
+		Visibility: input.Visibility,
+		Fields:     fields,
+	})
+	if err != nil {
+		return err
+	}
+	report, err := update.apply(ctx, u.repo, u.events, u.tx)
+	if err != nil {
+		return err
+	}
+	tpl, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := u.output.PresentTemplateChanges(ctx, report); err != nil {
+		return err
+	}
+	return u.output.PresentTemplate(ctx, tpl)
+}
+
+// Fork copies the current version of a template into a new template owned by the caller.
+// Any user may fork a template they can see; the copy starts at version 1 and records its source.
+func (u *TemplateInteractor) Fork(ctx context.Context, input port.TemplateForkInput) error {
+	source, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := template.ValidateTemplateAccess(source.Template, input.OwnerID); err != nil {
+		return err
+	}
+	fork, err := template.Fork(source.Template, input.OwnerID, input.Name)
+	if err != nil {
+		return err
+	}
+
+	createdID, err := createTemplate(ctx, u.repo, u.events, u.tx, fork)
+	if err != nil {
+		return err
+	}
+	tpl, err := u.repo.Get(ctx, createdID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTemplate(ctx, tpl)
+}
+
+// Deprecate stops new notes from using the template; its existing notes stay editable.
+// The successor, when given, must be another template the owner can see that is not deprecated.
+func (u *TemplateInteractor) Deprecate(ctx context.Context, input port.TemplateDeprecateInput) error {
+	tpl, err := u.repo.Get(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+	if err := template.ValidateTemplateOwnership(tpl.Template.OwnerID, input.OwnerID); err != nil {
+		return err
+	}
+	if input.SuccessorID != "" {
+		successor, err := getVisibleTemplate(ctx, u.repo, input.SuccessorID, input.OwnerID)
+		if err != nil {
+			return err
+		}
+		if err := template.ValidateSuccessor(tpl.Template, successor.Template); err != nil {
 			return err
 		}
 	}
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		_, err := u.repo.Update(txCtx, template.Template{
-			ID:   input.ID,
-			Name: input.Name,
-		})
+		deprecated, err := u.repo.Deprecate(txCtx, input.ID, input.SuccessorID)
 		if err != nil {
 			return err
 		}
-		if input.Fields != nil {
-			if len(input.Fields) == 0 {
-				return domainerr.ErrInvalidTemplateField
-			}
-			if err := u.repo.ReplaceFields(txCtx, input.ID, input.Fields); err != nil {
-				return err
-			}
+		return u.events.Append(txCtx, event.NewTemplateChanged(*deprecated, input.OwnerID, event.TemplateDeprecated))
+	})
+	if err != nil {
+		return err
+	}
+	return u.presentTemplate(ctx, input.ID)
+}
+
+// Undeprecate lets new notes use the template again.
+func (u *TemplateInteractor) Undeprecate(ctx context.Context, id, ownerID string) error {
+	tpl, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := template.ValidateTemplateOwnership(tpl.Template.OwnerID, ownerID); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		undeprecated, err := u.repo.Undeprecate(txCtx, id)
+		if err != nil {
+			return err
 		}
-		return nil
+		return u.events.Append(txCtx, event.NewTemplateChanged(*undeprecated, ownerID, event.TemplateUndeprecated))
 	})
 	if err != nil {
 		return err
 	}
-	tpl, err := u.repo.Get(ctx, input.ID)
+	return u.presentTemplate(ctx, id)
+}
+
+func (u *TemplateInteractor) presentTemplate(ctx context.Context, id string) error {
+	tpl, err := u.repo.Get(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -137,8 +246,90 @@ func (u *TemplateInteractor) Delete(ctx context.Context, id, ownerID string) err
 	if err := template.CanDeleteTemplate(tpl.IsUsed); err != nil {
 		return err
 	}
-	if err := u.repo.Delete(ctx, id); err != nil {
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.repo.Delete(txCtx, id); err != nil {
+			return err
+		}
+		return u.events.Append(txCtx, event.NewTemplateChanged(tpl.Template, ownerID, event.TemplateDeleted))
+	})
+	if err != nil {
 		return err
 	}
 	return u.output.PresentTemplateDeleted(ctx)
 }
+
+// createTemplate stores a validated template and its fields as its first version in one transaction.
+func createTemplate(ctx context.Context, repo port.TemplateRepository, events port.OutboxRepository, tx port.TxManager, tpl template.Template) (string, error) {
+	var createdID string
+	err := tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		created, err := repo.Create(txCtx, tpl)
+		if err != nil {
+			return err
+		}
+		createdID = created.ID
+		if len(tpl.Fields) > 0 {
+			if _, err := repo.CreateFields(txCtx, created.ID, created.Version, tpl.Fields); err != nil {
+				return err
+			}
+		}
+		return events.Append(txCtx, event.NewTemplateChanged(*created, tpl.OwnerID, event.TemplateCreated))
+	})
+	if err != nil {
+		return "", err
+	}
+	return createdID, nil
+}
+
+// templateUpdate is a validated move of a template to its next version.
+type templateUpdate struct {
+	current template.Template
+	next    template.Template
+	diff    template.FieldDiff
+}
+
+// planTemplateUpdate validates replacing current with next. The fields of next refer to current
+// fields by ID and an empty visibility keeps the current one.
+func planTemplateUpdate(ctx context.Context, repo port.TemplateRepository, current, next template.Template) (templateUpdate, error) {
+	if err := template.ValidateTemplate(next); err != nil {
+		return templateUpdate{}, err
+	}
+	diff, err := template.DiffFields(current.Fields, next.Fields)
+	if err != nil {
+		return templateUpdate{}, err
+	}
+	if next.Visibility == "" {
+		next.Visibility = current.Visibility
+	}
+	usedByOthers := false
+	if next.Visibility == template.VisibilityPrivate && current.Visibility != template.VisibilityPrivate {
+		if usedByOthers, err = repo.IsUsedByOthers(ctx, current.ID, current.OwnerID); err != nil {
+			return templateUpdate{}, err
+		}
+	}
+	if err := template.CanChangeVisibility(current.Visibility, next.Visibility, usedByOthers); err != nil {
+		return templateUpdate{}, err
+	}
+	return templateUpdate{current: current, next: next, diff: diff}, nil
+}
+
+// apply stores the whole field set under the next version in one transaction and reports what changed.
+func (p templateUpdate) apply(ctx context.Context, repo port.TemplateRepository, events port.OutboxRepository, tx port.TxManager) (template.ChangeReport, error) {
+	var report template.ChangeReport
+	err := tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated, err := repo.Update(txCtx, template.Template{
+			ID:         p.current.ID,
+			Name:       p.next.Name,
+			Visibility: p.next.Visibility,
+		})
+		if err != nil {
+			return err
+		}
+		created, err := repo.CreateFields(txCtx, p.current.ID, updated.Version, p.next.Fields)
+		if err != nil {
+			return err
+		}
+		report = p.diff.Report(p.current.Version, updated.Version, created)
+		return events.Append(txCtx, event.NewTemplateChanged(*updated, p.current.OwnerID, event.TemplateUpdated))
+	})
+	return report, err
+}
@@ -3,11 +3,14 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 	uc "immortal-architecture-clean/backend/internal/usecase"
@@ -32,7 +35,7 @@ func TestTemplateInteractor_Create(t *testing.T) {
 					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
 				},
 			},
-			created: &template.Template{ID: "tpl-1", Name: "Template", OwnerID: "owner-1"},
+			created: &template.Template{ID: "tpl-1", Name: "Template", OwnerID: "owner-1", Version: 1},
 			withFields: &template.WithUsage{
 				Template: template.Template{
 					ID:      "tpl-1",
@@ -69,6 +72,7 @@ func TestTemplateInteractor_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
@@ -85,12 +89,18 @@ func TestTemplateInteractor_Create(t *testing.T) {
 				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.created, tt.createErr)
 			}
 			if tt.created != nil && tt.createErr == nil {
-				repo.EXPECT().ReplaceFields(gomock.Any(), tt.created.ID, gomock.Any()).Return(nil)
+				repo.EXPECT().CreateFields(gomock.Any(), tt.created.ID, 1, gomock.Any()).Return(tt.withFields.Template.Fields, nil)
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.TemplateChanged || e.Data["change"] != string(event.TemplateCreated) {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
 				repo.EXPECT().Get(gomock.Any(), tt.created.ID).Return(tt.withFields, nil)
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.withFields).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
 			err := interactor.Create(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -106,19 +116,60 @@ func TestTemplateInteractor_Create(t *testing.T) {
 }
 
 func TestTemplateInteractor_List(t *testing.T) {
+	deprecatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
-		name      string
-		filters   template.Filters
-		result    []template.WithUsage
-		repoErr   error
-		wantError error
+		name        string
+		filters     template.Filters
+		result      []template.WithUsage
+		repoErr     error
+		wantMatched [][]string
+		wantIDs     []string
+		wantError   error
 	}{
 		{
 			name:    "[Success] list templates",
-			filters: template.Filters{OwnerID: strPtr("owner-1")},
+			filters: template.Filters{OwnerID: strPtr("owner-1"), ViewerID: strPtr("owner-1")},
+			result: []template.WithUsage{
+				{Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Visibility: template.VisibilityPrivate}},
+			},
+			wantMatched: [][]string{nil},
+		},
+		{
+			name:    "[Success] search marks matched fields",
+			filters: template.Filters{Query: strPtr("goal")},
+			result: []template.WithUsage{
+				{Template: template.Template{ID: "tpl-1", Name: "Goals", Visibility: template.VisibilityPublic, Fields: []template.Field{{ID: "f1", Label: "Body"}}}},
+				{Template: template.Template{ID: "tpl-2", Name: "Weekly", Visibility: template.VisibilityPublic, Fields: []template.Field{{ID: "f2", Label: "Body"}, {ID: "f3", Label: "Next goal"}}}},
+			},
+			wantMatched: [][]string{{}, {"f3"}},
+		},
+		{
+			name:    "[Success] hides private and unlisted templates of others",
+			filters: template.Filters{ViewerID: strPtr("viewer")},
 			result: []template.WithUsage{
-				{Template: template.Template{ID: "tpl-1", Name: "tpl"}},
+				{Template: template.Template{ID: "tpl-1", OwnerID: "viewer", Visibility: template.VisibilityPrivate}},
+				{Template: template.Template{ID: "tpl-2", OwnerID: "other", Visibility: template.VisibilityUnlisted}},
+				{Template: template.Template{ID: "tpl-3", OwnerID: "other", Visibility: template.VisibilityPublic}},
 			},
+			wantIDs: []string{"tpl-1", "tpl-3"},
+		},
+		{
+			name:    "[Success] hides deprecated templates by default",
+			filters: template.Filters{},
+			result: []template.WithUsage{
+				{Template: template.Template{ID: "tpl-1", Visibility: template.VisibilityPublic, DeprecatedAt: &deprecatedAt}},
+				{Template: template.Template{ID: "tpl-2", Visibility: template.VisibilityPublic}},
+			},
+			wantIDs: []string{"tpl-2"},
+		},
+		{
+			name:    "[Success] lists deprecated templates on request",
+			filters: template.Filters{IncludeDeprecated: true},
+			result: []template.WithUsage{
+				{Template: template.Template{ID: "tpl-1", Visibility: template.VisibilityPublic, DeprecatedAt: &deprecatedAt}},
+				{Template: template.Template{ID: "tpl-2", Visibility: template.VisibilityPublic}},
+			},
+			wantIDs: []string{"tpl-1", "tpl-2"},
 		},
 		{
 			name:      "[Fail] repo error",
@@ -134,15 +185,29 @@ func TestTemplateInteractor_List(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().List(gomock.Any(), tt.filters).Return(tt.result, tt.repoErr)
 			if tt.repoErr == nil {
-				out.EXPECT().PresentTemplateList(gomock.Any(), tt.result).Return(nil)
+				out.EXPECT().PresentTemplateList(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, list []template.WithUsage) error {
+						if tt.wantIDs != nil {
+							ids := make([]string, 0, len(list))
+							for _, l := range list {
+								ids = append(ids, l.Template.ID)
+							}
+							if !slices.Equal(ids, tt.wantIDs) {
+								t.Fatalf("listed %v, want %v", ids, tt.wantIDs)
+							}
+						}
+						return nil
+					},
+				)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
 			err := interactor.List(context.Background(), tt.filters)
 
 			if tt.wantError == nil && err != nil {
@@ -151,14 +216,22 @@ func TestTemplateInteractor_List(t *testing.T) {
 			if tt.wantError != nil && tt.wantError.Error() != err.Error() {
 				t.Fatalf("want %v, got %v", tt.wantError, err)
 			}
+			for i, want := range tt.wantMatched {
+				got := tt.result[i].MatchedFieldIDs
+				if (got == nil) != (want == nil) || !slices.Equal(got, want) {
+					t.Fatalf("template %d matched %v, want %v", i, got, want)
+				}
+			}
 		})
 	}
 }
 
 func TestTemplateInteractor_Get(t *testing.T) {
+	private := &template.WithUsage{Template: template.Template{ID: "tpl-2", OwnerID: "owner-1", Visibility: template.VisibilityPrivate}}
 	tests := []struct {
 		name      string
 		id        string
+		viewerID  string
 		result    *template.WithUsage
 		repoErr   error
 		wantError error
@@ -166,7 +239,20 @@ func TestTemplateInteractor_Get(t *testing.T) {
 		{
 			name:   "[Success] get template",
 			id:     "tpl-1",
-			result: &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl"}},
+			result: &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", Visibility: template.VisibilityPublic}},
+		},
+		{
+			name:     "[Success] owner gets private template",
+			id:       "tpl-2",
+			viewerID: "owner-1",
+			result:   private,
+		},
+		{
+			name:      "[Fail] private template hidden from others",
+			id:        "tpl-2",
+			viewerID:  "other",
+			result:    private,
+			wantError: domainerr.ErrNotFound,
 		},
 		{
 			name:      "[Fail] not found",
@@ -182,16 +268,17 @@ func TestTemplateInteractor_Get(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.result, tt.repoErr)
-			if tt.repoErr == nil {
+			if tt.wantError == nil {
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
-			err := interactor.Get(context.Background(), tt.id)
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
+			err := interactor.Get(context.Background(), tt.id, tt.viewerID)
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -205,14 +292,16 @@ func TestTemplateInteractor_Get(t *testing.T) {
 
 func TestTemplateInteractor_Update(t *testing.T) {
 	tests := []struct {
-		name        string
-		input       port.TemplateUpdateInput
-		current     *template.WithUsage
-		getErr      error
-		updateErr   error
-		replaceErr  error
-		wantError   error
-		expectTxRun bool
+		name         string
+		input        port.TemplateUpdateInput
+		current      *template.WithUsage
+		getErr       error
+		updateErr    error
+		fieldErr     error
+		checkUsage   bool
+		usedByOthers bool
+		wantError    error
+		expectTxRun  bool
 	}{
 		{
 			name: "[Success] update name and fields",
@@ -225,7 +314,9 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				},
 			},
 			current: &template.WithUsage{
-				Template: template.Template{ID: "tpl-1", Name: "old", OwnerID: "owner-1"},
+				Template: template.Template{ID: "tpl-1", Name: "old", OwnerID: "owner-1", Visibility: template.VisibilityPublic, Fields: []template.Field{
+					{ID: "f1", Label: "Old title", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+				}},
 			},
 			expectTxRun: true,
 		},
@@ -236,7 +327,7 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				Name:    "updated",
 				OwnerID: "",
 			},
-			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic}},
 			wantError: domainerr.ErrTemplateOwnerRequired,
 		},
 		{
@@ -247,7 +338,7 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				OwnerID: "owner-1",
 				Fields:  []template.Field{},
 			},
-			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic}},
 			wantError: domainerr.ErrFieldRequired,
 		},
 		{
@@ -259,6 +350,18 @@ func TestTemplateInteractor_Update(t *testing.T) {
 			getErr:    errors.New("get err"),
 			wantError: errors.New("get err"),
 		},
+		{
+			name: "[Success] rename keeps current fields",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+			},
+			current: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic, Version: 1, Fields: []template.Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: template.FieldTypeText},
+			}}},
+			expectTxRun: true,
+		},
 		{
 			name: "[Fail] update error",
 			input: port.TemplateUpdateInput{
@@ -266,13 +369,15 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				Name:    "updated",
 				OwnerID: "owner-1",
 			},
-			current:     &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
+			current: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic, Version: 1, Fields: []template.Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: template.FieldTypeText},
+			}}},
 			updateErr:   errors.New("update err"),
 			wantError:   errors.New("update err"),
 			expectTxRun: true,
 		},
 		{
-			name: "[Fail] replace fields error",
+			name: "[Fail] create fields error",
 			input: port.TemplateUpdateInput{
 				ID:      "tpl-1",
 				Name:    "updated",
@@ -281,12 +386,69 @@ func TestTemplateInteractor_Update(t *testing.T) {
 					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
 				},
 			},
-			current:     &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
+			current: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic, Fields: []template.Field{
+				{ID: "f1", Label: "Old title", Order: 1, IsRequired: true, Type: template.FieldTypeText},
+			}}},
 			updateErr:   nil,
-			replaceErr:  errors.New("replace err"),
-			wantError:   errors.New("replace err"),
+			fieldErr:    errors.New("field err"),
+			wantError:   errors.New("field err"),
 			expectTxRun: true,
 		},
+		{
+			name: "[Success] make private when unused by others",
+			input: port.TemplateUpdateInput{
+				ID:         "tpl-1",
+				Name:       "updated",
+				OwnerID:    "owner-1",
+				Visibility: template.VisibilityPrivate,
+			},
+			current: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic, Version: 1, Fields: []template.Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: template.FieldTypeText},
+			}}},
+			checkUsage:  true,
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] make private while used by others",
+			input: port.TemplateUpdateInput{
+				ID:         "tpl-1",
+				Name:       "updated",
+				OwnerID:    "owner-1",
+				Visibility: template.VisibilityPrivate,
+			},
+			current: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityUnlisted, Fields: []template.Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: template.FieldTypeText},
+			}}},
+			checkUsage:   true,
+			usedByOthers: true,
+			wantError:    domainerr.ErrTemplateUsedByOthers,
+		},
+		{
+			name: "[Fail] invalid visibility",
+			input: port.TemplateUpdateInput{
+				ID:         "tpl-1",
+				Name:       "updated",
+				OwnerID:    "owner-1",
+				Visibility: "secret",
+			},
+			current: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic, Fields: []template.Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: template.FieldTypeText},
+			}}},
+			wantError: domainerr.ErrInvalidVisibility,
+		},
+		{
+			name: "[Fail] unknown field id",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Fields: []template.Field{
+					{ID: "other", Label: "Title", Order: 1},
+				},
+			},
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic}},
+			wantError: domainerr.ErrUnknownField,
+		},
 	}
 
 	for _, tt := range tests {
@@ -295,10 +457,14 @@ func TestTemplateInteractor_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
+			if tt.checkUsage {
+				repo.EXPECT().IsUsedByOthers(gomock.Any(), tt.input.ID, tt.current.Template.OwnerID).Return(tt.usedByOthers, nil)
+			}
 			if tt.getErr == nil && tt.expectTxRun {
 				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(_ context.Context, fn func(context.Context) error) error {
@@ -307,17 +473,29 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				)
 			}
 			if tt.getErr == nil && tt.expectTxRun {
-				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&tt.current.Template, tt.updateErr)
-				if tt.updateErr == nil && tt.input.Fields != nil {
-					repo.EXPECT().ReplaceFields(gomock.Any(), tt.input.ID, tt.input.Fields).Return(tt.replaceErr)
+				next := tt.current.Template.Version + 1
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, tpl template.Template) (*template.Template, error) {
+						if tt.input.Visibility != "" && tpl.Visibility != tt.input.Visibility {
+							t.Fatalf("visibility %q, want %q", tpl.Visibility, tt.input.Visibility)
+						}
+						return &template.Template{ID: tt.input.ID, Version: next}, tt.updateErr
+					},
+				)
+				if tt.updateErr == nil {
+					repo.EXPECT().CreateFields(gomock.Any(), tt.input.ID, next, gomock.Any()).Return(nil, tt.fieldErr)
+				}
+				if tt.updateErr == nil && tt.fieldErr == nil {
+					events.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
 				}
 			}
-			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && tt.replaceErr == nil {
+			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && tt.fieldErr == nil {
 				repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
+				out.EXPECT().PresentTemplateChanges(gomock.Any(), gomock.Any()).Return(nil)
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
 			err := interactor.Update(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -330,6 +508,229 @@ func TestTemplateInteractor_Update(t *testing.T) {
 	}
 }
 
+func TestTemplateInteractor_Fork(t *testing.T) {
+	source := &template.WithUsage{Template: template.Template{
+		ID: "tpl-1", Name: "Daily", OwnerID: "owner-1", Version: 4, Visibility: template.VisibilityPublic,
+		Fields: []template.Field{{ID: "f1", Label: "Body", Order: 1, IsRequired: true}},
+	}}
+	private := &template.WithUsage{Template: template.Template{ID: "tpl-9", OwnerID: "owner-1", Visibility: template.VisibilityPrivate}}
+	forked := &template.WithUsage{Template: template.Template{
+		ID: "tpl-2", Name: "Daily", OwnerID: "owner-2", Version: 1, ForkedFromID: "tpl-1",
+		Fields: []template.Field{{ID: "g1", Label: "Body", Order: 1, IsRequired: true}},
+	}}
+
+	tests := []struct {
+		name      string
+		input     port.TemplateForkInput
+		getErr    error
+		createErr error
+		wantError error
+	}{
+		{
+			name:  "[Success] fork into own copy",
+			input: port.TemplateForkInput{ID: "tpl-1", OwnerID: "owner-2"},
+		},
+		{
+			name:      "[Fail] get error",
+			input:     port.TemplateForkInput{ID: "tpl-1", OwnerID: "owner-2"},
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] private source of another owner",
+			input:     port.TemplateForkInput{ID: "tpl-9", OwnerID: "owner-2"},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] owner required",
+			input:     port.TemplateForkInput{ID: "tpl-1"},
+			wantError: domainerr.ErrTemplateOwnerRequired,
+		},
+		{
+			name:      "[Fail] create error",
+			input:     port.TemplateForkInput{ID: "tpl-1", OwnerID: "owner-2"},
+			createErr: errors.New("create err"),
+			wantError: errors.New("create err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			switch {
+			case tt.getErr != nil:
+				repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(nil, tt.getErr)
+			case tt.input.ID == private.Template.ID:
+				repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(private, nil)
+			default:
+				repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(source, nil)
+			}
+			if tt.getErr == nil && tt.input.OwnerID != "" && tt.input.ID != private.Template.ID {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
+				if tt.createErr != nil {
+					repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, tt.createErr)
+				} else {
+					repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, tpl template.Template) (*template.Template, error) {
+							if tpl.OwnerID != "owner-2" || tpl.ForkedFromID != "tpl-1" || tpl.Name != "Daily" {
+								t.Fatalf("unexpected fork: %+v", tpl)
+							}
+							return &template.Template{ID: "tpl-2", Name: tpl.Name, OwnerID: tpl.OwnerID, Version: 1, ForkedFromID: tpl.ForkedFromID}, nil
+						},
+					)
+					repo.EXPECT().CreateFields(gomock.Any(), "tpl-2", 1, gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ string, _ int, fields []template.Field) ([]template.Field, error) {
+							if len(fields) != 1 || fields[0].ID != "" || fields[0].Label != "Body" {
+								t.Fatalf("unexpected fields: %+v", fields)
+							}
+							return forked.Template.Fields, nil
+						},
+					)
+					events.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
+					repo.EXPECT().Get(gomock.Any(), "tpl-2").Return(forked, nil)
+					out.EXPECT().PresentTemplate(gomock.Any(), forked).Return(nil)
+				}
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
+			err := interactor.Fork(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Deprecate(t *testing.T) {
+	deprecatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Visibility: template.VisibilityPublic}}
+	successor := &template.WithUsage{Template: template.Template{ID: "tpl-2", OwnerID: "owner-1", Visibility: template.VisibilityPrivate}}
+	deprecatedSuccessor := &template.WithUsage{Template: template.Template{ID: "tpl-3", OwnerID: "owner-1", Visibility: template.VisibilityPublic, DeprecatedAt: &deprecatedAt}}
+	othersPrivate := &template.WithUsage{Template: template.Template{ID: "tpl-4", OwnerID: "other", Visibility: template.VisibilityPrivate}}
+
+	tests := []struct {
+		name      string
+		input     port.TemplateDeprecateInput
+		successor *template.WithUsage
+		wantError error
+	}{
+		{name: "[Success] with successor", input: port.TemplateDeprecateInput{ID: "tpl-1", OwnerID: "owner-1", SuccessorID: "tpl-2"}, successor: successor},
+		{name: "[Success] without successor", input: port.TemplateDeprecateInput{ID: "tpl-1", OwnerID: "owner-1"}},
+		{name: "[Fail] not owner", input: port.TemplateDeprecateInput{ID: "tpl-1", OwnerID: "other"}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] successor is itself", input: port.TemplateDeprecateInput{ID: "tpl-1", OwnerID: "owner-1", SuccessorID: "tpl-1"}, successor: current, wantError: domainerr.ErrInvalidSuccessor},
+		{name: "[Fail] successor deprecated", input: port.TemplateDeprecateInput{ID: "tpl-1", OwnerID: "owner-1", SuccessorID: "tpl-3"}, successor: deprecatedSuccessor, wantError: domainerr.ErrInvalidSuccessor},
+		{name: "[Fail] successor private to another account", input: port.TemplateDeprecateInput{ID: "tpl-1", OwnerID: "owner-1", SuccessorID: "tpl-4"}, successor: othersPrivate, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(current, nil)
+			if tt.successor != nil {
+				repo.EXPECT().Get(gomock.Any(), tt.input.SuccessorID).Return(tt.successor, nil)
+			}
+			if tt.wantError == nil {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				repo.EXPECT().Deprecate(gomock.Any(), "tpl-1", tt.input.SuccessorID).Return(&template.Template{ID: "tpl-1"}, nil)
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.TemplateChanged || e.Data["change"] != string(event.TemplateDeprecated) {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
+				repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(current, nil)
+				out.EXPECT().PresentTemplate(gomock.Any(), current).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
+			err := interactor.Deprecate(context.Background(), tt.input)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Undeprecate(t *testing.T) {
+	deprecatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", DeprecatedAt: &deprecatedAt, SuccessorID: "tpl-2"}}
+
+	tests := []struct {
+		name      string
+		ownerID   string
+		wantError error
+	}{
+		{name: "[Success] undeprecate", ownerID: "owner-1"},
+		{name: "[Fail] not owner", ownerID: "other", wantError: domainerr.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(current, nil)
+			if tt.wantError == nil {
+				restored := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}}
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				repo.EXPECT().Undeprecate(gomock.Any(), "tpl-1").Return(&restored.Template, nil)
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.TemplateChanged || e.Data["change"] != string(event.TemplateUndeprecated) {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
+				repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(restored, nil)
+				out.EXPECT().PresentTemplate(gomock.Any(), restored).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
+			err := interactor.Undeprecate(context.Background(), "tpl-1", tt.ownerID)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestTemplateInteractor_Delete(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -386,18 +787,32 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			events := mockusecase.NewMockOutboxRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.current, tt.getErr)
 			if tt.getErr == nil && tt.expectDel {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
 				repo.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.deleteErr)
 			}
+			if tt.getErr == nil && tt.expectDel && tt.deleteErr == nil {
+				events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+					if e.Name != event.TemplateChanged || e.Data["change"] != string(event.TemplateDeleted) {
+						t.Fatalf("unexpected event: %+v", e)
+					}
+					return nil
+				})
+			}
 			if tt.getErr == nil && tt.wantError == nil && tt.deleteErr == nil {
 				out.EXPECT().PresentTemplateDeleted(gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, events, tx, out)
 			err := interactor.Delete(context.Background(), tt.id, tt.ownerID)
 
 			if tt.wantError == nil && err != nil {
@@ -409,3 +824,100 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateInteractor_Update_NewVersion(t *testing.T) {
+	current := &template.WithUsage{
+		Template: template.Template{ID: "tpl-1", Name: "ADR", OwnerID: "owner-1", Version: 3, Visibility: template.VisibilityPublic, Fields: []template.Field{
+			{ID: "f1", Label: "Context", Order: 1, Type: template.FieldTypeText},
+			{ID: "f2", Label: "Risks", Order: 2, Type: template.FieldTypeText},
+		}},
+		IsUsed: true,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockusecase.NewMockTemplateRepository(ctrl)
+	events := mockusecase.NewMockOutboxRepository(ctrl)
+	tx := mockusecase.NewMockTxManager(ctrl)
+	out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+	repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(current, nil)
+	tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(context.Context) error) error {
+			return fn(context.Background())
+		},
+	)
+	repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&template.Template{ID: "tpl-1", Version: 4}, nil)
+	repo.EXPECT().CreateFields(gomock.Any(), "tpl-1", 4, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int, fields []template.Field) ([]template.Field, error) {
+			if len(fields) != 2 || fields[0].ID != "f1" || fields[1].ID != "" {
+				t.Fatalf("unexpected fields: %+v", fields)
+			}
+			return []template.Field{{ID: "v4-1", Label: "Background", Order: 2}, {ID: "v4-2", Label: "Decision", Order: 1}}, nil
+		},
+	)
+	events.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e event.Event) error {
+		if e.Name != event.TemplateChanged || e.AggregateID != "tpl-1" || e.ActorID != "owner-1" || e.Data["version"] != "4" {
+			t.Fatalf("unexpected event: %+v", e)
+		}
+		return nil
+	})
+	repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(current, nil)
+	var got template.ChangeReport
+	out.EXPECT().PresentTemplateChanges(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, report template.ChangeReport) error {
+			got = report
+			return nil
+		},
+	)
+	out.EXPECT().PresentTemplate(gomock.Any(), current).Return(nil)
+
+	interactor := uc.NewTemplateInteractor(repo, events, tx, out)
+	err := interactor.Update(context.Background(), port.TemplateUpdateInput{
+		ID:      "tpl-1",
+		Name:    "ADR",
+		OwnerID: "owner-1",
+		Fields: []template.Field{
+			{ID: "f1", Label: "Background", Order: 2},
+			{Label: "Decision", Order: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.PreviousVersion != 3 || got.Version != 4 {
+		t.Fatalf("unexpected versions: %+v", got)
+	}
+	var ids, previous []string
+	for _, c := range got.Fields {
+		ids = append(ids, c.FieldID)
+		previous = append(previous, c.PreviousFieldID)
+	}
+	if !slices.Equal(ids, []string{"v4-1", "v4-2", ""}) || !slices.Equal(previous, []string{"f1", "", "f2"}) {
+		t.Fatalf("unexpected changes: %+v", got.Fields)
+	}
+}
+
+func TestTemplateInteractor_GetVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockusecase.NewMockTemplateRepository(ctrl)
+	out := mockusecase.NewMockTemplateOutputPort(ctrl)
+	tpl := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Version: 2, Visibility: template.VisibilityPrivate}}
+
+	repo.EXPECT().GetVersion(gomock.Any(), "tpl-1", 2).Return(tpl, nil).Times(2)
+	out.EXPECT().PresentTemplate(gomock.Any(), tpl).Return(nil)
+	repo.EXPECT().GetVersion(gomock.Any(), "tpl-1", 9).Return(nil, domainerr.ErrNotFound)
+
+	interactor := uc.NewTemplateInteractor(repo, nil, mockusecase.NewMockTxManager(ctrl), out)
+	if err := interactor.GetVersion(context.Background(), "tpl-1", 2, "owner-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := interactor.GetVersion(context.Background(), "tpl-1", 2, "other"); !errors.Is(err, domainerr.ErrNotFound) {
+		t.Fatalf("want ErrNotFound for private version, got %v", err)
+	}
+	if err := interactor.GetVersion(context.Background(), "tpl-1", 9, "owner-1"); !errors.Is(err, domainerr.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Delivery defaults.
+const (
+	deliverBatchSize = 20
+	// deliverLease hides claimed deliveries from other workers while they are being sent.
+	deliverLease = 2 * time.Minute
+)
+
+// WebhookDeliverer sends pending webhook deliveries and records each attempt.
+// Failed attempts are retried with backoff until the retry policy gives up.
+type WebhookDeliverer struct {
+	repo   port.WebhookRepository
+	sender port.WebhookSender
+	policy event.RetryPolicy
+}
+
+var _ port.WebhookDeliveryInputPort = (*WebhookDeliverer)(nil)
+
+// NewWebhookDeliverer creates WebhookDeliverer.
+func NewWebhookDeliverer(repo port.WebhookRepository, sender port.WebhookSender, policy event.RetryPolicy) *WebhookDeliverer {
+	return &WebhookDeliverer{repo: repo, sender: sender, policy: policy}
+}
+
+// DeliverPending sends one batch of due deliveries and returns how many were claimed.
+// Receiver failures are recorded on the delivery; only repository errors are returned.
+func (d *WebhookDeliverer) DeliverPending(ctx context.Context) (int, error) {
+	deliveries, err := d.repo.ClaimDeliveries(ctx, deliverBatchSize, deliverLease)
+	if err != nil {
+		return 0, err
+	}
+	subs := map[string]*webhook.Subscription{}
+	for _, delivery := range deliveries {
+		sub, ok := subs[delivery.SubscriptionID]
+		if !ok {
+			sub, err = d.repo.Get(ctx, delivery.SubscriptionID)
+			if err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+				return len(deliveries), err
+			}
+			subs[delivery.SubscriptionID] = sub
+		}
+		if err := d.deliver(ctx, sub, delivery); err != nil {
+			return len(deliveries), err
+		}
+	}
+	return len(deliveries), nil
+}
+
+func (d *WebhookDeliverer) deliver(ctx context.Context, sub *webhook.Subscription, delivery webhook.Delivery) error {
+	if sub == nil || !sub.Active {
+		return d.repo.RecordAttempt(ctx, delivery.ID, webhook.Attempt{Status: webhook.DeliveryFailed, Error: "subscription inactive"}, 0)
+	}
+	res, sendErr := d.sender.Send(ctx, sub.URL, webhook.Headers(*sub, delivery, time.Now()), delivery.Payload)
+	attempt, retryIn := webhook.NextAttempt(res, sendErr, delivery.Attempts, d.policy)
+	return d.repo.RecordAttempt(ctx, delivery.ID, attempt, retryIn)
+}
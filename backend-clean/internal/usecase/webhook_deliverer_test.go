@@ -0,0 +1,105 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookDeliverer_DeliverPending(t *testing.T) {
+	policy := event.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	sub := &webhook.Subscription{ID: "wh-1", URL: "https://example.com/hook", Secret: "0123456789abcdef", Active: true}
+	pending := webhook.Delivery{ID: "dl-1", SubscriptionID: "wh-1", EventName: event.NoteCreated, Payload: []byte(`{}`), Attempts: 1}
+	tests := []struct {
+		name        string
+		deliveries  []webhook.Delivery
+		claimErr    error
+		sub         *webhook.Subscription
+		getErr      error
+		response    webhook.Response
+		sendErr     error
+		wantAttempt webhook.Attempt
+		wantRetryIn time.Duration
+		wantSend    bool
+		wantCount   int
+		wantErr     bool
+	}{
+		{
+			name:        "[Success] delivered",
+			deliveries:  []webhook.Delivery{pending},
+			sub:         sub,
+			response:    webhook.Response{StatusCode: http.StatusOK, Latency: time.Millisecond},
+			wantAttempt: webhook.Attempt{Status: webhook.DeliverySucceeded, ResponseStatus: http.StatusOK, Latency: time.Millisecond},
+			wantSend:    true,
+			wantCount:   1,
+		},
+		{
+			name:        "[Success] receiver error is retried",
+			deliveries:  []webhook.Delivery{pending},
+			sub:         sub,
+			response:    webhook.Response{StatusCode: http.StatusBadGateway},
+			wantAttempt: webhook.Attempt{Status: webhook.DeliveryPending, ResponseStatus: http.StatusBadGateway, Error: "unexpected response status 502"},
+			wantRetryIn: time.Second,
+			wantSend:    true,
+			wantCount:   1,
+		},
+		{
+			name:        "[Success] gives up after max attempts",
+			deliveries:  []webhook.Delivery{{ID: "dl-1", SubscriptionID: "wh-1", Attempts: 3}},
+			sub:         sub,
+			sendErr:     errors.New("connection refused"),
+			wantAttempt: webhook.Attempt{Status: webhook.DeliveryFailed, Error: "connection refused"},
+			wantSend:    true,
+			wantCount:   1,
+		},
+		{
+			name:        "[Success] deleted subscription fails delivery",
+			deliveries:  []webhook.Delivery{pending},
+			getErr:      domainerr.ErrNotFound,
+			wantAttempt: webhook.Attempt{Status: webhook.DeliveryFailed, Error: "subscription inactive"},
+			wantCount:   1,
+		},
+		{name: "[Success] nothing due", wantCount: 0},
+		{name: "[Fail] claim error", claimErr: errors.New("db down"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			sender := mockusecase.NewMockWebhookSender(ctrl)
+			repo.EXPECT().ClaimDeliveries(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.deliveries, tt.claimErr)
+			for range tt.deliveries {
+				repo.EXPECT().Get(gomock.Any(), "wh-1").Return(tt.sub, tt.getErr)
+				repo.EXPECT().RecordAttempt(gomock.Any(), "dl-1", tt.wantAttempt, tt.wantRetryIn).Return(nil)
+			}
+			sender.EXPECT().Send(gomock.Any(), sub.URL, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, headers map[string]string, body []byte) (webhook.Response, error) {
+					if headers[webhook.DeliveryHeader] != "dl-1" || headers[webhook.SignatureHeader] == "" {
+						t.Fatalf("unexpected headers: %v", headers)
+					}
+					return tt.response, tt.sendErr
+				}).Times(b2i(tt.wantSend))
+
+			got, err := uc.NewWebhookDeliverer(repo, sender, policy).DeliverPending(context.Background())
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.wantCount {
+				t.Fatalf("count = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookEventHandler turns domain events into pending deliveries for matching subscriptions.
+// Enqueueing is idempotent per subscription and event, so redispatched events are not sent twice.
+type WebhookEventHandler struct {
+	repo port.WebhookRepository
+}
+
+var _ port.EventHandler = (*WebhookEventHandler)(nil)
+
+// NewWebhookEventHandler creates WebhookEventHandler.
+func NewWebhookEventHandler(repo port.WebhookRepository) *WebhookEventHandler {
+	return &WebhookEventHandler{repo: repo}
+}
+
+// Handle enqueues a delivery of the event to every subscription of its owner that matches it.
+func (h *WebhookEventHandler) Handle(ctx context.Context, e event.Event) error {
+	ownerID := e.Data["ownerId"]
+	if ownerID == "" {
+		return nil
+	}
+	subs, err := h.repo.ListByOwner(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		if !sub.Matches(e) {
+			continue
+		}
+		d, err := webhook.NewDelivery(sub, e)
+		if err != nil {
+			return err
+		}
+		if err := h.repo.Enqueue(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookEventHandler_Handle(t *testing.T) {
+	created := event.Event{ID: "ev-1", Name: event.NoteCreated, AggregateID: "note-1", Data: map[string]string{"ownerId": "owner-1"}}
+	subs := []webhook.Subscription{
+		{ID: "wh-all", OwnerID: "owner-1", Active: true},
+		{ID: "wh-templates", OwnerID: "owner-1", Active: true, Events: []event.Name{event.TemplateChanged}},
+		{ID: "wh-paused", OwnerID: "owner-1"},
+	}
+	tests := []struct {
+		name        string
+		event       event.Event
+		listErr     error
+		enqueueErr  error
+		wantEnqueue int
+		wantErr     bool
+	}{
+		{name: "[Success] enqueue for matching subscriptions", event: created, wantEnqueue: 1},
+		{name: "[Success] event without owner is ignored", event: event.Event{ID: "ev-2", Name: event.NoteCreated}},
+		{name: "[Fail] list error", event: created, listErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] enqueue error", event: created, enqueueErr: errors.New("db error"), wantEnqueue: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			hasOwner := tt.event.Data["ownerId"] != ""
+			repo.EXPECT().ListByOwner(gomock.Any(), "owner-1").Return(subs, tt.listErr).Times(b2i(hasOwner))
+			repo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d webhook.Delivery) error {
+				if d.SubscriptionID != "wh-all" || d.EventID != "ev-1" || d.Status != webhook.DeliveryPending {
+					t.Fatalf("unexpected delivery: %+v", d)
+				}
+				return tt.enqueueErr
+			}).Times(tt.wantEnqueue)
+
+			err := uc.NewWebhookEventHandler(repo).Handle(context.Background(), tt.event)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// deliveryHistoryLimit caps the deliveries listed for a subscription.
+const deliveryHistoryLimit = 50
+
+// WebhookInteractor handles webhook subscription use cases.
+type WebhookInteractor struct {
+	repo   port.WebhookRepository
+	output port.WebhookOutputPort
+}
+
+var _ port.WebhookInputPort = (*WebhookInteractor)(nil)
+
+// NewWebhookInteractor creates WebhookInteractor.
+func NewWebhookInteractor(repo port.WebhookRepository, output port.WebhookOutputPort) *WebhookInteractor {
+	return &WebhookInteractor{repo: repo, output: output}
+}
+
+// List returns the subscriptions of an account.
+func (u *WebhookInteractor) List(ctx context.Context, ownerID string) error {
+	subs, err := u.repo.ListByOwner(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhookList(ctx, subs)
+}
+
+// Get returns a subscription owned by the account.
+func (u *WebhookInteractor) Get(ctx context.Context, id, ownerID string) error {
+	sub, err := u.owned(ctx, id, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, sub)
+}
+
+// Create subscribes a URL to events; new subscriptions are active.
+func (u *WebhookInteractor) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	sub := webhook.Subscription{
+		OwnerID: input.OwnerID,
+		URL:     input.URL,
+		Secret:  input.Secret,
+		Events:  input.Events,
+		Active:  true,
+	}
+	if err := webhook.ValidateSubscription(sub); err != nil {
+		return err
+	}
+	created, err := u.repo.Create(ctx, sub)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, created)
+}
+
+// Update replaces URL, event filter and state of a subscription, and its secret when one is given.
+func (u *WebhookInteractor) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	sub, err := u.owned(ctx, input.ID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	sub.URL = input.URL
+	sub.Events = input.Events
+	sub.Active = input.Active
+	if input.Secret != "" {
+		sub.Secret = input.Secret
+	}
+	if err := webhook.ValidateSubscription(*sub); err != nil {
+		return err
+	}
+	updated, err := u.repo.Update(ctx, *sub)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, updated)
+}
+
+// Delete removes a subscription and its delivery history.
+func (u *WebhookInteractor) Delete(ctx context.Context, id, ownerID string) error {
+	if _, err := u.owned(ctx, id, ownerID); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return u.output.PresentWebhookDeleted(ctx)
+}
+
+// ListDeliveries returns the latest deliveries of a subscription.
+func (u *WebhookInteractor) ListDeliveries(ctx context.Context, id, ownerID string) error {
+	if _, err := u.owned(ctx, id, ownerID); err != nil {
+		return err
+	}
+	deliveries, err := u.repo.ListDeliveries(ctx, id, deliveryHistoryLimit)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhookDeliveries(ctx, deliveries)
+}
+
+// Redeliver sends the payload of an earlier delivery again as a new delivery.
+func (u *WebhookInteractor) Redeliver(ctx context.Context, id, deliveryID, ownerID string) error {
+	if _, err := u.owned(ctx, id, ownerID); err != nil {
+		return err
+	}
+	d, err := u.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	if d.SubscriptionID != id {
+		return domainerr.ErrNotFound
+	}
+	created, err := u.repo.CreateDelivery(ctx, webhook.NewRedelivery(*d))
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhookDelivery(ctx, created)
+}
+
+func (u *WebhookInteractor) owned(ctx context.Context, id, ownerID string) (*webhook.Subscription, error) {
+	sub, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := webhook.ValidateOwnership(sub.OwnerID, ownerID); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookInteractor_Create(t *testing.T) {
+	valid := port.WebhookCreateInput{OwnerID: "owner-1", URL: "https://example.com/hook", Secret: "0123456789abcdef", Events: []event.Name{event.NoteCreated}}
+	tests := []struct {
+		name      string
+		input     port.WebhookCreateInput
+		createErr error
+		wantErr   error
+	}{
+		{name: "[Success] create active subscription", input: valid},
+		{name: "[Fail] invalid url", input: port.WebhookCreateInput{OwnerID: "owner-1", URL: "ftp://example.com", Secret: valid.Secret}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] short secret", input: port.WebhookCreateInput{OwnerID: "owner-1", URL: valid.URL, Secret: "short"}, wantErr: domainerr.ErrWebhookSecretTooShort},
+		{name: "[Fail] repo error", input: valid, createErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+			valid := tt.wantErr == nil || tt.createErr != nil
+			created := &webhook.Subscription{ID: "wh-1", OwnerID: tt.input.OwnerID, Active: true}
+			repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+				if !sub.Active {
+					t.Fatalf("new subscription must be active")
+				}
+				return created, tt.createErr
+			}).Times(b2i(valid))
+			out.EXPECT().PresentWebhook(gomock.Any(), created).Return(nil).Times(b2i(tt.wantErr == nil))
+
+			err := uc.NewWebhookInteractor(repo, out).Create(context.Background(), tt.input)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWebhookInteractor_Update(t *testing.T) {
+	current := &webhook.Subscription{ID: "wh-1", OwnerID: "owner-1", URL: "https://example.com/old", Secret: "0123456789abcdef", Active: true}
+	tests := []struct {
+		name       string
+		input      port.WebhookUpdateInput
+		wantSecret string
+		wantErr    error
+	}{
+		{
+			name:       "[Success] keep secret when omitted",
+			input:      port.WebhookUpdateInput{ID: "wh-1", OwnerID: "owner-1", URL: "https://example.com/new", Active: false},
+			wantSecret: current.Secret,
+		},
+		{
+			name:       "[Success] rotate secret",
+			input:      port.WebhookUpdateInput{ID: "wh-1", OwnerID: "owner-1", URL: "https://example.com/new", Secret: "fedcba9876543210", Active: true},
+			wantSecret: "fedcba9876543210",
+		},
+		{name: "[Fail] not owner", input: port.WebhookUpdateInput{ID: "wh-1", OwnerID: "other", URL: current.URL}, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] unknown event", input: port.WebhookUpdateInput{ID: "wh-1", OwnerID: "owner-1", URL: current.URL, Events: []event.Name{"account.registered"}}, wantErr: domainerr.ErrInvalidWebhookEvent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+			cp := *current
+			repo.EXPECT().Get(gomock.Any(), "wh-1").Return(&cp, nil)
+			repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+				if sub.Secret != tt.wantSecret || sub.URL != tt.input.URL || sub.Active != tt.input.Active {
+					t.Fatalf("unexpected update: %+v", sub)
+				}
+				return &sub, nil
+			}).Times(b2i(tt.wantErr == nil))
+			out.EXPECT().PresentWebhook(gomock.Any(), gomock.Any()).Return(nil).Times(b2i(tt.wantErr == nil))
+
+			err := uc.NewWebhookInteractor(repo, out).Update(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWebhookInteractor_Redeliver(t *testing.T) {
+	sub := &webhook.Subscription{ID: "wh-1", OwnerID: "owner-1"}
+	original := &webhook.Delivery{ID: "dl-1", SubscriptionID: "wh-1", EventID: "ev-1", EventName: event.NoteCreated, Payload: []byte(`{}`), Status: webhook.DeliveryFailed}
+	tests := []struct {
+		name     string
+		ownerID  string
+		delivery *webhook.Delivery
+		getErr   error
+		wantErr  error
+	}{
+		{name: "[Success] redeliver", ownerID: "owner-1", delivery: original},
+		{name: "[Fail] not owner", ownerID: "other", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] delivery of another subscription", ownerID: "owner-1", delivery: &webhook.Delivery{ID: "dl-2", SubscriptionID: "wh-2"}, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] delivery not found", ownerID: "owner-1", getErr: domainerr.ErrNotFound, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+			repo.EXPECT().Get(gomock.Any(), "wh-1").Return(sub, nil)
+			repo.EXPECT().GetDelivery(gomock.Any(), "dl-1").Return(tt.delivery, tt.getErr).Times(b2i(tt.ownerID == sub.OwnerID))
+			repo.EXPECT().CreateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+				if d.RedeliveryOf != "dl-1" || d.Status != webhook.DeliveryPending || string(d.Payload) != "{}" {
+					t.Fatalf("unexpected redelivery: %+v", d)
+				}
+				d.ID = "dl-3"
+				return &d, nil
+			}).Times(b2i(tt.wantErr == nil))
+			out.EXPECT().PresentWebhookDelivery(gomock.Any(), gomock.Any()).Return(nil).Times(b2i(tt.wantErr == nil))
+
+			err := uc.NewWebhookInteractor(repo, out).Redeliver(context.Background(), "wh-1", "dl-1", tt.ownerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
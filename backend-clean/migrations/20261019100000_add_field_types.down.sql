@@ -0,0 +1,3 @@
+ALTER TABLE fields
+    DROP COLUMN IF EXISTS options,
+    DROP COLUMN IF EXISTS type;
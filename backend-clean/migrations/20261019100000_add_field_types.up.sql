@@ -0,0 +1,4 @@
+ALTER TABLE fields
+    ADD COLUMN type TEXT NOT NULL DEFAULT 'text'
+        CHECK (type IN ('text', 'markdown', 'url', 'number', 'date', 'select', 'checklist')),
+    ADD COLUMN options JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,6 @@
+ALTER TABLE fields
+    DROP COLUMN IF EXISTS help_text,
+    DROP COLUMN IF EXISTS placeholder,
+    DROP COLUMN IF EXISTS pattern,
+    DROP COLUMN IF EXISTS max_length,
+    DROP COLUMN IF EXISTS min_length;
@@ -0,0 +1,6 @@
+ALTER TABLE fields
+    ADD COLUMN min_length INT CHECK (min_length >= 0),
+    ADD COLUMN max_length INT CHECK (max_length >= 0),
+    ADD COLUMN pattern TEXT NOT NULL DEFAULT '',
+    ADD COLUMN placeholder TEXT NOT NULL DEFAULT '',
+    ADD COLUMN help_text TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE fields
+    DROP CONSTRAINT fields_unique_order,
+    ADD CONSTRAINT fields_unique_order UNIQUE (template_id, "order");
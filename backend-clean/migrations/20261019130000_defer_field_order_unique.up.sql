@@ -0,0 +1,4 @@
+-- Template updates renumber kept fields in place; check order uniqueness at commit.
+ALTER TABLE fields
+    DROP CONSTRAINT fields_unique_order,
+    ADD CONSTRAINT fields_unique_order UNIQUE (template_id, "order") DEFERRABLE INITIALLY DEFERRED;
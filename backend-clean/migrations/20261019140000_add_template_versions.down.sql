@@ -0,0 +1,14 @@
+-- Only reversible while every template still has a single version.
+DROP INDEX IF EXISTS idx_fields_template_version;
+CREATE INDEX idx_fields_template_id ON fields(template_id);
+
+ALTER TABLE notes
+    DROP COLUMN template_version;
+
+ALTER TABLE fields
+    DROP CONSTRAINT fields_unique_order,
+    DROP COLUMN version,
+    ADD CONSTRAINT fields_unique_order UNIQUE (template_id, "order") DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE templates
+    DROP COLUMN version;
@@ -0,0 +1,15 @@
+-- Every template update writes a new immutable field set under the next version;
+-- notes stay pinned to the version they were written against.
+ALTER TABLE templates
+    ADD COLUMN version INT NOT NULL DEFAULT 1 CHECK (version > 0);
+
+ALTER TABLE fields
+    ADD COLUMN version INT NOT NULL DEFAULT 1 CHECK (version > 0),
+    DROP CONSTRAINT fields_unique_order,
+    ADD CONSTRAINT fields_unique_order UNIQUE (template_id, version, "order");
+
+ALTER TABLE notes
+    ADD COLUMN template_version INT NOT NULL DEFAULT 1 CHECK (template_version > 0);
+
+DROP INDEX IF EXISTS idx_fields_template_id;
+CREATE INDEX idx_fields_template_version ON fields(template_id, version);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_templates_forked_from_id;
+
+ALTER TABLE templates
+    DROP COLUMN IF EXISTS forked_from_id;
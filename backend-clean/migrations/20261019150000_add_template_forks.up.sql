@@ -0,0 +1,5 @@
+-- A forked template remembers its source; deleting the source keeps the fork.
+ALTER TABLE templates
+    ADD COLUMN forked_from_id UUID REFERENCES templates(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_templates_forked_from_id ON templates(forked_from_id);
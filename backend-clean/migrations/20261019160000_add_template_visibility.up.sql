@@ -0,0 +1,4 @@
+-- Existing templates stay public, matching the behaviour before visibility existed.
+ALTER TABLE templates
+    ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('private', 'unlisted', 'public'));
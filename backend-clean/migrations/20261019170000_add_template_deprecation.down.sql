@@ -0,0 +1,3 @@
+ALTER TABLE templates
+    DROP COLUMN IF EXISTS successor_id,
+    DROP COLUMN IF EXISTS deprecated_at;
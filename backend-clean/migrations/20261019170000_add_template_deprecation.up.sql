@@ -0,0 +1,4 @@
+-- A deprecated template keeps its notes but cannot be used for new ones.
+ALTER TABLE templates
+    ADD COLUMN deprecated_at TIMESTAMPTZ,
+    ADD COLUMN successor_id UUID REFERENCES templates(id) ON DELETE SET NULL;
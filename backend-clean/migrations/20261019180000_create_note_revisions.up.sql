@@ -0,0 +1,12 @@
+-- template_id keeps no foreign key: a revision outlives the template the note moved away from.
+CREATE TABLE note_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    template_id UUID NOT NULL,
+    template_version INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    sections JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_note_revisions_note_id ON note_revisions(note_id);
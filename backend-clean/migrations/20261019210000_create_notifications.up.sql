@@ -0,0 +1,24 @@
+-- Notifications tell an account about events on its notes and templates caused by others.
+-- event_id keeps one notification per event and kind since the outbox delivers at least once.
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    recipient_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    actor_id UUID REFERENCES accounts(id) ON DELETE SET NULL,
+    subject_id UUID NOT NULL,
+    subject_title TEXT NOT NULL,
+    event_id UUID NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_notifications_event ON notifications(recipient_id, event_id, kind);
+CREATE INDEX idx_notifications_recipient ON notifications(recipient_id, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications(recipient_id) WHERE read_at IS NULL;
+
+-- Kinds an account muted; accounts without a row receive every kind.
+CREATE TABLE notification_preferences (
+    account_id UUID PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    muted_kinds TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
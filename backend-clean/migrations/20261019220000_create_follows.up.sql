@@ -0,0 +1,23 @@
+-- Accounts follow other accounts to see their activity in their feed.
+CREATE TABLE follows (
+    follower_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT follows_not_self CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX idx_follows_followee ON follows(followee_id);
+
+-- Feed items hold the latest public activity on each note and template, recorded from outbox events.
+-- A note or template appears once, at its latest activity; items of followed authors form the feed.
+CREATE TABLE feed_items (
+    subject_id UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    author_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    template_id UUID,
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_feed_items_author ON feed_items(author_id, occurred_at DESC, subject_id DESC);
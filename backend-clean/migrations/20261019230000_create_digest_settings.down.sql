@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_notes_owner_drafts;
+DROP TABLE IF EXISTS digest_settings;
@@ -0,0 +1,10 @@
+-- Digest settings hold how often an account receives the email digest and when the last one went out.
+-- Accounts without a row receive the default weekly digest.
+CREATE TABLE digest_settings (
+    account_id UUID PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    frequency TEXT NOT NULL CHECK (frequency IN ('off', 'daily', 'weekly')),
+    last_sent_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notes_owner_drafts ON notes(owner_id, updated_at) WHERE status = 'Draft';